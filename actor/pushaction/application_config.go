@@ -13,19 +13,39 @@ type ApplicationConfig struct {
 	CurrentRoutes []v2action.Route
 	DesiredRoutes []v2action.Route
 
+	CurrentServices map[string]v2action.ServiceInstance
+	DesiredServices map[string]v2action.ServiceInstance
+
+	AllResources       []v2action.Resource
+	MatchedResources   []v2action.Resource
+	UnmatchedResources []v2action.Resource
+
+	DependsOn         []string
+	NoRoute           bool
+	Strategy          Strategy
 	TargetedSpaceGUID string
 	Path              string
+	IgnoreFile        string
+
+	// Archive is true when Path is a zip archive rather than a directory.
+	Archive bool
 }
 
-func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
+// ConvertToApplicationConfig converts the manifest applications into the
+// configurations that are applied to the space. On a dry run the
+// application's files are not gathered or matched, since nothing is uploaded.
+func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]ApplicationConfig, Warnings, error) {
 	var configs []ApplicationConfig
 	var warnings Warnings
 
 	log.Infof("iterating through %d app configuration(s)", len(apps))
 	for _, app := range apps {
 		config := ApplicationConfig{
+			DependsOn:         app.DependsOn,
 			TargetedSpaceGUID: spaceGUID,
 			Path:              app.Path,
+			IgnoreFile:        app.IgnoreFile,
+			NoRoute:           app.NoRoute,
 		}
 
 		log.Infoln("searching for app", app.Name)
@@ -57,13 +77,39 @@ func (actor Actor) ConvertToApplicationConfig(orgGUID string, spaceGUID string,
 			config.DesiredApplication.SpaceGUID = spaceGUID
 		}
 
-		defaultRoute, routeWarnings, err := actor.GetRouteWithDefaultDomain(app.Name, orgGUID, spaceGUID)
+		var propertyWarnings Warnings
+		config.DesiredApplication, propertyWarnings, err = actor.overrideApplicationProperties(config.DesiredApplication, app)
+		warnings = append(warnings, propertyWarnings...)
+		if err != nil {
+			log.Errorln("applying manifest properties:", err)
+			return nil, warnings, err
+		}
+
+		var routeWarnings Warnings
+		config.DesiredRoutes, routeWarnings, err = actor.CalculateRoutes(app, orgGUID, spaceGUID)
 		warnings = append(warnings, routeWarnings...)
 		if err != nil {
-			log.Errorln("getting default route:", err)
+			log.Errorln("calculating routes:", err)
 			return nil, warnings, err
 		}
-		config.DesiredRoutes = []v2action.Route{defaultRoute}
+
+		var serviceWarnings Warnings
+		config, serviceWarnings, err = actor.configureServices(config, app.Services, spaceGUID)
+		warnings = append(warnings, serviceWarnings...)
+		if err != nil {
+			log.Errorln("configuring services:", err)
+			return nil, warnings, err
+		}
+
+		if !dryRun {
+			var resourceWarnings Warnings
+			config, resourceWarnings, err = actor.configureResources(config)
+			warnings = append(warnings, resourceWarnings...)
+			if err != nil {
+				log.Errorln("configuring resources:", err)
+				return nil, warnings, err
+			}
+		}
 
 		configs = append(configs, config)
 	}
@@ -79,3 +125,101 @@ func (actor Actor) FindOrReturnPartialApp(appName string, spaceGUID string) (boo
 	}
 	return true, foundApp, v2Warnings, err
 }
+
+// overrideApplicationProperties sets the properties provided by the manifest
+// on the desired application, leaving unset properties unchanged.
+func (actor Actor) overrideApplicationProperties(application v2action.Application, app manifest.Application) (v2action.Application, Warnings, error) {
+	if app.Buildpack.IsSet {
+		application.Buildpack = app.Buildpack
+	}
+	if app.Command.IsSet {
+		application.Command = app.Command
+	}
+	if app.DiskQuota != 0 {
+		application.DiskQuota = int(app.DiskQuota)
+	}
+	if app.DockerImage != "" {
+		application.DockerImage = app.DockerImage
+	}
+	if app.HealthCheckHTTPEndpoint != "" {
+		application.HealthCheckHTTPEndpoint = app.HealthCheckHTTPEndpoint
+	}
+	if app.HealthCheckTimeout != 0 {
+		application.HealthCheckTimeout = app.HealthCheckTimeout
+	}
+	if app.HealthCheckType != "" {
+		application.HealthCheckType = app.HealthCheckType
+	}
+	if app.Instances.IsSet {
+		application.Instances = app.Instances
+	}
+	if app.Memory != 0 {
+		application.Memory = int(app.Memory)
+	}
+
+	if len(app.EnvironmentVariables) > 0 {
+		env := map[string]interface{}{}
+		for name, value := range application.EnvironmentVariables {
+			env[name] = value
+		}
+		for name, value := range app.EnvironmentVariables {
+			env[name] = value
+		}
+		application.EnvironmentVariables = env
+	}
+
+	if app.StackName != "" {
+		log.Infoln("looking up stack", app.StackName)
+		stack, warnings, err := actor.V2Actor.GetStackByName(app.StackName)
+		if err != nil {
+			log.Errorln("stack lookup:", err)
+			return v2action.Application{}, Warnings(warnings), err
+		}
+		application.StackGUID = stack.GUID
+		return application, Warnings(warnings), nil
+	}
+
+	return application, nil, nil
+}
+
+// configureServices looks up the service instances listed in the manifest and
+// records which of them are already bound to the application.
+func (actor Actor) configureServices(config ApplicationConfig, serviceNames []string, spaceGUID string) (ApplicationConfig, Warnings, error) {
+	var warnings Warnings
+
+	for _, serviceName := range serviceNames {
+		log.Infoln("looking up service instance", serviceName)
+		serviceInstance, serviceWarnings, err := actor.V2Actor.GetServiceInstanceByNameAndSpace(serviceName, spaceGUID)
+		warnings = append(warnings, serviceWarnings...)
+		if err != nil {
+			log.Errorln("service instance lookup:", err)
+			return ApplicationConfig{}, warnings, err
+		}
+
+		if config.DesiredServices == nil {
+			config.DesiredServices = map[string]v2action.ServiceInstance{}
+		}
+		config.DesiredServices[serviceName] = serviceInstance
+
+		if config.CurrentApplication.GUID == "" {
+			continue
+		}
+
+		_, bindingWarnings, err := actor.V2Actor.GetServiceBindingByApplicationAndServiceInstance(config.CurrentApplication.GUID, serviceInstance.GUID)
+		warnings = append(warnings, bindingWarnings...)
+		if _, ok := err.(v2action.ServiceBindingNotFoundError); ok {
+			log.Debugf("service instance %s is not bound to app", serviceName)
+			continue
+		} else if err != nil {
+			log.Errorln("service binding lookup:", err)
+			return ApplicationConfig{}, warnings, err
+		}
+
+		if config.CurrentServices == nil {
+			config.CurrentServices = map[string]v2action.ServiceInstance{}
+		}
+		config.CurrentServices[serviceName] = serviceInstance
+	}
+
+	return config, warnings, nil
+}
@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -20,7 +21,7 @@ var _ = Describe("Application Config", func() {
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
 	})
 
 	Describe("ConvertToApplicationConfig", func() {
@@ -31,6 +32,8 @@ var _ = Describe("Application Config", func() {
 			domain       v2action.Domain
 			manifestApps []manifest.Application
 
+			dryRun bool
+
 			configs    []ApplicationConfig
 			warnings   Warnings
 			executeErr error
@@ -42,6 +45,7 @@ var _ = Describe("Application Config", func() {
 			appName = "some-app"
 			orgGUID = "some-org-guid"
 			spaceGUID = "some-space-guid"
+			dryRun = false
 			manifestApps = []manifest.Application{{
 				Name: appName,
 				Path: "some-path",
@@ -60,7 +64,7 @@ var _ = Describe("Application Config", func() {
 		})
 
 		JustBeforeEach(func() {
-			configs, warnings, executeErr = actor.ConvertToApplicationConfig(orgGUID, spaceGUID, manifestApps)
+			configs, warnings, executeErr = actor.ConvertToApplicationConfig(orgGUID, spaceGUID, dryRun, manifestApps)
 			if len(configs) > 0 {
 				firstConfig = configs[0]
 			}
@@ -196,5 +200,279 @@ var _ = Describe("Application Config", func() {
 				Expect(warnings).To(ConsistOf("private-domain-warnings", "shared-domain-warnings", "get-route-warnings"))
 			})
 		})
+
+		Context("when the manifest sets application properties", func() {
+			BeforeEach(func() {
+				manifestApps[0].Buildpack = types.FilteredString{IsSet: true, Value: "some-buildpack"}
+				manifestApps[0].Command = types.FilteredString{IsSet: true}
+				manifestApps[0].DiskQuota = 1024
+				manifestApps[0].DockerImage = "some-docker-image"
+				manifestApps[0].EnvironmentVariables = map[string]string{"ENV_1": "manifest-value"}
+				manifestApps[0].HealthCheckHTTPEndpoint = "/health"
+				manifestApps[0].HealthCheckTimeout = 120
+				manifestApps[0].HealthCheckType = "http"
+				manifestApps[0].Instances = types.NullInt{IsSet: true, Value: 3}
+				manifestApps[0].Memory = 256
+
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{
+					Name:                 appName,
+					GUID:                 "some-app-guid",
+					SpaceGUID:            spaceGUID,
+					Memory:               64,
+					EnvironmentVariables: map[string]interface{}{"ENV_1": "old-value", "ENV_2": float64(2)},
+				}, nil, nil)
+			})
+
+			It("overrides the existing properties on the desired application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.DesiredApplication).To(Equal(v2action.Application{
+					Buildpack:               types.FilteredString{IsSet: true, Value: "some-buildpack"},
+					Command:                 types.FilteredString{IsSet: true},
+					DiskQuota:               1024,
+					DockerImage:             "some-docker-image",
+					EnvironmentVariables:    map[string]interface{}{"ENV_1": "manifest-value", "ENV_2": float64(2)},
+					GUID:                    "some-app-guid",
+					HealthCheckHTTPEndpoint: "/health",
+					HealthCheckTimeout:      120,
+					HealthCheckType:         "http",
+					Instances:               types.NullInt{IsSet: true, Value: 3},
+					Memory:                  256,
+					Name:                    appName,
+					SpaceGUID:               spaceGUID,
+				}))
+				Expect(firstConfig.CurrentApplication.Memory).To(Equal(64))
+			})
+		})
+
+		Context("when the manifest sets a stack", func() {
+			BeforeEach(func() {
+				manifestApps[0].StackName = "some-stack"
+			})
+
+			Context("when the stack exists", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetStackByNameReturns(v2action.Stack{GUID: "some-stack-guid", Name: "some-stack"}, v2action.Warnings{"some-stack-warning"}, nil)
+				})
+
+				It("sets the stack GUID on the desired application", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("some-stack-warning"))
+					Expect(firstConfig.DesiredApplication.StackGUID).To(Equal("some-stack-guid"))
+
+					Expect(fakeV2Actor.GetStackByNameCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.GetStackByNameArgsForCall(0)).To(Equal("some-stack"))
+				})
+			})
+
+			Context("when the stack lookup errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = v2action.StackNotFoundError{Name: "some-stack"}
+					fakeV2Actor.GetStackByNameReturns(v2action.Stack{}, v2action.Warnings{"some-stack-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("some-stack-warning"))
+				})
+			})
+		})
+
+		Context("when the manifest lists services", func() {
+			BeforeEach(func() {
+				manifestApps[0].Services = []string{"service-1", "service-2"}
+
+				fakeV2Actor.GetServiceInstanceByNameAndSpaceStub = func(name string, _ string) (v2action.ServiceInstance, v2action.Warnings, error) {
+					return v2action.ServiceInstance{Name: name, GUID: name + "-guid"}, v2action.Warnings{"service-instance-warning"}, nil
+				}
+			})
+
+			Context("when the application exists", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{Name: appName, GUID: "some-app-guid"}, nil, nil)
+					fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceStub = func(_ string, serviceInstanceGUID string) (v2action.ServiceBinding, v2action.Warnings, error) {
+						if serviceInstanceGUID == "service-1-guid" {
+							return v2action.ServiceBinding{GUID: "some-binding-guid"}, v2action.Warnings{"service-binding-warning"}, nil
+						}
+						return v2action.ServiceBinding{}, v2action.Warnings{"service-binding-warning"}, v2action.ServiceBindingNotFoundError{}
+					}
+				})
+
+				It("sets the current and desired services", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("service-instance-warning"))
+					Expect(warnings).To(ContainElement("service-binding-warning"))
+
+					Expect(firstConfig.DesiredServices).To(Equal(map[string]v2action.ServiceInstance{
+						"service-1": {Name: "service-1", GUID: "service-1-guid"},
+						"service-2": {Name: "service-2", GUID: "service-2-guid"},
+					}))
+					Expect(firstConfig.CurrentServices).To(Equal(map[string]v2action.ServiceInstance{
+						"service-1": {Name: "service-1", GUID: "service-1-guid"},
+					}))
+
+					Expect(fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceCallCount()).To(Equal(2))
+					appGUID, _ := fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceArgsForCall(0)
+					Expect(appGUID).To(Equal("some-app-guid"))
+				})
+			})
+
+			Context("when the application does not exist", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{})
+				})
+
+				It("only sets the desired services", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(firstConfig.DesiredServices).To(HaveLen(2))
+					Expect(firstConfig.CurrentServices).To(BeEmpty())
+					Expect(fakeV2Actor.GetServiceBindingByApplicationAndServiceInstanceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a service instance cannot be found", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = v2action.ServiceInstanceNotFoundError{Name: "service-1"}
+					fakeV2Actor.GetServiceInstanceByNameAndSpaceStub = nil
+					fakeV2Actor.GetServiceInstanceByNameAndSpaceReturns(v2action.ServiceInstance{}, v2action.Warnings{"service-instance-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("service-instance-warning"))
+				})
+			})
+		})
+
+		Context("when the manifest sets no-route", func() {
+			BeforeEach(func() {
+				manifestApps[0].NoRoute = true
+			})
+
+			It("does not set any desired routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.NoRoute).To(BeTrue())
+				Expect(firstConfig.DesiredRoutes).To(BeEmpty())
+				Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the manifest declares dependencies", func() {
+			BeforeEach(func() {
+				manifestApps[0].DependsOn = []string{"some-other-app"}
+			})
+
+			It("sets the dependencies on the config", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(firstConfig.DependsOn).To(Equal([]string{"some-other-app"}))
+			})
+		})
+
+		Context("when the application path has files", func() {
+			var resources []v2action.Resource
+
+			BeforeEach(func() {
+				manifestApps[0].IgnoreFile = "some-ignore-file"
+				resources = []v2action.Resource{
+					{Filename: "some-dir"},
+					{Filename: "some-dir/file-1", SHA1: "some-sha-1", Size: 1},
+					{Filename: "file-2", SHA1: "some-sha-2", Size: 2},
+				}
+				fakeV2Actor.GatherDirectoryResourcesReturns(resources, nil)
+			})
+
+			Context("when matching the resources is successful", func() {
+				BeforeEach(func() {
+					fakeV2Actor.ResourceMatchReturns(resources[2:], resources[:2], v2action.Warnings{"resource-match-warning"}, nil)
+				})
+
+				It("sets the matched and unmatched resources on the config", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(warnings).To(ContainElement("resource-match-warning"))
+
+					Expect(fakeV2Actor.GatherDirectoryResourcesCallCount()).To(Equal(1))
+					path, ignoreFile := fakeV2Actor.GatherDirectoryResourcesArgsForCall(0)
+					Expect(path).To(Equal("some-path"))
+					Expect(ignoreFile).To(Equal("some-ignore-file"))
+					Expect(fakeV2Actor.ResourceMatchArgsForCall(0)).To(Equal(resources))
+
+					Expect(firstConfig.AllResources).To(Equal(resources))
+					Expect(firstConfig.MatchedResources).To(Equal(resources[2:]))
+					Expect(firstConfig.UnmatchedResources).To(Equal(resources[:2]))
+
+					cached, total := firstConfig.CachedFiles()
+					Expect(cached).To(Equal(1))
+					Expect(total).To(Equal(2))
+				})
+			})
+
+			Context("when matching the resources errors", func() {
+				var expectedErr error
+
+				BeforeEach(func() {
+					expectedErr = errors.New("resource match error")
+					fakeV2Actor.ResourceMatchReturns(nil, nil, v2action.Warnings{"resource-match-warning"}, expectedErr)
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError(expectedErr))
+					Expect(warnings).To(ContainElement("resource-match-warning"))
+				})
+			})
+
+			Context("when it is a dry run", func() {
+				BeforeEach(func() {
+					dryRun = true
+				})
+
+				It("does not gather or match the resources", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(fakeV2Actor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+					Expect(fakeV2Actor.ResourceMatchCallCount()).To(Equal(0))
+					Expect(firstConfig.AllResources).To(BeEmpty())
+				})
+			})
+		})
+
+		Context("when the application path is an archive", func() {
+			var resources []v2action.Resource
+
+			BeforeEach(func() {
+				resources = []v2action.Resource{
+					{Filename: "file-1", SHA1: "some-sha-1", Size: 1},
+				}
+				fakeV2Actor.IsArchiveReturns(true)
+				fakeV2Actor.GatherArchiveResourcesReturns(resources, nil)
+				fakeV2Actor.ResourceMatchReturns(nil, resources, nil, nil)
+			})
+
+			It("gathers the resources in the archive", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(fakeV2Actor.IsArchiveArgsForCall(0)).To(Equal("some-path"))
+				Expect(fakeV2Actor.GatherArchiveResourcesCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.GatherArchiveResourcesArgsForCall(0)).To(Equal("some-path"))
+				Expect(fakeV2Actor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+
+				Expect(firstConfig.Archive).To(BeTrue())
+				Expect(firstConfig.AllResources).To(Equal(resources))
+				Expect(firstConfig.UnmatchedResources).To(Equal(resources))
+			})
+		})
+
+		Context("when the application is a docker image", func() {
+			BeforeEach(func() {
+				manifestApps[0].DockerImage = "some-docker-image"
+			})
+
+			It("does not gather resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.GatherDirectoryResourcesCallCount()).To(Equal(0))
+				Expect(firstConfig.AllResources).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -1,6 +1,8 @@
 package pushaction
 
 import (
+	"reflect"
+
 	"code.cloudfoundry.org/cli/actor/v2action"
 	log "github.com/Sirupsen/logrus"
 )
@@ -16,9 +18,15 @@ func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warning
 		defer close(warningsStream)
 		defer close(errorStream)
 
+		if config.Strategy != DefaultStrategy && config.CurrentApplication.GUID != "" {
+			log.Infof("replacing application using %s strategy", config.Strategy)
+			actor.applyWithStrategy(config, eventStream, warningsStream, errorStream)
+			return
+		}
+
 		if config.DesiredApplication.GUID != "" {
 			log.Debugf("updating application: %#v", config.DesiredApplication)
-			app, warnings, err := actor.V2Actor.UpdateApplication(config.DesiredApplication)
+			app, warnings, err := actor.V2Actor.UpdateApplication(applicationUpdate(config))
 			warningsStream <- Warnings(warnings)
 			if err != nil {
 				log.Errorln("updating application:", err)
@@ -68,6 +76,27 @@ func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warning
 			eventStream <- RouteCreated
 		}
 
+		if config.NoRoute {
+			log.Info("unbinding routes")
+			var unboundRoutesMessage bool
+			for _, route := range config.CurrentRoutes {
+				log.Debugf("unbinding route: %#v", route)
+				warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, config.DesiredApplication.GUID)
+				warningsStream <- Warnings(warnings)
+				if err != nil {
+					log.Errorln("unbinding route:", err)
+					errorStream <- err
+					return
+				}
+				unboundRoutesMessage = true
+			}
+			config.CurrentRoutes = nil
+
+			if unboundRoutesMessage {
+				eventStream <- RouteUnbound
+			}
+		}
+
 		log.Info("binding routes")
 		var boundRoutesMessage bool
 		for _, route := range config.DesiredRoutes {
@@ -92,6 +121,38 @@ func (actor Actor) Apply(config ApplicationConfig) (<-chan Event, <-chan Warning
 			eventStream <- RouteBound
 		}
 
+		log.Info("binding services")
+		var boundServicesMessage bool
+		for serviceName, serviceInstance := range config.DesiredServices {
+			if _, ok := config.CurrentServices[serviceName]; ok {
+				log.Debugf("service %s already bound to app", serviceName)
+				continue
+			}
+
+			log.Debugf("binding service: %#v", serviceInstance)
+			warnings, err := actor.V2Actor.BindServiceByApplicationAndServiceInstance(config.DesiredApplication.GUID, serviceInstance.GUID)
+			warningsStream <- Warnings(warnings)
+			if err != nil {
+				log.Errorln("binding service:", err)
+				errorStream <- err
+				return
+			}
+			boundServicesMessage = true
+		}
+		config.CurrentServices = config.DesiredServices
+
+		if boundServicesMessage {
+			eventStream <- ServiceBound
+		}
+
+		if hasBits(config) {
+			err := actor.uploadBits(config, config.DesiredApplication.GUID, eventStream, warningsStream)
+			if err != nil {
+				errorStream <- err
+				return
+			}
+		}
+
 		log.Debug("completed apply")
 		eventStream <- Complete
 	}()
@@ -105,3 +166,14 @@ func (actor Actor) bindRouteToApp(route v2action.Route, appGUID string) (v2actio
 	}
 	return warnings, err
 }
+
+// applicationUpdate returns the desired application to update the current
+// application with. The env variables are left out when they have not
+// changed, so that updating an application does not rewrite its env.
+func applicationUpdate(config ApplicationConfig) v2action.Application {
+	application := config.DesiredApplication
+	if reflect.DeepEqual(application.EnvironmentVariables, config.CurrentApplication.EnvironmentVariables) {
+		application.EnvironmentVariables = nil
+	}
+	return application
+}
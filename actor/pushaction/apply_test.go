@@ -6,6 +6,7 @@ import (
 	. "code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -25,7 +26,7 @@ var _ = Describe("Apply", func() {
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
 
 		config = ApplicationConfig{
 			DesiredApplication: v2action.Application{
@@ -51,13 +52,13 @@ var _ = Describe("Apply", func() {
 				Name:      "some-app-name",
 				GUID:      "some-app-guid",
 				SpaceGUID: "some-space-guid",
-				Buildpack: "java",
+				Buildpack: types.FilteredString{IsSet: true, Value: "java"},
 			}
 			config.DesiredApplication = v2action.Application{
 				Name:      "some-app-name",
 				GUID:      "some-app-guid",
 				SpaceGUID: "some-space-guid",
-				Buildpack: "ruby",
+				Buildpack: types.FilteredString{IsSet: true, Value: "ruby"},
 			}
 		})
 
@@ -76,9 +77,37 @@ var _ = Describe("Apply", func() {
 					Name:      "some-app-name",
 					GUID:      "some-app-guid",
 					SpaceGUID: "some-space-guid",
-					Buildpack: "ruby",
+					Buildpack: types.FilteredString{IsSet: true, Value: "ruby"},
 				}))
 			})
+
+			Context("when the env variables are unchanged", func() {
+				BeforeEach(func() {
+					config.CurrentApplication.EnvironmentVariables = map[string]interface{}{"PORT": float64(8080), "CONFIG": map[string]interface{}{"a": float64(1)}}
+					config.DesiredApplication.EnvironmentVariables = map[string]interface{}{"PORT": float64(8080), "CONFIG": map[string]interface{}{"a": float64(1)}}
+				})
+
+				It("does not send the env variables", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
+					Eventually(eventStream).Should(Receive(Equal(Complete)))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0).EnvironmentVariables).To(BeNil())
+				})
+			})
+
+			Context("when the env variables have changed", func() {
+				BeforeEach(func() {
+					config.CurrentApplication.EnvironmentVariables = map[string]interface{}{"PORT": float64(8080), "NAME": "old"}
+					config.DesiredApplication.EnvironmentVariables = map[string]interface{}{"PORT": float64(8080), "NAME": "new"}
+				})
+
+				It("sends all the env variables, keeping their types", func() {
+					Eventually(warningsStream).Should(Receive())
+					Eventually(eventStream).Should(Receive(Equal(ApplicationUpdated)))
+					Eventually(eventStream).Should(Receive(Equal(Complete)))
+					Expect(fakeV2Actor.UpdateApplicationArgsForCall(0).EnvironmentVariables).To(Equal(map[string]interface{}{"PORT": float64(8080), "NAME": "new"}))
+				})
+			})
 		})
 
 		Context("when the update errors", func() {
@@ -301,4 +330,213 @@ var _ = Describe("Apply", func() {
 			Consistently(eventStream).ShouldNot(Receive(Equal(RouteBound)))
 		})
 	})
+
+	Context("when no-route is set", func() {
+		BeforeEach(func() {
+			config.NoRoute = true
+			config.CurrentRoutes = []v2action.Route{
+				{GUID: "some-route-guid-1", Host: "some-route-1"},
+				{GUID: "some-route-guid-2", Host: "some-route-2"},
+			}
+
+			fakeV2Actor.CreateApplicationReturns(
+				v2action.Application{
+					GUID: "some-app-guid",
+				},
+				v2action.Warnings{"create-app-warning"},
+				nil)
+		})
+
+		Context("when the unbinding is successful", func() {
+			BeforeEach(func() {
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, nil)
+			})
+
+			It("unbinds all the current routes", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+				Eventually(eventStream).Should(Receive(Equal(RouteUnbound)))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(2))
+
+				routeGUID, appGUID := fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid-1"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+
+				routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(1)
+				Expect(routeGUID).To(Equal("some-route-guid-2"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the unbinding errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh my")
+				fakeV2Actor.UnbindRouteFromApplicationReturns(v2action.Warnings{"unbind-route-warning"}, expectedErr)
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("unbind-route-warning")))
+
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Consistently(eventStream).ShouldNot(Receive(Equal(RouteUnbound)))
+			})
+		})
+	})
+
+	Context("when services need to be bound to the application", func() {
+		BeforeEach(func() {
+			config.CurrentServices = map[string]v2action.ServiceInstance{
+				"service-2": {Name: "service-2", GUID: "service-instance-guid-2"},
+			}
+			config.DesiredServices = map[string]v2action.ServiceInstance{
+				"service-1": {Name: "service-1", GUID: "service-instance-guid-1"},
+				"service-2": {Name: "service-2", GUID: "service-instance-guid-2"},
+			}
+
+			fakeV2Actor.CreateApplicationReturns(
+				v2action.Application{
+					GUID: "some-app-guid",
+				},
+				v2action.Warnings{"create-app-warning"},
+				nil)
+		})
+
+		Context("when the binding is successful", func() {
+			BeforeEach(func() {
+				fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-service-warning"}, nil)
+			})
+
+			It("only binds the services that are not already bound", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ServiceBound)))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("service-instance-guid-1"))
+			})
+		})
+
+		Context("when the binding errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh my")
+				fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(v2action.Warnings{"bind-service-warning"}, expectedErr)
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("bind-service-warning")))
+
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Consistently(eventStream).ShouldNot(Receive(Equal(ServiceBound)))
+			})
+		})
+	})
+
+	Context("when the application has bits to upload", func() {
+		BeforeEach(func() {
+			config.Path = "some-path"
+			config.AllResources = []v2action.Resource{
+				{Filename: "file-1", SHA1: "some-sha-1"},
+				{Filename: "file-2", SHA1: "some-sha-2"},
+			}
+			config.MatchedResources = config.AllResources[:1]
+			config.UnmatchedResources = config.AllResources[1:]
+
+			fakeV2Actor.CreateApplicationReturns(
+				v2action.Application{
+					GUID: "some-app-guid",
+				},
+				v2action.Warnings{"create-app-warning"},
+				nil)
+			fakeV2Actor.ZipResourcesReturns("some-zip-path", nil)
+		})
+
+		Context("when the upload is successful", func() {
+			BeforeEach(func() {
+				fakeV2Actor.UploadApplicationReturns(v2action.Warnings{"upload-warning"}, nil)
+			})
+
+			It("zips the unmatched files and uploads them with the matched files", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(Equal(ResourcesMatched)))
+				Eventually(eventStream).Should(Receive(Equal(UploadingApplication)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("upload-warning")))
+				Eventually(eventStream).Should(Receive(Equal(UploadComplete)))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				Expect(fakeV2Actor.ZipResourcesCallCount()).To(Equal(1))
+				sourceDir, filesToInclude := fakeV2Actor.ZipResourcesArgsForCall(0)
+				Expect(sourceDir).To(Equal("some-path"))
+				Expect(filesToInclude).To(Equal(config.UnmatchedResources))
+
+				Expect(fakeV2Actor.UploadApplicationCallCount()).To(Equal(1))
+				appGUID, existingResources, zipPath := fakeV2Actor.UploadApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(existingResources).To(Equal(config.MatchedResources))
+				Expect(zipPath).To(Equal("some-zip-path"))
+			})
+		})
+
+		Context("when the application path is an archive", func() {
+			BeforeEach(func() {
+				config.Archive = true
+				fakeV2Actor.ZipArchiveResourcesReturns("some-archive-zip-path", nil)
+			})
+
+			It("zips the unmatched files from the archive", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(Equal(ResourcesMatched)))
+				Eventually(eventStream).Should(Receive(Equal(UploadingApplication)))
+				Eventually(warningsStream).Should(Receive())
+				Eventually(eventStream).Should(Receive(Equal(UploadComplete)))
+				Eventually(eventStream).Should(Receive(Equal(Complete)))
+
+				Expect(fakeV2Actor.ZipResourcesCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.ZipArchiveResourcesCallCount()).To(Equal(1))
+				archivePath, filesToInclude := fakeV2Actor.ZipArchiveResourcesArgsForCall(0)
+				Expect(archivePath).To(Equal("some-path"))
+				Expect(filesToInclude).To(Equal(config.UnmatchedResources))
+
+				_, _, zipPath := fakeV2Actor.UploadApplicationArgsForCall(0)
+				Expect(zipPath).To(Equal("some-archive-zip-path"))
+			})
+		})
+
+		Context("when the upload errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("oh my")
+				fakeV2Actor.UploadApplicationReturns(v2action.Warnings{"upload-warning"}, expectedErr)
+			})
+
+			It("returns warnings and error and stops", func() {
+				Eventually(warningsStream).Should(Receive(ConsistOf("create-app-warning")))
+				Eventually(eventStream).Should(Receive(Equal(ApplicationCreated)))
+				Eventually(eventStream).Should(Receive(Equal(ResourcesMatched)))
+				Eventually(eventStream).Should(Receive(Equal(UploadingApplication)))
+				Eventually(warningsStream).Should(Receive(ConsistOf("upload-warning")))
+
+				Eventually(errorStream).Should(Receive(MatchError(expectedErr)))
+				Consistently(eventStream).ShouldNot(Receive(Equal(UploadComplete)))
+			})
+		})
+	})
 })
@@ -1,6 +1,8 @@
 package pushaction
 
 type CommandLineSettings struct {
-	Name string
-	Path string
+	CurrentDirectory string
+	IgnoreFile       string
+	Name             string
+	Path             string
 }
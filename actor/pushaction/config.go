@@ -0,0 +1,13 @@
+package pushaction
+
+import "time"
+
+//go:generate counterfeiter . Config
+
+// Config is the configuration used to wait for applications to stage and
+// start.
+type Config interface {
+	PollingInterval() time.Duration
+	StagingTimeout() time.Duration
+	StartupTimeout() time.Duration
+}
@@ -7,7 +7,20 @@ const (
 	ApplicationUpdated   Event = "application updated"
 	RouteCreated         Event = "route created"
 	RouteBound           Event = "route bound"
+	RouteUnbound         Event = "route unbound"
+	ServiceBound         Event = "service bound"
+	ResourcesMatched     Event = "resources matched"
 	UploadingApplication Event = "uploading application"
 	UploadComplete       Event = "upload complete"
 	Complete             Event = "complete"
+
+	NewApplicationCreated  Event = "new application created"
+	StartingNewApplication Event = "starting new application"
+	NewApplicationHealthy  Event = "new application healthy"
+	InstanceReplaced       Event = "instance replaced"
+	RoutesMoved            Event = "routes moved"
+	OldApplicationDeleted  Event = "old application deleted"
+	NewApplicationRenamed  Event = "new application renamed"
+	RollingBack            Event = "rolling back"
+	RolledBack             Event = "rolled back"
 )
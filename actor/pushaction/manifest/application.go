@@ -0,0 +1,129 @@
+package manifest
+
+import "code.cloudfoundry.org/cli/types"
+
+// Application represents an application's properties as described by a
+// manifest. DiskQuota and Memory are in megabytes. DependsOn lists the names
+// of applications in the same manifest that must be pushed before this one.
+// IgnoreFile is only set from the command line. Tasks are the task templates
+// that can be run against the application.
+type Application struct {
+	Buildpack               types.FilteredString
+	Command                 types.FilteredString
+	DependsOn               []string
+	DiskQuota               uint64
+	DockerImage             string
+	Domains                 []string
+	EnvironmentVariables    map[string]string
+	HealthCheckHTTPEndpoint string
+	HealthCheckTimeout      int
+	HealthCheckType         string
+	Hosts                   []string
+	IgnoreFile              string
+	Instances               types.NullInt
+	Memory                  uint64
+	Name                    string
+	NoHostname              bool
+	NoRoute                 bool
+	Path                    string
+	RandomRoute             bool
+	Routes                  []string
+	Services                []string
+	StackName               string
+	Tasks                   []TaskTemplate
+}
+
+// validate checks for properties that cannot be used together.
+func (app Application) validate() error {
+	if len(app.Routes) > 0 {
+		var conflicts []string
+		if len(app.Hosts) > 0 {
+			conflicts = append(conflicts, "hosts")
+		}
+		if len(app.Domains) > 0 {
+			conflicts = append(conflicts, "domains")
+		}
+		if app.NoHostname {
+			conflicts = append(conflicts, "no-hostname")
+		}
+		if app.RandomRoute {
+			conflicts = append(conflicts, "random-route")
+		}
+		if len(conflicts) > 0 {
+			return PropertyCombinationError{
+				AppName:    app.Name,
+				Properties: append([]string{"routes"}, conflicts...),
+			}
+		}
+	}
+
+	if app.DockerImage != "" && app.Buildpack.IsSet {
+		return PropertyCombinationError{
+			AppName:    app.Name,
+			Properties: []string{"docker", "buildpack"},
+		}
+	}
+
+	if app.HealthCheckHTTPEndpoint != "" && app.HealthCheckType != "http" {
+		return HTTPHealthCheckInvalidError{AppName: app.Name}
+	}
+
+	return nil
+}
+
+// validateDependencies checks that every dependency names an application in
+// the manifest and that the dependencies do not form a cycle.
+func validateDependencies(apps []Application) error {
+	byName := map[string]Application{}
+	for _, app := range apps {
+		byName[app.Name] = app
+	}
+
+	for _, app := range apps {
+		for _, dependency := range app.DependsOn {
+			if _, ok := byName[dependency]; !ok {
+				return DependencyNotFoundError{AppName: app.Name, Dependency: dependency}
+			}
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := map[string]int{}
+	var path []string
+
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			for i, pathName := range path {
+				if pathName == name {
+					return DependencyCycleError{AppNames: append(append([]string{}, path[i:]...), name)}
+				}
+			}
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		path = append(path, name)
+		for _, dependency := range byName[name].DependsOn {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+
+	for _, app := range apps {
+		if err := visit(app.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package manifest
+
+import (
+	"fmt"
+	"strings"
+)
+
+// InvalidManifestError is returned when a manifest cannot be parsed. Errors
+// contains one message per invalid property, prefixed with its line number
+// when it is known.
+type InvalidManifestError struct {
+	Path   string
+	Errors []string
+}
+
+func (e InvalidManifestError) Error() string {
+	return fmt.Sprintf("Manifest file '%s' is invalid:\n%s", e.Path, strings.Join(e.Errors, "\n"))
+}
+
+// InheritanceCycleError is returned when a manifest directly or indirectly
+// inherits from itself.
+type InheritanceCycleError struct {
+	Path string
+}
+
+func (e InheritanceCycleError) Error() string {
+	return fmt.Sprintf("Manifest '%s' inherits from itself", e.Path)
+}
+
+// PropertyCombinationError is returned when an application uses properties
+// that cannot be combined.
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (e PropertyCombinationError) Error() string {
+	return fmt.Sprintf("Application %s cannot use the combination of properties: %s", e.AppName, strings.Join(e.Properties, ", "))
+}
+
+// HTTPHealthCheckInvalidError is returned when an application sets a health
+// check HTTP endpoint without using the http health check type.
+type HTTPHealthCheckInvalidError struct {
+	AppName string
+}
+
+func (e HTTPHealthCheckInvalidError) Error() string {
+	return fmt.Sprintf("Application %s must use the 'http' health check type to set a health check HTTP endpoint", e.AppName)
+}
+
+// DependencyNotFoundError is returned when an application depends on an
+// application that is not in the manifest.
+type DependencyNotFoundError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e DependencyNotFoundError) Error() string {
+	return fmt.Sprintf("Application %s depends on %s, which is not in the manifest", e.AppName, e.Dependency)
+}
+
+// DependencyCycleError is returned when applications directly or indirectly
+// depend on themselves. AppNames lists the applications in the cycle, starting
+// and ending with the same application.
+type DependencyCycleError struct {
+	AppNames []string
+}
+
+func (e DependencyCycleError) Error() string {
+	return fmt.Sprintf("Application dependencies form a cycle: %s", strings.Join(e.AppNames, " -> "))
+}
+
+// TaskTemplateNotFoundError is returned when a manifest does not define the
+// requested task template for an application.
+type TaskTemplateNotFoundError struct {
+	AppName      string
+	TemplateName string
+}
+
+func (e TaskTemplateNotFoundError) Error() string {
+	return fmt.Sprintf("Task template %s not found for application %s", e.TemplateName, e.AppName)
+}
@@ -1,10 +1,100 @@
+// Package manifest reads, merges and validates application manifests used by
+// push.
 package manifest
 
+import (
+	"io/ioutil"
+	"path/filepath"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Manifest represents the contents of a manifest file after inheritance and
+// global properties have been applied.
 type Manifest struct {
 	Applications []Application
 }
 
-type Application struct {
-	Name string
-	Path string
+// ReadAndMergeManifests reads the manifest at the provided path, resolves any
+// inherited manifests and global properties, and returns the resulting list
+// of validated applications.
+func ReadAndMergeManifests(pathToManifest string) ([]Application, error) {
+	raw, err := readManifest(pathToManifest, map[string]bool{})
+	if err != nil {
+		return nil, err
+	}
+
+	var apps []Application
+	var errs []string
+	for _, rawApp := range raw.applications() {
+		app, appErrs := rawApp.toApplication()
+		if len(appErrs) > 0 {
+			errs = append(errs, appErrs...)
+			continue
+		}
+		apps = append(apps, app)
+	}
+
+	if len(errs) > 0 {
+		return nil, InvalidManifestError{Path: pathToManifest, Errors: errs}
+	}
+
+	for _, app := range apps {
+		if err := app.validate(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := validateDependencies(apps); err != nil {
+		return nil, err
+	}
+
+	return apps, nil
+}
+
+// readManifest parses the manifest at the provided path and recursively merges
+// in any manifests it inherits from. Visited paths are tracked to prevent
+// inheritance cycles.
+func readManifest(pathToManifest string, visited map[string]bool) (rawManifest, error) {
+	absPath, err := filepath.Abs(pathToManifest)
+	if err != nil {
+		return rawManifest{}, err
+	}
+
+	if visited[absPath] {
+		return rawManifest{}, InheritanceCycleError{Path: pathToManifest}
+	}
+	visited[absPath] = true
+
+	bytes, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return rawManifest{}, err
+	}
+
+	var raw rawManifest
+	err = yaml.Unmarshal(bytes, &raw)
+	if err != nil {
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			return rawManifest{}, InvalidManifestError{Path: pathToManifest, Errors: typeErr.Errors}
+		}
+		return rawManifest{}, InvalidManifestError{Path: pathToManifest, Errors: []string{err.Error()}}
+	}
+
+	raw.resolvePaths(filepath.Dir(pathToManifest))
+
+	if raw.Inherit == "" {
+		return raw, nil
+	}
+
+	inheritedPath := raw.Inherit
+	if !filepath.IsAbs(inheritedPath) {
+		inheritedPath = filepath.Join(filepath.Dir(pathToManifest), inheritedPath)
+	}
+
+	parent, err := readManifest(inheritedPath, visited)
+	if err != nil {
+		return rawManifest{}, err
+	}
+
+	return raw.inherit(parent), nil
 }
@@ -0,0 +1,565 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manifest", func() {
+	var (
+		tmpDir         string
+		pathToManifest string
+		manifestBytes  []byte
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		pathToManifest = filepath.Join(tmpDir, "manifest.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("ReadAndMergeManifests", func() {
+		var (
+			apps       []Application
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			Expect(ioutil.WriteFile(pathToManifest, manifestBytes, 0666)).To(Succeed())
+			apps, executeErr = ReadAndMergeManifests(pathToManifest)
+		})
+
+		Context("when the manifest uses every application property", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  buildpack: some-buildpack
+  command: some-command
+  disk_quota: 1G
+  domains:
+  - domain-1.com
+  - domain-2.com
+  env:
+    ENV_VAR_1: value-1
+    ENV_VAR_2: 2
+    ENV_VAR_3: 3.5
+    ENV_VAR_4: true
+  health-check-http-endpoint: /health
+  health-check-type: http
+  hosts:
+  - host-1
+  - host-2
+  instances: 3
+  memory: 256M
+  no-hostname: true
+  path: some-dir
+  random-route: true
+  services:
+  - service-1
+  - service-2
+  stack: some-stack
+  timeout: 120
+- name: app-2
+  docker:
+    image: some-docker-image
+  no-route: true
+  routes:
+  - route: example.com/path
+  - route: tcp.example.com:1234
+`)
+			})
+
+			It("parses every property", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(2))
+
+				Expect(apps[0]).To(Equal(Application{
+					Buildpack:               types.FilteredString{IsSet: true, Value: "some-buildpack"},
+					Command:                 types.FilteredString{IsSet: true, Value: "some-command"},
+					DiskQuota:               1024,
+					Domains:                 []string{"domain-1.com", "domain-2.com"},
+					EnvironmentVariables:    map[string]string{"ENV_VAR_1": "value-1", "ENV_VAR_2": "2", "ENV_VAR_3": "3.5", "ENV_VAR_4": "true"},
+					HealthCheckHTTPEndpoint: "/health",
+					HealthCheckTimeout:      120,
+					HealthCheckType:         "http",
+					Hosts:                   []string{"host-1", "host-2"},
+					Instances:               types.NullInt{IsSet: true, Value: 3},
+					Memory:                  256,
+					Name:                    "app-1",
+					NoHostname:              true,
+					Path:                    filepath.Join(tmpDir, "some-dir"),
+					RandomRoute:             true,
+					Services:                []string{"service-1", "service-2"},
+					StackName:               "some-stack",
+				}))
+
+				Expect(apps[1].Name).To(Equal("app-2"))
+				Expect(apps[1].DockerImage).To(Equal("some-docker-image"))
+				Expect(apps[1].NoRoute).To(BeTrue())
+				Expect(apps[1].Routes).To(Equal([]string{"example.com/path", "tcp.example.com:1234"}))
+			})
+		})
+
+		Context("when the manifest has singular host and domain properties", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  host: host-1
+  hosts:
+  - host-1
+  - host-2
+  domain: domain-1.com
+`)
+			})
+
+			It("combines them with the plural properties", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].Hosts).To(Equal([]string{"host-1", "host-2"}))
+				Expect(apps[0].Domains).To(Equal([]string{"domain-1.com"}))
+			})
+		})
+
+		Context("when buildpack and command are null or default", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  buildpack: null
+  command: default
+`)
+			})
+
+			It("sets them to the platform default", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].Buildpack).To(Equal(types.FilteredString{IsSet: true}))
+				Expect(apps[0].Command).To(Equal(types.FilteredString{IsSet: true}))
+			})
+		})
+
+		Context("when the path is absolute", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  path: /some/absolute/../path
+`)
+			})
+
+			It("cleans the path", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].Path).To(Equal("/some/path"))
+			})
+		})
+
+		Context("when the manifest contains global properties", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+memory: 128M
+instances: 2
+services:
+- global-service
+env:
+  GLOBAL: global-value
+  OVERRIDDEN: global-value
+applications:
+- name: app-1
+  memory: 1G
+  services:
+  - app-service
+  env:
+    OVERRIDDEN: app-value
+- name: app-2
+`)
+			})
+
+			It("applies them to every application, letting application properties take precedence", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(2))
+
+				Expect(apps[0].Memory).To(BeEquivalentTo(1024))
+				Expect(apps[0].Instances).To(Equal(types.NullInt{IsSet: true, Value: 2}))
+				Expect(apps[0].Services).To(Equal([]string{"global-service", "app-service"}))
+				Expect(apps[0].EnvironmentVariables).To(Equal(map[string]string{
+					"GLOBAL":     "global-value",
+					"OVERRIDDEN": "app-value",
+				}))
+
+				Expect(apps[1].Memory).To(BeEquivalentTo(128))
+				Expect(apps[1].Services).To(Equal([]string{"global-service"}))
+				Expect(apps[1].EnvironmentVariables).To(Equal(map[string]string{
+					"GLOBAL":     "global-value",
+					"OVERRIDDEN": "global-value",
+				}))
+			})
+		})
+
+		Context("when the manifest has no applications section", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+name: app-1
+memory: 64M
+`)
+			})
+
+			It("returns a single application using the global properties", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(1))
+				Expect(apps[0].Name).To(Equal("app-1"))
+				Expect(apps[0].Memory).To(BeEquivalentTo(64))
+			})
+		})
+
+		Context("when the manifest inherits from another manifest", func() {
+			BeforeEach(func() {
+				Expect(os.Mkdir(filepath.Join(tmpDir, "parent"), 0777)).To(Succeed())
+				parentManifest := []byte(`---
+memory: 128M
+instances: 4
+applications:
+- name: parent-app
+  path: parent-app-dir
+`)
+				Expect(ioutil.WriteFile(filepath.Join(tmpDir, "parent", "manifest.yml"), parentManifest, 0666)).To(Succeed())
+
+				manifestBytes = []byte(`---
+inherit: parent/manifest.yml
+memory: 256M
+applications:
+- name: child-app
+`)
+			})
+
+			It("merges the parent manifest under the child manifest", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(2))
+
+				Expect(apps[0].Name).To(Equal("parent-app"))
+				Expect(apps[0].Path).To(Equal(filepath.Join(tmpDir, "parent", "parent-app-dir")))
+				Expect(apps[0].Memory).To(BeEquivalentTo(256))
+				Expect(apps[0].Instances).To(Equal(types.NullInt{IsSet: true, Value: 4}))
+
+				Expect(apps[1].Name).To(Equal("child-app"))
+				Expect(apps[1].Memory).To(BeEquivalentTo(256))
+				Expect(apps[1].Instances).To(Equal(types.NullInt{IsSet: true, Value: 4}))
+			})
+		})
+
+		Context("when the manifest inherits from itself", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+inherit: manifest.yml
+`)
+			})
+
+			It("returns an InheritanceCycleError", func() {
+				Expect(executeErr).To(MatchError(InheritanceCycleError{Path: pathToManifest}))
+			})
+		})
+
+		Context("when hosts and routes use ${random-word}", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  hosts:
+  - host-${random-word}
+- name: app-2
+  routes:
+  - route: ${random-word}.example.com
+`)
+			})
+
+			It("expands the property", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps[0].Hosts).To(ConsistOf(MatchRegexp(`^host-\w+-\w+$`)))
+				Expect(apps[1].Routes).To(ConsistOf(MatchRegexp(`^\w+-\w+\.example\.com$`)))
+			})
+		})
+
+		Context("when a host uses an unsupported property", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  hosts:
+  - ${some-property}
+`)
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{
+					Path:   pathToManifest,
+					Errors: []string{"Property '${some-property}' found in manifest. This feature is no longer supported. Please remove it and try again."},
+				}))
+			})
+		})
+
+		Context("when properties are invalid", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  memory: 1 gigabyte
+  instances: many
+  health-check-type: some-type
+  routes:
+  - path: /some-path
+  env:
+    NULL_VAR: null
+- name: app-2
+  stack: null
+`)
+			})
+
+			It("returns an InvalidManifestError containing every error with its line number", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(InvalidManifestError{}))
+				manifestErr := executeErr.(InvalidManifestError)
+				Expect(manifestErr.Path).To(Equal(pathToManifest))
+				Expect(manifestErr.Errors).To(ConsistOf(
+					HavePrefix("line 4: invalid byte quantity `1 gigabyte`"),
+					"line 5: cannot unmarshal !!str `many` into int",
+					"line 6: invalid health-check-type `some-type`; must be one of http, none, port or process",
+					"line 8: each route in 'routes' must have a 'route' property",
+					"line 10: env var 'NULL_VAR' should not be null",
+					"line 11: stack should not be null",
+				))
+			})
+		})
+
+		Context("when routes are combined with hosts or domains", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  routes:
+  - route: example.com
+  hosts:
+  - some-host
+  domain: some-domain.com
+`)
+			})
+
+			It("returns a PropertyCombinationError", func() {
+				Expect(executeErr).To(MatchError(PropertyCombinationError{
+					AppName:    "app-1",
+					Properties: []string{"routes", "hosts", "domains"},
+				}))
+			})
+		})
+
+		Context("when docker is combined with buildpack", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  buildpack: some-buildpack
+  docker:
+    image: some-image
+`)
+			})
+
+			It("returns a PropertyCombinationError", func() {
+				Expect(executeErr).To(MatchError(PropertyCombinationError{
+					AppName:    "app-1",
+					Properties: []string{"docker", "buildpack"},
+				}))
+			})
+		})
+
+		Context("when a health check endpoint is set without the http health check type", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  health-check-type: port
+  health-check-http-endpoint: /health
+`)
+			})
+
+			It("returns an HTTPHealthCheckInvalidError", func() {
+				Expect(executeErr).To(MatchError(HTTPHealthCheckInvalidError{AppName: "app-1"}))
+			})
+		})
+
+		Context("when applications declare dependencies", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+depends_on: [ignored]
+applications:
+- name: app-1
+  depends_on:
+  - app-2
+  - app-3
+  - app-2
+- name: app-2
+  depends_on: [app-3]
+- name: app-3
+  depends_on: []
+`)
+			})
+
+			It("returns the dependencies of each application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(3))
+				Expect(apps[0].DependsOn).To(Equal([]string{"app-2", "app-3"}))
+				Expect(apps[1].DependsOn).To(Equal([]string{"app-3"}))
+				Expect(apps[2].DependsOn).To(BeEmpty())
+			})
+		})
+
+		Context("when an application depends on an application that is not in the manifest", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  depends_on: [app-2]
+`)
+			})
+
+			It("returns a DependencyNotFoundError", func() {
+				Expect(executeErr).To(MatchError(DependencyNotFoundError{AppName: "app-1", Dependency: "app-2"}))
+			})
+		})
+
+		Context("when application dependencies form a cycle", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  depends_on: [app-2]
+- name: app-2
+  depends_on: [app-3]
+- name: app-3
+  depends_on: [app-1]
+`)
+			})
+
+			It("returns a DependencyCycleError", func() {
+				Expect(executeErr).To(MatchError(DependencyCycleError{AppNames: []string{"app-1", "app-2", "app-3", "app-1"}}))
+			})
+		})
+
+		Context("when the manifest declares task templates", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+tasks:
+- name: migrate
+  command: rake db:migrate
+- name: cleanup
+  command: ./cleanup
+  memory: 256M
+applications:
+- name: app-1
+  tasks:
+  - name: migrate
+    command: rake db:migrate:all
+    disk_quota: 1G
+- name: app-2
+`)
+			})
+
+			It("merges the global templates under the application templates", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(HaveLen(2))
+
+				Expect(apps[0].Tasks).To(ConsistOf(
+					TaskTemplate{Name: "migrate", Command: "rake db:migrate:all", DiskQuota: 1024},
+					TaskTemplate{Name: "cleanup", Command: "./cleanup", Memory: 256},
+				))
+				Expect(apps[1].Tasks).To(ConsistOf(
+					TaskTemplate{Name: "migrate", Command: "rake db:migrate"},
+					TaskTemplate{Name: "cleanup", Command: "./cleanup", Memory: 256},
+				))
+			})
+		})
+
+		Context("when task templates are invalid", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  tasks:
+  - command: rake db:migrate
+  - name: cleanup
+`)
+			})
+
+			It("returns an InvalidManifestError containing every error with its line number", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{
+					Path: pathToManifest,
+					Errors: []string{
+						"line 5: each task in 'tasks' must have a 'name' property",
+						"line 6: each task in 'tasks' must have a 'command' property",
+					},
+				}))
+			})
+		})
+
+		Context("when an application defines a task template more than once", func() {
+			BeforeEach(func() {
+				manifestBytes = []byte(`---
+applications:
+- name: app-1
+  tasks:
+  - name: migrate
+    command: rake db:migrate
+  - name: migrate
+    command: rake db:migrate:all
+`)
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(MatchError(InvalidManifestError{
+					Path:   pathToManifest,
+					Errors: []string{"task 'migrate' is defined more than once"},
+				}))
+			})
+		})
+	})
+
+	Describe("FindTaskTemplate", func() {
+		var apps []Application
+
+		BeforeEach(func() {
+			apps = []Application{
+				{Name: "app-1", Tasks: []TaskTemplate{{Name: "migrate", Command: "app-1-migrate"}}},
+				{Name: "", Tasks: []TaskTemplate{{Name: "migrate", Command: "shared-migrate"}}},
+			}
+		})
+
+		It("returns the template of the matching application", func() {
+			template, err := FindTaskTemplate(apps, "app-1", "migrate")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Command).To(Equal("app-1-migrate"))
+		})
+
+		Context("when no application matches", func() {
+			It("returns the template of the unnamed application", func() {
+				template, err := FindTaskTemplate(apps, "app-2", "migrate")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(template.Command).To(Equal("shared-migrate"))
+			})
+		})
+
+		Context("when the template does not exist", func() {
+			It("returns a TaskTemplateNotFoundError", func() {
+				_, err := FindTaskTemplate(apps, "app-1", "seed")
+				Expect(err).To(MatchError(TaskTemplateNotFoundError{AppName: "app-1", TemplateName: "seed"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,355 @@
+package manifest
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"code.cloudfoundry.org/cli/types"
+	"code.cloudfoundry.org/cli/util/words/generator"
+	"gopkg.in/yaml.v2"
+)
+
+// rawApplication is the on-disk representation of an application in a
+// manifest. Optional properties are pointers so that unset properties can be
+// distinguished from zero values when merging in global properties.
+type rawApplication struct {
+	Buildpack               *string              `yaml:"buildpack"`
+	Command                 *string              `yaml:"command"`
+	DependsOn               []string             `yaml:"depends_on"`
+	DiskQuota               *byteQuantity        `yaml:"disk_quota"`
+	Docker                  *rawDocker           `yaml:"docker"`
+	Domain                  string               `yaml:"domain"`
+	Domains                 []string             `yaml:"domains"`
+	EnvironmentVariables    environmentVariables `yaml:"env"`
+	HealthCheckHTTPEndpoint *string              `yaml:"health-check-http-endpoint"`
+	HealthCheckType         *healthCheckType     `yaml:"health-check-type"`
+	Host                    string               `yaml:"host"`
+	Hosts                   []string             `yaml:"hosts"`
+	Instances               *int                 `yaml:"instances"`
+	Memory                  *byteQuantity        `yaml:"memory"`
+	Name                    string               `yaml:"name"`
+	NoHostname              *bool                `yaml:"no-hostname"`
+	NoRoute                 *bool                `yaml:"no-route"`
+	Path                    string               `yaml:"path"`
+	RandomRoute             *bool                `yaml:"random-route"`
+	Routes                  []rawRoute           `yaml:"routes"`
+	Services                []string             `yaml:"services"`
+	StackName               string               `yaml:"stack"`
+	Tasks                   []rawTaskTemplate    `yaml:"tasks"`
+	Timeout                 *int                 `yaml:"timeout"`
+}
+
+// nullableProperties can be set to null in order to reset them to the
+// platform default.
+var nullableProperties = map[string]bool{
+	"buildpack": true,
+	"command":   true,
+}
+
+// manifestSections are top level keys that are not application properties.
+var manifestSections = map[string]bool{
+	"applications":           true,
+	"inherit":                true,
+	"security-groups":        true,
+	"service-instances":      true,
+	"space-quota":            true,
+	"user-provided-services": true,
+}
+
+func (app *rawApplication) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	type plainApplication rawApplication
+
+	var errs []string
+	err := unmarshal((*plainApplication)(app))
+	if typeErr, ok := err.(*yaml.TypeError); ok {
+		errs = append(errs, typeErr.Errors...)
+	} else if err != nil {
+		return err
+	}
+
+	// yaml.v2 skips null values entirely, so the properties are decoded a
+	// second time to find the ones that were explicitly set to null.
+	var properties map[string]interface{}
+	if err := unmarshal(&properties); err == nil {
+		for _, property := range sortedKeys(properties) {
+			if properties[property] != nil || manifestSections[property] {
+				continue
+			}
+
+			if nullableProperties[property] {
+				app.setDefault(property)
+				continue
+			}
+
+			errs = append(errs, fmt.Sprintf("line %d: %s should not be null", lineNumber(unmarshal), property))
+		}
+	}
+
+	if len(errs) > 0 {
+		return &yaml.TypeError{Errors: errs}
+	}
+	return nil
+}
+
+func (app *rawApplication) setDefault(property string) {
+	defaultValue := "default"
+	switch property {
+	case "buildpack":
+		app.Buildpack = &defaultValue
+	case "command":
+		app.Command = &defaultValue
+	}
+}
+
+// mergeOnto returns a copy of base with the properties of app applied on top.
+// Scalar properties in app replace those in base, lists are combined and
+// environment variables and task templates are merged by name.
+func (app rawApplication) mergeOnto(base rawApplication) rawApplication {
+	merged := base
+
+	if app.Buildpack != nil {
+		merged.Buildpack = app.Buildpack
+	}
+	if app.Command != nil {
+		merged.Command = app.Command
+	}
+	if app.DependsOn != nil {
+		merged.DependsOn = app.DependsOn
+	}
+	if app.DiskQuota != nil {
+		merged.DiskQuota = app.DiskQuota
+	}
+	if app.Docker != nil {
+		merged.Docker = app.Docker
+	}
+	if app.Domain != "" {
+		merged.Domain = app.Domain
+	}
+	if app.HealthCheckHTTPEndpoint != nil {
+		merged.HealthCheckHTTPEndpoint = app.HealthCheckHTTPEndpoint
+	}
+	if app.HealthCheckType != nil {
+		merged.HealthCheckType = app.HealthCheckType
+	}
+	if app.Host != "" {
+		merged.Host = app.Host
+	}
+	if app.Instances != nil {
+		merged.Instances = app.Instances
+	}
+	if app.Memory != nil {
+		merged.Memory = app.Memory
+	}
+	if app.Name != "" {
+		merged.Name = app.Name
+	}
+	if app.NoHostname != nil {
+		merged.NoHostname = app.NoHostname
+	}
+	if app.NoRoute != nil {
+		merged.NoRoute = app.NoRoute
+	}
+	if app.Path != "" {
+		merged.Path = app.Path
+	}
+	if app.RandomRoute != nil {
+		merged.RandomRoute = app.RandomRoute
+	}
+	if app.StackName != "" {
+		merged.StackName = app.StackName
+	}
+	if app.Timeout != nil {
+		merged.Timeout = app.Timeout
+	}
+
+	merged.Domains = appendStrings(base.Domains, app.Domains)
+	merged.Hosts = appendStrings(base.Hosts, app.Hosts)
+	merged.Services = appendStrings(base.Services, app.Services)
+	merged.Routes = append(append([]rawRoute{}, base.Routes...), app.Routes...)
+
+	merged.Tasks = mergeTaskTemplates(base.Tasks, app.Tasks)
+
+	if base.EnvironmentVariables != nil || app.EnvironmentVariables != nil {
+		merged.EnvironmentVariables = environmentVariables{}
+		for name, value := range base.EnvironmentVariables {
+			merged.EnvironmentVariables[name] = value
+		}
+		for name, value := range app.EnvironmentVariables {
+			merged.EnvironmentVariables[name] = value
+		}
+	}
+
+	return merged
+}
+
+// mergeTaskTemplates combines the templates of base and app. Templates in app
+// replace templates in base with the same name.
+func mergeTaskTemplates(base []rawTaskTemplate, app []rawTaskTemplate) []rawTaskTemplate {
+	var merged []rawTaskTemplate
+	for _, baseTemplate := range base {
+		overridden := false
+		for _, appTemplate := range app {
+			if appTemplate.Name == baseTemplate.Name {
+				overridden = true
+				break
+			}
+		}
+		if !overridden {
+			merged = append(merged, baseTemplate)
+		}
+	}
+	return append(merged, app...)
+}
+
+func (app *rawApplication) resolvePath(manifestDir string) {
+	app.Path = resolvePath(manifestDir, app.Path)
+}
+
+var propertyRegex = regexp.MustCompile(`\${[\w-]+}`)
+
+// randomWord is used to expand the ${random-word} property.
+var randomWord = func() string {
+	return strings.ToLower(generator.NewWordGenerator().Babble())
+}
+
+// toApplication converts the raw application into an Application, expanding
+// properties in hosts and routes. Any problems are returned as error
+// messages.
+func (app rawApplication) toApplication() (Application, []string) {
+	converted := Application{
+		DependsOn:            removeDuplicates(app.DependsOn),
+		EnvironmentVariables: map[string]string(app.EnvironmentVariables),
+		Name:                 app.Name,
+		Path:                 app.Path,
+		Services:             app.Services,
+		StackName:            app.StackName,
+	}
+
+	if app.Buildpack != nil {
+		converted.Buildpack.ParseValue(*app.Buildpack)
+	}
+	if app.Command != nil {
+		converted.Command.ParseValue(*app.Command)
+	}
+	if app.DiskQuota != nil {
+		converted.DiskQuota = uint64(*app.DiskQuota)
+	}
+	if app.Docker != nil {
+		converted.DockerImage = app.Docker.Image
+	}
+	converted.HealthCheckHTTPEndpoint = stringValue(app.HealthCheckHTTPEndpoint)
+	if app.HealthCheckType != nil {
+		converted.HealthCheckType = string(*app.HealthCheckType)
+	}
+	if app.Instances != nil {
+		converted.Instances = types.NullInt{IsSet: true, Value: *app.Instances}
+	}
+	if app.Memory != nil {
+		converted.Memory = uint64(*app.Memory)
+	}
+	converted.NoHostname = boolValue(app.NoHostname)
+	converted.NoRoute = boolValue(app.NoRoute)
+	converted.RandomRoute = boolValue(app.RandomRoute)
+	if app.Timeout != nil {
+		converted.HealthCheckTimeout = *app.Timeout
+	}
+
+	var errs []string
+	converted.Domains = removeDuplicates(appendStrings(app.Domains, nonEmpty(app.Domain)))
+
+	hosts := removeDuplicates(appendStrings(app.Hosts, nonEmpty(app.Host)))
+	for _, host := range hosts {
+		expanded, err := expandProperties(host)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		converted.Hosts = append(converted.Hosts, expanded)
+	}
+
+	seenTemplates := map[string]bool{}
+	for _, template := range app.Tasks {
+		if seenTemplates[template.Name] {
+			errs = append(errs, fmt.Sprintf("task '%s' is defined more than once", template.Name))
+			continue
+		}
+		seenTemplates[template.Name] = true
+
+		converted.Tasks = append(converted.Tasks, TaskTemplate{
+			Name:      template.Name,
+			Command:   template.Command,
+			DiskQuota: uint64Value(template.DiskQuota),
+			Memory:    uint64Value(template.Memory),
+		})
+	}
+
+	for _, route := range app.Routes {
+		expanded, err := expandProperties(route.Route)
+		if err != nil {
+			errs = append(errs, err.Error())
+			continue
+		}
+		converted.Routes = append(converted.Routes, expanded)
+	}
+
+	return converted, errs
+}
+
+func expandProperties(value string) (string, error) {
+	for _, property := range propertyRegex.FindAllString(value, -1) {
+		if property != "${random-word}" {
+			return "", fmt.Errorf("Property '%s' found in manifest. This feature is no longer supported. Please remove it and try again.", property)
+		}
+	}
+
+	return strings.Replace(value, "${random-word}", randomWord(), -1), nil
+}
+
+func appendStrings(lists ...[]string) []string {
+	var combined []string
+	for _, list := range lists {
+		combined = append(combined, list...)
+	}
+	return combined
+}
+
+func nonEmpty(value string) []string {
+	if value == "" {
+		return nil
+	}
+	return []string{value}
+}
+
+func removeDuplicates(values []string) []string {
+	var unique []string
+	seen := map[string]bool{}
+	for _, value := range values {
+		if !seen[value] {
+			seen[value] = true
+			unique = append(unique, value)
+		}
+	}
+	return unique
+}
+
+func stringValue(value *string) string {
+	if value == nil {
+		return ""
+	}
+	return *value
+}
+
+func uint64Value(value *byteQuantity) uint64 {
+	if value == nil {
+		return 0
+	}
+	return uint64(*value)
+}
+
+func boolValue(value *bool) bool {
+	if value == nil {
+		return false
+	}
+	return *value
+}
@@ -0,0 +1,251 @@
+package manifest
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+
+	"github.com/cloudfoundry/bytefmt"
+	"gopkg.in/yaml.v2"
+)
+
+// rawManifest is the on-disk representation of a manifest. Properties set at
+// the top level of the manifest are stored in Globals and apply to every
+// application in the manifest.
+type rawManifest struct {
+	Inherit      string
+	Applications []rawApplication
+	Globals      rawApplication
+}
+
+func (m *rawManifest) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var content struct {
+		Inherit      string           `yaml:"inherit"`
+		Applications []rawApplication `yaml:"applications"`
+	}
+
+	// Both passes are attempted so that every invalid property is reported,
+	// not only the first one encountered.
+	var errs []string
+	for _, target := range []interface{}{&content, &m.Globals} {
+		err := unmarshal(target)
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			errs = append(errs, typeErr.Errors...)
+		} else if err != nil {
+			return err
+		}
+	}
+
+	if len(errs) > 0 {
+		return &yaml.TypeError{Errors: errs}
+	}
+
+	m.Inherit = content.Inherit
+	m.Applications = content.Applications
+	return nil
+}
+
+// applications returns the applications in the manifest with the global
+// properties applied. A manifest without an applications section describes a
+// single application using the global properties.
+func (m rawManifest) applications() []rawApplication {
+	if len(m.Applications) == 0 {
+		return []rawApplication{m.Globals}
+	}
+
+	apps := make([]rawApplication, 0, len(m.Applications))
+	for _, app := range m.Applications {
+		apps = append(apps, app.mergeOnto(m.Globals))
+	}
+	return apps
+}
+
+// inherit merges the current manifest on top of the parent manifest. Global
+// properties of the current manifest take precedence over the parent's, and
+// the applications of both manifests are combined.
+func (m rawManifest) inherit(parent rawManifest) rawManifest {
+	var apps []rawApplication
+	apps = append(apps, parent.Applications...)
+	apps = append(apps, m.Applications...)
+
+	return rawManifest{
+		Applications: apps,
+		Globals:      m.Globals.mergeOnto(parent.Globals),
+	}
+}
+
+// resolvePaths converts relative application paths to paths relative to the
+// directory containing the manifest.
+func (m *rawManifest) resolvePaths(manifestDir string) {
+	m.Globals.resolvePath(manifestDir)
+	for i := range m.Applications {
+		m.Applications[i].resolvePath(manifestDir)
+	}
+}
+
+type rawDocker struct {
+	Image string `yaml:"image"`
+}
+
+type rawRoute struct {
+	Route string
+}
+
+func (route *rawRoute) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var content struct {
+		Route *string `yaml:"route"`
+	}
+	if err := unmarshal(&content); err != nil {
+		return err
+	}
+
+	if content.Route == nil {
+		return &yaml.TypeError{Errors: []string{
+			fmt.Sprintf("line %d: each route in 'routes' must have a 'route' property", lineNumber(unmarshal)),
+		}}
+	}
+
+	route.Route = *content.Route
+	return nil
+}
+
+// rawTaskTemplate is a named task definition that can be run with
+// run-task --template.
+type rawTaskTemplate struct {
+	Name      string
+	Command   string
+	DiskQuota *byteQuantity
+	Memory    *byteQuantity
+}
+
+func (template *rawTaskTemplate) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var content struct {
+		Name      *string       `yaml:"name"`
+		Command   *string       `yaml:"command"`
+		DiskQuota *byteQuantity `yaml:"disk_quota"`
+		Memory    *byteQuantity `yaml:"memory"`
+	}
+	if err := unmarshal(&content); err != nil {
+		return err
+	}
+
+	var errs []string
+	if content.Name == nil || *content.Name == "" {
+		errs = append(errs, fmt.Sprintf("line %d: each task in 'tasks' must have a 'name' property", lineNumber(unmarshal)))
+	}
+	if content.Command == nil || *content.Command == "" {
+		errs = append(errs, fmt.Sprintf("line %d: each task in 'tasks' must have a 'command' property", lineNumber(unmarshal)))
+	}
+	if len(errs) > 0 {
+		return &yaml.TypeError{Errors: errs}
+	}
+
+	template.Name = *content.Name
+	template.Command = *content.Command
+	template.DiskQuota = content.DiskQuota
+	template.Memory = content.Memory
+	return nil
+}
+
+// byteQuantity is a memory or disk value, such as 1G or 256M, stored in
+// megabytes.
+type byteQuantity uint64
+
+func (quantity *byteQuantity) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	megabytes, err := bytefmt.ToMegabytes(value)
+	if err != nil {
+		return &yaml.TypeError{Errors: []string{
+			fmt.Sprintf("line %d: invalid byte quantity `%s`: %s", lineNumber(unmarshal), value, err),
+		}}
+	}
+
+	*quantity = byteQuantity(megabytes)
+	return nil
+}
+
+type healthCheckType string
+
+func (checkType *healthCheckType) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var value string
+	if err := unmarshal(&value); err != nil {
+		return err
+	}
+
+	switch value {
+	case "http", "none", "port", "process":
+		*checkType = healthCheckType(value)
+		return nil
+	}
+
+	return &yaml.TypeError{Errors: []string{
+		fmt.Sprintf("line %d: invalid health-check-type `%s`; must be one of http, none, port or process", lineNumber(unmarshal), value),
+	}}
+}
+
+type environmentVariables map[string]string
+
+func (env *environmentVariables) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var content map[string]interface{}
+	if err := unmarshal(&content); err != nil {
+		return err
+	}
+
+	var errs []string
+	*env = environmentVariables{}
+	for _, name := range sortedKeys(content) {
+		switch value := content[name].(type) {
+		case nil:
+			errs = append(errs, fmt.Sprintf("line %d: env var '%s' should not be null", lineNumber(unmarshal), name))
+		case float64:
+			(*env)[name] = strconv.FormatFloat(value, 'f', -1, 64)
+		default:
+			(*env)[name] = fmt.Sprint(value)
+		}
+	}
+
+	if len(errs) > 0 {
+		return &yaml.TypeError{Errors: errs}
+	}
+	return nil
+}
+
+// lineNumber returns the line number of the YAML node currently being
+// unmarshalled. yaml.v2 does not expose node positions, so the position is
+// extracted from the type error produced when decoding into an unsupported
+// type.
+func lineNumber(unmarshal func(interface{}) error) int {
+	var probe func()
+	err := unmarshal(&probe)
+	if typeErr, ok := err.(*yaml.TypeError); ok && len(typeErr.Errors) > 0 {
+		var line int
+		if _, scanErr := fmt.Sscanf(typeErr.Errors[0], "line %d:", &line); scanErr == nil {
+			return line
+		}
+	}
+	return 0
+}
+
+func sortedKeys(content map[string]interface{}) []string {
+	keys := make([]string, 0, len(content))
+	for key := range content {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func resolvePath(manifestDir string, path string) string {
+	if path == "" {
+		return ""
+	}
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path)
+	}
+	return filepath.Join(manifestDir, path)
+}
@@ -0,0 +1,150 @@
+package manifest
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Space represents the contents of a space manifest: the applications in a
+// space, along with the service instances, security groups and space quota
+// that the space uses. An empty SpaceQuota leaves the space quota unchanged.
+type Space struct {
+	Applications         []Application
+	ServiceInstances     []ServiceInstance
+	UserProvidedServices []UserProvidedService
+	SecurityGroups       []string
+	SpaceQuota           string
+}
+
+// ServiceInstance is a managed service instance described by a space
+// manifest.
+type ServiceInstance struct {
+	Name    string `yaml:"name"`
+	Service string `yaml:"service"`
+	Plan    string `yaml:"plan"`
+}
+
+// UserProvidedService is a user provided service instance described by a
+// space manifest.
+type UserProvidedService struct {
+	Name            string                 `yaml:"name"`
+	Credentials     map[string]interface{} `yaml:"credentials"`
+	SyslogDrainURL  string                 `yaml:"syslog_drain_url"`
+	RouteServiceURL string                 `yaml:"route_service_url"`
+}
+
+// rawSpace is the on-disk representation of the space level sections of a
+// space manifest.
+type rawSpace struct {
+	Applications         []interface{}         `yaml:"applications"`
+	ServiceInstances     []ServiceInstance     `yaml:"service-instances"`
+	UserProvidedServices []UserProvidedService `yaml:"user-provided-services"`
+	SecurityGroups       []string              `yaml:"security-groups"`
+	SpaceQuota           string                `yaml:"space-quota"`
+}
+
+// ReadSpaceManifest reads the space manifest at the provided path. The
+// applications section is read in the same way as a push manifest.
+func ReadSpaceManifest(pathToManifest string) (Space, error) {
+	bytes, err := ioutil.ReadFile(pathToManifest)
+	if err != nil {
+		return Space{}, err
+	}
+
+	var raw rawSpace
+	err = yaml.Unmarshal(bytes, &raw)
+	if err != nil {
+		if typeErr, ok := err.(*yaml.TypeError); ok {
+			return Space{}, InvalidManifestError{Path: pathToManifest, Errors: typeErr.Errors}
+		}
+		return Space{}, InvalidManifestError{Path: pathToManifest, Errors: []string{err.Error()}}
+	}
+
+	if errs := raw.validate(); len(errs) > 0 {
+		return Space{}, InvalidManifestError{Path: pathToManifest, Errors: errs}
+	}
+
+	space := Space{
+		ServiceInstances:     raw.ServiceInstances,
+		UserProvidedServices: raw.UserProvidedServices,
+		SecurityGroups:       raw.SecurityGroups,
+		SpaceQuota:           raw.SpaceQuota,
+	}
+	for i, service := range space.UserProvidedServices {
+		space.UserProvidedServices[i].Credentials = stringKeys(service.Credentials).(map[string]interface{})
+	}
+
+	if len(raw.Applications) > 0 {
+		space.Applications, err = ReadAndMergeManifests(pathToManifest)
+		if err != nil {
+			return Space{}, err
+		}
+	}
+
+	return space, nil
+}
+
+// validate checks that every service instance is named, that managed service
+// instances set a service and plan, and that no name is used twice.
+func (raw rawSpace) validate() []string {
+	var errs []string
+	names := map[string]bool{}
+	checkName := func(section string, i int, name string) {
+		switch {
+		case name == "":
+			errs = append(errs, fmt.Sprintf("%s %d: name is required", section, i+1))
+		case names[name]:
+			errs = append(errs, fmt.Sprintf("service instance %s is listed more than once", name))
+		}
+		names[name] = true
+	}
+
+	for i, instance := range raw.ServiceInstances {
+		checkName("service-instances", i, instance.Name)
+		if instance.Service == "" || instance.Plan == "" {
+			errs = append(errs, fmt.Sprintf("service instance %s must set service and plan", instance.Name))
+		}
+	}
+	for i, service := range raw.UserProvidedServices {
+		checkName("user-provided-services", i, service.Name)
+	}
+
+	groups := map[string]bool{}
+	for _, group := range raw.SecurityGroups {
+		if groups[group] {
+			errs = append(errs, fmt.Sprintf("security group %s is listed more than once", group))
+		}
+		groups[group] = true
+	}
+
+	return errs
+}
+
+// stringKeys converts the maps decoded by yaml, which have interface{} keys,
+// into maps with string keys so that the value can be encoded as JSON.
+func stringKeys(value interface{}) interface{} {
+	switch typed := value.(type) {
+	case map[interface{}]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			converted[fmt.Sprint(key)] = stringKeys(item)
+		}
+		return converted
+	case map[string]interface{}:
+		converted := make(map[string]interface{}, len(typed))
+		for key, item := range typed {
+			converted[key] = stringKeys(item)
+		}
+		return converted
+	case []interface{}:
+		converted := make([]interface{}, len(typed))
+		for i, item := range typed {
+			converted[i] = stringKeys(item)
+		}
+		return converted
+	default:
+		return value
+	}
+}
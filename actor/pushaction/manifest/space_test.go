@@ -0,0 +1,144 @@
+package manifest_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction/manifest"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space", func() {
+	var (
+		tmpDir         string
+		pathToManifest string
+		manifestBytes  []byte
+
+		space      Space
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "space-manifest-test")
+		Expect(err).ToNot(HaveOccurred())
+		pathToManifest = filepath.Join(tmpDir, "space.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		Expect(ioutil.WriteFile(pathToManifest, manifestBytes, 0666)).To(Succeed())
+		space, executeErr = ReadSpaceManifest(pathToManifest)
+	})
+
+	Context("when the manifest describes every section", func() {
+		BeforeEach(func() {
+			manifestBytes = []byte(`---
+space-quota: small
+security-groups:
+- public_networks
+- dns
+service-instances:
+- name: db
+  service: p-mysql
+  plan: 100mb
+user-provided-services:
+- name: logger
+  credentials:
+    username: admin
+    nested:
+      key: value
+  syslog_drain_url: syslog://example.com
+applications:
+- name: app-1
+  memory: 256M
+  services:
+  - db
+`)
+		})
+
+		It("returns the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(space.SpaceQuota).To(Equal("small"))
+			Expect(space.SecurityGroups).To(Equal([]string{"public_networks", "dns"}))
+			Expect(space.ServiceInstances).To(Equal([]ServiceInstance{
+				{Name: "db", Service: "p-mysql", Plan: "100mb"},
+			}))
+			Expect(space.UserProvidedServices).To(Equal([]UserProvidedService{{
+				Name: "logger",
+				Credentials: map[string]interface{}{
+					"username": "admin",
+					"nested":   map[string]interface{}{"key": "value"},
+				},
+				SyslogDrainURL: "syslog://example.com",
+			}}))
+
+			Expect(space.Applications).To(HaveLen(1))
+			Expect(space.Applications[0].Name).To(Equal("app-1"))
+			Expect(space.Applications[0].Memory).To(BeEquivalentTo(256))
+			Expect(space.Applications[0].Services).To(Equal([]string{"db"}))
+		})
+	})
+
+	Context("when the manifest has no applications", func() {
+		BeforeEach(func() {
+			manifestBytes = []byte(`---
+security-groups:
+- dns
+`)
+		})
+
+		It("returns a space without applications", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(space.Applications).To(BeEmpty())
+			Expect(space.SecurityGroups).To(Equal([]string{"dns"}))
+		})
+	})
+
+	Context("when the service instances are invalid", func() {
+		BeforeEach(func() {
+			manifestBytes = []byte(`---
+service-instances:
+- name: db
+  service: p-mysql
+- service: p-redis
+  plan: small
+user-provided-services:
+- name: db
+security-groups:
+- dns
+- dns
+`)
+		})
+
+		It("returns an InvalidManifestError listing every problem", func() {
+			Expect(executeErr).To(MatchError(InvalidManifestError{
+				Path: pathToManifest,
+				Errors: []string{
+					"service instance db must set service and plan",
+					"service-instances 2: name is required",
+					"service instance db is listed more than once",
+					"security group dns is listed more than once",
+				},
+			}))
+		})
+	})
+
+	Context("when a section has the wrong type", func() {
+		BeforeEach(func() {
+			manifestBytes = []byte(`---
+security-groups: dns
+`)
+		})
+
+		It("returns an InvalidManifestError", func() {
+			Expect(executeErr).To(BeAssignableToTypeOf(InvalidManifestError{}))
+		})
+	})
+})
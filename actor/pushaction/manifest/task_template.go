@@ -0,0 +1,41 @@
+package manifest
+
+// TaskTemplate is a named task definition declared in the tasks section of a
+// manifest. DiskQuota and Memory are in megabytes and are 0 when the platform
+// default should be used.
+type TaskTemplate struct {
+	Name      string
+	Command   string
+	DiskQuota uint64
+	Memory    uint64
+}
+
+// FindTaskTemplate returns the task template with the provided name for the
+// application. Templates are looked up on the application with a matching
+// name, falling back to an unnamed application so that a file containing
+// only a top level tasks section can be shared between applications.
+func FindTaskTemplate(apps []Application, appName string, templateName string) (TaskTemplate, error) {
+	var candidates []Application
+	for _, app := range apps {
+		if app.Name == appName {
+			candidates = append(candidates, app)
+		}
+	}
+	if len(candidates) == 0 {
+		for _, app := range apps {
+			if app.Name == "" {
+				candidates = append(candidates, app)
+			}
+		}
+	}
+
+	for _, app := range candidates {
+		for _, template := range app.Tasks {
+			if template.Name == templateName {
+				return template, nil
+			}
+		}
+	}
+
+	return TaskTemplate{}, TaskTemplateNotFoundError{AppName: appName, TemplateName: templateName}
+}
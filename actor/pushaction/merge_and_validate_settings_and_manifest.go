@@ -1,23 +1,95 @@
 package pushaction
 
 import (
-	"errors"
+	"fmt"
 
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	log "github.com/Sirupsen/logrus"
 )
 
-func (actor Actor) MergeAndValidateSettingsAndManifests(cmdConfig CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
-	if len(apps) != 0 {
-		return nil, errors.New("functionality still pending")
+// AppNotFoundInManifestError is returned when an application name is provided
+// on the command line but it is not listed in the manifest.
+type AppNotFoundInManifestError struct {
+	Name string
+}
+
+func (e AppNotFoundInManifestError) Error() string {
+	return fmt.Sprintf("Could not find app named '%s' in manifest", e.Name)
+}
+
+// CommandLineOptionsWithMultipleAppsError is returned when app specific
+// command line settings are provided while pushing multiple applications.
+type CommandLineOptionsWithMultipleAppsError struct{}
+
+func (CommandLineOptionsWithMultipleAppsError) Error() string {
+	return "cannot use command line flag with multiple apps"
+}
+
+// MissingNameError is returned when an application does not have a name.
+type MissingNameError struct{}
+
+func (MissingNameError) Error() string {
+	return "name not specified for app"
+}
+
+func (actor Actor) MergeAndValidateSettingsAndManifests(cmdLineSettings CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error) {
+	var mergedApps []manifest.Application
+
+	if len(apps) == 0 {
+		log.Info("no manifest applications, using command line settings")
+		mergedApps = []manifest.Application{{Name: cmdLineSettings.Name}}
+	} else {
+		var err error
+		mergedApps, err = actor.selectApp(cmdLineSettings.Name, apps)
+		if err != nil {
+			log.Errorln("selecting app:", err)
+			return nil, err
+		}
+	}
+
+	if cmdLineSettings.Path != "" && len(mergedApps) > 1 {
+		log.Error("path provided with multiple apps")
+		return nil, CommandLineOptionsWithMultipleAppsError{}
+	}
+
+	for i, app := range mergedApps {
+		if app.Name == "" {
+			log.Error("app has no name")
+			return nil, MissingNameError{}
+		}
+
+		if cmdLineSettings.Path != "" {
+			mergedApps[i].Path = cmdLineSettings.Path
+		} else if app.Path == "" {
+			mergedApps[i].Path = cmdLineSettings.CurrentDirectory
+		}
+
+		mergedApps[i].IgnoreFile = cmdLineSettings.IgnoreFile
+	}
+
+	log.Debugf("merged and validated manifests: %#v", mergedApps)
+	return mergedApps, nil
+}
+
+// selectApp returns the manifest application matching the provided name, or
+// all the applications when no name is provided. A manifest containing a
+// single unnamed application takes its name from the command line.
+func (actor Actor) selectApp(appName string, apps []manifest.Application) ([]manifest.Application, error) {
+	if appName == "" {
+		return apps, nil
 	}
-	manifests := []manifest.Application{{
-		Name: cmdConfig.Name,
-		Path: cmdConfig.Path,
-	}}
 
-	//TODO Add validations
+	if len(apps) == 1 && apps[0].Name == "" {
+		app := apps[0]
+		app.Name = appName
+		return []manifest.Application{app}, nil
+	}
+
+	for _, app := range apps {
+		if app.Name == appName {
+			return []manifest.Application{app}, nil
+		}
+	}
 
-	log.Debugf("merged and validated manifests: %#v", manifests)
-	return manifests, nil
+	return nil, AppNotFoundInManifestError{Name: appName}
 }
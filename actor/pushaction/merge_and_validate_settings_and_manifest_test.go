@@ -14,7 +14,7 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 	var actor *Actor
 
 	BeforeEach(func() {
-		actor = NewActor(nil)
+		actor = NewActor(nil, nil)
 	})
 
 	Context("when only passed command line settings", func() {
@@ -45,6 +45,121 @@ var _ = Describe("MergeAndValidateSettingsAndManifest", func() {
 	})
 
 	Context("when passed command line settings and manifests", func() {
-		// fill in here
+		var (
+			cmdSettings  CommandLineSettings
+			manifestApps []manifest.Application
+
+			mergedApps []manifest.Application
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			cmdSettings = CommandLineSettings{
+				CurrentDirectory: "some-current-directory",
+			}
+			manifestApps = []manifest.Application{
+				{Name: "app-1", Path: "some-path"},
+				{Name: "app-2"},
+			}
+		})
+
+		JustBeforeEach(func() {
+			mergedApps, executeErr = actor.MergeAndValidateSettingsAndManifests(cmdSettings, manifestApps)
+		})
+
+		Context("when no app name is provided", func() {
+			It("returns all the manifest apps, defaulting their paths to the current directory", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(mergedApps).To(Equal([]manifest.Application{
+					{Name: "app-1", Path: "some-path"},
+					{Name: "app-2", Path: "some-current-directory"},
+				}))
+			})
+
+			Context("when a path is provided", func() {
+				BeforeEach(func() {
+					cmdSettings.Path = "some-other-path"
+				})
+
+				It("returns a CommandLineOptionsWithMultipleAppsError", func() {
+					Expect(executeErr).To(MatchError(CommandLineOptionsWithMultipleAppsError{}))
+				})
+			})
+		})
+
+		Context("when an app name is provided", func() {
+			BeforeEach(func() {
+				cmdSettings.Name = "app-1"
+			})
+
+			It("returns only the matching manifest app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(mergedApps).To(Equal([]manifest.Application{
+					{Name: "app-1", Path: "some-path"},
+				}))
+			})
+
+			Context("when a path is provided", func() {
+				BeforeEach(func() {
+					cmdSettings.Path = "some-other-path"
+				})
+
+				It("overrides the manifest path", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(mergedApps).To(Equal([]manifest.Application{
+						{Name: "app-1", Path: "some-other-path"},
+					}))
+				})
+			})
+
+			Context("when an ignore file is provided", func() {
+				BeforeEach(func() {
+					cmdSettings.IgnoreFile = "some-ignore-file"
+				})
+
+				It("sets the ignore file on the app", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(mergedApps).To(Equal([]manifest.Application{
+						{Name: "app-1", Path: "some-path", IgnoreFile: "some-ignore-file"},
+					}))
+				})
+			})
+
+			Context("when the app is not in the manifest", func() {
+				BeforeEach(func() {
+					cmdSettings.Name = "some-other-app"
+				})
+
+				It("returns an AppNotFoundInManifestError", func() {
+					Expect(executeErr).To(MatchError(AppNotFoundInManifestError{Name: "some-other-app"}))
+				})
+			})
+
+			Context("when the manifest contains a single app without a name", func() {
+				BeforeEach(func() {
+					manifestApps = []manifest.Application{{Path: "some-path"}}
+				})
+
+				It("uses the provided name", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(mergedApps).To(Equal([]manifest.Application{
+						{Name: "app-1", Path: "some-path"},
+					}))
+				})
+			})
+		})
+
+		Context("when a manifest app does not have a name", func() {
+			BeforeEach(func() {
+				manifestApps = []manifest.Application{
+					{Name: "app-1"},
+					{Path: "some-path"},
+				}
+			})
+
+			It("returns a MissingNameError", func() {
+				Expect(executeErr).To(MatchError(MissingNameError{}))
+			})
+		})
 	})
 })
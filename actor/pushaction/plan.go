@@ -0,0 +1,175 @@
+package pushaction
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
+)
+
+// PlanAction is what Apply will do to an application, or what ApplySpace and
+// PruneSpace will do to a space resource.
+type PlanAction string
+
+const (
+	PlanCreate  PlanAction = "create"
+	PlanUpdate  PlanAction = "update"
+	PlanReplace PlanAction = "replace"
+	PlanDelete  PlanAction = "delete"
+	PlanBind    PlanAction = "bind"
+	PlanUnbind  PlanAction = "unbind"
+)
+
+// redactedValue replaces environment variable values in a plan.
+const redactedValue = "[PRIVATE DATA HIDDEN]"
+
+// PropertyChange is an application property that Apply will change. Current
+// is empty when the application is being created or the property was not
+// previously set.
+type PropertyChange struct {
+	Property string `json:"property"`
+	Current  string `json:"current,omitempty"`
+	Desired  string `json:"desired"`
+}
+
+// ApplicationPlan describes the changes Apply would make for an application
+// configuration.
+type ApplicationPlan struct {
+	Name          string           `json:"name"`
+	Action        PlanAction       `json:"action"`
+	Strategy      Strategy         `json:"strategy,omitempty"`
+	Changes       []PropertyChange `json:"changes"`
+	RoutesCreated []string         `json:"routes_created"`
+	RoutesBound   []string         `json:"routes_bound"`
+	RoutesUnbound []string         `json:"routes_unbound"`
+	ServicesBound []string         `json:"services_bound"`
+}
+
+// Plan compares the current and desired state of the application
+// configuration and returns the changes Apply would make. It does not contact
+// the Cloud Controller.
+func (actor Actor) Plan(config ApplicationConfig) ApplicationPlan {
+	plan := ApplicationPlan{
+		Name:          config.DesiredApplication.Name,
+		Action:        PlanUpdate,
+		Changes:       []PropertyChange{},
+		RoutesCreated: []string{},
+		RoutesBound:   []string{},
+		RoutesUnbound: []string{},
+		ServicesBound: []string{},
+	}
+
+	exists := config.CurrentApplication.GUID != ""
+	replace := exists && config.Strategy != DefaultStrategy
+	switch {
+	case !exists:
+		plan.Action = PlanCreate
+	case replace:
+		plan.Action = PlanReplace
+		plan.Strategy = config.Strategy
+	}
+
+	plan.Changes = propertyChanges(config.CurrentApplication, config.DesiredApplication)
+
+	routesToBind := config.DesiredRoutes
+	if replace && !config.NoRoute {
+		for _, route := range config.CurrentRoutes {
+			if !actor.routeInList(route, routesToBind) {
+				routesToBind = append(routesToBind, route)
+			}
+		}
+	}
+
+	for _, route := range routesToBind {
+		if route.GUID == "" {
+			plan.RoutesCreated = append(plan.RoutesCreated, route.String())
+		}
+		if replace || !actor.routeInList(route, config.CurrentRoutes) {
+			plan.RoutesBound = append(plan.RoutesBound, route.String())
+		}
+	}
+
+	if config.NoRoute || replace {
+		for _, route := range config.CurrentRoutes {
+			plan.RoutesUnbound = append(plan.RoutesUnbound, route.String())
+		}
+	}
+
+	for serviceName := range config.DesiredServices {
+		if _, ok := config.CurrentServices[serviceName]; ok && !replace {
+			continue
+		}
+		plan.ServicesBound = append(plan.ServicesBound, serviceName)
+	}
+	sort.Strings(plan.ServicesBound)
+
+	return plan
+}
+
+func propertyChanges(current v2action.Application, desired v2action.Application) []PropertyChange {
+	changes := []PropertyChange{}
+	addChange := func(property string, currentValue string, desiredValue string) {
+		if currentValue != desiredValue {
+			changes = append(changes, PropertyChange{
+				Property: property,
+				Current:  currentValue,
+				Desired:  desiredValue,
+			})
+		}
+	}
+
+	addChange("buildpack", current.Buildpack.String(), desired.Buildpack.String())
+	addChange("command", current.Command.String(), desired.Command.String())
+	addChange("disk_quota", megabytes(current.DiskQuota), megabytes(desired.DiskQuota))
+	addChange("docker_image", current.DockerImage, desired.DockerImage)
+	addChange("health_check_http_endpoint", current.HealthCheckHTTPEndpoint, desired.HealthCheckHTTPEndpoint)
+	addChange("health_check_timeout", nonZero(current.HealthCheckTimeout), nonZero(desired.HealthCheckTimeout))
+	addChange("health_check_type", current.HealthCheckType, desired.HealthCheckType)
+	addChange("instances", nullInt(current.Instances), nullInt(desired.Instances))
+	addChange("memory", megabytes(current.Memory), megabytes(desired.Memory))
+	addChange("stack_guid", current.StackGUID, desired.StackGUID)
+
+	var names []string
+	for name := range desired.EnvironmentVariables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		currentValue, ok := current.EnvironmentVariables[name]
+		if ok && reflect.DeepEqual(currentValue, desired.EnvironmentVariables[name]) {
+			continue
+		}
+
+		change := PropertyChange{Property: "env." + name, Desired: redactedValue}
+		if ok {
+			change.Current = redactedValue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes
+}
+
+func megabytes(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%dM", value)
+}
+
+func nonZero(value int) string {
+	if value == 0 {
+		return ""
+	}
+	return strconv.Itoa(value)
+}
+
+func nullInt(value types.NullInt) string {
+	if !value.IsSet {
+		return ""
+	}
+	return strconv.Itoa(value.Value)
+}
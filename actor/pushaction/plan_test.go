@@ -0,0 +1,130 @@
+package pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Plan", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+		config      ApplicationConfig
+		plan        ApplicationPlan
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
+
+		config = ApplicationConfig{
+			DesiredApplication: v2action.Application{
+				Name:                 "some-app",
+				Buildpack:            types.FilteredString{IsSet: true, Value: "ruby"},
+				Instances:            types.NullInt{IsSet: true, Value: 2},
+				Memory:               256,
+				EnvironmentVariables: map[string]interface{}{"FOO": "bar", "CONFIG": map[string]interface{}{"a": float64(1)}},
+			},
+			DesiredRoutes: []v2action.Route{
+				{Host: "new-host", Domain: v2action.Domain{Name: "example.com"}},
+			},
+			DesiredServices: map[string]v2action.ServiceInstance{
+				"service-b": {GUID: "service-b-guid"},
+				"service-a": {GUID: "service-a-guid"},
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		plan = actor.Plan(config)
+	})
+
+	AfterEach(func() {
+		Expect(fakeV2Actor.Invocations()).To(BeEmpty())
+	})
+
+	Context("when the application does not exist", func() {
+		It("plans to create the application with all of its properties", func() {
+			Expect(plan).To(Equal(ApplicationPlan{
+				Name:   "some-app",
+				Action: PlanCreate,
+				Changes: []PropertyChange{
+					{Property: "buildpack", Desired: "ruby"},
+					{Property: "instances", Desired: "2"},
+					{Property: "memory", Desired: "256M"},
+					{Property: "env.CONFIG", Desired: "[PRIVATE DATA HIDDEN]"},
+					{Property: "env.FOO", Desired: "[PRIVATE DATA HIDDEN]"},
+				},
+				RoutesCreated: []string{"new-host.example.com"},
+				RoutesBound:   []string{"new-host.example.com"},
+				RoutesUnbound: []string{},
+				ServicesBound: []string{"service-a", "service-b"},
+			}))
+		})
+	})
+
+	Context("when the application exists", func() {
+		BeforeEach(func() {
+			config.CurrentApplication = v2action.Application{
+				GUID:                 "some-app-guid",
+				Name:                 "some-app",
+				Buildpack:            types.FilteredString{IsSet: true, Value: "java"},
+				Instances:            types.NullInt{IsSet: true, Value: 2},
+				Memory:               256,
+				EnvironmentVariables: map[string]interface{}{"FOO": "baz", "CONFIG": map[string]interface{}{"a": float64(1)}},
+			}
+			config.DesiredApplication.GUID = "some-app-guid"
+			config.CurrentRoutes = []v2action.Route{
+				{GUID: "old-route-guid", Host: "old-host", Domain: v2action.Domain{Name: "example.com"}},
+			}
+			config.CurrentServices = map[string]v2action.ServiceInstance{
+				"service-a": {GUID: "service-a-guid"},
+			}
+		})
+
+		It("plans to update only the changed properties", func() {
+			Expect(plan.Action).To(Equal(PlanUpdate))
+			Expect(plan.Changes).To(Equal([]PropertyChange{
+				{Property: "buildpack", Current: "java", Desired: "ruby"},
+				{Property: "env.FOO", Current: "[PRIVATE DATA HIDDEN]", Desired: "[PRIVATE DATA HIDDEN]"},
+			}))
+			Expect(plan.RoutesCreated).To(ConsistOf("new-host.example.com"))
+			Expect(plan.RoutesBound).To(ConsistOf("new-host.example.com"))
+			Expect(plan.RoutesUnbound).To(BeEmpty())
+			Expect(plan.ServicesBound).To(ConsistOf("service-b"))
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				config.DesiredRoutes = nil
+			})
+
+			It("plans to unbind the current routes", func() {
+				Expect(plan.RoutesCreated).To(BeEmpty())
+				Expect(plan.RoutesBound).To(BeEmpty())
+				Expect(plan.RoutesUnbound).To(ConsistOf("old-host.example.com"))
+			})
+		})
+
+		Context("when a deployment strategy is set", func() {
+			BeforeEach(func() {
+				config.Strategy = BlueGreenStrategy
+			})
+
+			It("plans to move every route and service to a replacement application", func() {
+				Expect(plan.Action).To(Equal(PlanReplace))
+				Expect(plan.Strategy).To(Equal(BlueGreenStrategy))
+				Expect(plan.RoutesCreated).To(ConsistOf("new-host.example.com"))
+				Expect(plan.RoutesBound).To(ConsistOf("new-host.example.com", "old-host.example.com"))
+				Expect(plan.RoutesUnbound).To(ConsistOf("old-host.example.com"))
+				Expect(plan.ServicesBound).To(ConsistOf("service-a", "service-b"))
+			})
+		})
+	})
+})
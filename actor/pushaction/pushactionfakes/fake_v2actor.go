@@ -23,6 +23,34 @@ type FakeV2Actor struct {
 		result1 v2action.Warnings
 		result2 error
 	}
+	BindSecurityGroupToSpaceStub        func(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error)
+	bindSecurityGroupToSpaceMutex       sync.RWMutex
+	bindSecurityGroupToSpaceArgsForCall []struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}
+	bindSecurityGroupToSpaceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindSecurityGroupToSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	BindServiceByApplicationAndServiceInstanceStub        func(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
+	bindServiceByApplicationAndServiceInstanceMutex       sync.RWMutex
+	bindServiceByApplicationAndServiceInstanceArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	bindServiceByApplicationAndServiceInstanceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	bindServiceByApplicationAndServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	CheckRouteStub        func(route v2action.Route) (bool, v2action.Warnings, error)
 	checkRouteMutex       sync.RWMutex
 	checkRouteArgsForCall []struct {
@@ -69,6 +97,117 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	CreateServiceInstanceStub        func(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	createServiceInstanceMutex       sync.RWMutex
+	createServiceInstanceArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+	}
+	createServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	createServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	CreateUserProvidedServiceInstanceStub        func(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	createUserProvidedServiceInstanceMutex       sync.RWMutex
+	createUserProvidedServiceInstanceArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+	}
+	createUserProvidedServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	createUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	DeleteApplicationStub        func(guid string) (v2action.Warnings, error)
+	deleteApplicationMutex       sync.RWMutex
+	deleteApplicationArgsForCall []struct {
+		guid string
+	}
+	deleteApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteRouteStub        func(routeGUID string) (v2action.Warnings, error)
+	deleteRouteMutex       sync.RWMutex
+	deleteRouteArgsForCall []struct {
+		routeGUID string
+	}
+	deleteRouteReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteRouteReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	DeleteServiceInstanceStub        func(serviceInstance v2action.ServiceInstance) (v2action.Warnings, error)
+	deleteServiceInstanceMutex       sync.RWMutex
+	deleteServiceInstanceArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+	}
+	deleteServiceInstanceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	deleteServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	GatherArchiveResourcesStub        func(archivePath string) ([]v2action.Resource, error)
+	gatherArchiveResourcesMutex       sync.RWMutex
+	gatherArchiveResourcesArgsForCall []struct {
+		archivePath string
+	}
+	gatherArchiveResourcesReturns struct {
+		result1 []v2action.Resource
+		result2 error
+	}
+	gatherArchiveResourcesReturnsOnCall map[int]struct {
+		result1 []v2action.Resource
+		result2 error
+	}
+	GatherDirectoryResourcesStub        func(sourceDir string, ignoreFile string) ([]v2action.Resource, error)
+	gatherDirectoryResourcesMutex       sync.RWMutex
+	gatherDirectoryResourcesArgsForCall []struct {
+		sourceDir  string
+		ignoreFile string
+	}
+	gatherDirectoryResourcesReturns struct {
+		result1 []v2action.Resource
+		result2 error
+	}
+	gatherDirectoryResourcesReturnsOnCall map[int]struct {
+		result1 []v2action.Resource
+		result2 error
+	}
+	GetApplicationStub        func(guid string) (v2action.Application, v2action.Warnings, error)
+	getApplicationMutex       sync.RWMutex
+	getApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 	getApplicationByNameAndSpaceMutex       sync.RWMutex
 	getApplicationByNameAndSpaceArgsForCall []struct {
@@ -85,6 +224,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationInstancesByApplicationStub        func(guid string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error)
+	getApplicationInstancesByApplicationMutex       sync.RWMutex
+	getApplicationInstancesByApplicationArgsForCall []struct {
+		guid string
+	}
+	getApplicationInstancesByApplicationReturns struct {
+		result1 map[int]v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationInstancesByApplicationReturnsOnCall map[int]struct {
+		result1 map[int]v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetApplicationRoutesStub        func(applicationGUID string) ([]v2action.Route, v2action.Warnings, error)
 	getApplicationRoutesMutex       sync.RWMutex
 	getApplicationRoutesArgsForCall []struct {
@@ -100,6 +254,21 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
 	GetOrganizationDomainsStub        func(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
 	getOrganizationDomainsMutex       sync.RWMutex
 	getOrganizationDomainsArgsForCall []struct {
@@ -115,22 +284,277 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
-	GetRouteByHostAndDomainStub        func(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
-	getRouteByHostAndDomainMutex       sync.RWMutex
-	getRouteByHostAndDomainArgsForCall []struct {
-		host       string
-		domainGUID string
+	GetOrphanedRoutesBySpaceStub        func(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	getOrphanedRoutesBySpaceMutex       sync.RWMutex
+	getOrphanedRoutesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getOrphanedRoutesBySpaceReturns struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	getOrphanedRoutesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRouteByComponentsStub        func(route v2action.Route) (v2action.Route, v2action.Warnings, error)
+	getRouteByComponentsMutex       sync.RWMutex
+	getRouteByComponentsArgsForCall []struct {
+		route v2action.Route
 	}
-	getRouteByHostAndDomainReturns struct {
+	getRouteByComponentsReturns struct {
 		result1 v2action.Route
 		result2 v2action.Warnings
 		result3 error
 	}
-	getRouteByHostAndDomainReturnsOnCall map[int]struct {
+	getRouteByComponentsReturnsOnCall map[int]struct {
 		result1 v2action.Route
 		result2 v2action.Warnings
 		result3 error
 	}
+	GetSecurityGroupByNameStub        func(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	getSecurityGroupByNameMutex       sync.RWMutex
+	getSecurityGroupByNameArgsForCall []struct {
+		securityGroupName string
+	}
+	getSecurityGroupByNameReturns struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSecurityGroupByNameReturnsOnCall map[int]struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceBindingByApplicationAndServiceInstanceStub        func(appGUID string, serviceInstanceGUID string) (v2action.ServiceBinding, v2action.Warnings, error)
+	getServiceBindingByApplicationAndServiceInstanceMutex       sync.RWMutex
+	getServiceBindingByApplicationAndServiceInstanceArgsForCall []struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}
+	getServiceBindingByApplicationAndServiceInstanceReturns struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingByApplicationAndServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceBindingsByApplicationStub        func(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error)
+	getServiceBindingsByApplicationMutex       sync.RWMutex
+	getServiceBindingsByApplicationArgsForCall []struct {
+		appGUID string
+	}
+	getServiceBindingsByApplicationReturns struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceBindingsByApplicationReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceInstanceByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstanceByNameAndSpaceMutex       sync.RWMutex
+	getServiceInstanceByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getServiceInstanceByNameAndSpaceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstanceByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServiceInstancesBySpaceStub        func(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	getServiceInstancesBySpaceMutex       sync.RWMutex
+	getServiceInstancesBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getServiceInstancesBySpaceReturns struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServiceInstancesBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetServicePlanByNameServiceAndSpaceStub        func(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error)
+	getServicePlanByNameServiceAndSpaceMutex       sync.RWMutex
+	getServicePlanByNameServiceAndSpaceArgsForCall []struct {
+		planName    string
+		serviceName string
+		spaceGUID   string
+	}
+	getServicePlanByNameServiceAndSpaceReturns struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}
+	getServicePlanByNameServiceAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceByOrganizationAndNameStub        func(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	getSpaceByOrganizationAndNameMutex       sync.RWMutex
+	getSpaceByOrganizationAndNameArgsForCall []struct {
+		orgGUID   string
+		spaceName string
+	}
+	getSpaceByOrganizationAndNameReturns struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceByOrganizationAndNameReturnsOnCall map[int]struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceQuotaStub        func(guid string) (v2action.SpaceQuota, v2action.Warnings, error)
+	getSpaceQuotaMutex       sync.RWMutex
+	getSpaceQuotaArgsForCall []struct {
+		guid string
+	}
+	getSpaceQuotaReturns struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceQuotaReturnsOnCall map[int]struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceQuotaByNameStub        func(orgGUID string, name string) (v2action.SpaceQuota, v2action.Warnings, error)
+	getSpaceQuotaByNameMutex       sync.RWMutex
+	getSpaceQuotaByNameArgsForCall []struct {
+		orgGUID string
+		name    string
+	}
+	getSpaceQuotaByNameReturns struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceQuotaByNameReturnsOnCall map[int]struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetSpaceRunningSecurityGroupsBySpaceStub        func(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	getSpaceRunningSecurityGroupsBySpaceMutex       sync.RWMutex
+	getSpaceRunningSecurityGroupsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getSpaceRunningSecurityGroupsBySpaceReturns struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	getSpaceRunningSecurityGroupsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetStackByNameStub        func(stackName string) (v2action.Stack, v2action.Warnings, error)
+	getStackByNameMutex       sync.RWMutex
+	getStackByNameArgsForCall []struct {
+		stackName string
+	}
+	getStackByNameReturns struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	getStackByNameReturnsOnCall map[int]struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}
+	IsArchiveStub        func(path string) bool
+	isArchiveMutex       sync.RWMutex
+	isArchiveArgsForCall []struct {
+		path string
+	}
+	isArchiveReturns struct {
+		result1 bool
+	}
+	isArchiveReturnsOnCall map[int]struct {
+		result1 bool
+	}
+	ResourceMatchStub        func(allResources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error)
+	resourceMatchMutex       sync.RWMutex
+	resourceMatchArgsForCall []struct {
+		allResources []v2action.Resource
+	}
+	resourceMatchReturns struct {
+		result1 []v2action.Resource
+		result2 []v2action.Resource
+		result3 v2action.Warnings
+		result4 error
+	}
+	resourceMatchReturnsOnCall map[int]struct {
+		result1 []v2action.Resource
+		result2 []v2action.Resource
+		result3 v2action.Warnings
+		result4 error
+	}
+	SetSpaceQuotaStub        func(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error)
+	setSpaceQuotaMutex       sync.RWMutex
+	setSpaceQuotaArgsForCall []struct {
+		spaceGUID      string
+		spaceQuotaGUID string
+	}
+	setSpaceQuotaReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	setSpaceQuotaReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	UnbindRouteFromApplicationStub        func(routeGUID string, appGUID string) (v2action.Warnings, error)
+	unbindRouteFromApplicationMutex       sync.RWMutex
+	unbindRouteFromApplicationArgsForCall []struct {
+		routeGUID string
+		appGUID   string
+	}
+	unbindRouteFromApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unbindRouteFromApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	UnbindSecurityGroupByNameAndSpaceStub        func(securityGroupName string, spaceGUID string) (v2action.Warnings, error)
+	unbindSecurityGroupByNameAndSpaceMutex       sync.RWMutex
+	unbindSecurityGroupByNameAndSpaceArgsForCall []struct {
+		securityGroupName string
+		spaceGUID         string
+	}
+	unbindSecurityGroupByNameAndSpaceReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	unbindSecurityGroupByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
 	UpdateApplicationStub        func(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	updateApplicationMutex       sync.RWMutex
 	updateApplicationArgsForCall []struct {
@@ -146,6 +570,79 @@ type FakeV2Actor struct {
 		result2 v2action.Warnings
 		result3 error
 	}
+	UpdateServiceInstanceStub        func(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	updateServiceInstanceMutex       sync.RWMutex
+	updateServiceInstanceArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+	}
+	updateServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	UpdateUserProvidedServiceInstanceStub        func(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	updateUserProvidedServiceInstanceMutex       sync.RWMutex
+	updateUserProvidedServiceInstanceArgsForCall []struct {
+		serviceInstance v2action.ServiceInstance
+	}
+	updateUserProvidedServiceInstanceReturns struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	updateUserProvidedServiceInstanceReturnsOnCall map[int]struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}
+	UploadApplicationStub        func(appGUID string, existingResources []v2action.Resource, zipPath string) (v2action.Warnings, error)
+	uploadApplicationMutex       sync.RWMutex
+	uploadApplicationArgsForCall []struct {
+		appGUID           string
+		existingResources []v2action.Resource
+		zipPath           string
+	}
+	uploadApplicationReturns struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	uploadApplicationReturnsOnCall map[int]struct {
+		result1 v2action.Warnings
+		result2 error
+	}
+	ZipArchiveResourcesStub        func(sourceArchivePath string, filesToInclude []v2action.Resource) (string, error)
+	zipArchiveResourcesMutex       sync.RWMutex
+	zipArchiveResourcesArgsForCall []struct {
+		sourceArchivePath string
+		filesToInclude    []v2action.Resource
+	}
+	zipArchiveResourcesReturns struct {
+		result1 string
+		result2 error
+	}
+	zipArchiveResourcesReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
+	ZipResourcesStub        func(sourceDir string, filesToInclude []v2action.Resource) (string, error)
+	zipResourcesMutex       sync.RWMutex
+	zipResourcesArgsForCall []struct {
+		sourceDir      string
+		filesToInclude []v2action.Resource
+	}
+	zipResourcesReturns struct {
+		result1 string
+		result2 error
+	}
+	zipResourcesReturnsOnCall map[int]struct {
+		result1 string
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -202,27 +699,131 @@ func (fake *FakeV2Actor) BindRouteToApplicationReturnsOnCall(i int, result1 v2ac
 	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) CheckRoute(route v2action.Route) (bool, v2action.Warnings, error) {
-	fake.checkRouteMutex.Lock()
-	ret, specificReturn := fake.checkRouteReturnsOnCall[len(fake.checkRouteArgsForCall)]
-	fake.checkRouteArgsForCall = append(fake.checkRouteArgsForCall, struct {
-		route v2action.Route
-	}{route})
-	fake.recordInvocation("CheckRoute", []interface{}{route})
-	fake.checkRouteMutex.Unlock()
-	if fake.CheckRouteStub != nil {
-		return fake.CheckRouteStub(route)
+func (fake *FakeV2Actor) BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error) {
+	fake.bindSecurityGroupToSpaceMutex.Lock()
+	ret, specificReturn := fake.bindSecurityGroupToSpaceReturnsOnCall[len(fake.bindSecurityGroupToSpaceArgsForCall)]
+	fake.bindSecurityGroupToSpaceArgsForCall = append(fake.bindSecurityGroupToSpaceArgsForCall, struct {
+		securityGroupGUID string
+		spaceGUID         string
+	}{securityGroupGUID, spaceGUID})
+	fake.recordInvocation("BindSecurityGroupToSpace", []interface{}{securityGroupGUID, spaceGUID})
+	fake.bindSecurityGroupToSpaceMutex.Unlock()
+	if fake.BindSecurityGroupToSpaceStub != nil {
+		return fake.BindSecurityGroupToSpaceStub(securityGroupGUID, spaceGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.checkRouteReturns.result1, fake.checkRouteReturns.result2, fake.checkRouteReturns.result3
+	return fake.bindSecurityGroupToSpaceReturns.result1, fake.bindSecurityGroupToSpaceReturns.result2
 }
 
-func (fake *FakeV2Actor) CheckRouteCallCount() int {
-	fake.checkRouteMutex.RLock()
-	defer fake.checkRouteMutex.RUnlock()
-	return len(fake.checkRouteArgsForCall)
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceCallCount() int {
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	return len(fake.bindSecurityGroupToSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceArgsForCall(i int) (string, string) {
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	return fake.bindSecurityGroupToSpaceArgsForCall[i].securityGroupGUID, fake.bindSecurityGroupToSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToSpaceStub = nil
+	fake.bindSecurityGroupToSpaceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindSecurityGroupToSpaceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindSecurityGroupToSpaceStub = nil
+	if fake.bindSecurityGroupToSpaceReturnsOnCall == nil {
+		fake.bindSecurityGroupToSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindSecurityGroupToSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error) {
+	fake.bindServiceByApplicationAndServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall[len(fake.bindServiceByApplicationAndServiceInstanceArgsForCall)]
+	fake.bindServiceByApplicationAndServiceInstanceArgsForCall = append(fake.bindServiceByApplicationAndServiceInstanceArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("BindServiceByApplicationAndServiceInstance", []interface{}{appGUID, serviceInstanceGUID})
+	fake.bindServiceByApplicationAndServiceInstanceMutex.Unlock()
+	if fake.BindServiceByApplicationAndServiceInstanceStub != nil {
+		return fake.BindServiceByApplicationAndServiceInstanceStub(appGUID, serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.bindServiceByApplicationAndServiceInstanceReturns.result1, fake.bindServiceByApplicationAndServiceInstanceReturns.result2
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceCallCount() int {
+	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
+	return len(fake.bindServiceByApplicationAndServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceArgsForCall(i int) (string, string) {
+	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
+	return fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].appGUID, fake.bindServiceByApplicationAndServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturns(result1 v2action.Warnings, result2 error) {
+	fake.BindServiceByApplicationAndServiceInstanceStub = nil
+	fake.bindServiceByApplicationAndServiceInstanceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) BindServiceByApplicationAndServiceInstanceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.BindServiceByApplicationAndServiceInstanceStub = nil
+	if fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall == nil {
+		fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.bindServiceByApplicationAndServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) CheckRoute(route v2action.Route) (bool, v2action.Warnings, error) {
+	fake.checkRouteMutex.Lock()
+	ret, specificReturn := fake.checkRouteReturnsOnCall[len(fake.checkRouteArgsForCall)]
+	fake.checkRouteArgsForCall = append(fake.checkRouteArgsForCall, struct {
+		route v2action.Route
+	}{route})
+	fake.recordInvocation("CheckRoute", []interface{}{route})
+	fake.checkRouteMutex.Unlock()
+	if fake.CheckRouteStub != nil {
+		return fake.CheckRouteStub(route)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.checkRouteReturns.result1, fake.checkRouteReturns.result2, fake.checkRouteReturns.result3
+}
+
+func (fake *FakeV2Actor) CheckRouteCallCount() int {
+	fake.checkRouteMutex.RLock()
+	defer fake.checkRouteMutex.RUnlock()
+	return len(fake.checkRouteArgsForCall)
 }
 
 func (fake *FakeV2Actor) CheckRouteArgsForCall(i int) v2action.Route {
@@ -365,276 +966,2001 @@ func (fake *FakeV2Actor) CreateRouteReturnsOnCall(i int, result1 v2action.Route,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
-	fake.getApplicationByNameAndSpaceMutex.Lock()
-	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
-	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
-		name      string
-		spaceGUID string
-	}{name, spaceGUID})
-	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
-	fake.getApplicationByNameAndSpaceMutex.Unlock()
-	if fake.GetApplicationByNameAndSpaceStub != nil {
-		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+func (fake *FakeV2Actor) CreateServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.createServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createServiceInstanceReturnsOnCall[len(fake.createServiceInstanceArgsForCall)]
+	fake.createServiceInstanceArgsForCall = append(fake.createServiceInstanceArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("CreateServiceInstance", []interface{}{serviceInstance})
+	fake.createServiceInstanceMutex.Unlock()
+	if fake.CreateServiceInstanceStub != nil {
+		return fake.CreateServiceInstanceStub(serviceInstance)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+	return fake.createServiceInstanceReturns.result1, fake.createServiceInstanceReturns.result2, fake.createServiceInstanceReturns.result3
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceCallCount() int {
-	fake.getApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+func (fake *FakeV2Actor) CreateServiceInstanceCallCount() int {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return len(fake.createServiceInstanceArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
-	fake.getApplicationByNameAndSpaceMutex.RLock()
-	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
-	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+func (fake *FakeV2Actor) CreateServiceInstanceArgsForCall(i int) v2action.ServiceInstance {
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	return fake.createServiceInstanceArgsForCall[i].serviceInstance
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationByNameAndSpaceStub = nil
-	fake.getApplicationByNameAndSpaceReturns = struct {
-		result1 v2action.Application
+func (fake *FakeV2Actor) CreateServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	fake.createServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationByNameAndSpaceStub = nil
-	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
-		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
-			result1 v2action.Application
+func (fake *FakeV2Actor) CreateServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateServiceInstanceStub = nil
+	if fake.createServiceInstanceReturnsOnCall == nil {
+		fake.createServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
 			result2 v2action.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
-		result1 v2action.Application
+	fake.createServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error) {
-	fake.getApplicationRoutesMutex.Lock()
-	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
-	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
-		applicationGUID string
-	}{applicationGUID})
-	fake.recordInvocation("GetApplicationRoutes", []interface{}{applicationGUID})
-	fake.getApplicationRoutesMutex.Unlock()
-	if fake.GetApplicationRoutesStub != nil {
-		return fake.GetApplicationRoutesStub(applicationGUID)
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.createUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.createUserProvidedServiceInstanceReturnsOnCall[len(fake.createUserProvidedServiceInstanceArgsForCall)]
+	fake.createUserProvidedServiceInstanceArgsForCall = append(fake.createUserProvidedServiceInstanceArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("CreateUserProvidedServiceInstance", []interface{}{serviceInstance})
+	fake.createUserProvidedServiceInstanceMutex.Unlock()
+	if fake.CreateUserProvidedServiceInstanceStub != nil {
+		return fake.CreateUserProvidedServiceInstanceStub(serviceInstance)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
 	}
-	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+	return fake.createUserProvidedServiceInstanceReturns.result1, fake.createUserProvidedServiceInstanceReturns.result2, fake.createUserProvidedServiceInstanceReturns.result3
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return len(fake.getApplicationRoutesArgsForCall)
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceCallCount() int {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.createUserProvidedServiceInstanceArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
-	fake.getApplicationRoutesMutex.RLock()
-	defer fake.getApplicationRoutesMutex.RUnlock()
-	return fake.getApplicationRoutesArgsForCall[i].applicationGUID
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceArgsForCall(i int) v2action.ServiceInstance {
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.createUserProvidedServiceInstanceArgsForCall[i].serviceInstance
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	fake.getApplicationRoutesReturns = struct {
-		result1 []v2action.Route
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	fake.createUserProvidedServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetApplicationRoutesReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.GetApplicationRoutesStub = nil
-	if fake.getApplicationRoutesReturnsOnCall == nil {
-		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
-			result1 []v2action.Route
+func (fake *FakeV2Actor) CreateUserProvidedServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.CreateUserProvidedServiceInstanceStub = nil
+	if fake.createUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.createUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
 			result2 v2action.Warnings
 			result3 error
 		})
 	}
-	fake.getApplicationRoutesReturnsOnCall[i] = struct {
-		result1 []v2action.Route
+	fake.createUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
 		result2 v2action.Warnings
 		result3 error
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2Actor) GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error) {
-	fake.getOrganizationDomainsMutex.Lock()
-	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
-	fake.getOrganizationDomainsArgsForCall = append(fake.getOrganizationDomainsArgsForCall, struct {
-		orgGUID string
-	}{orgGUID})
-	fake.recordInvocation("GetOrganizationDomains", []interface{}{orgGUID})
-	fake.getOrganizationDomainsMutex.Unlock()
-	if fake.GetOrganizationDomainsStub != nil {
-		return fake.GetOrganizationDomainsStub(orgGUID)
+func (fake *FakeV2Actor) DeleteApplication(guid string) (v2action.Warnings, error) {
+	fake.deleteApplicationMutex.Lock()
+	ret, specificReturn := fake.deleteApplicationReturnsOnCall[len(fake.deleteApplicationArgsForCall)]
+	fake.deleteApplicationArgsForCall = append(fake.deleteApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("DeleteApplication", []interface{}{guid})
+	fake.deleteApplicationMutex.Unlock()
+	if fake.DeleteApplicationStub != nil {
+		return fake.DeleteApplicationStub(guid)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getOrganizationDomainsReturns.result1, fake.getOrganizationDomainsReturns.result2, fake.getOrganizationDomainsReturns.result3
+	return fake.deleteApplicationReturns.result1, fake.deleteApplicationReturns.result2
 }
 
-func (fake *FakeV2Actor) GetOrganizationDomainsCallCount() int {
-	fake.getOrganizationDomainsMutex.RLock()
-	defer fake.getOrganizationDomainsMutex.RUnlock()
-	return len(fake.getOrganizationDomainsArgsForCall)
+func (fake *FakeV2Actor) DeleteApplicationCallCount() int {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return len(fake.deleteApplicationArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetOrganizationDomainsArgsForCall(i int) string {
-	fake.getOrganizationDomainsMutex.RLock()
-	defer fake.getOrganizationDomainsMutex.RUnlock()
-	return fake.getOrganizationDomainsArgsForCall[i].orgGUID
+func (fake *FakeV2Actor) DeleteApplicationArgsForCall(i int) string {
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	return fake.deleteApplicationArgsForCall[i].guid
 }
 
-func (fake *FakeV2Actor) GetOrganizationDomainsReturns(result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
-	fake.GetOrganizationDomainsStub = nil
-	fake.getOrganizationDomainsReturns = struct {
-		result1 []v2action.Domain
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeV2Actor) DeleteApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	fake.deleteApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
-	fake.GetOrganizationDomainsStub = nil
-	if fake.getOrganizationDomainsReturnsOnCall == nil {
-		fake.getOrganizationDomainsReturnsOnCall = make(map[int]struct {
-			result1 []v2action.Domain
-			result2 v2action.Warnings
-			result3 error
+func (fake *FakeV2Actor) DeleteApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteApplicationStub = nil
+	if fake.deleteApplicationReturnsOnCall == nil {
+		fake.deleteApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
 		})
 	}
-	fake.getOrganizationDomainsReturnsOnCall[i] = struct {
-		result1 []v2action.Domain
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error) {
-	fake.getRouteByHostAndDomainMutex.Lock()
-	ret, specificReturn := fake.getRouteByHostAndDomainReturnsOnCall[len(fake.getRouteByHostAndDomainArgsForCall)]
-	fake.getRouteByHostAndDomainArgsForCall = append(fake.getRouteByHostAndDomainArgsForCall, struct {
-		host       string
-		domainGUID string
-	}{host, domainGUID})
-	fake.recordInvocation("GetRouteByHostAndDomain", []interface{}{host, domainGUID})
-	fake.getRouteByHostAndDomainMutex.Unlock()
-	if fake.GetRouteByHostAndDomainStub != nil {
-		return fake.GetRouteByHostAndDomainStub(host, domainGUID)
+func (fake *FakeV2Actor) DeleteRoute(routeGUID string) (v2action.Warnings, error) {
+	fake.deleteRouteMutex.Lock()
+	ret, specificReturn := fake.deleteRouteReturnsOnCall[len(fake.deleteRouteArgsForCall)]
+	fake.deleteRouteArgsForCall = append(fake.deleteRouteArgsForCall, struct {
+		routeGUID string
+	}{routeGUID})
+	fake.recordInvocation("DeleteRoute", []interface{}{routeGUID})
+	fake.deleteRouteMutex.Unlock()
+	if fake.DeleteRouteStub != nil {
+		return fake.DeleteRouteStub(routeGUID)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.getRouteByHostAndDomainReturns.result1, fake.getRouteByHostAndDomainReturns.result2, fake.getRouteByHostAndDomainReturns.result3
+	return fake.deleteRouteReturns.result1, fake.deleteRouteReturns.result2
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainCallCount() int {
-	fake.getRouteByHostAndDomainMutex.RLock()
-	defer fake.getRouteByHostAndDomainMutex.RUnlock()
-	return len(fake.getRouteByHostAndDomainArgsForCall)
+func (fake *FakeV2Actor) DeleteRouteCallCount() int {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return len(fake.deleteRouteArgsForCall)
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainArgsForCall(i int) (string, string) {
-	fake.getRouteByHostAndDomainMutex.RLock()
-	defer fake.getRouteByHostAndDomainMutex.RUnlock()
-	return fake.getRouteByHostAndDomainArgsForCall[i].host, fake.getRouteByHostAndDomainArgsForCall[i].domainGUID
+func (fake *FakeV2Actor) DeleteRouteArgsForCall(i int) string {
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	return fake.deleteRouteArgsForCall[i].routeGUID
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.GetRouteByHostAndDomainStub = nil
-	fake.getRouteByHostAndDomainReturns = struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeV2Actor) DeleteRouteReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	fake.deleteRouteReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) GetRouteByHostAndDomainReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
-	fake.GetRouteByHostAndDomainStub = nil
-	if fake.getRouteByHostAndDomainReturnsOnCall == nil {
-		fake.getRouteByHostAndDomainReturnsOnCall = make(map[int]struct {
-			result1 v2action.Route
-			result2 v2action.Warnings
-			result3 error
+func (fake *FakeV2Actor) DeleteRouteReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteRouteStub = nil
+	if fake.deleteRouteReturnsOnCall == nil {
+		fake.deleteRouteReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
 		})
 	}
-	fake.getRouteByHostAndDomainReturnsOnCall[i] = struct {
-		result1 v2action.Route
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteRouteReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
-	fake.updateApplicationMutex.Lock()
-	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
-	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
-		application v2action.Application
-	}{application})
-	fake.recordInvocation("UpdateApplication", []interface{}{application})
-	fake.updateApplicationMutex.Unlock()
-	if fake.UpdateApplicationStub != nil {
-		return fake.UpdateApplicationStub(application)
+func (fake *FakeV2Actor) DeleteServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.Warnings, error) {
+	fake.deleteServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.deleteServiceInstanceReturnsOnCall[len(fake.deleteServiceInstanceArgsForCall)]
+	fake.deleteServiceInstanceArgsForCall = append(fake.deleteServiceInstanceArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("DeleteServiceInstance", []interface{}{serviceInstance})
+	fake.deleteServiceInstanceMutex.Unlock()
+	if fake.DeleteServiceInstanceStub != nil {
+		return fake.DeleteServiceInstanceStub(serviceInstance)
 	}
 	if specificReturn {
-		return ret.result1, ret.result2, ret.result3
+		return ret.result1, ret.result2
 	}
-	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+	return fake.deleteServiceInstanceReturns.result1, fake.deleteServiceInstanceReturns.result2
 }
 
-func (fake *FakeV2Actor) UpdateApplicationCallCount() int {
-	fake.updateApplicationMutex.RLock()
-	defer fake.updateApplicationMutex.RUnlock()
-	return len(fake.updateApplicationArgsForCall)
+func (fake *FakeV2Actor) DeleteServiceInstanceCallCount() int {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return len(fake.deleteServiceInstanceArgsForCall)
 }
 
-func (fake *FakeV2Actor) UpdateApplicationArgsForCall(i int) v2action.Application {
-	fake.updateApplicationMutex.RLock()
-	defer fake.updateApplicationMutex.RUnlock()
-	return fake.updateApplicationArgsForCall[i].application
+func (fake *FakeV2Actor) DeleteServiceInstanceArgsForCall(i int) v2action.ServiceInstance {
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	return fake.deleteServiceInstanceArgsForCall[i].serviceInstance
 }
 
-func (fake *FakeV2Actor) UpdateApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
-	fake.UpdateApplicationStub = nil
-	fake.updateApplicationReturns = struct {
-		result1 v2action.Application
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+func (fake *FakeV2Actor) DeleteServiceInstanceReturns(result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	fake.deleteServiceInstanceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
 }
 
-func (fake *FakeV2Actor) UpdateApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
-	fake.UpdateApplicationStub = nil
-	if fake.updateApplicationReturnsOnCall == nil {
-		fake.updateApplicationReturnsOnCall = make(map[int]struct {
-			result1 v2action.Application
-			result2 v2action.Warnings
-			result3 error
+func (fake *FakeV2Actor) DeleteServiceInstanceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.DeleteServiceInstanceStub = nil
+	if fake.deleteServiceInstanceReturnsOnCall == nil {
+		fake.deleteServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
 		})
 	}
-	fake.updateApplicationReturnsOnCall[i] = struct {
-		result1 v2action.Application
-		result2 v2action.Warnings
-		result3 error
-	}{result1, result2, result3}
+	fake.deleteServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GatherArchiveResources(archivePath string) ([]v2action.Resource, error) {
+	fake.gatherArchiveResourcesMutex.Lock()
+	ret, specificReturn := fake.gatherArchiveResourcesReturnsOnCall[len(fake.gatherArchiveResourcesArgsForCall)]
+	fake.gatherArchiveResourcesArgsForCall = append(fake.gatherArchiveResourcesArgsForCall, struct {
+		archivePath string
+	}{archivePath})
+	fake.recordInvocation("GatherArchiveResources", []interface{}{archivePath})
+	fake.gatherArchiveResourcesMutex.Unlock()
+	if fake.GatherArchiveResourcesStub != nil {
+		return fake.GatherArchiveResourcesStub(archivePath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.gatherArchiveResourcesReturns.result1, fake.gatherArchiveResourcesReturns.result2
+}
+
+func (fake *FakeV2Actor) GatherArchiveResourcesCallCount() int {
+	fake.gatherArchiveResourcesMutex.RLock()
+	defer fake.gatherArchiveResourcesMutex.RUnlock()
+	return len(fake.gatherArchiveResourcesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GatherArchiveResourcesArgsForCall(i int) string {
+	fake.gatherArchiveResourcesMutex.RLock()
+	defer fake.gatherArchiveResourcesMutex.RUnlock()
+	return fake.gatherArchiveResourcesArgsForCall[i].archivePath
+}
+
+func (fake *FakeV2Actor) GatherArchiveResourcesReturns(result1 []v2action.Resource, result2 error) {
+	fake.GatherArchiveResourcesStub = nil
+	fake.gatherArchiveResourcesReturns = struct {
+		result1 []v2action.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GatherArchiveResourcesReturnsOnCall(i int, result1 []v2action.Resource, result2 error) {
+	fake.GatherArchiveResourcesStub = nil
+	if fake.gatherArchiveResourcesReturnsOnCall == nil {
+		fake.gatherArchiveResourcesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Resource
+			result2 error
+		})
+	}
+	fake.gatherArchiveResourcesReturnsOnCall[i] = struct {
+		result1 []v2action.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GatherDirectoryResources(sourceDir string, ignoreFile string) ([]v2action.Resource, error) {
+	fake.gatherDirectoryResourcesMutex.Lock()
+	ret, specificReturn := fake.gatherDirectoryResourcesReturnsOnCall[len(fake.gatherDirectoryResourcesArgsForCall)]
+	fake.gatherDirectoryResourcesArgsForCall = append(fake.gatherDirectoryResourcesArgsForCall, struct {
+		sourceDir  string
+		ignoreFile string
+	}{sourceDir, ignoreFile})
+	fake.recordInvocation("GatherDirectoryResources", []interface{}{sourceDir, ignoreFile})
+	fake.gatherDirectoryResourcesMutex.Unlock()
+	if fake.GatherDirectoryResourcesStub != nil {
+		return fake.GatherDirectoryResourcesStub(sourceDir, ignoreFile)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.gatherDirectoryResourcesReturns.result1, fake.gatherDirectoryResourcesReturns.result2
+}
+
+func (fake *FakeV2Actor) GatherDirectoryResourcesCallCount() int {
+	fake.gatherDirectoryResourcesMutex.RLock()
+	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	return len(fake.gatherDirectoryResourcesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GatherDirectoryResourcesArgsForCall(i int) (string, string) {
+	fake.gatherDirectoryResourcesMutex.RLock()
+	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	return fake.gatherDirectoryResourcesArgsForCall[i].sourceDir, fake.gatherDirectoryResourcesArgsForCall[i].ignoreFile
+}
+
+func (fake *FakeV2Actor) GatherDirectoryResourcesReturns(result1 []v2action.Resource, result2 error) {
+	fake.GatherDirectoryResourcesStub = nil
+	fake.gatherDirectoryResourcesReturns = struct {
+		result1 []v2action.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GatherDirectoryResourcesReturnsOnCall(i int, result1 []v2action.Resource, result2 error) {
+	fake.GatherDirectoryResourcesStub = nil
+	if fake.gatherDirectoryResourcesReturnsOnCall == nil {
+		fake.gatherDirectoryResourcesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Resource
+			result2 error
+		})
+	}
+	fake.gatherDirectoryResourcesReturnsOnCall[i] = struct {
+		result1 []v2action.Resource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) GetApplication(guid string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationReturnsOnCall[len(fake.getApplicationArgsForCall)]
+	fake.getApplicationArgsForCall = append(fake.getApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplication", []interface{}{guid})
+	fake.getApplicationMutex.Unlock()
+	if fake.GetApplicationStub != nil {
+		return fake.GetApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationReturns.result1, fake.getApplicationReturns.result2, fake.getApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationCallCount() int {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return len(fake.getApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationArgsForCall(i int) string {
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
+	return fake.getApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	fake.getApplicationReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationStub = nil
+	if fake.getApplicationReturnsOnCall == nil {
+		fake.getApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesByApplication(guid string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error) {
+	fake.getApplicationInstancesByApplicationMutex.Lock()
+	ret, specificReturn := fake.getApplicationInstancesByApplicationReturnsOnCall[len(fake.getApplicationInstancesByApplicationArgsForCall)]
+	fake.getApplicationInstancesByApplicationArgsForCall = append(fake.getApplicationInstancesByApplicationArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetApplicationInstancesByApplication", []interface{}{guid})
+	fake.getApplicationInstancesByApplicationMutex.Unlock()
+	if fake.GetApplicationInstancesByApplicationStub != nil {
+		return fake.GetApplicationInstancesByApplicationStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationInstancesByApplicationReturns.result1, fake.getApplicationInstancesByApplicationReturns.result2, fake.getApplicationInstancesByApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesByApplicationCallCount() int {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return len(fake.getApplicationInstancesByApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesByApplicationArgsForCall(i int) string {
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
+	return fake.getApplicationInstancesByApplicationArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesByApplicationReturns(result1 map[int]v2action.ApplicationInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	fake.getApplicationInstancesByApplicationReturns = struct {
+		result1 map[int]v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationInstancesByApplicationReturnsOnCall(i int, result1 map[int]v2action.ApplicationInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationInstancesByApplicationStub = nil
+	if fake.getApplicationInstancesByApplicationReturnsOnCall == nil {
+		fake.getApplicationInstancesByApplicationReturnsOnCall = make(map[int]struct {
+			result1 map[int]v2action.ApplicationInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationInstancesByApplicationReturnsOnCall[i] = struct {
+		result1 map[int]v2action.ApplicationInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error) {
+	fake.getApplicationRoutesMutex.Lock()
+	ret, specificReturn := fake.getApplicationRoutesReturnsOnCall[len(fake.getApplicationRoutesArgsForCall)]
+	fake.getApplicationRoutesArgsForCall = append(fake.getApplicationRoutesArgsForCall, struct {
+		applicationGUID string
+	}{applicationGUID})
+	fake.recordInvocation("GetApplicationRoutes", []interface{}{applicationGUID})
+	fake.getApplicationRoutesMutex.Unlock()
+	if fake.GetApplicationRoutesStub != nil {
+		return fake.GetApplicationRoutesStub(applicationGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationRoutesReturns.result1, fake.getApplicationRoutesReturns.result2, fake.getApplicationRoutesReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesCallCount() int {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return len(fake.getApplicationRoutesArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesArgsForCall(i int) string {
+	fake.getApplicationRoutesMutex.RLock()
+	defer fake.getApplicationRoutesMutex.RUnlock()
+	return fake.getApplicationRoutesArgsForCall[i].applicationGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	fake.getApplicationRoutesReturns = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationRoutesReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationRoutesStub = nil
+	if fake.getApplicationRoutesReturnsOnCall == nil {
+		fake.getApplicationRoutesReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationRoutesReturnsOnCall[i] = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error) {
+	fake.getOrganizationDomainsMutex.Lock()
+	ret, specificReturn := fake.getOrganizationDomainsReturnsOnCall[len(fake.getOrganizationDomainsArgsForCall)]
+	fake.getOrganizationDomainsArgsForCall = append(fake.getOrganizationDomainsArgsForCall, struct {
+		orgGUID string
+	}{orgGUID})
+	fake.recordInvocation("GetOrganizationDomains", []interface{}{orgGUID})
+	fake.getOrganizationDomainsMutex.Unlock()
+	if fake.GetOrganizationDomainsStub != nil {
+		return fake.GetOrganizationDomainsStub(orgGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrganizationDomainsReturns.result1, fake.getOrganizationDomainsReturns.result2, fake.getOrganizationDomainsReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsCallCount() int {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return len(fake.getOrganizationDomainsArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsArgsForCall(i int) string {
+	fake.getOrganizationDomainsMutex.RLock()
+	defer fake.getOrganizationDomainsMutex.RUnlock()
+	return fake.getOrganizationDomainsArgsForCall[i].orgGUID
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsReturns(result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainsStub = nil
+	fake.getOrganizationDomainsReturns = struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrganizationDomainsReturnsOnCall(i int, result1 []v2action.Domain, result2 v2action.Warnings, result3 error) {
+	fake.GetOrganizationDomainsStub = nil
+	if fake.getOrganizationDomainsReturnsOnCall == nil {
+		fake.getOrganizationDomainsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Domain
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrganizationDomainsReturnsOnCall[i] = struct {
+		result1 []v2action.Domain
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpace(spaceGUID string) ([]v2action.Route, v2action.Warnings, error) {
+	fake.getOrphanedRoutesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getOrphanedRoutesBySpaceReturnsOnCall[len(fake.getOrphanedRoutesBySpaceArgsForCall)]
+	fake.getOrphanedRoutesBySpaceArgsForCall = append(fake.getOrphanedRoutesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetOrphanedRoutesBySpace", []interface{}{spaceGUID})
+	fake.getOrphanedRoutesBySpaceMutex.Unlock()
+	if fake.GetOrphanedRoutesBySpaceStub != nil {
+		return fake.GetOrphanedRoutesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getOrphanedRoutesBySpaceReturns.result1, fake.getOrphanedRoutesBySpaceReturns.result2, fake.getOrphanedRoutesBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceCallCount() int {
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	return len(fake.getOrphanedRoutesBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceArgsForCall(i int) string {
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	return fake.getOrphanedRoutesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceReturns(result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetOrphanedRoutesBySpaceStub = nil
+	fake.getOrphanedRoutesBySpaceReturns = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetOrphanedRoutesBySpaceReturnsOnCall(i int, result1 []v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetOrphanedRoutesBySpaceStub = nil
+	if fake.getOrphanedRoutesBySpaceReturnsOnCall == nil {
+		fake.getOrphanedRoutesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getOrphanedRoutesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetRouteByComponents(route v2action.Route) (v2action.Route, v2action.Warnings, error) {
+	fake.getRouteByComponentsMutex.Lock()
+	ret, specificReturn := fake.getRouteByComponentsReturnsOnCall[len(fake.getRouteByComponentsArgsForCall)]
+	fake.getRouteByComponentsArgsForCall = append(fake.getRouteByComponentsArgsForCall, struct {
+		route v2action.Route
+	}{route})
+	fake.recordInvocation("GetRouteByComponents", []interface{}{route})
+	fake.getRouteByComponentsMutex.Unlock()
+	if fake.GetRouteByComponentsStub != nil {
+		return fake.GetRouteByComponentsStub(route)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getRouteByComponentsReturns.result1, fake.getRouteByComponentsReturns.result2, fake.getRouteByComponentsReturns.result3
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsCallCount() int {
+	fake.getRouteByComponentsMutex.RLock()
+	defer fake.getRouteByComponentsMutex.RUnlock()
+	return len(fake.getRouteByComponentsArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsArgsForCall(i int) v2action.Route {
+	fake.getRouteByComponentsMutex.RLock()
+	defer fake.getRouteByComponentsMutex.RUnlock()
+	return fake.getRouteByComponentsArgsForCall[i].route
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsReturns(result1 v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteByComponentsStub = nil
+	fake.getRouteByComponentsReturns = struct {
+		result1 v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetRouteByComponentsReturnsOnCall(i int, result1 v2action.Route, result2 v2action.Warnings, result3 error) {
+	fake.GetRouteByComponentsStub = nil
+	if fake.getRouteByComponentsReturnsOnCall == nil {
+		fake.getRouteByComponentsReturnsOnCall = make(map[int]struct {
+			result1 v2action.Route
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getRouteByComponentsReturnsOnCall[i] = struct {
+		result1 v2action.Route
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSecurityGroupByNameMutex.Lock()
+	ret, specificReturn := fake.getSecurityGroupByNameReturnsOnCall[len(fake.getSecurityGroupByNameArgsForCall)]
+	fake.getSecurityGroupByNameArgsForCall = append(fake.getSecurityGroupByNameArgsForCall, struct {
+		securityGroupName string
+	}{securityGroupName})
+	fake.recordInvocation("GetSecurityGroupByName", []interface{}{securityGroupName})
+	fake.getSecurityGroupByNameMutex.Unlock()
+	if fake.GetSecurityGroupByNameStub != nil {
+		return fake.GetSecurityGroupByNameStub(securityGroupName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSecurityGroupByNameReturns.result1, fake.getSecurityGroupByNameReturns.result2, fake.getSecurityGroupByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameCallCount() int {
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	return len(fake.getSecurityGroupByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameArgsForCall(i int) string {
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	return fake.getSecurityGroupByNameArgsForCall[i].securityGroupName
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameReturns(result1 v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupByNameStub = nil
+	fake.getSecurityGroupByNameReturns = struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSecurityGroupByNameReturnsOnCall(i int, result1 v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSecurityGroupByNameStub = nil
+	if fake.getSecurityGroupByNameReturnsOnCall == nil {
+		fake.getSecurityGroupByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.SecurityGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSecurityGroupByNameReturnsOnCall[i] = struct {
+		result1 v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.ServiceBinding, v2action.Warnings, error) {
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall[len(fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall)]
+	fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall = append(fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall, struct {
+		appGUID             string
+		serviceInstanceGUID string
+	}{appGUID, serviceInstanceGUID})
+	fake.recordInvocation("GetServiceBindingByApplicationAndServiceInstance", []interface{}{appGUID, serviceInstanceGUID})
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.Unlock()
+	if fake.GetServiceBindingByApplicationAndServiceInstanceStub != nil {
+		return fake.GetServiceBindingByApplicationAndServiceInstanceStub(appGUID, serviceInstanceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingByApplicationAndServiceInstanceReturns.result1, fake.getServiceBindingByApplicationAndServiceInstanceReturns.result2, fake.getServiceBindingByApplicationAndServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceCallCount() int {
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceMutex.RUnlock()
+	return len(fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceArgsForCall(i int) (string, string) {
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceMutex.RUnlock()
+	return fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall[i].appGUID, fake.getServiceBindingByApplicationAndServiceInstanceArgsForCall[i].serviceInstanceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceReturns(result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingByApplicationAndServiceInstanceStub = nil
+	fake.getServiceBindingByApplicationAndServiceInstanceReturns = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceBindingByApplicationAndServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingByApplicationAndServiceInstanceStub = nil
+	if fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall == nil {
+		fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingByApplicationAndServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplication(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error) {
+	fake.getServiceBindingsByApplicationMutex.Lock()
+	ret, specificReturn := fake.getServiceBindingsByApplicationReturnsOnCall[len(fake.getServiceBindingsByApplicationArgsForCall)]
+	fake.getServiceBindingsByApplicationArgsForCall = append(fake.getServiceBindingsByApplicationArgsForCall, struct {
+		appGUID string
+	}{appGUID})
+	fake.recordInvocation("GetServiceBindingsByApplication", []interface{}{appGUID})
+	fake.getServiceBindingsByApplicationMutex.Unlock()
+	if fake.GetServiceBindingsByApplicationStub != nil {
+		return fake.GetServiceBindingsByApplicationStub(appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceBindingsByApplicationReturns.result1, fake.getServiceBindingsByApplicationReturns.result2, fake.getServiceBindingsByApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationCallCount() int {
+	fake.getServiceBindingsByApplicationMutex.RLock()
+	defer fake.getServiceBindingsByApplicationMutex.RUnlock()
+	return len(fake.getServiceBindingsByApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationArgsForCall(i int) string {
+	fake.getServiceBindingsByApplicationMutex.RLock()
+	defer fake.getServiceBindingsByApplicationMutex.RUnlock()
+	return fake.getServiceBindingsByApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationReturns(result1 []v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingsByApplicationStub = nil
+	fake.getServiceBindingsByApplicationReturns = struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceBindingsByApplicationReturnsOnCall(i int, result1 []v2action.ServiceBinding, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceBindingsByApplicationStub = nil
+	if fake.getServiceBindingsByApplicationReturnsOnCall == nil {
+		fake.getServiceBindingsByApplicationReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceBinding
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceBindingsByApplicationReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceBinding
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstanceByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstanceByNameAndSpaceReturnsOnCall[len(fake.getServiceInstanceByNameAndSpaceArgsForCall)]
+	fake.getServiceInstanceByNameAndSpaceArgsForCall = append(fake.getServiceInstanceByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetServiceInstanceByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getServiceInstanceByNameAndSpaceMutex.Unlock()
+	if fake.GetServiceInstanceByNameAndSpaceStub != nil {
+		return fake.GetServiceInstanceByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstanceByNameAndSpaceReturns.result1, fake.getServiceInstanceByNameAndSpaceReturns.result2, fake.getServiceInstanceByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceCallCount() int {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return len(fake.getServiceInstanceByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	return fake.getServiceInstanceByNameAndSpaceArgsForCall[i].name, fake.getServiceInstanceByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	fake.getServiceInstanceByNameAndSpaceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstanceByNameAndSpaceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstanceByNameAndSpaceStub = nil
+	if fake.getServiceInstanceByNameAndSpaceReturnsOnCall == nil {
+		fake.getServiceInstanceByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstanceByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.getServiceInstancesBySpaceMutex.Lock()
+	ret, specificReturn := fake.getServiceInstancesBySpaceReturnsOnCall[len(fake.getServiceInstancesBySpaceArgsForCall)]
+	fake.getServiceInstancesBySpaceArgsForCall = append(fake.getServiceInstancesBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetServiceInstancesBySpace", []interface{}{spaceGUID})
+	fake.getServiceInstancesBySpaceMutex.Unlock()
+	if fake.GetServiceInstancesBySpaceStub != nil {
+		return fake.GetServiceInstancesBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServiceInstancesBySpaceReturns.result1, fake.getServiceInstancesBySpaceReturns.result2, fake.getServiceInstancesBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceCallCount() int {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return len(fake.getServiceInstancesBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceArgsForCall(i int) string {
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	return fake.getServiceInstancesBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceReturns(result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	fake.getServiceInstancesBySpaceReturns = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServiceInstancesBySpaceReturnsOnCall(i int, result1 []v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.GetServiceInstancesBySpaceStub = nil
+	if fake.getServiceInstancesBySpaceReturnsOnCall == nil {
+		fake.getServiceInstancesBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServiceInstancesBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameServiceAndSpace(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error) {
+	fake.getServicePlanByNameServiceAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getServicePlanByNameServiceAndSpaceReturnsOnCall[len(fake.getServicePlanByNameServiceAndSpaceArgsForCall)]
+	fake.getServicePlanByNameServiceAndSpaceArgsForCall = append(fake.getServicePlanByNameServiceAndSpaceArgsForCall, struct {
+		planName    string
+		serviceName string
+		spaceGUID   string
+	}{planName, serviceName, spaceGUID})
+	fake.recordInvocation("GetServicePlanByNameServiceAndSpace", []interface{}{planName, serviceName, spaceGUID})
+	fake.getServicePlanByNameServiceAndSpaceMutex.Unlock()
+	if fake.GetServicePlanByNameServiceAndSpaceStub != nil {
+		return fake.GetServicePlanByNameServiceAndSpaceStub(planName, serviceName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getServicePlanByNameServiceAndSpaceReturns.result1, fake.getServicePlanByNameServiceAndSpaceReturns.result2, fake.getServicePlanByNameServiceAndSpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameServiceAndSpaceCallCount() int {
+	fake.getServicePlanByNameServiceAndSpaceMutex.RLock()
+	defer fake.getServicePlanByNameServiceAndSpaceMutex.RUnlock()
+	return len(fake.getServicePlanByNameServiceAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameServiceAndSpaceArgsForCall(i int) (string, string, string) {
+	fake.getServicePlanByNameServiceAndSpaceMutex.RLock()
+	defer fake.getServicePlanByNameServiceAndSpaceMutex.RUnlock()
+	return fake.getServicePlanByNameServiceAndSpaceArgsForCall[i].planName, fake.getServicePlanByNameServiceAndSpaceArgsForCall[i].serviceName, fake.getServicePlanByNameServiceAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameServiceAndSpaceReturns(result1 v2action.ServicePlan, result2 v2action.Warnings, result3 error) {
+	fake.GetServicePlanByNameServiceAndSpaceStub = nil
+	fake.getServicePlanByNameServiceAndSpaceReturns = struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetServicePlanByNameServiceAndSpaceReturnsOnCall(i int, result1 v2action.ServicePlan, result2 v2action.Warnings, result3 error) {
+	fake.GetServicePlanByNameServiceAndSpaceStub = nil
+	if fake.getServicePlanByNameServiceAndSpaceReturnsOnCall == nil {
+		fake.getServicePlanByNameServiceAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServicePlan
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getServicePlanByNameServiceAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.ServicePlan
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error) {
+	fake.getSpaceByOrganizationAndNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceByOrganizationAndNameReturnsOnCall[len(fake.getSpaceByOrganizationAndNameArgsForCall)]
+	fake.getSpaceByOrganizationAndNameArgsForCall = append(fake.getSpaceByOrganizationAndNameArgsForCall, struct {
+		orgGUID   string
+		spaceName string
+	}{orgGUID, spaceName})
+	fake.recordInvocation("GetSpaceByOrganizationAndName", []interface{}{orgGUID, spaceName})
+	fake.getSpaceByOrganizationAndNameMutex.Unlock()
+	if fake.GetSpaceByOrganizationAndNameStub != nil {
+		return fake.GetSpaceByOrganizationAndNameStub(orgGUID, spaceName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceByOrganizationAndNameReturns.result1, fake.getSpaceByOrganizationAndNameReturns.result2, fake.getSpaceByOrganizationAndNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameCallCount() int {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return len(fake.getSpaceByOrganizationAndNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameArgsForCall(i int) (string, string) {
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	return fake.getSpaceByOrganizationAndNameArgsForCall[i].orgGUID, fake.getSpaceByOrganizationAndNameArgsForCall[i].spaceName
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameReturns(result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	fake.getSpaceByOrganizationAndNameReturns = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceByOrganizationAndNameReturnsOnCall(i int, result1 v2action.Space, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceByOrganizationAndNameStub = nil
+	if fake.getSpaceByOrganizationAndNameReturnsOnCall == nil {
+		fake.getSpaceByOrganizationAndNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Space
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceByOrganizationAndNameReturnsOnCall[i] = struct {
+		result1 v2action.Space
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceQuota(guid string) (v2action.SpaceQuota, v2action.Warnings, error) {
+	fake.getSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotaReturnsOnCall[len(fake.getSpaceQuotaArgsForCall)]
+	fake.getSpaceQuotaArgsForCall = append(fake.getSpaceQuotaArgsForCall, struct {
+		guid string
+	}{guid})
+	fake.recordInvocation("GetSpaceQuota", []interface{}{guid})
+	fake.getSpaceQuotaMutex.Unlock()
+	if fake.GetSpaceQuotaStub != nil {
+		return fake.GetSpaceQuotaStub(guid)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotaReturns.result1, fake.getSpaceQuotaReturns.result2, fake.getSpaceQuotaReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaCallCount() int {
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	return len(fake.getSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaArgsForCall(i int) string {
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	return fake.getSpaceQuotaArgsForCall[i].guid
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaReturns(result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceQuotaStub = nil
+	fake.getSpaceQuotaReturns = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaReturnsOnCall(i int, result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceQuotaStub = nil
+	if fake.getSpaceQuotaReturnsOnCall == nil {
+		fake.getSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotaReturnsOnCall[i] = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaByName(orgGUID string, name string) (v2action.SpaceQuota, v2action.Warnings, error) {
+	fake.getSpaceQuotaByNameMutex.Lock()
+	ret, specificReturn := fake.getSpaceQuotaByNameReturnsOnCall[len(fake.getSpaceQuotaByNameArgsForCall)]
+	fake.getSpaceQuotaByNameArgsForCall = append(fake.getSpaceQuotaByNameArgsForCall, struct {
+		orgGUID string
+		name    string
+	}{orgGUID, name})
+	fake.recordInvocation("GetSpaceQuotaByName", []interface{}{orgGUID, name})
+	fake.getSpaceQuotaByNameMutex.Unlock()
+	if fake.GetSpaceQuotaByNameStub != nil {
+		return fake.GetSpaceQuotaByNameStub(orgGUID, name)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceQuotaByNameReturns.result1, fake.getSpaceQuotaByNameReturns.result2, fake.getSpaceQuotaByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaByNameCallCount() int {
+	fake.getSpaceQuotaByNameMutex.RLock()
+	defer fake.getSpaceQuotaByNameMutex.RUnlock()
+	return len(fake.getSpaceQuotaByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaByNameArgsForCall(i int) (string, string) {
+	fake.getSpaceQuotaByNameMutex.RLock()
+	defer fake.getSpaceQuotaByNameMutex.RUnlock()
+	return fake.getSpaceQuotaByNameArgsForCall[i].orgGUID, fake.getSpaceQuotaByNameArgsForCall[i].name
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaByNameReturns(result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceQuotaByNameStub = nil
+	fake.getSpaceQuotaByNameReturns = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceQuotaByNameReturnsOnCall(i int, result1 v2action.SpaceQuota, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceQuotaByNameStub = nil
+	if fake.getSpaceQuotaByNameReturnsOnCall == nil {
+		fake.getSpaceQuotaByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.SpaceQuota
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceQuotaByNameReturnsOnCall[i] = struct {
+		result1 v2action.SpaceQuota
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error) {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)]
+	fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall = append(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetSpaceRunningSecurityGroupsBySpace", []interface{}{spaceGUID})
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.Unlock()
+	if fake.GetSpaceRunningSecurityGroupsBySpaceStub != nil {
+		return fake.GetSpaceRunningSecurityGroupsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getSpaceRunningSecurityGroupsBySpaceReturns.result1, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result2, fake.getSpaceRunningSecurityGroupsBySpaceReturns.result3
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceCallCount() int {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return len(fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceArgsForCall(i int) string {
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	return fake.getSpaceRunningSecurityGroupsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceReturns(result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	fake.getSpaceRunningSecurityGroupsBySpaceReturns = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetSpaceRunningSecurityGroupsBySpaceReturnsOnCall(i int, result1 []v2action.SecurityGroup, result2 v2action.Warnings, result3 error) {
+	fake.GetSpaceRunningSecurityGroupsBySpaceStub = nil
+	if fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall == nil {
+		fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.SecurityGroup
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getSpaceRunningSecurityGroupsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.SecurityGroup
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error) {
+	fake.getStackByNameMutex.Lock()
+	ret, specificReturn := fake.getStackByNameReturnsOnCall[len(fake.getStackByNameArgsForCall)]
+	fake.getStackByNameArgsForCall = append(fake.getStackByNameArgsForCall, struct {
+		stackName string
+	}{stackName})
+	fake.recordInvocation("GetStackByName", []interface{}{stackName})
+	fake.getStackByNameMutex.Unlock()
+	if fake.GetStackByNameStub != nil {
+		return fake.GetStackByNameStub(stackName)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getStackByNameReturns.result1, fake.getStackByNameReturns.result2, fake.getStackByNameReturns.result3
+}
+
+func (fake *FakeV2Actor) GetStackByNameCallCount() int {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return len(fake.getStackByNameArgsForCall)
+}
+
+func (fake *FakeV2Actor) GetStackByNameArgsForCall(i int) string {
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	return fake.getStackByNameArgsForCall[i].stackName
+}
+
+func (fake *FakeV2Actor) GetStackByNameReturns(result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	fake.getStackByNameReturns = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) GetStackByNameReturnsOnCall(i int, result1 v2action.Stack, result2 v2action.Warnings, result3 error) {
+	fake.GetStackByNameStub = nil
+	if fake.getStackByNameReturnsOnCall == nil {
+		fake.getStackByNameReturnsOnCall = make(map[int]struct {
+			result1 v2action.Stack
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getStackByNameReturnsOnCall[i] = struct {
+		result1 v2action.Stack
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) IsArchive(path string) bool {
+	fake.isArchiveMutex.Lock()
+	ret, specificReturn := fake.isArchiveReturnsOnCall[len(fake.isArchiveArgsForCall)]
+	fake.isArchiveArgsForCall = append(fake.isArchiveArgsForCall, struct {
+		path string
+	}{path})
+	fake.recordInvocation("IsArchive", []interface{}{path})
+	fake.isArchiveMutex.Unlock()
+	if fake.IsArchiveStub != nil {
+		return fake.IsArchiveStub(path)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.isArchiveReturns.result1
+}
+
+func (fake *FakeV2Actor) IsArchiveCallCount() int {
+	fake.isArchiveMutex.RLock()
+	defer fake.isArchiveMutex.RUnlock()
+	return len(fake.isArchiveArgsForCall)
+}
+
+func (fake *FakeV2Actor) IsArchiveArgsForCall(i int) string {
+	fake.isArchiveMutex.RLock()
+	defer fake.isArchiveMutex.RUnlock()
+	return fake.isArchiveArgsForCall[i].path
+}
+
+func (fake *FakeV2Actor) IsArchiveReturns(result1 bool) {
+	fake.IsArchiveStub = nil
+	fake.isArchiveReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeV2Actor) IsArchiveReturnsOnCall(i int, result1 bool) {
+	fake.IsArchiveStub = nil
+	if fake.isArchiveReturnsOnCall == nil {
+		fake.isArchiveReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.isArchiveReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeV2Actor) ResourceMatch(allResources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error) {
+	var allResourcesCopy []v2action.Resource
+	if allResources != nil {
+		allResourcesCopy = make([]v2action.Resource, len(allResources))
+		copy(allResourcesCopy, allResources)
+	}
+	fake.resourceMatchMutex.Lock()
+	ret, specificReturn := fake.resourceMatchReturnsOnCall[len(fake.resourceMatchArgsForCall)]
+	fake.resourceMatchArgsForCall = append(fake.resourceMatchArgsForCall, struct {
+		allResources []v2action.Resource
+	}{allResourcesCopy})
+	fake.recordInvocation("ResourceMatch", []interface{}{allResourcesCopy})
+	fake.resourceMatchMutex.Unlock()
+	if fake.ResourceMatchStub != nil {
+		return fake.ResourceMatchStub(allResources)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fake.resourceMatchReturns.result1, fake.resourceMatchReturns.result2, fake.resourceMatchReturns.result3, fake.resourceMatchReturns.result4
+}
+
+func (fake *FakeV2Actor) ResourceMatchCallCount() int {
+	fake.resourceMatchMutex.RLock()
+	defer fake.resourceMatchMutex.RUnlock()
+	return len(fake.resourceMatchArgsForCall)
+}
+
+func (fake *FakeV2Actor) ResourceMatchArgsForCall(i int) []v2action.Resource {
+	fake.resourceMatchMutex.RLock()
+	defer fake.resourceMatchMutex.RUnlock()
+	return fake.resourceMatchArgsForCall[i].allResources
+}
+
+func (fake *FakeV2Actor) ResourceMatchReturns(result1 []v2action.Resource, result2 []v2action.Resource, result3 v2action.Warnings, result4 error) {
+	fake.ResourceMatchStub = nil
+	fake.resourceMatchReturns = struct {
+		result1 []v2action.Resource
+		result2 []v2action.Resource
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeV2Actor) ResourceMatchReturnsOnCall(i int, result1 []v2action.Resource, result2 []v2action.Resource, result3 v2action.Warnings, result4 error) {
+	fake.ResourceMatchStub = nil
+	if fake.resourceMatchReturnsOnCall == nil {
+		fake.resourceMatchReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Resource
+			result2 []v2action.Resource
+			result3 v2action.Warnings
+			result4 error
+		})
+	}
+	fake.resourceMatchReturnsOnCall[i] = struct {
+		result1 []v2action.Resource
+		result2 []v2action.Resource
+		result3 v2action.Warnings
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeV2Actor) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error) {
+	fake.setSpaceQuotaMutex.Lock()
+	ret, specificReturn := fake.setSpaceQuotaReturnsOnCall[len(fake.setSpaceQuotaArgsForCall)]
+	fake.setSpaceQuotaArgsForCall = append(fake.setSpaceQuotaArgsForCall, struct {
+		spaceGUID      string
+		spaceQuotaGUID string
+	}{spaceGUID, spaceQuotaGUID})
+	fake.recordInvocation("SetSpaceQuota", []interface{}{spaceGUID, spaceQuotaGUID})
+	fake.setSpaceQuotaMutex.Unlock()
+	if fake.SetSpaceQuotaStub != nil {
+		return fake.SetSpaceQuotaStub(spaceGUID, spaceQuotaGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.setSpaceQuotaReturns.result1, fake.setSpaceQuotaReturns.result2
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaCallCount() int {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return len(fake.setSpaceQuotaArgsForCall)
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaArgsForCall(i int) (string, string) {
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	return fake.setSpaceQuotaArgsForCall[i].spaceGUID, fake.setSpaceQuotaArgsForCall[i].spaceQuotaGUID
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaReturns(result1 v2action.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	fake.setSpaceQuotaReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) SetSpaceQuotaReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.SetSpaceQuotaStub = nil
+	if fake.setSpaceQuotaReturnsOnCall == nil {
+		fake.setSpaceQuotaReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.setSpaceQuotaReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error) {
+	fake.unbindRouteFromApplicationMutex.Lock()
+	ret, specificReturn := fake.unbindRouteFromApplicationReturnsOnCall[len(fake.unbindRouteFromApplicationArgsForCall)]
+	fake.unbindRouteFromApplicationArgsForCall = append(fake.unbindRouteFromApplicationArgsForCall, struct {
+		routeGUID string
+		appGUID   string
+	}{routeGUID, appGUID})
+	fake.recordInvocation("UnbindRouteFromApplication", []interface{}{routeGUID, appGUID})
+	fake.unbindRouteFromApplicationMutex.Unlock()
+	if fake.UnbindRouteFromApplicationStub != nil {
+		return fake.UnbindRouteFromApplicationStub(routeGUID, appGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindRouteFromApplicationReturns.result1, fake.unbindRouteFromApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationCallCount() int {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return len(fake.unbindRouteFromApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationArgsForCall(i int) (string, string) {
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	return fake.unbindRouteFromApplicationArgsForCall[i].routeGUID, fake.unbindRouteFromApplicationArgsForCall[i].appGUID
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	fake.unbindRouteFromApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindRouteFromApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnbindRouteFromApplicationStub = nil
+	if fake.unbindRouteFromApplicationReturnsOnCall == nil {
+		fake.unbindRouteFromApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unbindRouteFromApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string) (v2action.Warnings, error) {
+	fake.unbindSecurityGroupByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.unbindSecurityGroupByNameAndSpaceReturnsOnCall[len(fake.unbindSecurityGroupByNameAndSpaceArgsForCall)]
+	fake.unbindSecurityGroupByNameAndSpaceArgsForCall = append(fake.unbindSecurityGroupByNameAndSpaceArgsForCall, struct {
+		securityGroupName string
+		spaceGUID         string
+	}{securityGroupName, spaceGUID})
+	fake.recordInvocation("UnbindSecurityGroupByNameAndSpace", []interface{}{securityGroupName, spaceGUID})
+	fake.unbindSecurityGroupByNameAndSpaceMutex.Unlock()
+	if fake.UnbindSecurityGroupByNameAndSpaceStub != nil {
+		return fake.UnbindSecurityGroupByNameAndSpaceStub(securityGroupName, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.unbindSecurityGroupByNameAndSpaceReturns.result1, fake.unbindSecurityGroupByNameAndSpaceReturns.result2
+}
+
+func (fake *FakeV2Actor) UnbindSecurityGroupByNameAndSpaceCallCount() int {
+	fake.unbindSecurityGroupByNameAndSpaceMutex.RLock()
+	defer fake.unbindSecurityGroupByNameAndSpaceMutex.RUnlock()
+	return len(fake.unbindSecurityGroupByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeV2Actor) UnbindSecurityGroupByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.unbindSecurityGroupByNameAndSpaceMutex.RLock()
+	defer fake.unbindSecurityGroupByNameAndSpaceMutex.RUnlock()
+	return fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].securityGroupName, fake.unbindSecurityGroupByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeV2Actor) UnbindSecurityGroupByNameAndSpaceReturns(result1 v2action.Warnings, result2 error) {
+	fake.UnbindSecurityGroupByNameAndSpaceStub = nil
+	fake.unbindSecurityGroupByNameAndSpaceReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UnbindSecurityGroupByNameAndSpaceReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UnbindSecurityGroupByNameAndSpaceStub = nil
+	if fake.unbindSecurityGroupByNameAndSpaceReturnsOnCall == nil {
+		fake.unbindSecurityGroupByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.unbindSecurityGroupByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error) {
+	fake.updateApplicationMutex.Lock()
+	ret, specificReturn := fake.updateApplicationReturnsOnCall[len(fake.updateApplicationArgsForCall)]
+	fake.updateApplicationArgsForCall = append(fake.updateApplicationArgsForCall, struct {
+		application v2action.Application
+	}{application})
+	fake.recordInvocation("UpdateApplication", []interface{}{application})
+	fake.updateApplicationMutex.Unlock()
+	if fake.UpdateApplicationStub != nil {
+		return fake.UpdateApplicationStub(application)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateApplicationReturns.result1, fake.updateApplicationReturns.result2, fake.updateApplicationReturns.result3
+}
+
+func (fake *FakeV2Actor) UpdateApplicationCallCount() int {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return len(fake.updateApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateApplicationArgsForCall(i int) v2action.Application {
+	fake.updateApplicationMutex.RLock()
+	defer fake.updateApplicationMutex.RUnlock()
+	return fake.updateApplicationArgsForCall[i].application
+}
+
+func (fake *FakeV2Actor) UpdateApplicationReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	fake.updateApplicationReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateApplicationReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.UpdateApplicationStub = nil
+	if fake.updateApplicationReturnsOnCall == nil {
+		fake.updateApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.updateServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateServiceInstanceReturnsOnCall[len(fake.updateServiceInstanceArgsForCall)]
+	fake.updateServiceInstanceArgsForCall = append(fake.updateServiceInstanceArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("UpdateServiceInstance", []interface{}{serviceInstance})
+	fake.updateServiceInstanceMutex.Unlock()
+	if fake.UpdateServiceInstanceStub != nil {
+		return fake.UpdateServiceInstanceStub(serviceInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateServiceInstanceReturns.result1, fake.updateServiceInstanceReturns.result2, fake.updateServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceCallCount() int {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return len(fake.updateServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceArgsForCall(i int) v2action.ServiceInstance {
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	return fake.updateServiceInstanceArgsForCall[i].serviceInstance
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	fake.updateServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.UpdateServiceInstanceStub = nil
+	if fake.updateServiceInstanceReturnsOnCall == nil {
+		fake.updateServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateUserProvidedServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error) {
+	fake.updateUserProvidedServiceInstanceMutex.Lock()
+	ret, specificReturn := fake.updateUserProvidedServiceInstanceReturnsOnCall[len(fake.updateUserProvidedServiceInstanceArgsForCall)]
+	fake.updateUserProvidedServiceInstanceArgsForCall = append(fake.updateUserProvidedServiceInstanceArgsForCall, struct {
+		serviceInstance v2action.ServiceInstance
+	}{serviceInstance})
+	fake.recordInvocation("UpdateUserProvidedServiceInstance", []interface{}{serviceInstance})
+	fake.updateUserProvidedServiceInstanceMutex.Unlock()
+	if fake.UpdateUserProvidedServiceInstanceStub != nil {
+		return fake.UpdateUserProvidedServiceInstanceStub(serviceInstance)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.updateUserProvidedServiceInstanceReturns.result1, fake.updateUserProvidedServiceInstanceReturns.result2, fake.updateUserProvidedServiceInstanceReturns.result3
+}
+
+func (fake *FakeV2Actor) UpdateUserProvidedServiceInstanceCallCount() int {
+	fake.updateUserProvidedServiceInstanceMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceMutex.RUnlock()
+	return len(fake.updateUserProvidedServiceInstanceArgsForCall)
+}
+
+func (fake *FakeV2Actor) UpdateUserProvidedServiceInstanceArgsForCall(i int) v2action.ServiceInstance {
+	fake.updateUserProvidedServiceInstanceMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceMutex.RUnlock()
+	return fake.updateUserProvidedServiceInstanceArgsForCall[i].serviceInstance
+}
+
+func (fake *FakeV2Actor) UpdateUserProvidedServiceInstanceReturns(result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.UpdateUserProvidedServiceInstanceStub = nil
+	fake.updateUserProvidedServiceInstanceReturns = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UpdateUserProvidedServiceInstanceReturnsOnCall(i int, result1 v2action.ServiceInstance, result2 v2action.Warnings, result3 error) {
+	fake.UpdateUserProvidedServiceInstanceStub = nil
+	if fake.updateUserProvidedServiceInstanceReturnsOnCall == nil {
+		fake.updateUserProvidedServiceInstanceReturnsOnCall = make(map[int]struct {
+			result1 v2action.ServiceInstance
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.updateUserProvidedServiceInstanceReturnsOnCall[i] = struct {
+		result1 v2action.ServiceInstance
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeV2Actor) UploadApplication(appGUID string, existingResources []v2action.Resource, zipPath string) (v2action.Warnings, error) {
+	var existingResourcesCopy []v2action.Resource
+	if existingResources != nil {
+		existingResourcesCopy = make([]v2action.Resource, len(existingResources))
+		copy(existingResourcesCopy, existingResources)
+	}
+	fake.uploadApplicationMutex.Lock()
+	ret, specificReturn := fake.uploadApplicationReturnsOnCall[len(fake.uploadApplicationArgsForCall)]
+	fake.uploadApplicationArgsForCall = append(fake.uploadApplicationArgsForCall, struct {
+		appGUID           string
+		existingResources []v2action.Resource
+		zipPath           string
+	}{appGUID, existingResourcesCopy, zipPath})
+	fake.recordInvocation("UploadApplication", []interface{}{appGUID, existingResourcesCopy, zipPath})
+	fake.uploadApplicationMutex.Unlock()
+	if fake.UploadApplicationStub != nil {
+		return fake.UploadApplicationStub(appGUID, existingResources, zipPath)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.uploadApplicationReturns.result1, fake.uploadApplicationReturns.result2
+}
+
+func (fake *FakeV2Actor) UploadApplicationCallCount() int {
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return len(fake.uploadApplicationArgsForCall)
+}
+
+func (fake *FakeV2Actor) UploadApplicationArgsForCall(i int) (string, []v2action.Resource, string) {
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	return fake.uploadApplicationArgsForCall[i].appGUID, fake.uploadApplicationArgsForCall[i].existingResources, fake.uploadApplicationArgsForCall[i].zipPath
+}
+
+func (fake *FakeV2Actor) UploadApplicationReturns(result1 v2action.Warnings, result2 error) {
+	fake.UploadApplicationStub = nil
+	fake.uploadApplicationReturns = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) UploadApplicationReturnsOnCall(i int, result1 v2action.Warnings, result2 error) {
+	fake.UploadApplicationStub = nil
+	if fake.uploadApplicationReturnsOnCall == nil {
+		fake.uploadApplicationReturnsOnCall = make(map[int]struct {
+			result1 v2action.Warnings
+			result2 error
+		})
+	}
+	fake.uploadApplicationReturnsOnCall[i] = struct {
+		result1 v2action.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude []v2action.Resource) (string, error) {
+	var filesToIncludeCopy []v2action.Resource
+	if filesToInclude != nil {
+		filesToIncludeCopy = make([]v2action.Resource, len(filesToInclude))
+		copy(filesToIncludeCopy, filesToInclude)
+	}
+	fake.zipArchiveResourcesMutex.Lock()
+	ret, specificReturn := fake.zipArchiveResourcesReturnsOnCall[len(fake.zipArchiveResourcesArgsForCall)]
+	fake.zipArchiveResourcesArgsForCall = append(fake.zipArchiveResourcesArgsForCall, struct {
+		sourceArchivePath string
+		filesToInclude    []v2action.Resource
+	}{sourceArchivePath, filesToIncludeCopy})
+	fake.recordInvocation("ZipArchiveResources", []interface{}{sourceArchivePath, filesToIncludeCopy})
+	fake.zipArchiveResourcesMutex.Unlock()
+	if fake.ZipArchiveResourcesStub != nil {
+		return fake.ZipArchiveResourcesStub(sourceArchivePath, filesToInclude)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.zipArchiveResourcesReturns.result1, fake.zipArchiveResourcesReturns.result2
+}
+
+func (fake *FakeV2Actor) ZipArchiveResourcesCallCount() int {
+	fake.zipArchiveResourcesMutex.RLock()
+	defer fake.zipArchiveResourcesMutex.RUnlock()
+	return len(fake.zipArchiveResourcesArgsForCall)
+}
+
+func (fake *FakeV2Actor) ZipArchiveResourcesArgsForCall(i int) (string, []v2action.Resource) {
+	fake.zipArchiveResourcesMutex.RLock()
+	defer fake.zipArchiveResourcesMutex.RUnlock()
+	return fake.zipArchiveResourcesArgsForCall[i].sourceArchivePath, fake.zipArchiveResourcesArgsForCall[i].filesToInclude
+}
+
+func (fake *FakeV2Actor) ZipArchiveResourcesReturns(result1 string, result2 error) {
+	fake.ZipArchiveResourcesStub = nil
+	fake.zipArchiveResourcesReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) ZipArchiveResourcesReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ZipArchiveResourcesStub = nil
+	if fake.zipArchiveResourcesReturnsOnCall == nil {
+		fake.zipArchiveResourcesReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.zipArchiveResourcesReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) ZipResources(sourceDir string, filesToInclude []v2action.Resource) (string, error) {
+	var filesToIncludeCopy []v2action.Resource
+	if filesToInclude != nil {
+		filesToIncludeCopy = make([]v2action.Resource, len(filesToInclude))
+		copy(filesToIncludeCopy, filesToInclude)
+	}
+	fake.zipResourcesMutex.Lock()
+	ret, specificReturn := fake.zipResourcesReturnsOnCall[len(fake.zipResourcesArgsForCall)]
+	fake.zipResourcesArgsForCall = append(fake.zipResourcesArgsForCall, struct {
+		sourceDir      string
+		filesToInclude []v2action.Resource
+	}{sourceDir, filesToIncludeCopy})
+	fake.recordInvocation("ZipResources", []interface{}{sourceDir, filesToIncludeCopy})
+	fake.zipResourcesMutex.Unlock()
+	if fake.ZipResourcesStub != nil {
+		return fake.ZipResourcesStub(sourceDir, filesToInclude)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.zipResourcesReturns.result1, fake.zipResourcesReturns.result2
+}
+
+func (fake *FakeV2Actor) ZipResourcesCallCount() int {
+	fake.zipResourcesMutex.RLock()
+	defer fake.zipResourcesMutex.RUnlock()
+	return len(fake.zipResourcesArgsForCall)
+}
+
+func (fake *FakeV2Actor) ZipResourcesArgsForCall(i int) (string, []v2action.Resource) {
+	fake.zipResourcesMutex.RLock()
+	defer fake.zipResourcesMutex.RUnlock()
+	return fake.zipResourcesArgsForCall[i].sourceDir, fake.zipResourcesArgsForCall[i].filesToInclude
+}
+
+func (fake *FakeV2Actor) ZipResourcesReturns(result1 string, result2 error) {
+	fake.ZipResourcesStub = nil
+	fake.zipResourcesReturns = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeV2Actor) ZipResourcesReturnsOnCall(i int, result1 string, result2 error) {
+	fake.ZipResourcesStub = nil
+	if fake.zipResourcesReturnsOnCall == nil {
+		fake.zipResourcesReturnsOnCall = make(map[int]struct {
+			result1 string
+			result2 error
+		})
+	}
+	fake.zipResourcesReturnsOnCall[i] = struct {
+		result1 string
+		result2 error
+	}{result1, result2}
 }
 
 func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
@@ -642,22 +2968,90 @@ func (fake *FakeV2Actor) Invocations() map[string][][]interface{} {
 	defer fake.invocationsMutex.RUnlock()
 	fake.bindRouteToApplicationMutex.RLock()
 	defer fake.bindRouteToApplicationMutex.RUnlock()
+	fake.bindSecurityGroupToSpaceMutex.RLock()
+	defer fake.bindSecurityGroupToSpaceMutex.RUnlock()
+	fake.bindServiceByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.bindServiceByApplicationAndServiceInstanceMutex.RUnlock()
 	fake.checkRouteMutex.RLock()
 	defer fake.checkRouteMutex.RUnlock()
 	fake.createApplicationMutex.RLock()
 	defer fake.createApplicationMutex.RUnlock()
 	fake.createRouteMutex.RLock()
 	defer fake.createRouteMutex.RUnlock()
+	fake.createServiceInstanceMutex.RLock()
+	defer fake.createServiceInstanceMutex.RUnlock()
+	fake.createUserProvidedServiceInstanceMutex.RLock()
+	defer fake.createUserProvidedServiceInstanceMutex.RUnlock()
+	fake.deleteApplicationMutex.RLock()
+	defer fake.deleteApplicationMutex.RUnlock()
+	fake.deleteRouteMutex.RLock()
+	defer fake.deleteRouteMutex.RUnlock()
+	fake.deleteServiceInstanceMutex.RLock()
+	defer fake.deleteServiceInstanceMutex.RUnlock()
+	fake.gatherArchiveResourcesMutex.RLock()
+	defer fake.gatherArchiveResourcesMutex.RUnlock()
+	fake.gatherDirectoryResourcesMutex.RLock()
+	defer fake.gatherDirectoryResourcesMutex.RUnlock()
+	fake.getApplicationMutex.RLock()
+	defer fake.getApplicationMutex.RUnlock()
 	fake.getApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getApplicationInstancesByApplicationMutex.RLock()
+	defer fake.getApplicationInstancesByApplicationMutex.RUnlock()
 	fake.getApplicationRoutesMutex.RLock()
 	defer fake.getApplicationRoutesMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
 	fake.getOrganizationDomainsMutex.RLock()
 	defer fake.getOrganizationDomainsMutex.RUnlock()
-	fake.getRouteByHostAndDomainMutex.RLock()
-	defer fake.getRouteByHostAndDomainMutex.RUnlock()
+	fake.getOrphanedRoutesBySpaceMutex.RLock()
+	defer fake.getOrphanedRoutesBySpaceMutex.RUnlock()
+	fake.getRouteByComponentsMutex.RLock()
+	defer fake.getRouteByComponentsMutex.RUnlock()
+	fake.getSecurityGroupByNameMutex.RLock()
+	defer fake.getSecurityGroupByNameMutex.RUnlock()
+	fake.getServiceBindingByApplicationAndServiceInstanceMutex.RLock()
+	defer fake.getServiceBindingByApplicationAndServiceInstanceMutex.RUnlock()
+	fake.getServiceBindingsByApplicationMutex.RLock()
+	defer fake.getServiceBindingsByApplicationMutex.RUnlock()
+	fake.getServiceInstanceByNameAndSpaceMutex.RLock()
+	defer fake.getServiceInstanceByNameAndSpaceMutex.RUnlock()
+	fake.getServiceInstancesBySpaceMutex.RLock()
+	defer fake.getServiceInstancesBySpaceMutex.RUnlock()
+	fake.getServicePlanByNameServiceAndSpaceMutex.RLock()
+	defer fake.getServicePlanByNameServiceAndSpaceMutex.RUnlock()
+	fake.getSpaceByOrganizationAndNameMutex.RLock()
+	defer fake.getSpaceByOrganizationAndNameMutex.RUnlock()
+	fake.getSpaceQuotaMutex.RLock()
+	defer fake.getSpaceQuotaMutex.RUnlock()
+	fake.getSpaceQuotaByNameMutex.RLock()
+	defer fake.getSpaceQuotaByNameMutex.RUnlock()
+	fake.getSpaceRunningSecurityGroupsBySpaceMutex.RLock()
+	defer fake.getSpaceRunningSecurityGroupsBySpaceMutex.RUnlock()
+	fake.getStackByNameMutex.RLock()
+	defer fake.getStackByNameMutex.RUnlock()
+	fake.isArchiveMutex.RLock()
+	defer fake.isArchiveMutex.RUnlock()
+	fake.resourceMatchMutex.RLock()
+	defer fake.resourceMatchMutex.RUnlock()
+	fake.setSpaceQuotaMutex.RLock()
+	defer fake.setSpaceQuotaMutex.RUnlock()
+	fake.unbindRouteFromApplicationMutex.RLock()
+	defer fake.unbindRouteFromApplicationMutex.RUnlock()
+	fake.unbindSecurityGroupByNameAndSpaceMutex.RLock()
+	defer fake.unbindSecurityGroupByNameAndSpaceMutex.RUnlock()
 	fake.updateApplicationMutex.RLock()
 	defer fake.updateApplicationMutex.RUnlock()
+	fake.updateServiceInstanceMutex.RLock()
+	defer fake.updateServiceInstanceMutex.RUnlock()
+	fake.updateUserProvidedServiceInstanceMutex.RLock()
+	defer fake.updateUserProvidedServiceInstanceMutex.RUnlock()
+	fake.uploadApplicationMutex.RLock()
+	defer fake.uploadApplicationMutex.RUnlock()
+	fake.zipArchiveResourcesMutex.RLock()
+	defer fake.zipArchiveResourcesMutex.RUnlock()
+	fake.zipResourcesMutex.RLock()
+	defer fake.zipResourcesMutex.RUnlock()
 	return fake.invocations
 }
 
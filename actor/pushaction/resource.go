@@ -0,0 +1,105 @@
+package pushaction
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	log "github.com/Sirupsen/logrus"
+)
+
+// CachedFiles returns the number of files that the Cloud Controller already
+// has cached and the total number of files in the application's bits.
+func (config ApplicationConfig) CachedFiles() (int, int) {
+	var cached, total int
+	for _, resource := range config.MatchedResources {
+		if resource.SHA1 != "" {
+			cached++
+		}
+	}
+	for _, resource := range config.AllResources {
+		if resource.SHA1 != "" {
+			total++
+		}
+	}
+	return cached, total
+}
+
+// configureResources gathers the files in the application's path, which is
+// either a directory or a zip archive, and splits them into the files the
+// Cloud Controller already has cached and the files that need to be uploaded.
+// Docker applications have no bits to upload.
+func (actor Actor) configureResources(config ApplicationConfig) (ApplicationConfig, Warnings, error) {
+	if config.DesiredApplication.DockerImage != "" || config.Path == "" {
+		log.Debug("no bits to upload")
+		return config, nil, nil
+	}
+
+	var (
+		resources []v2action.Resource
+		err       error
+	)
+	config.Archive = actor.V2Actor.IsArchive(config.Path)
+	if config.Archive {
+		log.Infoln("gathering resources in archive", config.Path)
+		resources, err = actor.V2Actor.GatherArchiveResources(config.Path)
+	} else {
+		log.Infoln("gathering resources in", config.Path)
+		resources, err = actor.V2Actor.GatherDirectoryResources(config.Path, config.IgnoreFile)
+	}
+	if err != nil {
+		log.Errorln("gathering resources:", err)
+		return ApplicationConfig{}, nil, err
+	}
+
+	matched, unmatched, warnings, err := actor.V2Actor.ResourceMatch(resources)
+	if err != nil {
+		log.Errorln("matching resources:", err)
+		return ApplicationConfig{}, Warnings(warnings), err
+	}
+
+	config.AllResources = resources
+	config.MatchedResources = matched
+	config.UnmatchedResources = unmatched
+	return config, Warnings(warnings), nil
+}
+
+// uploadBits zips the files that are not cached by the Cloud Controller and
+// uploads them, along with the list of cached files, as the bits of the
+// application.
+func (actor Actor) uploadBits(config ApplicationConfig, appGUID string, eventStream chan<- Event, warningsStream chan<- Warnings) error {
+	eventStream <- ResourcesMatched
+	eventStream <- UploadingApplication
+
+	log.WithField("number_of_files", len(config.UnmatchedResources)).Info("zipping unmatched resources")
+	var (
+		zipPath string
+		err     error
+	)
+	if config.Archive {
+		zipPath, err = actor.V2Actor.ZipArchiveResources(config.Path, config.UnmatchedResources)
+	} else {
+		zipPath, err = actor.V2Actor.ZipResources(config.Path, config.UnmatchedResources)
+	}
+	if err != nil {
+		log.Errorln("zipping resources:", err)
+		return err
+	}
+	defer os.Remove(zipPath)
+
+	log.Infoln("uploading bits for application", appGUID)
+	warnings, err := actor.V2Actor.UploadApplication(appGUID, config.MatchedResources, zipPath)
+	warningsStream <- Warnings(warnings)
+	if err != nil {
+		log.Errorln("uploading bits:", err)
+		return err
+	}
+
+	eventStream <- UploadComplete
+	return nil
+}
+
+// hasBits returns true when the application configuration has files to
+// upload.
+func hasBits(config ApplicationConfig) bool {
+	return len(config.AllResources) > 0
+}
@@ -1,13 +1,184 @@
 package pushaction
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	log "github.com/Sirupsen/logrus"
 )
 
-// FindOrReturnPartialRoute finds the route with the given host and domain. If
-// it is unable to find the route, it will return back the partial route. When
-// the route exists in another space, RouteInDifferentSpaceError is returned.
+// NoMatchingDomainError is returned when a route does not match any of the
+// domains accessible to an organization.
+type NoMatchingDomainError struct {
+	Route string
+}
+
+func (e NoMatchingDomainError) Error() string {
+	return fmt.Sprintf("No matching domains found for route %s", e.Route)
+}
+
+// InvalidRoutePortError is returned when the port of a route is not a valid
+// number.
+type InvalidRoutePortError struct {
+	Route string
+}
+
+func (e InvalidRoutePortError) Error() string {
+	return fmt.Sprintf("Invalid port for route %s", e.Route)
+}
+
+// CalculateRoutes returns the routes described by the manifest application.
+// Routes take precedence over hosts and domains; when neither are provided
+// the application name and the default domain are used. The returned routes
+// may be partial routes (ie no GUID) if they do not exist yet.
+func (actor Actor) CalculateRoutes(app manifest.Application, orgGUID string, spaceGUID string) ([]v2action.Route, Warnings, error) {
+	if app.NoRoute {
+		log.Info("no-route set, skipping route calculation")
+		return nil, nil, nil
+	}
+
+	log.Infoln("getting org domains for org GUID:", orgGUID)
+	domains, domainWarnings, err := actor.V2Actor.GetOrganizationDomains(orgGUID)
+	warnings := Warnings(domainWarnings)
+	if err != nil {
+		log.Errorln("searching for domains in org:", err)
+		return nil, warnings, err
+	}
+
+	if len(domains) == 0 {
+		log.Error("no domains found")
+		return nil, warnings, NoDomainsFoundError{OrganizationGUID: orgGUID}
+	}
+
+	var partialRoutes []v2action.Route
+	if len(app.Routes) > 0 {
+		for _, routeString := range app.Routes {
+			route, err := actor.parseRoute(routeString, domains, spaceGUID)
+			if err != nil {
+				log.Errorln("parsing route:", err)
+				return nil, warnings, err
+			}
+			partialRoutes = append(partialRoutes, route)
+		}
+	} else {
+		routeDomains, err := actor.selectDomains(app.Domains, domains)
+		if err != nil {
+			log.Errorln("selecting domains:", err)
+			return nil, warnings, err
+		}
+
+		for _, host := range actor.calculateHosts(app) {
+			for _, domain := range routeDomains {
+				partialRoutes = append(partialRoutes, v2action.Route{
+					Domain:    domain,
+					Host:      host,
+					SpaceGUID: spaceGUID,
+				})
+			}
+		}
+	}
+
+	var routes []v2action.Route
+	for _, partialRoute := range partialRoutes {
+		route, routeWarnings, err := actor.FindOrReturnPartialRoute(partialRoute)
+		warnings = append(warnings, routeWarnings...)
+		if err != nil {
+			return nil, warnings, err
+		}
+		routes = append(routes, route)
+	}
+
+	log.Debugf("calculated routes: %#v", routes)
+	return routes, warnings, nil
+}
+
+func (actor Actor) calculateHosts(app manifest.Application) []string {
+	switch {
+	case app.NoHostname:
+		return []string{""}
+	case len(app.Hosts) > 0:
+		return app.Hosts
+	case app.RandomRoute:
+		return []string{fmt.Sprintf("%s-%s", app.Name, strings.ToLower(actor.WordGenerator.Babble()))}
+	default:
+		return []string{app.Name}
+	}
+}
+
+func (actor Actor) selectDomains(domainNames []string, domains []v2action.Domain) ([]v2action.Domain, error) {
+	if len(domainNames) == 0 {
+		log.Debugf("selecting first domain as default domain: %#v", domains)
+		return domains[:1], nil
+	}
+
+	var selected []v2action.Domain
+	for _, name := range domainNames {
+		found := false
+		for _, domain := range domains {
+			if domain.Name == name {
+				selected = append(selected, domain)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, v2action.DomainNotFoundError{Name: name}
+		}
+	}
+	return selected, nil
+}
+
+// parseRoute converts a route of the form [host.]domain[:port][/path] into a
+// partial route, using the longest matching domain.
+func (actor Actor) parseRoute(routeString string, domains []v2action.Domain, spaceGUID string) (v2action.Route, error) {
+	route := v2action.Route{SpaceGUID: spaceGUID}
+
+	hostAndDomain := routeString
+	if index := strings.Index(hostAndDomain, "/"); index != -1 {
+		route.Path = hostAndDomain[index:]
+		hostAndDomain = hostAndDomain[:index]
+	}
+
+	if index := strings.Index(hostAndDomain, ":"); index != -1 {
+		port, err := strconv.Atoi(hostAndDomain[index+1:])
+		if err != nil {
+			return v2action.Route{}, InvalidRoutePortError{Route: routeString}
+		}
+		route.Port = port
+		hostAndDomain = hostAndDomain[:index]
+	}
+
+	var matched bool
+	for _, domain := range domains {
+		if len(domain.Name) <= len(route.Domain.Name) {
+			continue
+		}
+
+		if hostAndDomain == domain.Name {
+			route.Domain = domain
+			route.Host = ""
+			matched = true
+		} else if strings.HasSuffix(hostAndDomain, "."+domain.Name) {
+			route.Domain = domain
+			route.Host = strings.TrimSuffix(hostAndDomain, "."+domain.Name)
+			matched = true
+		}
+	}
+
+	if !matched {
+		return v2action.Route{}, NoMatchingDomainError{Route: routeString}
+	}
+
+	return route, nil
+}
+
+// FindOrReturnPartialRoute finds the route with the given host, domain, path
+// and port. If it is unable to find the route, it will return back the
+// partial route. When the route exists in another space,
+// RouteInDifferentSpaceError is returned.
 func (actor Actor) FindOrReturnPartialRoute(route v2action.Route) (v2action.Route, Warnings, error) {
 	// This check only works for API versions 2.55 or higher. It will return
 	// false for anything below that.
@@ -21,8 +192,7 @@ func (actor Actor) FindOrReturnPartialRoute(route v2action.Route) (v2action.Rout
 	if exists {
 		log.Debug("route exists")
 
-		// TODO: Use a more generic search mechanism to support path, port, and no host
-		existingRoute, routeWarnings, err := actor.V2Actor.GetRouteByHostAndDomain(route.Host, route.Domain.GUID)
+		existingRoute, routeWarnings, err := actor.V2Actor.GetRouteByComponents(route)
 		if _, ok := err.(v2action.RouteNotFoundError); ok {
 			log.Errorf("unable to find route %s in current space", route.String())
 			return v2action.Route{}, append(Warnings(warnings), routeWarnings...), v2action.RouteInDifferentSpaceError{Route: route.String()}
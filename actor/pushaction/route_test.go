@@ -4,8 +4,10 @@ import (
 	"errors"
 
 	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
 	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/util/words/generator/generatorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -19,7 +21,7 @@ var _ = Describe("Routes", func() {
 
 	BeforeEach(func() {
 		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
-		actor = NewActor(fakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
 	})
 
 	Describe("FindOrReturnEmptyRoute", func() {
@@ -58,7 +60,7 @@ var _ = Describe("Routes", func() {
 
 			Context("when the route exists in this space", func() {
 				BeforeEach(func() {
-					fakeV2Actor.GetRouteByHostAndDomainReturns(existingRoute, v2action.Warnings{"get-route-warnings"}, nil)
+					fakeV2Actor.GetRouteByComponentsReturns(existingRoute, v2action.Warnings{"get-route-warnings"}, nil)
 				})
 
 				It("returns the existing route", func() {
@@ -69,17 +71,15 @@ var _ = Describe("Routes", func() {
 					Expect(fakeV2Actor.CheckRouteCallCount()).To(Equal(1))
 					Expect(fakeV2Actor.CheckRouteArgsForCall(0)).To(Equal(route))
 
-					Expect(fakeV2Actor.GetRouteByHostAndDomainCallCount()).To(Equal(1))
-					host, domainGUID := fakeV2Actor.GetRouteByHostAndDomainArgsForCall(0)
-					Expect(host).To(Equal(route.Host))
-					Expect(domainGUID).To(Equal(route.Domain.GUID))
+					Expect(fakeV2Actor.GetRouteByComponentsCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.GetRouteByComponentsArgsForCall(0)).To(Equal(route))
 				})
 			})
 
 			Context("when the route exists in a different space", func() {
 				Context("when the user has access to the space the route is in", func() {
 					BeforeEach(func() {
-						fakeV2Actor.GetRouteByHostAndDomainReturns(v2action.Route{SpaceGUID: "some-other-space-guid"}, v2action.Warnings{"get-route-warnings"}, nil)
+						fakeV2Actor.GetRouteByComponentsReturns(v2action.Route{SpaceGUID: "some-other-space-guid"}, v2action.Warnings{"get-route-warnings"}, nil)
 					})
 
 					It("returns a RouteInDifferentSpaceError and warnings", func() {
@@ -90,7 +90,7 @@ var _ = Describe("Routes", func() {
 
 				Context("when the user cannot see the space the route is in", func() {
 					BeforeEach(func() {
-						fakeV2Actor.GetRouteByHostAndDomainReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
+						fakeV2Actor.GetRouteByComponentsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, v2action.RouteNotFoundError{})
 					})
 
 					It("returns a RouteInDifferentSpaceError and warnings", func() {
@@ -105,7 +105,7 @@ var _ = Describe("Routes", func() {
 
 				BeforeEach(func() {
 					expectedErr = errors.New("nooooo")
-					fakeV2Actor.GetRouteByHostAndDomainReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, expectedErr)
+					fakeV2Actor.GetRouteByComponentsReturns(v2action.Route{}, v2action.Warnings{"get-route-warnings"}, expectedErr)
 				})
 
 				It("the error and warnings", func() {
@@ -142,6 +142,176 @@ var _ = Describe("Routes", func() {
 		})
 	})
 
+	Describe("CalculateRoutes", func() {
+		var (
+			app       manifest.Application
+			orgGUID   string
+			spaceGUID string
+
+			domains []v2action.Domain
+
+			routes     []v2action.Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			app = manifest.Application{Name: "some-app"}
+			orgGUID = "some-org-guid"
+			spaceGUID = "some-space-guid"
+
+			domains = []v2action.Domain{
+				{Name: "default-domain.com", GUID: "default-domain-guid"},
+				{Name: "example.com", GUID: "example-domain-guid"},
+				{Name: "sub.example.com", GUID: "sub-example-domain-guid"},
+				{Name: "tcp.example.com", GUID: "tcp-domain-guid"},
+			}
+			fakeV2Actor.GetOrganizationDomainsReturns(domains, v2action.Warnings{"domain-warning"}, nil)
+			fakeV2Actor.CheckRouteReturns(false, v2action.Warnings{"check-route-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			routes, warnings, executeErr = actor.CalculateRoutes(app, orgGUID, spaceGUID)
+		})
+
+		Context("when no route properties are set", func() {
+			It("returns a route with the app name and the default domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("domain-warning", "check-route-warning"))
+				Expect(routes).To(ConsistOf(v2action.Route{
+					Domain:    domains[0],
+					Host:      "some-app",
+					SpaceGUID: spaceGUID,
+				}))
+
+				Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.GetOrganizationDomainsArgsForCall(0)).To(Equal(orgGUID))
+			})
+		})
+
+		Context("when hosts and domains are set", func() {
+			BeforeEach(func() {
+				app.Hosts = []string{"host-1", "host-2"}
+				app.Domains = []string{"example.com", "sub.example.com"}
+			})
+
+			It("returns a route for every combination of host and domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(
+					v2action.Route{Domain: domains[1], Host: "host-1", SpaceGUID: spaceGUID},
+					v2action.Route{Domain: domains[2], Host: "host-1", SpaceGUID: spaceGUID},
+					v2action.Route{Domain: domains[1], Host: "host-2", SpaceGUID: spaceGUID},
+					v2action.Route{Domain: domains[2], Host: "host-2", SpaceGUID: spaceGUID},
+				))
+			})
+		})
+
+		Context("when a domain does not exist", func() {
+			BeforeEach(func() {
+				app.Domains = []string{"some-other-domain.com"}
+			})
+
+			It("returns a DomainNotFoundError", func() {
+				Expect(executeErr).To(MatchError(v2action.DomainNotFoundError{Name: "some-other-domain.com"}))
+				Expect(warnings).To(ConsistOf("domain-warning"))
+			})
+		})
+
+		Context("when no-hostname is set", func() {
+			BeforeEach(func() {
+				app.NoHostname = true
+			})
+
+			It("returns a route without a host", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(v2action.Route{
+					Domain:    domains[0],
+					SpaceGUID: spaceGUID,
+				}))
+			})
+		})
+
+		Context("when random-route is set", func() {
+			BeforeEach(func() {
+				app.RandomRoute = true
+				fakeWordGenerator := new(generatorfakes.FakeWordGenerator)
+				fakeWordGenerator.BabbleReturns("Random-Word")
+				actor.WordGenerator = fakeWordGenerator
+			})
+
+			It("returns a route with a random host", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(v2action.Route{
+					Domain:    domains[0],
+					Host:      "some-app-random-word",
+					SpaceGUID: spaceGUID,
+				}))
+			})
+		})
+
+		Context("when routes are set", func() {
+			BeforeEach(func() {
+				app.Routes = []string{
+					"example.com",
+					"host.sub.example.com/some/path",
+					"tcp.example.com:1234",
+				}
+			})
+
+			It("matches each route to the longest matching domain", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(ConsistOf(
+					v2action.Route{Domain: domains[1], SpaceGUID: spaceGUID},
+					v2action.Route{Domain: domains[2], Host: "host", Path: "/some/path", SpaceGUID: spaceGUID},
+					v2action.Route{Domain: domains[3], Port: 1234, SpaceGUID: spaceGUID},
+				))
+			})
+
+			Context("when a route does not match any domain", func() {
+				BeforeEach(func() {
+					app.Routes = []string{"host.some-other-domain.com"}
+				})
+
+				It("returns a NoMatchingDomainError", func() {
+					Expect(executeErr).To(MatchError(NoMatchingDomainError{Route: "host.some-other-domain.com"}))
+				})
+			})
+
+			Context("when a route has an invalid port", func() {
+				BeforeEach(func() {
+					app.Routes = []string{"tcp.example.com:port"}
+				})
+
+				It("returns an InvalidRoutePortError", func() {
+					Expect(executeErr).To(MatchError(InvalidRoutePortError{Route: "tcp.example.com:port"}))
+				})
+			})
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				app.NoRoute = true
+			})
+
+			It("returns no routes", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(routes).To(BeEmpty())
+				Expect(fakeV2Actor.GetOrganizationDomainsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the org has no domains", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetOrganizationDomainsReturns(nil, v2action.Warnings{"domain-warning"}, nil)
+			})
+
+			It("returns a NoDomainsFoundError", func() {
+				Expect(executeErr).To(MatchError(NoDomainsFoundError{OrganizationGUID: orgGUID}))
+				Expect(warnings).To(ConsistOf("domain-warning"))
+			})
+		})
+	})
+
 	Describe("GetRouteWithDefaultDomain", func() {
 		var (
 			host      string
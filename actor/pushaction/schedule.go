@@ -0,0 +1,138 @@
+package pushaction
+
+import (
+	"fmt"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// DependencyFailedError is returned for an application that was not applied
+// because an application it depends on could not be applied.
+type DependencyFailedError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e DependencyFailedError) Error() string {
+	return fmt.Sprintf("%s was not pushed because %s failed", e.AppName, e.Dependency)
+}
+
+// ApplyFunc applies a single application configuration.
+type ApplyFunc func(config ApplicationConfig) error
+
+// ScheduleApplications calls apply for each configuration, with at most
+// maxInFlight configurations being applied at the same time. A configuration
+// is only applied once every configuration it depends on has been applied
+// successfully; otherwise it is skipped with a DependencyFailedError.
+// Dependencies on applications that are not in configs are ignored. The
+// returned errors are in the same order as configs, with nil for each
+// configuration that was applied successfully.
+func ScheduleApplications(configs []ApplicationConfig, maxInFlight int, apply ApplyFunc) []error {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	indexes := map[string]int{}
+	for i, config := range configs {
+		indexes[config.DesiredApplication.Name] = i
+	}
+
+	errs := make([]error, len(configs))
+	unresolved := make([]int, len(configs))
+	dependents := make([][]int, len(configs))
+	for i, config := range configs {
+		for _, dependency := range config.DependsOn {
+			j, ok := indexes[dependency]
+			if !ok || j == i {
+				continue
+			}
+			unresolved[i]++
+			dependents[j] = append(dependents[j], i)
+		}
+	}
+
+	var ready []int
+	for i := range configs {
+		if unresolved[i] == 0 {
+			ready = append(ready, i)
+		}
+	}
+
+	type result struct {
+		index int
+		err   error
+	}
+	results := make(chan result)
+	finished := make([]bool, len(configs))
+	var running, done int
+
+	var skipDependents func(i int)
+	skipDependents = func(i int) {
+		for _, dependent := range dependents[i] {
+			if finished[dependent] {
+				continue
+			}
+			log.WithField("app", configs[dependent].DesiredApplication.Name).Warn("skipping app due to failed dependency")
+			errs[dependent] = DependencyFailedError{
+				AppName:    configs[dependent].DesiredApplication.Name,
+				Dependency: configs[i].DesiredApplication.Name,
+			}
+			finished[dependent] = true
+			done++
+			skipDependents(dependent)
+		}
+	}
+
+	for done < len(configs) {
+		for running < maxInFlight && len(ready) > 0 {
+			i := ready[0]
+			ready = ready[1:]
+			if finished[i] {
+				continue
+			}
+
+			log.WithField("app", configs[i].DesiredApplication.Name).Info("scheduling app")
+			running++
+			go func(i int) {
+				results <- result{index: i, err: apply(configs[i])}
+			}(i)
+		}
+
+		if running == 0 {
+			break
+		}
+
+		r := <-results
+		running--
+		done++
+		finished[r.index] = true
+		errs[r.index] = r.err
+
+		if r.err != nil {
+			skipDependents(r.index)
+			continue
+		}
+
+		for _, dependent := range dependents[r.index] {
+			unresolved[dependent]--
+			if unresolved[dependent] == 0 && !finished[dependent] {
+				ready = insertSorted(ready, dependent)
+			}
+		}
+	}
+
+	return errs
+}
+
+// insertSorted inserts value into the sorted list so that applications are
+// scheduled in manifest order when several become ready at once.
+func insertSorted(list []int, value int) []int {
+	i := 0
+	for i < len(list) && list[i] < value {
+		i++
+	}
+	list = append(list, 0)
+	copy(list[i+1:], list[i:])
+	list[i] = value
+	return list
+}
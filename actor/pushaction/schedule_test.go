@@ -0,0 +1,140 @@
+package pushaction_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ScheduleApplications", func() {
+	var (
+		configs     []ApplicationConfig
+		maxInFlight int
+		failures    map[string]error
+
+		lock     sync.Mutex
+		started  []string
+		inFlight int
+		peak     int
+
+		errs []error
+	)
+
+	newConfig := func(name string, dependsOn ...string) ApplicationConfig {
+		return ApplicationConfig{
+			DesiredApplication: v2action.Application{Name: name},
+			DependsOn:          dependsOn,
+		}
+	}
+
+	BeforeEach(func() {
+		maxInFlight = 1
+		failures = map[string]error{}
+		started = nil
+		inFlight = 0
+		peak = 0
+	})
+
+	JustBeforeEach(func() {
+		errs = ScheduleApplications(configs, maxInFlight, func(config ApplicationConfig) error {
+			lock.Lock()
+			started = append(started, config.DesiredApplication.Name)
+			inFlight++
+			if inFlight > peak {
+				peak = inFlight
+			}
+			lock.Unlock()
+
+			time.Sleep(10 * time.Millisecond)
+
+			lock.Lock()
+			inFlight--
+			lock.Unlock()
+			return failures[config.DesiredApplication.Name]
+		})
+	})
+
+	Context("when there are no dependencies", func() {
+		BeforeEach(func() {
+			configs = []ApplicationConfig{
+				newConfig("app-1"),
+				newConfig("app-2"),
+				newConfig("app-3"),
+				newConfig("app-4"),
+			}
+		})
+
+		Context("when max in flight is 1", func() {
+			It("applies the apps one at a time in order", func() {
+				Expect(errs).To(Equal([]error{nil, nil, nil, nil}))
+				Expect(started).To(Equal([]string{"app-1", "app-2", "app-3", "app-4"}))
+				Expect(peak).To(Equal(1))
+			})
+		})
+
+		Context("when max in flight is greater than 1", func() {
+			BeforeEach(func() {
+				maxInFlight = 2
+			})
+
+			It("applies at most max in flight apps at the same time", func() {
+				Expect(errs).To(Equal([]error{nil, nil, nil, nil}))
+				Expect(started).To(ConsistOf("app-1", "app-2", "app-3", "app-4"))
+				Expect(peak).To(Equal(2))
+			})
+		})
+
+		Context("when an app fails", func() {
+			BeforeEach(func() {
+				failures["app-2"] = errors.New("some-error")
+			})
+
+			It("continues applying the other apps and returns the error for the failed app", func() {
+				Expect(errs).To(Equal([]error{nil, errors.New("some-error"), nil, nil}))
+				Expect(started).To(HaveLen(4))
+			})
+		})
+	})
+
+	Context("when apps have dependencies", func() {
+		BeforeEach(func() {
+			maxInFlight = 4
+			configs = []ApplicationConfig{
+				newConfig("web", "api"),
+				newConfig("api", "db", "cache"),
+				newConfig("db"),
+				newConfig("cache"),
+				newConfig("worker", "db", "not-being-pushed"),
+			}
+		})
+
+		It("applies each app after its dependencies", func() {
+			Expect(errs).To(Equal([]error{nil, nil, nil, nil, nil}))
+			Expect(started).To(HaveLen(5))
+			Expect(started[:2]).To(ConsistOf("db", "cache"))
+			Expect(started[4]).To(Equal("web"))
+		})
+
+		Context("when a dependency fails", func() {
+			BeforeEach(func() {
+				failures["db"] = errors.New("some-error")
+			})
+
+			It("skips the apps that depend on it, directly or indirectly", func() {
+				Expect(errs).To(Equal([]error{
+					DependencyFailedError{AppName: "web", Dependency: "api"},
+					DependencyFailedError{AppName: "api", Dependency: "db"},
+					errors.New("some-error"),
+					nil,
+					DependencyFailedError{AppName: "worker", Dependency: "db"},
+				}))
+				Expect(started).To(ConsistOf("db", "cache"))
+			})
+		})
+	})
+})
@@ -0,0 +1,126 @@
+package pushaction
+
+import (
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/Sirupsen/logrus"
+)
+
+// ApplySpace sets the space quota, binds security groups, and creates or
+// updates service instances so that the space matches the space manifest.
+// It is called before the applications are applied, since they may be bound
+// to the service instances.
+func (actor Actor) ApplySpace(config SpaceConfig) (Warnings, error) {
+	var allWarnings Warnings
+
+	if config.DesiredSpaceQuota.GUID != config.CurrentSpaceQuota.GUID {
+		log.Infoln("setting space quota", config.DesiredSpaceQuota.Name)
+		warnings, err := actor.V2Actor.SetSpaceQuota(config.SpaceGUID, config.DesiredSpaceQuota.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("setting space quota:", err)
+			return allWarnings, err
+		}
+	}
+
+	for _, securityGroup := range config.securityGroupsToBind() {
+		log.Infoln("binding security group", securityGroup.Name)
+		warnings, err := actor.V2Actor.BindSecurityGroupToSpace(securityGroup.GUID, config.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("binding security group:", err)
+			return allWarnings, err
+		}
+	}
+
+	for _, desired := range config.DesiredServiceInstances {
+		current, exists := config.currentServiceInstance(desired.Name)
+		if exists && !serviceInstanceChanged(current, desired) {
+			log.Debugf("service instance %s is unchanged", desired.Name)
+			continue
+		}
+
+		log.Debugf("converging service instance: %#v", desired)
+		warnings, err := actor.convergeServiceInstance(desired, exists)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("converging service instance:", err)
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
+
+func (actor Actor) convergeServiceInstance(desired v2action.ServiceInstance, exists bool) (v2action.Warnings, error) {
+	var (
+		warnings v2action.Warnings
+		err      error
+	)
+
+	switch {
+	case desired.Type == ccv2.UserProvidedService && exists:
+		_, warnings, err = actor.V2Actor.UpdateUserProvidedServiceInstance(desired)
+	case desired.Type == ccv2.UserProvidedService:
+		_, warnings, err = actor.V2Actor.CreateUserProvidedServiceInstance(desired)
+	case exists:
+		_, warnings, err = actor.V2Actor.UpdateServiceInstance(desired)
+	default:
+		_, warnings, err = actor.V2Actor.CreateServiceInstance(desired)
+	}
+	return warnings, err
+}
+
+// PruneSpace deletes the applications and service instances that are not in
+// the space manifest, deletes the orphaned routes, and unbinds the security
+// groups that are not in the space manifest. It does nothing unless Prune is
+// set, and is called after the applications are applied.
+func (actor Actor) PruneSpace(config SpaceConfig) (Warnings, error) {
+	if !config.Prune {
+		return nil, nil
+	}
+
+	var allWarnings Warnings
+
+	for _, app := range config.applicationsToDelete() {
+		log.Infoln("deleting application", app.Name)
+		warnings, err := actor.V2Actor.DeleteApplication(app.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("deleting application:", err)
+			return allWarnings, err
+		}
+	}
+
+	for _, route := range config.OrphanedRoutes {
+		log.Infoln("deleting route", route)
+		warnings, err := actor.V2Actor.DeleteRoute(route.GUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("deleting route:", err)
+			return allWarnings, err
+		}
+	}
+
+	for _, serviceInstance := range config.serviceInstancesToDelete() {
+		log.Infoln("deleting service instance", serviceInstance.Name)
+		warnings, err := actor.V2Actor.DeleteServiceInstance(serviceInstance)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("deleting service instance:", err)
+			return allWarnings, err
+		}
+	}
+
+	for _, securityGroup := range config.securityGroupsToUnbind() {
+		log.Infoln("unbinding security group", securityGroup.Name)
+		warnings, err := actor.V2Actor.UnbindSecurityGroupByNameAndSpace(securityGroup.Name, config.SpaceGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			log.Errorln("unbinding security group:", err)
+			return allWarnings, err
+		}
+	}
+
+	return allWarnings, nil
+}
@@ -0,0 +1,167 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Apply", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+		config      SpaceConfig
+
+		warnings   Warnings
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
+
+		config = SpaceConfig{
+			SpaceGUID:             "some-space-guid",
+			CurrentSpaceQuota:     v2action.SpaceQuota{GUID: "small-guid", Name: "small"},
+			DesiredSpaceQuota:     v2action.SpaceQuota{GUID: "large-guid", Name: "large"},
+			CurrentSecurityGroups: []v2action.SecurityGroup{{GUID: "public-guid", Name: "public"}},
+			DesiredSecurityGroups: []v2action.SecurityGroup{{GUID: "dns-guid", Name: "dns"}},
+			CurrentServiceInstances: []v2action.ServiceInstance{
+				{GUID: "db-guid", Name: "db", Type: ccv2.ManagedService, ServicePlanGUID: "small-plan-guid"},
+				{GUID: "logger-guid", Name: "logger", Type: ccv2.UserProvidedService},
+				{GUID: "cache-guid", Name: "cache", Type: ccv2.ManagedService},
+			},
+			DesiredServiceInstances: []v2action.ServiceInstance{
+				{GUID: "db-guid", Name: "db", Type: ccv2.ManagedService, ServicePlanGUID: "large-plan-guid"},
+				{GUID: "logger-guid", Name: "logger", Type: ccv2.UserProvidedService},
+				{Name: "mq", Type: ccv2.ManagedService, ServicePlanGUID: "mq-plan-guid"},
+				{Name: "auth", Type: ccv2.UserProvidedService, SyslogDrainURL: "syslog://example.com"},
+			},
+			CurrentApplications: []v2action.Application{{GUID: "app-1-guid", Name: "app-1"}, {GUID: "app-2-guid", Name: "app-2"}},
+			DesiredApplications: []manifest.Application{{Name: "app-1"}},
+			OrphanedRoutes:      []v2action.Route{{GUID: "route-guid", Host: "old"}},
+		}
+
+		fakeV2Actor.SetSpaceQuotaReturns(v2action.Warnings{"quota-warning"}, nil)
+		fakeV2Actor.BindSecurityGroupToSpaceReturns(v2action.Warnings{"bind-warning"}, nil)
+		fakeV2Actor.UpdateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"update-warning"}, nil)
+		fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-warning"}, nil)
+		fakeV2Actor.CreateUserProvidedServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-ups-warning"}, nil)
+	})
+
+	Describe("ApplySpace", func() {
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.ApplySpace(config)
+		})
+
+		It("sets the space quota, binds security groups and converges the changed service instances", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(Equal(Warnings{"quota-warning", "bind-warning", "update-warning", "create-warning", "create-ups-warning"}))
+
+			spaceGUID, quotaGUID := fakeV2Actor.SetSpaceQuotaArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(quotaGUID).To(Equal("large-guid"))
+
+			Expect(fakeV2Actor.BindSecurityGroupToSpaceCallCount()).To(Equal(1))
+			groupGUID, spaceGUID := fakeV2Actor.BindSecurityGroupToSpaceArgsForCall(0)
+			Expect(groupGUID).To(Equal("dns-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeV2Actor.UpdateServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.UpdateServiceInstanceArgsForCall(0).ServicePlanGUID).To(Equal("large-plan-guid"))
+			Expect(fakeV2Actor.CreateServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.CreateServiceInstanceArgsForCall(0).Name).To(Equal("mq"))
+			Expect(fakeV2Actor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.CreateUserProvidedServiceInstanceArgsForCall(0).Name).To(Equal("auth"))
+			Expect(fakeV2Actor.UpdateUserProvidedServiceInstanceCallCount()).To(Equal(0))
+
+			Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+			Expect(fakeV2Actor.UnbindSecurityGroupByNameAndSpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when the space quota is unchanged", func() {
+			BeforeEach(func() {
+				config.DesiredSpaceQuota = config.CurrentSpaceQuota
+			})
+
+			It("does not set the space quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.SetSpaceQuotaCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when creating a service instance fails", func() {
+			BeforeEach(func() {
+				fakeV2Actor.CreateServiceInstanceReturns(v2action.ServiceInstance{}, v2action.Warnings{"create-warning"}, errors.New("create error"))
+			})
+
+			It("returns the error and the warnings so far", func() {
+				Expect(executeErr).To(MatchError("create error"))
+				Expect(warnings).To(Equal(Warnings{"quota-warning", "bind-warning", "update-warning", "create-warning"}))
+				Expect(fakeV2Actor.CreateUserProvidedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Describe("PruneSpace", func() {
+		BeforeEach(func() {
+			fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-app-warning"}, nil)
+			fakeV2Actor.DeleteRouteReturns(v2action.Warnings{"delete-route-warning"}, nil)
+			fakeV2Actor.DeleteServiceInstanceReturns(v2action.Warnings{"delete-instance-warning"}, nil)
+			fakeV2Actor.UnbindSecurityGroupByNameAndSpaceReturns(v2action.Warnings{"unbind-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.PruneSpace(config)
+		})
+
+		Context("when prune is not set", func() {
+			It("does nothing", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(BeEmpty())
+				Expect(fakeV2Actor.Invocations()).To(BeEmpty())
+			})
+		})
+
+		Context("when prune is set", func() {
+			BeforeEach(func() {
+				config.Prune = true
+			})
+
+			It("removes the unmanaged resources", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"delete-app-warning", "delete-route-warning", "delete-instance-warning", "unbind-warning"}))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("app-2-guid"))
+				Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteRouteArgsForCall(0)).To(Equal("route-guid"))
+				Expect(fakeV2Actor.DeleteServiceInstanceCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteServiceInstanceArgsForCall(0).GUID).To(Equal("cache-guid"))
+				Expect(fakeV2Actor.UnbindSecurityGroupByNameAndSpaceCallCount()).To(Equal(1))
+				groupName, spaceGUID := fakeV2Actor.UnbindSecurityGroupByNameAndSpaceArgsForCall(0)
+				Expect(groupName).To(Equal("public"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+			})
+
+			Context("when deleting an application fails", func() {
+				BeforeEach(func() {
+					fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-app-warning"}, errors.New("delete error"))
+				})
+
+				It("returns the error and warnings and stops", func() {
+					Expect(executeErr).To(MatchError("delete error"))
+					Expect(warnings).To(Equal(Warnings{"delete-app-warning"}))
+					Expect(fakeV2Actor.DeleteRouteCallCount()).To(Equal(0))
+				})
+			})
+		})
+	})
+})
@@ -0,0 +1,257 @@
+package pushaction
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	log "github.com/Sirupsen/logrus"
+)
+
+// ServiceInstanceTypeChangedError is returned when a space manifest describes
+// a managed service instance that exists as a user provided service instance,
+// or the other way around.
+type ServiceInstanceTypeChangedError struct {
+	Name string
+}
+
+func (e ServiceInstanceTypeChangedError) Error() string {
+	return fmt.Sprintf("Service instance %s cannot change between managed and user provided", e.Name)
+}
+
+// SpaceConfig is the current and desired state of a space described by a
+// space manifest. Applications are converged with Apply; the other resources
+// are converged with ApplySpace and, when Prune is set, PruneSpace.
+type SpaceConfig struct {
+	OrgGUID   string
+	SpaceGUID string
+	Prune     bool
+
+	CurrentSpaceQuota v2action.SpaceQuota
+	DesiredSpaceQuota v2action.SpaceQuota
+
+	CurrentSecurityGroups []v2action.SecurityGroup
+	DesiredSecurityGroups []v2action.SecurityGroup
+
+	// DesiredServiceInstances have the GUID of the current service instance
+	// with the same name, if there is one.
+	CurrentServiceInstances []v2action.ServiceInstance
+	DesiredServiceInstances []v2action.ServiceInstance
+
+	CurrentApplications []v2action.Application
+	DesiredApplications []manifest.Application
+
+	// OrphanedRoutes are the routes in the space that are not mapped to an
+	// application and are not used by a desired application.
+	OrphanedRoutes []v2action.Route
+}
+
+// ConvertToSpaceConfig reads the current state of the space and resolves the
+// resources named in the space manifest.
+func (actor Actor) ConvertToSpaceConfig(orgGUID string, spaceName string, space manifest.Space, prune bool) (SpaceConfig, Warnings, error) {
+	var warnings Warnings
+
+	log.Infoln("looking up space", spaceName)
+	currentSpace, spaceWarnings, err := actor.V2Actor.GetSpaceByOrganizationAndName(orgGUID, spaceName)
+	warnings = append(warnings, spaceWarnings...)
+	if err != nil {
+		log.Errorln("space lookup:", err)
+		return SpaceConfig{}, warnings, err
+	}
+
+	config := SpaceConfig{
+		OrgGUID:             orgGUID,
+		SpaceGUID:           currentSpace.GUID,
+		Prune:               prune,
+		DesiredApplications: space.Applications,
+	}
+
+	for _, configure := range []func(SpaceConfig, manifest.Space, v2action.Space) (SpaceConfig, Warnings, error){
+		actor.configureSpaceQuota,
+		actor.configureSecurityGroups,
+		actor.configureServiceInstances,
+		actor.configureApplications,
+	} {
+		var configureWarnings Warnings
+		config, configureWarnings, err = configure(config, space, currentSpace)
+		warnings = append(warnings, configureWarnings...)
+		if err != nil {
+			return SpaceConfig{}, warnings, err
+		}
+	}
+
+	return config, warnings, nil
+}
+
+func (actor Actor) configureSpaceQuota(config SpaceConfig, space manifest.Space, currentSpace v2action.Space) (SpaceConfig, Warnings, error) {
+	var warnings Warnings
+
+	if currentSpace.SpaceQuotaDefinitionGUID != "" {
+		log.Info("looking up current space quota")
+		spaceQuota, quotaWarnings, err := actor.V2Actor.GetSpaceQuota(currentSpace.SpaceQuotaDefinitionGUID)
+		warnings = append(warnings, quotaWarnings...)
+		if err != nil {
+			log.Errorln("space quota lookup:", err)
+			return SpaceConfig{}, warnings, err
+		}
+		config.CurrentSpaceQuota = spaceQuota
+	}
+
+	config.DesiredSpaceQuota = config.CurrentSpaceQuota
+	if space.SpaceQuota != "" {
+		log.Infoln("looking up space quota", space.SpaceQuota)
+		spaceQuota, quotaWarnings, err := actor.V2Actor.GetSpaceQuotaByName(config.OrgGUID, space.SpaceQuota)
+		warnings = append(warnings, quotaWarnings...)
+		if err != nil {
+			log.Errorln("space quota lookup:", err)
+			return SpaceConfig{}, warnings, err
+		}
+		config.DesiredSpaceQuota = spaceQuota
+	}
+
+	return config, warnings, nil
+}
+
+func (actor Actor) configureSecurityGroups(config SpaceConfig, space manifest.Space, _ v2action.Space) (SpaceConfig, Warnings, error) {
+	log.Info("looking up security groups bound to space")
+	securityGroups, warnings, err := actor.V2Actor.GetSpaceRunningSecurityGroupsBySpace(config.SpaceGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		log.Errorln("security groups lookup:", err)
+		return SpaceConfig{}, allWarnings, err
+	}
+	config.CurrentSecurityGroups = securityGroups
+
+	for _, name := range space.SecurityGroups {
+		log.Infoln("looking up security group", name)
+		securityGroup, groupWarnings, err := actor.V2Actor.GetSecurityGroupByName(name)
+		allWarnings = append(allWarnings, groupWarnings...)
+		if err != nil {
+			log.Errorln("security group lookup:", err)
+			return SpaceConfig{}, allWarnings, err
+		}
+		config.DesiredSecurityGroups = append(config.DesiredSecurityGroups, securityGroup)
+	}
+
+	return config, allWarnings, nil
+}
+
+func (actor Actor) configureServiceInstances(config SpaceConfig, space manifest.Space, _ v2action.Space) (SpaceConfig, Warnings, error) {
+	log.Info("looking up service instances in space")
+	serviceInstances, warnings, err := actor.V2Actor.GetServiceInstancesBySpace(config.SpaceGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		log.Errorln("service instances lookup:", err)
+		return SpaceConfig{}, allWarnings, err
+	}
+	config.CurrentServiceInstances = serviceInstances
+
+	for _, instance := range space.ServiceInstances {
+		log.Infof("looking up plan %s of service %s", instance.Plan, instance.Service)
+		plan, planWarnings, err := actor.V2Actor.GetServicePlanByNameServiceAndSpace(instance.Plan, instance.Service, config.SpaceGUID)
+		allWarnings = append(allWarnings, planWarnings...)
+		if err != nil {
+			log.Errorln("service plan lookup:", err)
+			return SpaceConfig{}, allWarnings, err
+		}
+
+		desired := v2action.ServiceInstance{
+			Name:            instance.Name,
+			Type:            ccv2.ManagedService,
+			SpaceGUID:       config.SpaceGUID,
+			ServicePlanGUID: plan.GUID,
+		}
+		desired, err = config.withCurrentGUID(desired)
+		if err != nil {
+			return SpaceConfig{}, allWarnings, err
+		}
+		config.DesiredServiceInstances = append(config.DesiredServiceInstances, desired)
+	}
+
+	for _, service := range space.UserProvidedServices {
+		desired := v2action.ServiceInstance{
+			Name:            service.Name,
+			Type:            ccv2.UserProvidedService,
+			SpaceGUID:       config.SpaceGUID,
+			Credentials:     service.Credentials,
+			SyslogDrainURL:  service.SyslogDrainURL,
+			RouteServiceURL: service.RouteServiceURL,
+		}
+		desired, err = config.withCurrentGUID(desired)
+		if err != nil {
+			return SpaceConfig{}, allWarnings, err
+		}
+		config.DesiredServiceInstances = append(config.DesiredServiceInstances, desired)
+	}
+
+	return config, allWarnings, nil
+}
+
+// withCurrentGUID sets the GUID of the current service instance with the
+// same name on the desired service instance.
+func (config SpaceConfig) withCurrentGUID(desired v2action.ServiceInstance) (v2action.ServiceInstance, error) {
+	current, ok := config.currentServiceInstance(desired.Name)
+	if !ok {
+		return desired, nil
+	}
+
+	if current.Type != desired.Type {
+		return v2action.ServiceInstance{}, ServiceInstanceTypeChangedError{Name: desired.Name}
+	}
+	desired.GUID = current.GUID
+	return desired, nil
+}
+
+func (config SpaceConfig) currentServiceInstance(name string) (v2action.ServiceInstance, bool) {
+	for _, current := range config.CurrentServiceInstances {
+		if current.Name == name {
+			return current, true
+		}
+	}
+	return v2action.ServiceInstance{}, false
+}
+
+func (actor Actor) configureApplications(config SpaceConfig, space manifest.Space, _ v2action.Space) (SpaceConfig, Warnings, error) {
+	log.Info("looking up applications in space")
+	applications, warnings, err := actor.V2Actor.GetApplicationsBySpace(config.SpaceGUID)
+	allWarnings := Warnings(warnings)
+	if err != nil {
+		log.Errorln("applications lookup:", err)
+		return SpaceConfig{}, allWarnings, err
+	}
+	config.CurrentApplications = applications
+
+	if !config.Prune {
+		return config, allWarnings, nil
+	}
+
+	log.Info("looking up orphaned routes in space")
+	orphanedRoutes, warnings, err := actor.V2Actor.GetOrphanedRoutesBySpace(config.SpaceGUID)
+	allWarnings = append(allWarnings, warnings...)
+	if _, ok := err.(v2action.OrphanedRoutesNotFoundError); ok {
+		return config, allWarnings, nil
+	} else if err != nil {
+		log.Errorln("orphaned routes lookup:", err)
+		return SpaceConfig{}, allWarnings, err
+	}
+
+	var desiredRoutes []v2action.Route
+	for _, app := range space.Applications {
+		routes, routeWarnings, err := actor.CalculateRoutes(app, config.OrgGUID, config.SpaceGUID)
+		allWarnings = append(allWarnings, routeWarnings...)
+		if err != nil {
+			log.Errorln("calculating routes:", err)
+			return SpaceConfig{}, allWarnings, err
+		}
+		desiredRoutes = append(desiredRoutes, routes...)
+	}
+
+	for _, route := range orphanedRoutes {
+		if !actor.routeInList(route, desiredRoutes) {
+			config.OrphanedRoutes = append(config.OrphanedRoutes, route)
+		}
+	}
+
+	return config, allWarnings, nil
+}
@@ -0,0 +1,212 @@
+package pushaction_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Space Config", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
+	})
+
+	Describe("ConvertToSpaceConfig", func() {
+		var (
+			space manifest.Space
+			prune bool
+
+			config     SpaceConfig
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			prune = false
+			space = manifest.Space{
+				SpaceQuota:     "large",
+				SecurityGroups: []string{"dns"},
+				ServiceInstances: []manifest.ServiceInstance{
+					{Name: "db", Service: "p-mysql", Plan: "100mb"},
+				},
+				UserProvidedServices: []manifest.UserProvidedService{
+					{Name: "logger", SyslogDrainURL: "syslog://example.com"},
+				},
+				Applications: []manifest.Application{{Name: "app-1"}},
+			}
+
+			fakeV2Actor.GetSpaceByOrganizationAndNameReturns(
+				v2action.Space{GUID: "some-space-guid", SpaceQuotaDefinitionGUID: "small-guid"},
+				v2action.Warnings{"space-warning"},
+				nil,
+			)
+			fakeV2Actor.GetSpaceQuotaReturns(v2action.SpaceQuota{GUID: "small-guid", Name: "small"}, v2action.Warnings{"quota-warning"}, nil)
+			fakeV2Actor.GetSpaceQuotaByNameReturns(v2action.SpaceQuota{GUID: "large-guid", Name: "large"}, v2action.Warnings{"quota-name-warning"}, nil)
+			fakeV2Actor.GetSpaceRunningSecurityGroupsBySpaceReturns(
+				[]v2action.SecurityGroup{{GUID: "public-guid", Name: "public"}},
+				v2action.Warnings{"groups-warning"},
+				nil,
+			)
+			fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{GUID: "dns-guid", Name: "dns"}, v2action.Warnings{"group-warning"}, nil)
+			fakeV2Actor.GetServiceInstancesBySpaceReturns(
+				[]v2action.ServiceInstance{
+					{GUID: "logger-guid", Name: "logger", Type: ccv2.UserProvidedService},
+				},
+				v2action.Warnings{"instances-warning"},
+				nil,
+			)
+			fakeV2Actor.GetServicePlanByNameServiceAndSpaceReturns(v2action.ServicePlan{GUID: "100mb-guid", Name: "100mb"}, v2action.Warnings{"plan-warning"}, nil)
+			fakeV2Actor.GetApplicationsBySpaceReturns(
+				[]v2action.Application{{GUID: "app-1-guid", Name: "app-1"}},
+				v2action.Warnings{"apps-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			config, warnings, executeErr = actor.ConvertToSpaceConfig("some-org-guid", "some-space", space, prune)
+		})
+
+		It("returns the current and desired state of the space, and all warnings", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf(
+				"space-warning", "quota-warning", "quota-name-warning", "groups-warning", "group-warning",
+				"instances-warning", "plan-warning", "apps-warning",
+			))
+
+			Expect(config.OrgGUID).To(Equal("some-org-guid"))
+			Expect(config.SpaceGUID).To(Equal("some-space-guid"))
+			Expect(config.CurrentSpaceQuota).To(Equal(v2action.SpaceQuota{GUID: "small-guid", Name: "small"}))
+			Expect(config.DesiredSpaceQuota).To(Equal(v2action.SpaceQuota{GUID: "large-guid", Name: "large"}))
+			Expect(config.CurrentSecurityGroups).To(Equal([]v2action.SecurityGroup{{GUID: "public-guid", Name: "public"}}))
+			Expect(config.DesiredSecurityGroups).To(Equal([]v2action.SecurityGroup{{GUID: "dns-guid", Name: "dns"}}))
+			Expect(config.DesiredServiceInstances).To(Equal([]v2action.ServiceInstance{
+				{
+					Name:            "db",
+					Type:            ccv2.ManagedService,
+					SpaceGUID:       "some-space-guid",
+					ServicePlanGUID: "100mb-guid",
+				},
+				{
+					GUID:           "logger-guid",
+					Name:           "logger",
+					Type:           ccv2.UserProvidedService,
+					SpaceGUID:      "some-space-guid",
+					SyslogDrainURL: "syslog://example.com",
+				},
+			}))
+			Expect(config.CurrentApplications).To(Equal([]v2action.Application{{GUID: "app-1-guid", Name: "app-1"}}))
+			Expect(config.DesiredApplications).To(Equal(space.Applications))
+
+			orgGUID, spaceName := fakeV2Actor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+
+			planName, serviceName, spaceGUID := fakeV2Actor.GetServicePlanByNameServiceAndSpaceArgsForCall(0)
+			Expect(planName).To(Equal("100mb"))
+			Expect(serviceName).To(Equal("p-mysql"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeV2Actor.GetOrphanedRoutesBySpaceCallCount()).To(Equal(0))
+		})
+
+		Context("when the space manifest does not set a space quota", func() {
+			BeforeEach(func() {
+				space.SpaceQuota = ""
+			})
+
+			It("keeps the current space quota", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(config.DesiredSpaceQuota).To(Equal(config.CurrentSpaceQuota))
+				Expect(fakeV2Actor.GetSpaceQuotaByNameCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when a service instance changes between managed and user provided", func() {
+			BeforeEach(func() {
+				space.ServiceInstances[0].Name = "logger"
+				space.UserProvidedServices = nil
+			})
+
+			It("returns a ServiceInstanceTypeChangedError", func() {
+				Expect(executeErr).To(MatchError(ServiceInstanceTypeChangedError{Name: "logger"}))
+			})
+		})
+
+		Context("when a security group cannot be found", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = v2action.SecurityGroupNotFoundError{Name: "dns"}
+				fakeV2Actor.GetSecurityGroupByNameReturns(v2action.SecurityGroup{}, v2action.Warnings{"group-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ContainElement("group-warning"))
+			})
+		})
+
+		Context("when pruning", func() {
+			BeforeEach(func() {
+				prune = true
+				fakeV2Actor.GetOrganizationDomainsReturns([]v2action.Domain{{GUID: "domain-guid", Name: "example.com"}}, nil, nil)
+				fakeV2Actor.CheckRouteReturns(true, nil, nil)
+				fakeV2Actor.GetRouteByComponentsReturns(
+					v2action.Route{GUID: "app-1-route-guid", Host: "app-1", Domain: v2action.Domain{GUID: "domain-guid", Name: "example.com"}, SpaceGUID: "some-space-guid"},
+					nil,
+					nil,
+				)
+				fakeV2Actor.GetOrphanedRoutesBySpaceReturns(
+					[]v2action.Route{
+						{GUID: "app-1-route-guid", Host: "app-1"},
+						{GUID: "old-route-guid", Host: "old"},
+					},
+					v2action.Warnings{"orphaned-warning"},
+					nil,
+				)
+			})
+
+			It("records the orphaned routes that no desired application uses", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ContainElement("orphaned-warning"))
+				Expect(config.OrphanedRoutes).To(Equal([]v2action.Route{{GUID: "old-route-guid", Host: "old"}}))
+			})
+
+			Context("when the space has no orphaned routes", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetOrphanedRoutesBySpaceReturns(nil, nil, v2action.OrphanedRoutesNotFoundError{})
+				})
+
+				It("records no orphaned routes", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(config.OrphanedRoutes).To(BeEmpty())
+				})
+			})
+
+			Context("when looking up the orphaned routes fails", func() {
+				BeforeEach(func() {
+					fakeV2Actor.GetOrphanedRoutesBySpaceReturns(nil, v2action.Warnings{"orphaned-warning"}, errors.New("routes error"))
+				})
+
+				It("returns the error and warnings", func() {
+					Expect(executeErr).To(MatchError("routes error"))
+					Expect(warnings).To(ContainElement("orphaned-warning"))
+				})
+			})
+		})
+	})
+})
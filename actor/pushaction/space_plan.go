@@ -0,0 +1,197 @@
+package pushaction
+
+import (
+	"encoding/json"
+	"reflect"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// SpaceResource is the kind of resource a SpaceChange applies to.
+type SpaceResource string
+
+const (
+	ApplicationResource         SpaceResource = "app"
+	RouteResource               SpaceResource = "route"
+	SecurityGroupResource       SpaceResource = "security group"
+	ServiceInstanceResource     SpaceResource = "service instance"
+	SpaceQuotaResource          SpaceResource = "space quota"
+	UserProvidedServiceResource SpaceResource = "user-provided service"
+)
+
+// SpaceChange is a change that ApplySpace, Apply or PruneSpace will make to
+// a resource in the space.
+type SpaceChange struct {
+	Resource SpaceResource `json:"resource"`
+	Name     string        `json:"name"`
+	Action   PlanAction    `json:"action"`
+}
+
+// SpacePlan describes the changes that converging a space would make, in the
+// order they are made.
+type SpacePlan struct {
+	Changes []SpaceChange `json:"changes"`
+}
+
+// PlanSpace compares the current and desired state of the space
+// configuration and returns the changes that would be made. Desired
+// applications are always listed, since Apply pushes them even when their
+// properties are unchanged. It does not contact the Cloud Controller.
+func (actor Actor) PlanSpace(config SpaceConfig) SpacePlan {
+	plan := SpacePlan{Changes: []SpaceChange{}}
+	add := func(resource SpaceResource, name string, action PlanAction) {
+		plan.Changes = append(plan.Changes, SpaceChange{Resource: resource, Name: name, Action: action})
+	}
+
+	if config.DesiredSpaceQuota.GUID != config.CurrentSpaceQuota.GUID {
+		add(SpaceQuotaResource, config.DesiredSpaceQuota.Name, PlanUpdate)
+	}
+
+	for _, securityGroup := range config.securityGroupsToBind() {
+		add(SecurityGroupResource, securityGroup.Name, PlanBind)
+	}
+
+	for _, desired := range config.DesiredServiceInstances {
+		resource := serviceInstanceResource(desired)
+		current, exists := config.currentServiceInstance(desired.Name)
+		switch {
+		case !exists:
+			add(resource, desired.Name, PlanCreate)
+		case serviceInstanceChanged(current, desired):
+			add(resource, desired.Name, PlanUpdate)
+		}
+	}
+
+	for _, app := range config.DesiredApplications {
+		action := PlanCreate
+		for _, current := range config.CurrentApplications {
+			if current.Name == app.Name {
+				action = PlanUpdate
+				break
+			}
+		}
+		add(ApplicationResource, app.Name, action)
+	}
+
+	if !config.Prune {
+		return plan
+	}
+
+	for _, app := range config.applicationsToDelete() {
+		add(ApplicationResource, app.Name, PlanDelete)
+	}
+	for _, route := range config.OrphanedRoutes {
+		add(RouteResource, route.String(), PlanDelete)
+	}
+	for _, serviceInstance := range config.serviceInstancesToDelete() {
+		add(serviceInstanceResource(serviceInstance), serviceInstance.Name, PlanDelete)
+	}
+	for _, securityGroup := range config.securityGroupsToUnbind() {
+		add(SecurityGroupResource, securityGroup.Name, PlanUnbind)
+	}
+
+	return plan
+}
+
+func serviceInstanceResource(serviceInstance v2action.ServiceInstance) SpaceResource {
+	if serviceInstance.Type == ccv2.UserProvidedService {
+		return UserProvidedServiceResource
+	}
+	return ServiceInstanceResource
+}
+
+// serviceInstanceChanged returns true if the desired service instance has a
+// different plan, or different credentials, syslog drain URL or route service
+// URL, than the current one.
+func serviceInstanceChanged(current v2action.ServiceInstance, desired v2action.ServiceInstance) bool {
+	if desired.Type == ccv2.ManagedService {
+		return current.ServicePlanGUID != desired.ServicePlanGUID
+	}
+
+	return current.SyslogDrainURL != desired.SyslogDrainURL ||
+		current.RouteServiceURL != desired.RouteServiceURL ||
+		!sameCredentials(current.Credentials, desired.Credentials)
+}
+
+// sameCredentials compares credentials by their JSON representation, since
+// numbers read from a manifest and from the Cloud Controller have different
+// types.
+func sameCredentials(current map[string]interface{}, desired map[string]interface{}) bool {
+	if len(current) == 0 && len(desired) == 0 {
+		return true
+	}
+
+	var normalized [2]interface{}
+	for i, credentials := range []map[string]interface{}{current, desired} {
+		raw, err := json.Marshal(credentials)
+		if err != nil {
+			return false
+		}
+		if err := json.Unmarshal(raw, &normalized[i]); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(normalized[0], normalized[1])
+}
+
+func (config SpaceConfig) securityGroupsToBind() []v2action.SecurityGroup {
+	return securityGroupsMissingFrom(config.DesiredSecurityGroups, config.CurrentSecurityGroups)
+}
+
+func (config SpaceConfig) securityGroupsToUnbind() []v2action.SecurityGroup {
+	return securityGroupsMissingFrom(config.CurrentSecurityGroups, config.DesiredSecurityGroups)
+}
+
+// securityGroupsMissingFrom returns the security groups in groups that are
+// not in others.
+func securityGroupsMissingFrom(groups []v2action.SecurityGroup, others []v2action.SecurityGroup) []v2action.SecurityGroup {
+	var missing []v2action.SecurityGroup
+	for _, group := range groups {
+		found := false
+		for _, other := range others {
+			if other.GUID == group.GUID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missing = append(missing, group)
+		}
+	}
+	return missing
+}
+
+// applicationsToDelete returns the current applications that are not in the
+// space manifest.
+func (config SpaceConfig) applicationsToDelete() []v2action.Application {
+	desired := map[string]bool{}
+	for _, app := range config.DesiredApplications {
+		desired[app.Name] = true
+	}
+
+	var unmanaged []v2action.Application
+	for _, app := range config.CurrentApplications {
+		if !desired[app.Name] {
+			unmanaged = append(unmanaged, app)
+		}
+	}
+	return unmanaged
+}
+
+// serviceInstancesToDelete returns the current service instances that are
+// not in the space manifest.
+func (config SpaceConfig) serviceInstancesToDelete() []v2action.ServiceInstance {
+	desired := map[string]bool{}
+	for _, serviceInstance := range config.DesiredServiceInstances {
+		desired[serviceInstance.Name] = true
+	}
+
+	var unmanaged []v2action.ServiceInstance
+	for _, serviceInstance := range config.CurrentServiceInstances {
+		if !desired[serviceInstance.Name] {
+			unmanaged = append(unmanaged, serviceInstance)
+		}
+	}
+	return unmanaged
+}
@@ -0,0 +1,94 @@
+package pushaction_test
+
+import (
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PlanSpace", func() {
+	var (
+		actor       *Actor
+		fakeV2Actor *pushactionfakes.FakeV2Actor
+		config      SpaceConfig
+		plan        SpacePlan
+	)
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		actor = NewActor(fakeV2Actor, nil)
+
+		config = SpaceConfig{
+			CurrentSpaceQuota:     v2action.SpaceQuota{GUID: "small-guid", Name: "small"},
+			DesiredSpaceQuota:     v2action.SpaceQuota{GUID: "large-guid", Name: "large"},
+			CurrentSecurityGroups: []v2action.SecurityGroup{{GUID: "public-guid", Name: "public"}, {GUID: "dns-guid", Name: "dns"}},
+			DesiredSecurityGroups: []v2action.SecurityGroup{{GUID: "dns-guid", Name: "dns"}, {GUID: "ldap-guid", Name: "ldap"}},
+			CurrentServiceInstances: []v2action.ServiceInstance{
+				{GUID: "db-guid", Name: "db", Type: ccv2.ManagedService, ServicePlanGUID: "small-plan-guid"},
+				{GUID: "cache-guid", Name: "cache", Type: ccv2.ManagedService, ServicePlanGUID: "cache-plan-guid"},
+				{GUID: "logger-guid", Name: "logger", Type: ccv2.UserProvidedService, Credentials: map[string]interface{}{"port": float64(514)}},
+				{GUID: "old-guid", Name: "old", Type: ccv2.UserProvidedService},
+			},
+			DesiredServiceInstances: []v2action.ServiceInstance{
+				{GUID: "db-guid", Name: "db", Type: ccv2.ManagedService, ServicePlanGUID: "large-plan-guid"},
+				{GUID: "cache-guid", Name: "cache", Type: ccv2.ManagedService, ServicePlanGUID: "cache-plan-guid"},
+				{GUID: "logger-guid", Name: "logger", Type: ccv2.UserProvidedService, Credentials: map[string]interface{}{"port": 514}},
+				{Name: "mq", Type: ccv2.ManagedService, ServicePlanGUID: "mq-plan-guid"},
+				{Name: "auth", Type: ccv2.UserProvidedService},
+			},
+			CurrentApplications: []v2action.Application{{GUID: "app-1-guid", Name: "app-1"}, {GUID: "app-3-guid", Name: "app-3"}},
+			DesiredApplications: []manifest.Application{{Name: "app-1"}, {Name: "app-2"}},
+			OrphanedRoutes:      []v2action.Route{{GUID: "route-guid", Host: "old", Domain: v2action.Domain{Name: "example.com"}}},
+		}
+	})
+
+	JustBeforeEach(func() {
+		plan = actor.PlanSpace(config)
+	})
+
+	AfterEach(func() {
+		Expect(fakeV2Actor.Invocations()).To(BeEmpty())
+	})
+
+	It("lists only the space resources that differ, and every desired app", func() {
+		Expect(plan).To(Equal(SpacePlan{Changes: []SpaceChange{
+			{Resource: SpaceQuotaResource, Name: "large", Action: PlanUpdate},
+			{Resource: SecurityGroupResource, Name: "ldap", Action: PlanBind},
+			{Resource: ServiceInstanceResource, Name: "db", Action: PlanUpdate},
+			{Resource: ServiceInstanceResource, Name: "mq", Action: PlanCreate},
+			{Resource: UserProvidedServiceResource, Name: "auth", Action: PlanCreate},
+			{Resource: ApplicationResource, Name: "app-1", Action: PlanUpdate},
+			{Resource: ApplicationResource, Name: "app-2", Action: PlanCreate},
+		}}))
+	})
+
+	Context("when pruning", func() {
+		BeforeEach(func() {
+			config.Prune = true
+		})
+
+		It("also lists the unmanaged resources that will be removed", func() {
+			Expect(plan.Changes[7:]).To(Equal([]SpaceChange{
+				{Resource: ApplicationResource, Name: "app-3", Action: PlanDelete},
+				{Resource: RouteResource, Name: "old.example.com", Action: PlanDelete},
+				{Resource: UserProvidedServiceResource, Name: "old", Action: PlanDelete},
+				{Resource: SecurityGroupResource, Name: "public", Action: PlanUnbind},
+			}))
+		})
+	})
+
+	Context("when the user provided service properties change", func() {
+		BeforeEach(func() {
+			config.DesiredServiceInstances[2].SyslogDrainURL = "syslog://example.com"
+		})
+
+		It("plans to update the user provided service", func() {
+			Expect(plan.Changes).To(ContainElement(SpaceChange{Resource: UserProvidedServiceResource, Name: "logger", Action: PlanUpdate}))
+		})
+	})
+})
@@ -0,0 +1,523 @@
+package pushaction
+
+import (
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+	log "github.com/Sirupsen/logrus"
+)
+
+// Strategy is the way an existing application is replaced during a push.
+type Strategy string
+
+const (
+	// DefaultStrategy updates the existing application in place.
+	DefaultStrategy Strategy = ""
+
+	// BlueGreenStrategy starts a full copy of the application alongside the
+	// existing one and moves the routes across once every instance is
+	// healthy.
+	BlueGreenStrategy Strategy = "blue-green"
+
+	// RollingStrategy starts a copy of the application with a single instance
+	// and replaces the existing instances one at a time before moving the
+	// routes across.
+	RollingStrategy Strategy = "rolling"
+)
+
+// NewApplicationSuffix is appended to the name of the application that is
+// staged alongside the existing application.
+const NewApplicationSuffix = "-new"
+
+// OldApplicationSuffix is appended to the name of the existing application
+// while the new application takes over its name.
+const OldApplicationSuffix = "-old"
+
+// TemporaryApplicationExistsError is returned when an application already
+// has one of the names used while replacing an application, such as one left
+// behind by an earlier deployment.
+type TemporaryApplicationExistsError struct {
+	Name string
+}
+
+func (e TemporaryApplicationExistsError) Error() string {
+	return fmt.Sprintf("application %s already exists", e.Name)
+}
+
+// RollbackFailedError is returned when a deployment fails and the existing
+// application could not be fully restored.
+type RollbackFailedError struct {
+	AppName     string
+	Err         error
+	RollbackErr error
+}
+
+func (e RollbackFailedError) Error() string {
+	return fmt.Sprintf("deploying %s failed (%s) and rolling back failed (%s)", e.AppName, e.Err, e.RollbackErr)
+}
+
+// deployment tracks the changes made while replacing an application so that
+// they can be undone if a later step fails.
+type deployment struct {
+	config ApplicationConfig
+
+	newApp             v2action.Application
+	movedRoutes        []v2action.Route
+	oldInstancesScaled bool
+	oldAppRenamed      bool
+
+	eventStream    chan<- Event
+	warningsStream chan<- Warnings
+}
+
+// applyWithStrategy replaces the current application with a new application
+// built from the desired configuration. Services and routes are only moved to
+// the new application once it is healthy, the new application takes over the
+// name of the old application before the old application is deleted, and any
+// failure restores the old application.
+func (actor Actor) applyWithStrategy(config ApplicationConfig, eventStream chan<- Event, warningsStream chan<- Warnings, errorStream chan<- error) {
+	d := &deployment{
+		config:         config,
+		eventStream:    eventStream,
+		warningsStream: warningsStream,
+	}
+
+	err := actor.checkTemporaryNames(d)
+	if err != nil {
+		log.Errorln("checking temporary application names:", err)
+		errorStream <- err
+		return
+	}
+
+	steps := []func(*deployment) error{
+		actor.createNewApplication,
+		actor.uploadNewApplicationBits,
+		actor.bindNewApplicationServices,
+		actor.startNewApplication,
+		actor.bindNewApplicationRoutes,
+		actor.replaceInstances,
+		actor.unbindOldApplicationRoutes,
+		actor.renameOldApplication,
+		actor.renameNewApplication,
+		actor.deleteOldApplication,
+	}
+	for _, step := range steps {
+		err := step(d)
+		if err != nil {
+			log.Errorln("deploying new application:", err)
+			errorStream <- actor.rollback(d, err)
+			return
+		}
+	}
+
+	log.Debug("completed apply")
+	eventStream <- Complete
+}
+
+// checkTemporaryNames makes sure that no application already has one of the
+// names that the new and old applications are given during the deployment.
+func (actor Actor) checkTemporaryNames(d *deployment) error {
+	name := d.config.DesiredApplication.Name
+	for _, tempName := range []string{name + NewApplicationSuffix, name + OldApplicationSuffix} {
+		_, warnings, err := actor.V2Actor.GetApplicationByNameAndSpace(tempName, d.config.DesiredApplication.SpaceGUID)
+		d.warningsStream <- Warnings(warnings)
+		if _, ok := err.(v2action.ApplicationNotFoundError); ok {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		return TemporaryApplicationExistsError{Name: tempName}
+	}
+	return nil
+}
+
+func (actor Actor) createNewApplication(d *deployment) error {
+	desired := d.config.DesiredApplication
+	newApp := v2action.Application{
+		Buildpack:               desired.Buildpack,
+		Command:                 desired.Command,
+		DiskQuota:               desired.DiskQuota,
+		DockerImage:             desired.DockerImage,
+		EnvironmentVariables:    desired.EnvironmentVariables,
+		HealthCheckHTTPEndpoint: desired.HealthCheckHTTPEndpoint,
+		HealthCheckTimeout:      desired.HealthCheckTimeout,
+		HealthCheckType:         desired.HealthCheckType,
+		Instances:               desired.Instances,
+		Memory:                  desired.Memory,
+		Name:                    desired.Name + NewApplicationSuffix,
+		SpaceGUID:               desired.SpaceGUID,
+		StackGUID:               desired.StackGUID,
+	}
+	if d.config.Strategy == RollingStrategy && instanceCount(newApp) > 1 {
+		newApp.Instances = types.NullInt{IsSet: true, Value: 1}
+	}
+
+	log.Debugf("creating new application: %#v", newApp)
+	app, warnings, err := actor.V2Actor.CreateApplication(newApp)
+	d.warningsStream <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+	d.newApp = app
+	d.newApp.Instances = newApp.Instances
+
+	d.eventStream <- NewApplicationCreated
+	return nil
+}
+
+// uploadNewApplicationBits uploads the application's bits to the new
+// application.
+func (actor Actor) uploadNewApplicationBits(d *deployment) error {
+	if !hasBits(d.config) {
+		return nil
+	}
+	return actor.uploadBits(d.config, d.newApp.GUID, d.eventStream, d.warningsStream)
+}
+
+// bindNewApplicationServices binds the desired services, along with every
+// service bound to the old application, to the new application.
+func (actor Actor) bindNewApplicationServices(d *deployment) error {
+	var serviceInstanceGUIDs []string
+	included := map[string]bool{}
+	include := func(serviceInstanceGUID string) {
+		if !included[serviceInstanceGUID] {
+			included[serviceInstanceGUID] = true
+			serviceInstanceGUIDs = append(serviceInstanceGUIDs, serviceInstanceGUID)
+		}
+	}
+
+	for _, serviceInstance := range d.config.DesiredServices {
+		include(serviceInstance.GUID)
+	}
+
+	serviceBindings, warnings, err := actor.V2Actor.GetServiceBindingsByApplication(d.config.CurrentApplication.GUID)
+	d.warningsStream <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+	for _, serviceBinding := range serviceBindings {
+		include(serviceBinding.ServiceInstanceGUID)
+	}
+
+	if len(serviceInstanceGUIDs) == 0 {
+		return nil
+	}
+
+	for _, serviceInstanceGUID := range serviceInstanceGUIDs {
+		log.Debugf("binding service instance %s to new application", serviceInstanceGUID)
+		warnings, err := actor.V2Actor.BindServiceByApplicationAndServiceInstance(d.newApp.GUID, serviceInstanceGUID)
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.eventStream <- ServiceBound
+	return nil
+}
+
+func (actor Actor) startNewApplication(d *deployment) error {
+	d.eventStream <- StartingNewApplication
+
+	log.Infoln("starting new application", d.newApp.Name)
+	_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+		GUID:  d.newApp.GUID,
+		State: ccv2.ApplicationStarted,
+	})
+	d.warningsStream <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	err = actor.waitForHealthyInstances(d, instanceCount(d.newApp))
+	if err != nil {
+		return err
+	}
+
+	d.eventStream <- NewApplicationHealthy
+	return nil
+}
+
+// bindNewApplicationRoutes binds the desired routes, along with any routes of
+// the old application, to the new application. The old application keeps its
+// routes so that both applications serve traffic while instances are
+// replaced.
+func (actor Actor) bindNewApplicationRoutes(d *deployment) error {
+	routes := d.config.DesiredRoutes
+	if !d.config.NoRoute {
+		for _, route := range d.config.CurrentRoutes {
+			if !actor.routeInList(route, routes) {
+				routes = append(routes, route)
+			}
+		}
+	}
+
+	for _, route := range routes {
+		if route.GUID == "" {
+			log.Debugf("creating route: %#v", route)
+			createdRoute, warnings, err := actor.V2Actor.CreateRoute(route, false)
+			d.warningsStream <- Warnings(warnings)
+			if err != nil {
+				return err
+			}
+			route = createdRoute
+		}
+
+		log.Debugf("binding route %s to new application", route)
+		warnings, err := actor.bindRouteToApp(route, d.newApp.GUID)
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// replaceInstances scales up the new application one instance at a time,
+// scaling down the old application as each new instance becomes healthy. It
+// only applies to the rolling strategy, and runs once the routes are bound to
+// the new application so that the total capacity behind the routes does not
+// drop.
+func (actor Actor) replaceInstances(d *deployment) error {
+	if d.config.Strategy != RollingStrategy {
+		return nil
+	}
+
+	desiredInstances := instanceCount(d.config.DesiredApplication)
+	oldInstances := instanceCount(d.config.CurrentApplication)
+	for newInstances := instanceCount(d.newApp); newInstances < desiredInstances; {
+		newInstances++
+		log.Debugf("scaling new application to %d instances", newInstances)
+		_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+			GUID:      d.newApp.GUID,
+			Instances: types.NullInt{IsSet: true, Value: newInstances},
+		})
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		err = actor.waitForHealthyInstances(d, newInstances)
+		if err != nil {
+			return err
+		}
+
+		// The old application keeps at least one instance until its routes
+		// are unbound.
+		if oldInstances > 1 {
+			oldInstances--
+			log.Debugf("scaling old application to %d instances", oldInstances)
+			_, warnings, err = actor.V2Actor.UpdateApplication(v2action.Application{
+				GUID:      d.config.CurrentApplication.GUID,
+				Instances: types.NullInt{IsSet: true, Value: oldInstances},
+			})
+			d.warningsStream <- Warnings(warnings)
+			d.oldInstancesScaled = true
+			if err != nil {
+				return err
+			}
+		}
+
+		d.eventStream <- InstanceReplaced
+	}
+
+	return nil
+}
+
+// unbindOldApplicationRoutes unbinds the routes of the old application, which
+// leaves the new application serving all of the traffic.
+func (actor Actor) unbindOldApplicationRoutes(d *deployment) error {
+	for _, route := range d.config.CurrentRoutes {
+		log.Debugf("unbinding route %s from old application", route)
+		warnings, err := actor.V2Actor.UnbindRouteFromApplication(route.GUID, d.config.CurrentApplication.GUID)
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+		d.movedRoutes = append(d.movedRoutes, route)
+	}
+
+	d.eventStream <- RoutesMoved
+	return nil
+}
+
+// renameOldApplication moves the old application out of the way so that the
+// new application can take over its name.
+func (actor Actor) renameOldApplication(d *deployment) error {
+	oldName := d.config.DesiredApplication.Name + OldApplicationSuffix
+	log.Infoln("renaming old application to", oldName)
+	_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+		GUID: d.config.CurrentApplication.GUID,
+		Name: oldName,
+	})
+	d.warningsStream <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+	d.oldAppRenamed = true
+	return nil
+}
+
+func (actor Actor) renameNewApplication(d *deployment) error {
+	log.Infoln("renaming new application to", d.config.DesiredApplication.Name)
+	_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+		GUID: d.newApp.GUID,
+		Name: d.config.DesiredApplication.Name,
+	})
+	d.warningsStream <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	d.eventStream <- NewApplicationRenamed
+	return nil
+}
+
+func (actor Actor) deleteOldApplication(d *deployment) error {
+	log.Infoln("deleting old application", d.config.CurrentApplication.GUID)
+	warnings, err := actor.V2Actor.DeleteApplication(d.config.CurrentApplication.GUID)
+	d.warningsStream <- Warnings(warnings)
+	if err != nil {
+		return err
+	}
+
+	d.eventStream <- OldApplicationDeleted
+	return nil
+}
+
+// rollback restores the routes, instances and name of the old application
+// and deletes the new application. The original error is returned unless the
+// rollback itself fails.
+func (actor Actor) rollback(d *deployment, deployErr error) error {
+	d.eventStream <- RollingBack
+
+	var rollbackErr error
+	for _, route := range d.movedRoutes {
+		log.Debugf("rebinding route %s to old application", route)
+		warnings, err := actor.V2Actor.BindRouteToApplication(route.GUID, d.config.CurrentApplication.GUID)
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			log.Errorln("rebinding route:", err)
+			rollbackErr = err
+		}
+	}
+
+	if d.oldInstancesScaled {
+		log.Debug("restoring old application instances")
+		_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+			GUID:      d.config.CurrentApplication.GUID,
+			Instances: d.config.CurrentApplication.Instances,
+		})
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			log.Errorln("restoring instances:", err)
+			rollbackErr = err
+		}
+	}
+
+	if d.newApp.GUID != "" {
+		log.Debug("deleting new application")
+		warnings, err := actor.V2Actor.DeleteApplication(d.newApp.GUID)
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			log.Errorln("deleting new application:", err)
+			rollbackErr = err
+		}
+	}
+
+	if d.oldAppRenamed {
+		log.Debug("restoring old application name")
+		_, warnings, err := actor.V2Actor.UpdateApplication(v2action.Application{
+			GUID: d.config.CurrentApplication.GUID,
+			Name: d.config.DesiredApplication.Name,
+		})
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			log.Errorln("restoring old application name:", err)
+			rollbackErr = err
+		}
+	}
+
+	if rollbackErr != nil {
+		return RollbackFailedError{
+			AppName:     d.config.DesiredApplication.Name,
+			Err:         deployErr,
+			RollbackErr: rollbackErr,
+		}
+	}
+
+	d.eventStream <- RolledBack
+	return deployErr
+}
+
+// waitForHealthyInstances polls the new application until it has staged and
+// the given number of instances are running.
+func (actor Actor) waitForHealthyInstances(d *deployment, instances int) error {
+	app := d.newApp
+
+	stagingTimeout := time.Now().Add(actor.Config.StagingTimeout())
+	for {
+		currentApp, warnings, err := actor.V2Actor.GetApplication(app.GUID)
+		d.warningsStream <- Warnings(warnings)
+		if err != nil {
+			return err
+		}
+
+		if currentApp.StagingCompleted() {
+			break
+		}
+		if currentApp.StagingFailed() {
+			if currentApp.StagingFailedNoAppDetected() {
+				return v2action.StagingFailedNoAppDetectedError{Reason: currentApp.StagingFailedMessage()}
+			}
+			return v2action.StagingFailedError{Reason: currentApp.StagingFailedMessage()}
+		}
+		if time.Now().After(stagingTimeout) {
+			return v2action.StagingTimeoutError{Name: app.Name, Timeout: actor.Config.StagingTimeout()}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+
+	startupTimeout := time.Now().Add(actor.Config.StartupTimeout())
+	for {
+		currentInstances, warnings, err := actor.V2Actor.GetApplicationInstancesByApplication(app.GUID)
+		d.warningsStream <- Warnings(warnings)
+		if _, ok := err.(v2action.ApplicationInstancesNotFoundError); !ok && err != nil {
+			return err
+		}
+
+		var running int
+		for _, instance := range currentInstances {
+			switch {
+			case instance.Running():
+				running++
+			case instance.Crashed():
+				return v2action.ApplicationInstanceCrashedError{Name: app.Name}
+			case instance.Flapping():
+				return v2action.ApplicationInstanceFlappingError{Name: app.Name}
+			}
+		}
+
+		if running >= instances {
+			return nil
+		}
+		if time.Now().After(startupTimeout) {
+			return v2action.StartupTimeoutError{Name: app.Name}
+		}
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
+// instanceCount returns the number of instances of the application, which
+// defaults to one when unset.
+func instanceCount(app v2action.Application) int {
+	if !app.Instances.IsSet {
+		return 1
+	}
+	return app.Instances.Value
+}
@@ -0,0 +1,585 @@
+package pushaction_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/pushactionfakes"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Apply with a deployment strategy", func() {
+	var (
+		actor          *Actor
+		fakeV2Actor    *pushactionfakes.FakeV2Actor
+		fakeConfig     *pushactionfakes.FakeConfig
+		config         ApplicationConfig
+		runningMap     map[int]v2action.ApplicationInstance
+		receivedEvents []Event
+		receivedWarns  Warnings
+		receivedErr    error
+	)
+
+	runningInstances := func(count int) map[int]v2action.ApplicationInstance {
+		instances := map[int]v2action.ApplicationInstance{}
+		for i := 0; i < count; i++ {
+			instances[i] = v2action.ApplicationInstance{State: ccv2.ApplicationInstanceRunning}
+		}
+		return instances
+	}
+
+	BeforeEach(func() {
+		fakeV2Actor = new(pushactionfakes.FakeV2Actor)
+		fakeConfig = new(pushactionfakes.FakeConfig)
+		fakeConfig.StagingTimeoutReturns(time.Minute)
+		fakeConfig.StartupTimeoutReturns(time.Minute)
+		actor = NewActor(fakeV2Actor, fakeConfig)
+
+		config = ApplicationConfig{
+			CurrentApplication: v2action.Application{
+				GUID:      "old-app-guid",
+				Name:      "some-app-name",
+				SpaceGUID: "some-space-guid",
+				Instances: types.NullInt{IsSet: true, Value: 2},
+				State:     ccv2.ApplicationStarted,
+			},
+			DesiredApplication: v2action.Application{
+				GUID:      "old-app-guid",
+				Name:      "some-app-name",
+				SpaceGUID: "some-space-guid",
+				Buildpack: types.FilteredString{IsSet: true, Value: "ruby"},
+				Instances: types.NullInt{IsSet: true, Value: 3},
+				State:     ccv2.ApplicationStarted,
+			},
+			CurrentRoutes: []v2action.Route{{GUID: "old-route-guid", Host: "old-host"}},
+			DesiredRoutes: []v2action.Route{{Host: "new-host"}},
+			DesiredServices: map[string]v2action.ServiceInstance{
+				"some-service": {Name: "some-service", GUID: "some-service-guid"},
+			},
+			Strategy: BlueGreenStrategy,
+		}
+
+		fakeV2Actor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+			return v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: name}
+		}
+		fakeV2Actor.CreateApplicationReturns(
+			v2action.Application{GUID: "new-app-guid", Name: "some-app-name-new"},
+			v2action.Warnings{"create-warning"},
+			nil,
+		)
+		fakeV2Actor.GetApplicationReturns(v2action.Application{PackageState: ccv2.ApplicationPackageStaged}, nil, nil)
+		runningMap = runningInstances(3)
+		fakeV2Actor.GetApplicationInstancesByApplicationStub = func(string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error) {
+			return runningMap, nil, nil
+		}
+		fakeV2Actor.CreateRouteReturns(v2action.Route{GUID: "new-route-guid", Host: "new-host"}, nil, nil)
+		fakeV2Actor.DeleteApplicationReturns(v2action.Warnings{"delete-warning"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		eventStream, warningsStream, errorStream := actor.Apply(config)
+
+		receivedEvents = nil
+		receivedWarns = nil
+		receivedErr = nil
+		for eventStream != nil || warningsStream != nil || errorStream != nil {
+			select {
+			case event, ok := <-eventStream:
+				if !ok {
+					eventStream = nil
+					continue
+				}
+				receivedEvents = append(receivedEvents, event)
+			case warnings, ok := <-warningsStream:
+				if !ok {
+					warningsStream = nil
+					continue
+				}
+				receivedWarns = append(receivedWarns, warnings...)
+			case err, ok := <-errorStream:
+				if !ok {
+					errorStream = nil
+					continue
+				}
+				receivedErr = err
+			}
+		}
+	})
+
+	Context("when the application does not exist", func() {
+		BeforeEach(func() {
+			config.CurrentApplication = v2action.Application{}
+			config.CurrentRoutes = nil
+			config.DesiredApplication.GUID = ""
+			fakeV2Actor.CreateApplicationReturns(v2action.Application{GUID: "some-app-guid"}, nil, nil)
+		})
+
+		It("creates the application in place", func() {
+			Expect(receivedErr).ToNot(HaveOccurred())
+			Expect(receivedEvents).To(ContainElement(ApplicationCreated))
+			Expect(receivedEvents).ToNot(ContainElement(NewApplicationCreated))
+
+			Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.CreateApplicationArgsForCall(0).Name).To(Equal("some-app-name"))
+			Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when using the blue-green strategy", func() {
+		It("replaces the old application with a new one", func() {
+			Expect(receivedErr).ToNot(HaveOccurred())
+			Expect(receivedWarns).To(ConsistOf("create-warning", "delete-warning"))
+			Expect(receivedEvents).To(Equal([]Event{
+				NewApplicationCreated,
+				ServiceBound,
+				StartingNewApplication,
+				NewApplicationHealthy,
+				RoutesMoved,
+				NewApplicationRenamed,
+				OldApplicationDeleted,
+				Complete,
+			}))
+
+			Expect(fakeV2Actor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+			name, spaceGUID := fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(name).To(Equal("some-app-name-new"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			name, spaceGUID = fakeV2Actor.GetApplicationByNameAndSpaceArgsForCall(1)
+			Expect(name).To(Equal("some-app-name-old"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.CreateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				Name:      "some-app-name-new",
+				SpaceGUID: "some-space-guid",
+				Buildpack: types.FilteredString{IsSet: true, Value: "ruby"},
+				Instances: types.NullInt{IsSet: true, Value: 3},
+			}))
+
+			Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(1))
+			appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+			Expect(appGUID).To(Equal("new-app-guid"))
+			Expect(serviceInstanceGUID).To(Equal("some-service-guid"))
+
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(3))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(0)).To(Equal(v2action.Application{
+				GUID:  "new-app-guid",
+				State: ccv2.ApplicationStarted,
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID: "old-app-guid",
+				Name: "some-app-name-old",
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(2)).To(Equal(v2action.Application{
+				GUID: "new-app-guid",
+				Name: "some-app-name",
+			}))
+
+			Expect(fakeV2Actor.CreateRouteCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(2))
+			routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("new-route-guid"))
+			Expect(appGUID).To(Equal("new-app-guid"))
+			routeGUID, appGUID = fakeV2Actor.BindRouteToApplicationArgsForCall(1)
+			Expect(routeGUID).To(Equal("old-route-guid"))
+			Expect(appGUID).To(Equal("new-app-guid"))
+
+			Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+			routeGUID, appGUID = fakeV2Actor.UnbindRouteFromApplicationArgsForCall(0)
+			Expect(routeGUID).To(Equal("old-route-guid"))
+			Expect(appGUID).To(Equal("old-app-guid"))
+
+			Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+			Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("old-app-guid"))
+		})
+
+		Context("when an application already has one of the temporary names", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+					if name == "some-app-name-old" {
+						return v2action.Application{GUID: "leftover-app-guid", Name: name}, v2action.Warnings{"get-app-warning"}, nil
+					}
+					return v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: name}
+				}
+			})
+
+			It("returns a TemporaryApplicationExistsError without changing anything", func() {
+				Expect(receivedErr).To(MatchError(TemporaryApplicationExistsError{Name: "some-app-name-old"}))
+				Expect(receivedWarns).To(ConsistOf("get-app-warning"))
+				Expect(receivedEvents).ToNot(ContainElement(RollingBack))
+
+				Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when looking up the temporary names fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("get app failed")
+				fakeV2Actor.GetApplicationByNameAndSpaceStub = nil
+				fakeV2Actor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, expectedErr)
+			})
+
+			It("returns the error without creating the new application", func() {
+				Expect(receivedErr).To(MatchError(expectedErr))
+				Expect(fakeV2Actor.CreateApplicationCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the old application has services that are not in the manifest", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetServiceBindingsByApplicationReturns(
+					[]v2action.ServiceBinding{
+						{GUID: "binding-guid-1", ServiceInstanceGUID: "some-service-guid"},
+						{GUID: "binding-guid-2", ServiceInstanceGUID: "other-service-guid"},
+					},
+					v2action.Warnings{"bindings-warning"},
+					nil,
+				)
+			})
+
+			It("binds every service of the old application to the new application", func() {
+				Expect(receivedErr).ToNot(HaveOccurred())
+				Expect(receivedWarns).To(ContainElement("bindings-warning"))
+
+				Expect(fakeV2Actor.GetServiceBindingsByApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.GetServiceBindingsByApplicationArgsForCall(0)).To(Equal("old-app-guid"))
+
+				Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(2))
+				appGUID, serviceInstanceGUID := fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(0)
+				Expect(appGUID).To(Equal("new-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("some-service-guid"))
+				appGUID, serviceInstanceGUID = fakeV2Actor.BindServiceByApplicationAndServiceInstanceArgsForCall(1)
+				Expect(appGUID).To(Equal("new-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("other-service-guid"))
+			})
+		})
+
+		Context("when getting the old application's service bindings fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("bindings failed")
+				fakeV2Actor.GetServiceBindingsByApplicationReturns(nil, nil, expectedErr)
+			})
+
+			It("rolls back and returns the error", func() {
+				Expect(receivedErr).To(MatchError(expectedErr))
+				Expect(fakeV2Actor.BindServiceByApplicationAndServiceInstanceCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+			})
+		})
+
+		Context("when no-route is set", func() {
+			BeforeEach(func() {
+				config.NoRoute = true
+				config.DesiredRoutes = nil
+			})
+
+			It("does not move the old routes to the new application", func() {
+				Expect(receivedErr).ToNot(HaveOccurred())
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.UnbindRouteFromApplicationCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the application has bits to upload", func() {
+			BeforeEach(func() {
+				config.Path = "some-path"
+				config.AllResources = []v2action.Resource{{Filename: "some-file", SHA1: "some-sha"}}
+				config.UnmatchedResources = config.AllResources
+				fakeV2Actor.ZipResourcesReturns("some-zip-path", nil)
+			})
+
+			It("uploads the bits to the new application before starting it", func() {
+				Expect(receivedErr).ToNot(HaveOccurred())
+				Expect(receivedEvents).To(ContainElement(UploadComplete))
+
+				Expect(fakeV2Actor.UploadApplicationCallCount()).To(Equal(1))
+				appGUID, _, zipPath := fakeV2Actor.UploadApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("new-app-guid"))
+				Expect(zipPath).To(Equal("some-zip-path"))
+			})
+
+			Context("when the upload fails", func() {
+				BeforeEach(func() {
+					fakeV2Actor.UploadApplicationReturns(nil, errors.New("upload failed"))
+				})
+
+				It("deletes the new application and returns the error", func() {
+					Expect(receivedErr).To(MatchError("upload failed"))
+					Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+					Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+				})
+			})
+		})
+
+		Context("when the new application fails to stage", func() {
+			BeforeEach(func() {
+				fakeV2Actor.GetApplicationReturns(v2action.Application{
+					PackageState:        ccv2.ApplicationPackageFailed,
+					StagingFailedReason: "OhNoes",
+				}, nil, nil)
+			})
+
+			It("deletes the new application and returns the staging error", func() {
+				Expect(receivedErr).To(MatchError(v2action.StagingFailedError{Reason: "OhNoes"}))
+				Expect(receivedEvents).To(Equal([]Event{
+					NewApplicationCreated,
+					ServiceBound,
+					StartingNewApplication,
+					RollingBack,
+					RolledBack,
+				}))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(0))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+			})
+		})
+
+		Context("when an instance of the new application crashes", func() {
+			BeforeEach(func() {
+				runningMap = map[int]v2action.ApplicationInstance{
+					0: {State: ccv2.ApplicationInstanceRunning},
+					1: {State: ccv2.ApplicationInstanceCrashed},
+				}
+			})
+
+			It("rolls back and returns the crash error", func() {
+				Expect(receivedErr).To(MatchError(v2action.ApplicationInstanceCrashedError{Name: "some-app-name-new"}))
+				Expect(receivedEvents).To(ContainElement(RolledBack))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+			})
+		})
+
+		Context("when the new application does not start in time", func() {
+			BeforeEach(func() {
+				fakeConfig.StartupTimeoutReturns(0)
+				runningMap = runningInstances(1)
+			})
+
+			It("rolls back and returns a StartupTimeoutError", func() {
+				Expect(receivedErr).To(MatchError(v2action.StartupTimeoutError{Name: "some-app-name-new"}))
+				Expect(receivedEvents).To(ContainElement(RolledBack))
+			})
+		})
+
+		Context("when deleting the old application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete failed")
+				fakeV2Actor.DeleteApplicationReturnsOnCall(0, v2action.Warnings{"delete-warning"}, expectedErr)
+			})
+
+			It("moves the routes and the name back to the old application and deletes the new one", func() {
+				Expect(receivedErr).To(MatchError(expectedErr))
+				Expect(receivedEvents).To(ContainElement(RoutesMoved))
+				Expect(receivedEvents).ToNot(ContainElement(OldApplicationDeleted))
+				Expect(receivedEvents[len(receivedEvents)-1]).To(Equal(RolledBack))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(3))
+				routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(2)
+				Expect(routeGUID).To(Equal("old-route-guid"))
+				Expect(appGUID).To(Equal("old-app-guid"))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(2))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(1)).To(Equal("new-app-guid"))
+
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(4))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(3)).To(Equal(v2action.Application{
+					GUID: "old-app-guid",
+					Name: "some-app-name",
+				}))
+			})
+		})
+
+		Context("when renaming the old application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("rename failed")
+				fakeV2Actor.UpdateApplicationReturnsOnCall(1, v2action.Application{}, nil, expectedErr)
+			})
+
+			It("rolls back without renaming the old application again", func() {
+				Expect(receivedErr).To(MatchError(expectedErr))
+				Expect(receivedEvents).ToNot(ContainElement(NewApplicationRenamed))
+				Expect(receivedEvents[len(receivedEvents)-1]).To(Equal(RolledBack))
+
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(2))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+			})
+		})
+
+		Context("when the rollback fails", func() {
+			var (
+				deployErr   error
+				rollbackErr error
+			)
+
+			BeforeEach(func() {
+				deployErr = errors.New("bind failed")
+				rollbackErr = errors.New("delete failed")
+				fakeV2Actor.BindServiceByApplicationAndServiceInstanceReturns(nil, deployErr)
+				fakeV2Actor.DeleteApplicationReturns(nil, rollbackErr)
+			})
+
+			It("returns a RollbackFailedError", func() {
+				Expect(receivedErr).To(MatchError(RollbackFailedError{
+					AppName:     "some-app-name",
+					Err:         deployErr,
+					RollbackErr: rollbackErr,
+				}))
+				Expect(receivedEvents).To(ContainElement(RollingBack))
+				Expect(receivedEvents).ToNot(ContainElement(RolledBack))
+			})
+		})
+
+		Context("when renaming the new application fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("rename failed")
+				fakeV2Actor.UpdateApplicationReturnsOnCall(2, v2action.Application{}, nil, expectedErr)
+			})
+
+			It("keeps the old application, restores its routes and name, and deletes the new application", func() {
+				Expect(receivedErr).To(MatchError(expectedErr))
+				Expect(receivedEvents).ToNot(ContainElement(NewApplicationRenamed))
+				Expect(receivedEvents).ToNot(ContainElement(OldApplicationDeleted))
+				Expect(receivedEvents[len(receivedEvents)-1]).To(Equal(RolledBack))
+
+				Expect(fakeV2Actor.BindRouteToApplicationCallCount()).To(Equal(3))
+				routeGUID, appGUID := fakeV2Actor.BindRouteToApplicationArgsForCall(2)
+				Expect(routeGUID).To(Equal("old-route-guid"))
+				Expect(appGUID).To(Equal("old-app-guid"))
+
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(4))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(3)).To(Equal(v2action.Application{
+					GUID: "old-app-guid",
+					Name: "some-app-name",
+				}))
+			})
+		})
+	})
+
+	Context("when using the rolling strategy", func() {
+		BeforeEach(func() {
+			config.Strategy = RollingStrategy
+		})
+
+		It("replaces the old instances one at a time", func() {
+			Expect(receivedErr).ToNot(HaveOccurred())
+			Expect(receivedEvents).To(Equal([]Event{
+				NewApplicationCreated,
+				ServiceBound,
+				StartingNewApplication,
+				NewApplicationHealthy,
+				InstanceReplaced,
+				InstanceReplaced,
+				RoutesMoved,
+				NewApplicationRenamed,
+				OldApplicationDeleted,
+				Complete,
+			}))
+
+			Expect(fakeV2Actor.CreateApplicationArgsForCall(0).Instances).To(Equal(types.NullInt{IsSet: true, Value: 1}))
+
+			Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(6))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(1)).To(Equal(v2action.Application{
+				GUID:      "new-app-guid",
+				Instances: types.NullInt{IsSet: true, Value: 2},
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(2)).To(Equal(v2action.Application{
+				GUID:      "old-app-guid",
+				Instances: types.NullInt{IsSet: true, Value: 1},
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(3)).To(Equal(v2action.Application{
+				GUID:      "new-app-guid",
+				Instances: types.NullInt{IsSet: true, Value: 3},
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(4)).To(Equal(v2action.Application{
+				GUID: "old-app-guid",
+				Name: "some-app-name-old",
+			}))
+			Expect(fakeV2Actor.UpdateApplicationArgsForCall(5)).To(Equal(v2action.Application{
+				GUID: "new-app-guid",
+				Name: "some-app-name",
+			}))
+		})
+
+		Context("when the old application is scaled down", func() {
+			var calls []string
+
+			BeforeEach(func() {
+				calls = nil
+				fakeV2Actor.BindRouteToApplicationStub = func(routeGUID string, appGUID string) (v2action.Warnings, error) {
+					calls = append(calls, "bind "+routeGUID+" to "+appGUID)
+					return nil, nil
+				}
+				fakeV2Actor.UpdateApplicationStub = func(app v2action.Application) (v2action.Application, v2action.Warnings, error) {
+					if app.GUID == "old-app-guid" && app.Instances.IsSet {
+						calls = append(calls, "scale old-app-guid")
+					}
+					return app, nil, nil
+				}
+				fakeV2Actor.UnbindRouteFromApplicationStub = func(routeGUID string, appGUID string) (v2action.Warnings, error) {
+					calls = append(calls, "unbind "+routeGUID+" from "+appGUID)
+					return nil, nil
+				}
+			})
+
+			It("binds the routes to the new application first and unbinds them from the old application last", func() {
+				Expect(receivedErr).ToNot(HaveOccurred())
+				Expect(calls).To(Equal([]string{
+					"bind new-route-guid to new-app-guid",
+					"bind old-route-guid to new-app-guid",
+					"scale old-app-guid",
+					"unbind old-route-guid from old-app-guid",
+				}))
+			})
+		})
+
+		Context("when a replacement instance crashes", func() {
+			BeforeEach(func() {
+				calls := 0
+				fakeV2Actor.GetApplicationInstancesByApplicationStub = func(string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error) {
+					calls++
+					if calls < 3 {
+						return runningInstances(calls), nil, nil
+					}
+					return map[int]v2action.ApplicationInstance{0: {State: ccv2.ApplicationInstanceCrashed}}, nil, nil
+				}
+			})
+
+			It("restores the old application's instances", func() {
+				Expect(receivedErr).To(MatchError(v2action.ApplicationInstanceCrashedError{Name: "some-app-name-new"}))
+
+				Expect(fakeV2Actor.UpdateApplicationCallCount()).To(Equal(5))
+				Expect(fakeV2Actor.UpdateApplicationArgsForCall(4)).To(Equal(v2action.Application{
+					GUID:      "old-app-guid",
+					Instances: types.NullInt{IsSet: true, Value: 2},
+				}))
+				Expect(fakeV2Actor.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeV2Actor.DeleteApplicationArgsForCall(0)).To(Equal("new-app-guid"))
+			})
+		})
+	})
+})
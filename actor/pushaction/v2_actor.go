@@ -6,12 +6,46 @@ import "code.cloudfoundry.org/cli/actor/v2action"
 
 type V2Actor interface {
 	BindRouteToApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	BindSecurityGroupToSpace(securityGroupGUID string, spaceGUID string) (v2action.Warnings, error)
+	BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.Warnings, error)
 	CheckRoute(route v2action.Route) (bool, v2action.Warnings, error)
 	CreateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
 	CreateRoute(route v2action.Route, generatePort bool) (v2action.Route, v2action.Warnings, error)
+	CreateServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	CreateUserProvidedServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	DeleteApplication(guid string) (v2action.Warnings, error)
+	DeleteRoute(routeGUID string) (v2action.Warnings, error)
+	DeleteServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.Warnings, error)
+	GatherArchiveResources(archivePath string) ([]v2action.Resource, error)
+	GatherDirectoryResources(sourceDir string, ignoreFile string) ([]v2action.Resource, error)
+	GetApplication(guid string) (v2action.Application, v2action.Warnings, error)
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationInstancesByApplication(guid string) (map[int]v2action.ApplicationInstance, v2action.Warnings, error)
 	GetApplicationRoutes(applicationGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
 	GetOrganizationDomains(orgGUID string) ([]v2action.Domain, v2action.Warnings, error)
-	GetRouteByHostAndDomain(host string, domainGUID string) (v2action.Route, v2action.Warnings, error)
+	GetOrphanedRoutesBySpace(spaceGUID string) ([]v2action.Route, v2action.Warnings, error)
+	GetRouteByComponents(route v2action.Route) (v2action.Route, v2action.Warnings, error)
+	GetSecurityGroupByName(securityGroupName string) (v2action.SecurityGroup, v2action.Warnings, error)
+	GetServiceBindingByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (v2action.ServiceBinding, v2action.Warnings, error)
+	GetServiceBindingsByApplication(appGUID string) ([]v2action.ServiceBinding, v2action.Warnings, error)
+	GetServiceInstanceByNameAndSpace(name string, spaceGUID string) (v2action.ServiceInstance, v2action.Warnings, error)
+	GetServiceInstancesBySpace(spaceGUID string) ([]v2action.ServiceInstance, v2action.Warnings, error)
+	GetServicePlanByNameServiceAndSpace(planName string, serviceName string, spaceGUID string) (v2action.ServicePlan, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+	GetSpaceQuota(guid string) (v2action.SpaceQuota, v2action.Warnings, error)
+	GetSpaceQuotaByName(orgGUID string, name string) (v2action.SpaceQuota, v2action.Warnings, error)
+	GetSpaceRunningSecurityGroupsBySpace(spaceGUID string) ([]v2action.SecurityGroup, v2action.Warnings, error)
+	GetStackByName(stackName string) (v2action.Stack, v2action.Warnings, error)
+	IsArchive(path string) bool
+	ResourceMatch(allResources []v2action.Resource) ([]v2action.Resource, []v2action.Resource, v2action.Warnings, error)
+	SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (v2action.Warnings, error)
+	UnbindRouteFromApplication(routeGUID string, appGUID string) (v2action.Warnings, error)
+	UnbindSecurityGroupByNameAndSpace(securityGroupName string, spaceGUID string) (v2action.Warnings, error)
 	UpdateApplication(application v2action.Application) (v2action.Application, v2action.Warnings, error)
+	UpdateServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	UpdateUserProvidedServiceInstance(serviceInstance v2action.ServiceInstance) (v2action.ServiceInstance, v2action.Warnings, error)
+	UploadApplication(appGUID string, existingResources []v2action.Resource, zipPath string) (v2action.Warnings, error)
+	ZipArchiveResources(sourceArchivePath string, filesToInclude []v2action.Resource) (string, error)
+	ZipResources(sourceDir string, filesToInclude []v2action.Resource) (string, error)
 }
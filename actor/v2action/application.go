@@ -2,10 +2,12 @@ package v2action
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util/glob"
 )
 
 // Application represents an application.
@@ -13,8 +15,8 @@ type Application ccv2.Application
 
 // CalculatedBuildpack returns the buildpack that will be used.
 func (application Application) CalculatedBuildpack() string {
-	if application.Buildpack != "" {
-		return application.Buildpack
+	if application.Buildpack.IsSet && application.Buildpack.Value != "" {
+		return application.Buildpack.Value
 	}
 
 	return application.DetectedBuildpack
@@ -148,6 +150,17 @@ func (actor Actor) CreateApplication(application Application) (Application, Warn
 	return Application(app), Warnings(warnings), err
 }
 
+// DeleteApplication deletes the application with the given GUID.
+func (actor Actor) DeleteApplication(guid string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteApplication(guid)
+
+	if _, ok := err.(ccerror.ResourceNotFoundError); ok {
+		return Warnings(warnings), ApplicationNotFoundError{GUID: guid}
+	}
+
+	return Warnings(warnings), err
+}
+
 // GetApplication returns the application
 func (actor Actor) GetApplication(guid string) (Application, Warnings, error) {
 	app, warnings, err := actor.CloudControllerClient.GetApplication(guid)
@@ -181,6 +194,62 @@ func (actor Actor) GetApplicationsBySpace(spaceGUID string) ([]Application, Warn
 	return apps, Warnings(warnings), nil
 }
 
+// GetApplicationsByNamePatternsAndSpace returns the applications in the space
+// whose names match any of the patterns, in the order of the patterns. A
+// pattern is either an application name or a glob, such as "web-*". An
+// ApplicationNotFoundError is returned for a pattern that matches nothing.
+func (actor Actor) GetApplicationsByNamePatternsAndSpace(patterns []string, spaceGUID string) ([]Application, Warnings, error) {
+	var allWarnings Warnings
+	var spaceApps []Application
+	var apps []Application
+	found := map[string]bool{}
+
+	for _, pattern := range patterns {
+		if !strings.ContainsAny(pattern, "*?") {
+			app, warnings, err := actor.GetApplicationByNameAndSpace(pattern, spaceGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+			if !found[app.GUID] {
+				found[app.GUID] = true
+				apps = append(apps, app)
+			}
+			continue
+		}
+
+		appGlob, err := glob.CompileGlob(pattern)
+		if err != nil {
+			return nil, allWarnings, err
+		}
+
+		if spaceApps == nil {
+			var warnings Warnings
+			spaceApps, warnings, err = actor.GetApplicationsBySpace(spaceGUID)
+			allWarnings = append(allWarnings, warnings...)
+			if err != nil {
+				return nil, allWarnings, err
+			}
+		}
+
+		matched := false
+		for _, app := range spaceApps {
+			if appGlob.Match(app.Name) {
+				matched = true
+				if !found[app.GUID] {
+					found[app.GUID] = true
+					apps = append(apps, app)
+				}
+			}
+		}
+		if !matched {
+			return nil, allWarnings, ApplicationNotFoundError{Name: pattern}
+		}
+	}
+
+	return apps, allWarnings, nil
+}
+
 // GetApplicationByNameAndSpace returns an application with matching name in
 // the space.
 func (actor Actor) GetApplicationByNameAndSpace(name string, spaceGUID string) (Application, Warnings, error) {
@@ -256,7 +325,7 @@ func (actor Actor) StartApplication(app Application, client NOAAClient, config C
 			return
 		}
 
-		if updatedApp.Instances == 0 {
+		if updatedApp.Instances.Value == 0 {
 			return
 		}
 
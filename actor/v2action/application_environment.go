@@ -0,0 +1,201 @@
+package v2action
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"strings"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// EnvironmentGroup is where an environment variable of an application comes
+// from.
+type EnvironmentGroup string
+
+const (
+	UserProvidedEnvironmentGroup EnvironmentGroup = "user-provided"
+	RunningEnvironmentGroup      EnvironmentGroup = "running"
+	StagingEnvironmentGroup      EnvironmentGroup = "staging"
+	ServicesEnvironmentGroup     EnvironmentGroup = "services"
+)
+
+// ApplicationEnvironment is the environment of an application that can be
+// compared with another application's.
+type ApplicationEnvironment struct {
+	UserProvided map[string]interface{}
+	Running      map[string]interface{}
+	Staging      map[string]interface{}
+
+	// ServiceBindings maps the name of each service instance bound to the
+	// application to the label of its service, as listed in VCAP_SERVICES.
+	ServiceBindings map[string]interface{}
+}
+
+// EnvironmentDifference is an environment variable, or service binding, that
+// is set differently in two application environments.
+type EnvironmentDifference struct {
+	Group EnvironmentGroup
+	Name  string
+
+	// FirstValue and SecondValue are the values in each environment, and are
+	// nil when the variable is not set.
+	FirstValue  interface{}
+	SecondValue interface{}
+}
+
+// EnvironmentVariableChanges are the names of the user provided environment
+// variables of an application that were changed, in order.
+type EnvironmentVariableChanges struct {
+	// Set are the variables that were added or given a new value.
+	Set []string
+
+	// Removed are the variables that were pruned.
+	Removed []string
+}
+
+// GetApplicationEnvironment returns the environment of the application with
+// the provided GUID.
+func (actor Actor) GetApplicationEnvironment(appGUID string) (ApplicationEnvironment, Warnings, error) {
+	ccEnvironment, warnings, err := actor.CloudControllerClient.GetApplicationEnvironment(appGUID)
+	if err != nil {
+		return ApplicationEnvironment{}, Warnings(warnings), err
+	}
+
+	return ApplicationEnvironment{
+		UserProvided:    ccEnvironment.EnvironmentVariables,
+		Running:         ccEnvironment.RunningEnvironmentVariables,
+		Staging:         ccEnvironment.StagingEnvironmentVariables,
+		ServiceBindings: serviceBindingsFromEnvironment(ccEnvironment),
+	}, Warnings(warnings), nil
+}
+
+// SetApplicationEnvironmentVariables sets the provided user provided
+// environment variables of the application with the provided GUID, leaving
+// the other variables as they are, or removing them when prune is true. All
+// the changes are made in a single update of the application, which is
+// skipped when nothing changes.
+func (actor Actor) SetApplicationEnvironmentVariables(appGUID string, variables map[string]string, prune bool) (EnvironmentVariableChanges, Warnings, error) {
+	ccEnvironment, ccWarnings, err := actor.CloudControllerClient.GetApplicationEnvironment(appGUID)
+	warnings := Warnings(ccWarnings)
+	if err != nil {
+		return EnvironmentVariableChanges{}, warnings, err
+	}
+
+	var changes EnvironmentVariableChanges
+	updated := map[string]interface{}{}
+	for name, value := range ccEnvironment.EnvironmentVariables {
+		if _, ok := variables[name]; prune && !ok {
+			changes.Removed = append(changes.Removed, name)
+			continue
+		}
+		updated[name] = value
+	}
+	for name, text := range variables {
+		current, ok := updated[name]
+		value := environmentVariableValue(current, text)
+		if ok && reflect.DeepEqual(current, value) {
+			continue
+		}
+		updated[name] = value
+		changes.Set = append(changes.Set, name)
+	}
+	sort.Strings(changes.Set)
+	sort.Strings(changes.Removed)
+
+	if len(changes.Set) == 0 && len(changes.Removed) == 0 {
+		return changes, warnings, nil
+	}
+
+	ccWarnings, err = actor.CloudControllerClient.UpdateApplicationEnvironmentVariables(appGUID, updated)
+	warnings = append(warnings, ccWarnings...)
+	if err != nil {
+		return EnvironmentVariableChanges{}, warnings, err
+	}
+	return changes, warnings, nil
+}
+
+// environmentVariableValue returns the value to set a variable to from its
+// text. When the variable currently holds a JSON value other than a string,
+// such as a number written out by export-env, the text is decoded so that the
+// variable keeps its type.
+func environmentVariableValue(current interface{}, text string) interface{} {
+	if _, isString := current.(string); current == nil || isString {
+		return text
+	}
+
+	decoder := json.NewDecoder(strings.NewReader(text))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil || decoder.More() {
+		return text
+	}
+	return value
+}
+
+// DiffApplicationEnvironments returns the variables and service bindings that
+// differ between the two environments, ordered by group and then by name.
+func DiffApplicationEnvironments(first ApplicationEnvironment, second ApplicationEnvironment) []EnvironmentDifference {
+	var differences []EnvironmentDifference
+	for _, group := range []struct {
+		group  EnvironmentGroup
+		first  map[string]interface{}
+		second map[string]interface{}
+	}{
+		{UserProvidedEnvironmentGroup, first.UserProvided, second.UserProvided},
+		{RunningEnvironmentGroup, first.Running, second.Running},
+		{StagingEnvironmentGroup, first.Staging, second.Staging},
+		{ServicesEnvironmentGroup, first.ServiceBindings, second.ServiceBindings},
+	} {
+		differences = append(differences, diffVariables(group.group, group.first, group.second)...)
+	}
+	return differences
+}
+
+func diffVariables(group EnvironmentGroup, first map[string]interface{}, second map[string]interface{}) []EnvironmentDifference {
+	names := map[string]bool{}
+	for name := range first {
+		names[name] = true
+	}
+	for name := range second {
+		names[name] = true
+	}
+
+	sortedNames := make([]string, 0, len(names))
+	for name := range names {
+		sortedNames = append(sortedNames, name)
+	}
+	sort.Strings(sortedNames)
+
+	var differences []EnvironmentDifference
+	for _, name := range sortedNames {
+		firstValue, secondValue := first[name], second[name]
+		if reflect.DeepEqual(firstValue, secondValue) {
+			continue
+		}
+		differences = append(differences, EnvironmentDifference{
+			Group:       group,
+			Name:        name,
+			FirstValue:  firstValue,
+			SecondValue: secondValue,
+		})
+	}
+	return differences
+}
+
+func serviceBindingsFromEnvironment(environment ccv2.ApplicationEnvironment) map[string]interface{} {
+	bindings := map[string]interface{}{}
+
+	services, _ := environment.SystemEnvironmentVariables["VCAP_SERVICES"].(map[string]interface{})
+	for label, instances := range services {
+		instanceList, _ := instances.([]interface{})
+		for _, instance := range instanceList {
+			instanceMap, _ := instance.(map[string]interface{})
+			if name, ok := instanceMap["name"].(string); ok {
+				bindings[name] = label
+			}
+		}
+	}
+
+	return bindings
+}
@@ -0,0 +1,246 @@
+package v2action_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Application Environment Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		Context("when the environment can be retrieved", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv2.ApplicationEnvironment{
+						EnvironmentVariables:        map[string]interface{}{"USER_VAR": "user"},
+						RunningEnvironmentVariables: map[string]interface{}{"RUNNING_VAR": "running"},
+						StagingEnvironmentVariables: map[string]interface{}{"STAGING_VAR": "staging"},
+						SystemEnvironmentVariables: map[string]interface{}{
+							"VCAP_SERVICES": map[string]interface{}{
+								"p-mysql": []interface{}{
+									map[string]interface{}{"name": "some-db", "credentials": map[string]interface{}{"password": "secret"}},
+								},
+								"user-provided": []interface{}{
+									map[string]interface{}{"name": "some-ups"},
+								},
+							},
+						},
+					},
+					ccv2.Warnings{"env-warning"},
+					nil,
+				)
+			})
+
+			It("returns the environment with the service binding names, and warnings", func() {
+				environment, warnings, err := actor.GetApplicationEnvironment("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("env-warning"))
+				Expect(environment).To(Equal(ApplicationEnvironment{
+					UserProvided:    map[string]interface{}{"USER_VAR": "user"},
+					Running:         map[string]interface{}{"RUNNING_VAR": "running"},
+					Staging:         map[string]interface{}{"STAGING_VAR": "staging"},
+					ServiceBindings: map[string]interface{}{"some-db": "p-mysql", "some-ups": "user-provided"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when retrieving the environment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"env-warning"}, errors.New("env error"))
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetApplicationEnvironment("some-app-guid")
+				Expect(err).To(MatchError("env error"))
+				Expect(warnings).To(ConsistOf("env-warning"))
+			})
+		})
+	})
+
+	Describe("SetApplicationEnvironmentVariables", func() {
+		var (
+			variables map[string]string
+			prune     bool
+
+			changes    EnvironmentVariableChanges
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			variables = map[string]string{"CHANGED": "new", "NEW": "added", "SAME": "value"}
+			prune = false
+
+			fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+				ccv2.ApplicationEnvironment{
+					EnvironmentVariables: map[string]interface{}{"CHANGED": "old", "DEBUG": true, "SAME": "value"},
+				},
+				ccv2.Warnings{"env-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(ccv2.Warnings{"update-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			changes, warnings, executeErr = actor.SetApplicationEnvironmentVariables("some-app-guid", variables, prune)
+		})
+
+		It("merges the variables into the existing ones in a single update", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("env-warning", "update-warning"))
+			Expect(changes).To(Equal(EnvironmentVariableChanges{Set: []string{"CHANGED", "NEW"}}))
+
+			Expect(fakeCloudControllerClient.GetApplicationEnvironmentArgsForCall(0)).To(Equal("some-app-guid"))
+			Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+			appGUID, updated := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(updated).To(Equal(map[string]interface{}{"CHANGED": "new", "DEBUG": true, "NEW": "added", "SAME": "value"}))
+		})
+
+		Context("when prune is true", func() {
+			BeforeEach(func() {
+				prune = true
+			})
+
+			It("removes the variables that are not provided", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal(EnvironmentVariableChanges{Set: []string{"CHANGED", "NEW"}, Removed: []string{"DEBUG"}}))
+
+				_, updated := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+				Expect(updated).To(Equal(map[string]interface{}{"CHANGED": "new", "NEW": "added", "SAME": "value"}))
+			})
+		})
+
+		Context("when nothing changes", func() {
+			BeforeEach(func() {
+				variables = map[string]string{"SAME": "value"}
+			})
+
+			It("does not update the application", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("env-warning"))
+				Expect(changes).To(Equal(EnvironmentVariableChanges{}))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the variables hold JSON values", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(
+					ccv2.ApplicationEnvironment{
+						EnvironmentVariables: map[string]interface{}{
+							"DEBUG":   true,
+							"PORT":    json.Number("8080"),
+							"OPTIONS": map[string]interface{}{"retries": json.Number("3")},
+							"WORKERS": json.Number("2"),
+						},
+					},
+					nil,
+					nil,
+				)
+				variables = map[string]string{
+					"DEBUG":   "true",
+					"PORT":    "8080",
+					"OPTIONS": `{"retries":3}`,
+					"WORKERS": "4",
+				}
+			})
+
+			It("only sets the variables whose values differ and keeps their types", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(changes).To(Equal(EnvironmentVariableChanges{Set: []string{"WORKERS"}}))
+
+				_, updated := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+				Expect(updated).To(Equal(map[string]interface{}{
+					"DEBUG":   true,
+					"PORT":    json.Number("8080"),
+					"OPTIONS": map[string]interface{}{"retries": json.Number("3")},
+					"WORKERS": json.Number("4"),
+				}))
+			})
+
+			Context("when a value is not valid JSON", func() {
+				BeforeEach(func() {
+					variables = map[string]string{"PORT": "not a number"}
+				})
+
+				It("sets the variable to the text", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(changes).To(Equal(EnvironmentVariableChanges{Set: []string{"PORT"}}))
+
+					_, updated := fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesArgsForCall(0)
+					Expect(updated).To(HaveKeyWithValue("PORT", "not a number"))
+				})
+			})
+		})
+
+		Context("when retrieving the environment fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationEnvironmentReturns(ccv2.ApplicationEnvironment{}, ccv2.Warnings{"env-warning"}, errors.New("env error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("env error"))
+				Expect(warnings).To(ConsistOf("env-warning"))
+				Expect(fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when updating the application fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UpdateApplicationEnvironmentVariablesReturns(ccv2.Warnings{"update-warning"}, errors.New("update error"))
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(executeErr).To(MatchError("update error"))
+				Expect(warnings).To(ConsistOf("env-warning", "update-warning"))
+			})
+		})
+	})
+
+	Describe("DiffApplicationEnvironments", func() {
+		It("returns the differences ordered by group and name", func() {
+			first := ApplicationEnvironment{
+				UserProvided:    map[string]interface{}{"SAME": "value", "CHANGED": "old", "REMOVED": "gone"},
+				Running:         map[string]interface{}{"GROUP_VAR": "x"},
+				ServiceBindings: map[string]interface{}{"some-db": "p-mysql", "cache": "p-redis"},
+			}
+			second := ApplicationEnvironment{
+				UserProvided:    map[string]interface{}{"SAME": "value", "CHANGED": "new", "ADDED": float64(1)},
+				Running:         map[string]interface{}{"GROUP_VAR": "x"},
+				Staging:         map[string]interface{}{"STAGING_VAR": "y"},
+				ServiceBindings: map[string]interface{}{"some-db": "p-mysql"},
+			}
+
+			Expect(DiffApplicationEnvironments(first, second)).To(Equal([]EnvironmentDifference{
+				{Group: UserProvidedEnvironmentGroup, Name: "ADDED", SecondValue: float64(1)},
+				{Group: UserProvidedEnvironmentGroup, Name: "CHANGED", FirstValue: "old", SecondValue: "new"},
+				{Group: UserProvidedEnvironmentGroup, Name: "REMOVED", FirstValue: "gone"},
+				{Group: StagingEnvironmentGroup, Name: "STAGING_VAR", SecondValue: "y"},
+				{Group: ServicesEnvironmentGroup, Name: "cache", FirstValue: "p-redis"},
+			}))
+		})
+
+		It("returns no differences for identical environments", func() {
+			environment := ApplicationEnvironment{UserProvided: map[string]interface{}{"VAR": map[string]interface{}{"nested": true}}}
+			Expect(DiffApplicationEnvironments(environment, environment)).To(BeEmpty())
+		})
+	})
+})
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
@@ -34,7 +35,7 @@ var _ = Describe("Application Actions", func() {
 		Describe("CalculatedBuildpack", func() {
 			Context("when buildpack is set", func() {
 				BeforeEach(func() {
-					app.Buildpack = "foo"
+					app.Buildpack = types.FilteredString{IsSet: true, Value: "foo"}
 					app.DetectedBuildpack = "bar"
 				})
 
@@ -262,6 +263,50 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the deletion is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, nil)
+			})
+
+			It("deletes the application and returns warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(Equal(Warnings{"delete-warning"}))
+
+				Expect(fakeCloudControllerClient.DeleteApplicationCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.DeleteApplicationArgsForCall(0)).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, ccerror.ResourceNotFoundError{})
+			})
+
+			It("returns an ApplicationNotFoundError and warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ApplicationNotFoundError{GUID: "some-app-guid"}))
+				Expect(warnings).To(Equal(Warnings{"delete-warning"}))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("delete failed")
+				fakeCloudControllerClient.DeleteApplicationReturns(ccv2.Warnings{"delete-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := actor.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(Equal(Warnings{"delete-warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
@@ -365,6 +410,74 @@ var _ = Describe("Application Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationsByNamePatternsAndSpace", func() {
+		var (
+			patterns   []string
+			apps       []Application
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetApplicationsStub = func(queries []ccv2.Query) ([]ccv2.Application, ccv2.Warnings, error) {
+				if queries[0].Filter == ccv2.NameFilter {
+					return []ccv2.Application{{GUID: "some-guid-" + queries[0].Value, Name: queries[0].Value}}, ccv2.Warnings{"name-warning"}, nil
+				}
+				return []ccv2.Application{
+					{GUID: "some-guid-web-1", Name: "web-1"},
+					{GUID: "some-guid-web-2", Name: "web-2"},
+					{GUID: "some-guid-worker", Name: "worker"},
+				}, ccv2.Warnings{"space-warning"}, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			apps, warnings, executeErr = actor.GetApplicationsByNamePatternsAndSpace(patterns, "some-space-guid")
+		})
+
+		Context("when the patterns are names and globs", func() {
+			BeforeEach(func() {
+				patterns = []string{"worker", "web-*", "web-?"}
+			})
+
+			It("returns each matching application once, in the order of the patterns", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(apps).To(Equal([]Application{
+					{GUID: "some-guid-worker", Name: "worker"},
+					{GUID: "some-guid-web-1", Name: "web-1"},
+					{GUID: "some-guid-web-2", Name: "web-2"},
+				}))
+				Expect(warnings).To(ConsistOf("name-warning", "space-warning"))
+
+				Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a glob matches no applications", func() {
+			BeforeEach(func() {
+				patterns = []string{"api-*"}
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "api-*"}))
+				Expect(warnings).To(ConsistOf("space-warning"))
+			})
+		})
+
+		Context("when an application does not exist", func() {
+			BeforeEach(func() {
+				patterns = []string{"web-*", "banana"}
+				fakeCloudControllerClient.GetApplicationsStub = nil
+				fakeCloudControllerClient.GetApplicationsReturns(nil, ccv2.Warnings{"some-warning"}, nil)
+			})
+
+			It("returns an ApplicationNotFoundError", func() {
+				Expect(executeErr).To(MatchError(ApplicationNotFoundError{Name: "web-*"}))
+				Expect(warnings).To(ConsistOf("some-warning"))
+			})
+		})
+	})
+
 	Describe("GetApplicationByNameAndSpace", func() {
 		Context("when the application exists", func() {
 			BeforeEach(func() {
@@ -516,7 +629,7 @@ var _ = Describe("Application Actions", func() {
 			app = Application{
 				GUID:      "some-app-guid",
 				Name:      "some-app",
-				Instances: 2,
+				Instances: types.NullInt{Value: 2, IsSet: true},
 			}
 
 			fakeNOAAClient = new(v2actionfakes.FakeNOAAClient)
@@ -537,7 +650,7 @@ var _ = Describe("Application Actions", func() {
 			}
 
 			fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{GUID: "some-app-guid",
-				Instances: 2,
+				Instances: types.NullInt{Value: 2, IsSet: true},
 				Name:      "some-app",
 			}, ccv2.Warnings{"update-warning"}, nil)
 
@@ -547,7 +660,7 @@ var _ = Describe("Application Actions", func() {
 					appCount += 1
 					return ccv2.Application{
 						GUID:         "some-app-guid",
-						Instances:    2,
+						Instances:    types.NullInt{Value: 2, IsSet: true},
 						Name:         "some-app",
 						PackageState: ccv2.ApplicationPackagePending,
 					}, ccv2.Warnings{"app-warnings-1"}, nil
@@ -556,7 +669,7 @@ var _ = Describe("Application Actions", func() {
 				return ccv2.Application{
 					GUID:         "some-app-guid",
 					Name:         "some-app",
-					Instances:    2,
+					Instances:    types.NullInt{Value: 2, IsSet: true},
 					PackageState: ccv2.ApplicationPackageStaged,
 				}, ccv2.Warnings{"app-warnings-2"}, nil
 			}
@@ -613,7 +726,7 @@ var _ = Describe("Application Actions", func() {
 		Context("when the app has zero instances", func() {
 			BeforeEach(func() {
 				fakeCloudControllerClient.UpdateApplicationReturns(ccv2.Application{GUID: "some-app-guid",
-					Instances: 0,
+					Instances: types.NullInt{Value: 0, IsSet: true},
 					Name:      "some-app",
 				}, ccv2.Warnings{"update-warning"}, nil)
 			})
@@ -689,7 +802,7 @@ var _ = Describe("Application Actions", func() {
 							return ccv2.Application{
 								GUID:                "some-app-guid",
 								Name:                "some-app",
-								Instances:           2,
+								Instances:           types.NullInt{Value: 2, IsSet: true},
 								PackageState:        ccv2.ApplicationPackageFailed,
 								StagingFailedReason: "NoAppDetectedError",
 							}, ccv2.Warnings{"app-warnings-1"}, nil
@@ -716,7 +829,7 @@ var _ = Describe("Application Actions", func() {
 							return ccv2.Application{
 								GUID:                "some-app-guid",
 								Name:                "some-app",
-								Instances:           2,
+								Instances:           types.NullInt{Value: 2, IsSet: true},
 								PackageState:        ccv2.ApplicationPackageFailed,
 								StagingFailedReason: "OhNoes",
 							}, ccv2.Warnings{"app-warnings-1"}, nil
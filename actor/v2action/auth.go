@@ -0,0 +1,29 @@
+package v2action
+
+import "code.cloudfoundry.org/cli/api/uaa"
+
+// Authenticate requests tokens from the UAA with the provided grant and stores
+// them in the config. The targeted organization and space are cleared. When
+// authenticating with client credentials, the credentials are also stored so
+// that the tokens can be renewed without a user.
+func (actor Actor) Authenticate(config Config, credentials map[string]string, grantType uaa.GrantType) error {
+	config.UnsetOrganizationInformation()
+	config.UnsetSpaceInformation()
+
+	accessToken, refreshToken, err := actor.UAAClient.Authenticate(credentials, grantType)
+	if err != nil {
+		config.SetTokenInformation("", "", "")
+		return err
+	}
+
+	config.SetTokenInformation(accessToken, refreshToken, "")
+
+	if grantType == uaa.GrantTypeClientCredentials {
+		config.SetUAAGrantType(string(grantType))
+		config.SetUAAClientCredentials(credentials["client_id"], credentials["client_secret"])
+	} else {
+		config.SetUAAGrantType("")
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Auth Actions", func() {
+	var (
+		actor         Actor
+		fakeUAAClient *v2actionfakes.FakeUAAClient
+		fakeConfig    *v2actionfakes.FakeConfig
+		credentials   map[string]string
+		grantType     uaa.GrantType
+		actualErr     error
+	)
+
+	BeforeEach(func() {
+		fakeUAAClient = new(v2actionfakes.FakeUAAClient)
+		fakeConfig = new(v2actionfakes.FakeConfig)
+		actor = NewActor(nil, fakeUAAClient)
+	})
+
+	JustBeforeEach(func() {
+		actualErr = actor.Authenticate(fakeConfig, credentials, grantType)
+	})
+
+	Describe("Authenticate", func() {
+		Context("when the password grant succeeds", func() {
+			BeforeEach(func() {
+				credentials = map[string]string{"username": "some-user", "password": "some-password"}
+				grantType = uaa.GrantTypePassword
+				fakeUAAClient.AuthenticateReturns("bearer some-access-token", "some-refresh-token", nil)
+			})
+
+			It("stores the tokens and clears the targeted org and space", func() {
+				Expect(actualErr).ToNot(HaveOccurred())
+
+				Expect(fakeUAAClient.AuthenticateCallCount()).To(Equal(1))
+				passedCredentials, passedGrantType := fakeUAAClient.AuthenticateArgsForCall(0)
+				Expect(passedCredentials).To(Equal(credentials))
+				Expect(passedGrantType).To(Equal(uaa.GrantTypePassword))
+
+				Expect(fakeConfig.UnsetOrganizationInformationCallCount()).To(Equal(1))
+				Expect(fakeConfig.UnsetSpaceInformationCallCount()).To(Equal(1))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(BeEmpty())
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the client credentials grant succeeds", func() {
+			BeforeEach(func() {
+				credentials = map[string]string{"client_id": "some-client", "client_secret": "some-secret"}
+				grantType = uaa.GrantTypeClientCredentials
+				fakeUAAClient.AuthenticateReturns("bearer some-access-token", "", nil)
+			})
+
+			It("stores the grant type and the client credentials", func() {
+				Expect(actualErr).ToNot(HaveOccurred())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(1))
+				Expect(fakeConfig.SetUAAGrantTypeArgsForCall(0)).To(Equal("client_credentials"))
+
+				Expect(fakeConfig.SetUAAClientCredentialsCallCount()).To(Equal(1))
+				client, clientSecret := fakeConfig.SetUAAClientCredentialsArgsForCall(0)
+				Expect(client).To(Equal("some-client"))
+				Expect(clientSecret).To(Equal("some-secret"))
+			})
+		})
+
+		Context("when authentication fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				credentials = map[string]string{"passcode": "some-passcode"}
+				grantType = uaa.GrantTypePassword
+				expectedErr = errors.New("some error")
+				fakeUAAClient.AuthenticateReturns("", "", expectedErr)
+			})
+
+			It("clears the tokens and returns the error", func() {
+				Expect(actualErr).To(MatchError(expectedErr))
+
+				Expect(fakeConfig.SetTokenInformationCallCount()).To(Equal(1))
+				accessToken, refreshToken, sshOAuthClient := fakeConfig.SetTokenInformationArgsForCall(0)
+				Expect(accessToken).To(BeEmpty())
+				Expect(refreshToken).To(BeEmpty())
+				Expect(sshOAuthClient).To(BeEmpty())
+
+				Expect(fakeConfig.SetUAAGrantTypeCallCount()).To(Equal(0))
+			})
+		})
+	})
+})
@@ -0,0 +1,158 @@
+package v2action
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// Event represents a Cloud Controller audit event.
+type Event ccv2.Event
+
+// EventFilter narrows down the events returned by GetEvents. Empty fields do
+// not filter events.
+type EventFilter struct {
+	// Actor matches the GUID or name of whoever caused the event.
+	Actor string
+
+	// Type matches the event type, such as 'audit.route.delete-request'.
+	Type string
+
+	// Target matches the GUID or name of the resource the event happened to.
+	Target string
+
+	// TargetGUID matches the GUID of the resource the event happened to.
+	TargetGUID string
+
+	SpaceGUID        string
+	OrganizationGUID string
+
+	// Since and Until limit events to those that happened in the time range.
+	Since time.Time
+	Until time.Time
+
+	// Limit is the number of most recent events to return. Zero returns every
+	// event.
+	Limit int
+}
+
+var guidRegexp = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+// Description summarizes the event's metadata as a list of 'key: value'
+// pairs, sorted by key. When the event was caused by a request, only the
+// request parameters are described.
+func (event Event) Description() string {
+	details := event.Metadata
+	if request, ok := details["request"].(map[string]interface{}); ok {
+		details = request
+	}
+
+	keys := make([]string, 0, len(details))
+	for key := range details {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, fmt.Sprintf("%s: %s", key, describeValue(details[key])))
+	}
+	return strings.Join(pairs, ", ")
+}
+
+func describeValue(value interface{}) string {
+	if str, ok := value.(string); ok {
+		return str
+	}
+
+	raw, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	return string(raw)
+}
+
+// GetEvents returns the events that match the filter, newest first. The
+// type, target GUID, space, organization, time range and any actor or target
+// given as a GUID are filtered by the Cloud Controller. Actor and target names
+// are matched afterwards, since the Cloud Controller cannot filter on them, so
+// every event is requested and the limit is applied after matching.
+func (actor Actor) GetEvents(filter EventFilter) ([]Event, Warnings, error) {
+	actorName, actorGUID := splitGUIDOrName(filter.Actor)
+	targetName, targetGUID := splitGUIDOrName(filter.Target)
+	if targetGUID == "" {
+		targetGUID = filter.TargetGUID
+	}
+
+	var queries []ccv2.Query
+	for _, query := range []struct {
+		filter   ccv2.QueryFilter
+		operator ccv2.QueryOperator
+		value    string
+	}{
+		{ccv2.TypeFilter, ccv2.EqualOperator, filter.Type},
+		{ccv2.ActorFilter, ccv2.EqualOperator, actorGUID},
+		{ccv2.ActeeFilter, ccv2.EqualOperator, targetGUID},
+		{ccv2.SpaceGUIDFilter, ccv2.EqualOperator, filter.SpaceGUID},
+		{ccv2.OrganizationGUIDFilter, ccv2.EqualOperator, filter.OrganizationGUID},
+		{ccv2.TimestampFilter, ccv2.GreaterThanOrEqualOperator, formatEventTime(filter.Since)},
+		{ccv2.TimestampFilter, ccv2.LessThanOrEqualOperator, formatEventTime(filter.Until)},
+	} {
+		if query.value != "" {
+			queries = append(queries, ccv2.Query{
+				Filter:   query.filter,
+				Operator: query.operator,
+				Value:    query.value,
+			})
+		}
+	}
+
+	limit := filter.Limit
+	if actorName != "" || targetName != "" {
+		limit = 0
+	}
+
+	ccEvents, warnings, err := actor.CloudControllerClient.GetEvents(limit, queries)
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var events []Event
+	for _, ccEvent := range ccEvents {
+		if filter.Limit > 0 && len(events) == filter.Limit {
+			break
+		}
+		if !matchesName(actorName, ccEvent.ActorName) ||
+			!matchesName(targetName, ccEvent.ActeeName) {
+			continue
+		}
+		events = append(events, Event(ccEvent))
+	}
+
+	return events, Warnings(warnings), nil
+}
+
+func formatEventTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+// splitGUIDOrName returns value as a name when it is not a GUID, and as a
+// GUID when it is.
+func splitGUIDOrName(value string) (string, string) {
+	if guidRegexp.MatchString(value) {
+		return "", value
+	}
+	return value, ""
+}
+
+func matchesName(value string, name string) bool {
+	return value == "" || value == name
+}
@@ -0,0 +1,214 @@
+package v2action_test
+
+import (
+	"errors"
+	"time"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Event Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetEvents", func() {
+		var (
+			filter   EventFilter
+			events   []Event
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			filter = EventFilter{}
+			fakeCloudControllerClient.GetEventsReturns(
+				[]ccv2.Event{
+					{GUID: "event-3", ActorGUID: "admin-guid", ActorName: "admin", ActeeGUID: "route-2-guid", ActeeName: "route-2"},
+					{GUID: "event-2", ActorGUID: "dev-guid", ActorName: "dev", ActeeGUID: "route-2-guid", ActeeName: "route-2"},
+					{GUID: "event-1", ActorGUID: "admin-guid", ActorName: "admin", ActeeGUID: "route-1-guid", ActeeName: "route-1"},
+				},
+				ccv2.Warnings{"events-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			events, warnings, err = actor.GetEvents(filter)
+		})
+
+		Context("when no filter is set", func() {
+			It("returns every event and the warnings", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("events-warning"))
+				Expect(events).To(HaveLen(3))
+
+				Expect(fakeCloudControllerClient.GetEventsCallCount()).To(Equal(1))
+				limit, queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(limit).To(Equal(0))
+				Expect(queries).To(BeEmpty())
+			})
+		})
+
+		Context("when the server-side filters are set", func() {
+			BeforeEach(func() {
+				filter = EventFilter{
+					Type:             "audit.route.delete-request",
+					TargetGUID:       "route-2-guid",
+					SpaceGUID:        "some-space-guid",
+					OrganizationGUID: "some-org-guid",
+					Since:            time.Date(2017, 4, 1, 0, 0, 0, 0, time.UTC),
+					Until:            time.Date(2017, 4, 8, 1, 0, 0, 0, time.FixedZone("CET", 3600)),
+				}
+			})
+
+			It("passes them to the Cloud Controller", func() {
+				Expect(err).ToNot(HaveOccurred())
+				_, queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(queries).To(Equal([]ccv2.Query{
+					{Filter: ccv2.TypeFilter, Operator: ccv2.EqualOperator, Value: "audit.route.delete-request"},
+					{Filter: ccv2.ActeeFilter, Operator: ccv2.EqualOperator, Value: "route-2-guid"},
+					{Filter: ccv2.SpaceGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-space-guid"},
+					{Filter: ccv2.OrganizationGUIDFilter, Operator: ccv2.EqualOperator, Value: "some-org-guid"},
+					{Filter: ccv2.TimestampFilter, Operator: ccv2.GreaterThanOrEqualOperator, Value: "2017-04-01T00:00:00Z"},
+					{Filter: ccv2.TimestampFilter, Operator: ccv2.LessThanOrEqualOperator, Value: "2017-04-08T00:00:00Z"},
+				}))
+			})
+		})
+
+		Context("when the limit is set", func() {
+			BeforeEach(func() {
+				filter.Limit = 2
+			})
+
+			It("requests and returns only the most recent events", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("event-3"))
+				Expect(events[1].GUID).To(Equal("event-2"))
+
+				limit, _ := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(limit).To(Equal(2))
+			})
+		})
+
+		Context("when the actor is set by name", func() {
+			BeforeEach(func() {
+				filter.Actor = "admin"
+			})
+
+			It("returns only the events caused by the actor", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(HaveLen(2))
+				Expect(events[0].GUID).To(Equal("event-3"))
+				Expect(events[1].GUID).To(Equal("event-1"))
+
+				_, queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(queries).To(BeEmpty())
+			})
+
+			Context("when the limit is set", func() {
+				BeforeEach(func() {
+					filter.Limit = 1
+				})
+
+				It("requests every event and returns only the most recent matching events", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(events).To(HaveLen(1))
+					Expect(events[0].GUID).To(Equal("event-3"))
+
+					limit, _ := fakeCloudControllerClient.GetEventsArgsForCall(0)
+					Expect(limit).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when the actor is set by GUID", func() {
+			BeforeEach(func() {
+				filter.Actor = "4a6f3f23-6c82-4f2f-8b3e-6e2b9e1e7a10"
+				filter.Limit = 2
+			})
+
+			It("filters the events by actor on the Cloud Controller", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(HaveLen(2))
+
+				limit, queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(limit).To(Equal(2))
+				Expect(queries).To(Equal([]ccv2.Query{
+					{Filter: ccv2.ActorFilter, Operator: ccv2.EqualOperator, Value: "4a6f3f23-6c82-4f2f-8b3e-6e2b9e1e7a10"},
+				}))
+			})
+		})
+
+		Context("when the target is set by name", func() {
+			BeforeEach(func() {
+				filter.Actor = "admin"
+				filter.Target = "route-2"
+			})
+
+			It("returns only the events that happened to the target", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].GUID).To(Equal("event-3"))
+			})
+		})
+
+		Context("when the target is set by GUID", func() {
+			BeforeEach(func() {
+				filter.Target = "9d3f8f0c-2b41-4a0e-9c55-0e3a7d1b6f42"
+			})
+
+			It("filters the events by target on the Cloud Controller", func() {
+				Expect(err).ToNot(HaveOccurred())
+
+				_, queries := fakeCloudControllerClient.GetEventsArgsForCall(0)
+				Expect(queries).To(Equal([]ccv2.Query{
+					{Filter: ccv2.ActeeFilter, Operator: ccv2.EqualOperator, Value: "9d3f8f0c-2b41-4a0e-9c55-0e3a7d1b6f42"},
+				}))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetEventsReturns(nil, ccv2.Warnings{"events-warning"}, errors.New("events error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError("events error"))
+				Expect(warnings).To(ConsistOf("events-warning"))
+			})
+		})
+	})
+
+	Describe("Description", func() {
+		It("describes the request parameters", func() {
+			event := Event{Metadata: map[string]interface{}{
+				"request": map[string]interface{}{
+					"memory":           float64(256),
+					"environment_json": "PRIVATE DATA HIDDEN",
+					"name":             "some-app",
+				},
+			}}
+			Expect(event.Description()).To(Equal("environment_json: PRIVATE DATA HIDDEN, memory: 256, name: some-app"))
+		})
+
+		It("describes the metadata when there is no request", func() {
+			event := Event{Metadata: map[string]interface{}{
+				"index":  float64(0),
+				"reason": "CRASHED",
+			}}
+			Expect(event.Description()).To(Equal("index: 0, reason: CRASHED"))
+		})
+	})
+})
@@ -1,8 +1,15 @@
 package v2action
 
 import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
+	"code.cloudfoundry.org/cli/types"
+
 	"github.com/cloudfoundry/noaa"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
@@ -16,7 +23,24 @@ func (e NOAATimeoutError) Error() string {
 	return "Timeout trying to connect to NOAA"
 }
 
+// LogMergeWindow is how long log messages from several applications are
+// buffered so they can be displayed in timestamp order.
+const LogMergeWindow = 250 * time.Millisecond
+
+// ApplicationLogStreamError is sent when the log stream of one of several
+// applications returns an error. The streams of the other applications are
+// not affected.
+type ApplicationLogStreamError struct {
+	AppName string
+	Err     error
+}
+
+func (e ApplicationLogStreamError) Error() string {
+	return fmt.Sprintf("%s: %s", e.AppName, e.Err)
+}
+
 type LogMessage struct {
+	appName        string
 	message        string
 	messageType    events.LogMessage_MessageType
 	timestamp      time.Time
@@ -24,6 +48,12 @@ type LogMessage struct {
 	sourceInstance string
 }
 
+// AppName returns the name of the application that the message is from. It is
+// only set for messages from several applications.
+func (log LogMessage) AppName() string {
+	return log.appName
+}
+
 func (log LogMessage) Message() string {
 	return log.message
 }
@@ -51,6 +81,58 @@ func (log LogMessage) SourceInstance() string {
 	return log.sourceInstance
 }
 
+// LogFilter selects log messages by their source, instance, type and
+// timestamp. Unset fields match every message.
+type LogFilter struct {
+	// SourceTypes are the sources to match, such as APP, RTR, STG or CELL.
+	// They are compared to the first component of the message's source type,
+	// so APP matches APP/PROC/WEB.
+	SourceTypes []string
+	// SourceInstance is the instance index to match.
+	SourceInstance types.NullInt
+	// Type is either OUT or ERR.
+	Type string
+	// Since and Until bound the timestamps of the matched messages.
+	Since time.Time
+	Until time.Time
+}
+
+// Matches returns true if the log message satisfies every criterion of the
+// filter.
+func (filter LogFilter) Matches(message LogMessage) bool {
+	if len(filter.SourceTypes) > 0 {
+		source := strings.SplitN(message.SourceType(), "/", 2)[0]
+		found := false
+		for _, sourceType := range filter.SourceTypes {
+			if strings.EqualFold(source, sourceType) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	if filter.SourceInstance.IsSet && message.SourceInstance() != strconv.Itoa(filter.SourceInstance.Value) {
+		return false
+	}
+
+	if filter.Type != "" && !strings.EqualFold(message.Type(), filter.Type) {
+		return false
+	}
+
+	if !filter.Since.IsZero() && message.Timestamp().Before(filter.Since) {
+		return false
+	}
+
+	if !filter.Until.IsZero() && message.Timestamp().After(filter.Until) {
+		return false
+	}
+
+	return true
+}
+
 func NewLogMessage(message string, messageType int, timestamp time.Time, sourceType string, sourceInstance string) *LogMessage {
 	return &LogMessage{
 		message:        message,
@@ -144,3 +226,118 @@ func (actor Actor) GetStreamingLogsForApplicationByNameAndSpace(appName string,
 
 	return messages, logErrs, allWarnings, err
 }
+
+// GetRecentLogsForApplications returns the recent logs of all the
+// applications, sorted by timestamp.
+func (actor Actor) GetRecentLogsForApplications(apps []Application, client NOAAClient, config Config) ([]LogMessage, error) {
+	var logMessages []LogMessage
+	for _, app := range apps {
+		noaaMessages, err := client.RecentLogs(app.GUID, "")
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range noaaMessages {
+			logMessages = append(logMessages, LogMessage{
+				appName:        app.Name,
+				message:        string(message.GetMessage()),
+				messageType:    message.GetMessageType(),
+				timestamp:      time.Unix(0, message.GetTimestamp()),
+				sourceType:     message.GetSourceType(),
+				sourceInstance: message.GetSourceInstance(),
+			})
+		}
+	}
+
+	sort.Stable(sortableLogMessages(logMessages))
+	return logMessages, nil
+}
+
+// GetStreamingLogsForApplications tails the logs of all the applications,
+// using one stream per application. Messages are buffered for LogMergeWindow
+// and sent in timestamp order. Errors from a stream are sent as
+// ApplicationLogStreamErrors and do not stop the other streams. The returned
+// channels are closed once every stream has ended.
+func (actor Actor) GetStreamingLogsForApplications(apps []Application, client NOAAClient, config Config) (<-chan *LogMessage, <-chan error) {
+	messages := make(chan *LogMessage)
+	errs := make(chan error)
+	received := make(chan LogMessage)
+
+	var wg sync.WaitGroup
+	for _, app := range apps {
+		appMessages, appErrs := actor.GetStreamingLogs(app.GUID, client, config)
+
+		wg.Add(1)
+		go func(appName string, appMessages <-chan *LogMessage, appErrs <-chan error) {
+			defer wg.Done()
+			for appMessages != nil || appErrs != nil {
+				select {
+				case message, ok := <-appMessages:
+					if !ok {
+						appMessages = nil
+						break
+					}
+
+					message.appName = appName
+					received <- *message
+				case err, ok := <-appErrs:
+					if !ok {
+						appErrs = nil
+						break
+					}
+
+					errs <- ApplicationLogStreamError{AppName: appName, Err: err}
+				}
+			}
+		}(app.Name, appMessages, appErrs)
+	}
+
+	go func() {
+		wg.Wait()
+		close(received)
+	}()
+
+	go func() {
+		defer close(errs)
+		defer close(messages)
+
+		ticker := time.NewTicker(LogMergeWindow)
+		defer ticker.Stop()
+
+		var buffer []LogMessage
+		flush := func() {
+			sort.Stable(sortableLogMessages(buffer))
+			for i := range buffer {
+				messages <- &buffer[i]
+			}
+			buffer = nil
+		}
+
+		for {
+			select {
+			case message, ok := <-received:
+				if !ok {
+					flush()
+					return
+				}
+				buffer = append(buffer, message)
+			case <-ticker.C:
+				flush()
+			}
+		}
+	}()
+
+	return messages, errs
+}
+
+type sortableLogMessages []LogMessage
+
+func (messages sortableLogMessages) Len() int { return len(messages) }
+
+func (messages sortableLogMessages) Swap(i int, j int) {
+	messages[i], messages[j] = messages[j], messages[i]
+}
+
+func (messages sortableLogMessages) Less(i int, j int) bool {
+	return messages[i].timestamp.Before(messages[j].timestamp)
+}
@@ -7,9 +7,11 @@ import (
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	noaaErrors "github.com/cloudfoundry/noaa/errors"
 	"github.com/cloudfoundry/sonde-go/events"
 	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
 )
 
@@ -46,6 +48,32 @@ var _ = Describe("Logging Actions", func() {
 		})
 	})
 
+	Describe("LogFilter", func() {
+		Describe("Matches", func() {
+			var message LogMessage
+
+			BeforeEach(func() {
+				message = *NewLogMessage("some-message", int(events.LogMessage_ERR), time.Unix(100, 0), "APP/PROC/WEB", "1")
+			})
+
+			DescribeTable("filters log messages",
+				func(filter LogFilter, expected bool) {
+					Expect(filter.Matches(message)).To(Equal(expected))
+				},
+				Entry("matches everything when empty", LogFilter{}, true),
+				Entry("matches the first component of the source type", LogFilter{SourceTypes: []string{"RTR", "app"}}, true),
+				Entry("does not match other source types", LogFilter{SourceTypes: []string{"RTR", "STG"}}, false),
+				Entry("matches the instance", LogFilter{SourceInstance: types.NullInt{IsSet: true, Value: 1}}, true),
+				Entry("does not match other instances", LogFilter{SourceInstance: types.NullInt{IsSet: true, Value: 0}}, false),
+				Entry("matches the type", LogFilter{Type: "ERR"}, true),
+				Entry("does not match other types", LogFilter{Type: "OUT"}, false),
+				Entry("matches timestamps inside the window", LogFilter{Since: time.Unix(50, 0), Until: time.Unix(100, 0)}, true),
+				Entry("does not match timestamps before since", LogFilter{Since: time.Unix(101, 0)}, false),
+				Entry("does not match timestamps after until", LogFilter{Until: time.Unix(99, 0)}, false),
+			)
+		})
+	})
+
 	Describe("GetStreamingLogs", func() {
 		var (
 			expectedAppGUID string
@@ -429,4 +457,164 @@ var _ = Describe("Logging Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetRecentLogsForApplications", func() {
+		var apps []Application
+
+		BeforeEach(func() {
+			apps = []Application{
+				{Name: "app-1", GUID: "app-guid-1"},
+				{Name: "app-2", GUID: "app-guid-2"},
+			}
+		})
+
+		Context("when NOAA returns logs", func() {
+			BeforeEach(func() {
+				fakeNOAAClient.RecentLogsStub = func(appGUID string, _ string) ([]*events.LogMessage, error) {
+					outMessage := events.LogMessage_OUT
+					ts1 := int64(10)
+					ts2 := int64(20)
+					if appGUID == "app-guid-2" {
+						ts1, ts2 = 15, 25
+					}
+					return []*events.LogMessage{
+						{Message: []byte(appGUID + "-message-2"), MessageType: &outMessage, Timestamp: &ts2},
+						{Message: []byte(appGUID + "-message-1"), MessageType: &outMessage, Timestamp: &ts1},
+					}, nil
+				}
+			})
+
+			It("returns the logs of all the applications sorted by timestamp", func() {
+				messages, err := actor.GetRecentLogsForApplications(apps, fakeNOAAClient, fakeConfig)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(messages).To(HaveLen(4))
+
+				Expect(messages[0].Message()).To(Equal("app-guid-1-message-1"))
+				Expect(messages[0].AppName()).To(Equal("app-1"))
+				Expect(messages[1].Message()).To(Equal("app-guid-2-message-1"))
+				Expect(messages[1].AppName()).To(Equal("app-2"))
+				Expect(messages[2].Message()).To(Equal("app-guid-1-message-2"))
+				Expect(messages[3].Message()).To(Equal("app-guid-2-message-2"))
+
+				Expect(fakeNOAAClient.RecentLogsCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when NOAA errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("ZOMG")
+				fakeNOAAClient.RecentLogsReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				_, err := actor.GetRecentLogsForApplications(apps, fakeNOAAClient, fakeConfig)
+				Expect(err).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Describe("GetStreamingLogsForApplications", func() {
+		var (
+			eventStreams map[string]chan *events.LogMessage
+			errStreams   map[string]chan error
+
+			messages <-chan *LogMessage
+			logErrs  <-chan error
+		)
+
+		BeforeEach(func() {
+			eventStreams = map[string]chan *events.LogMessage{
+				"app-guid-1": make(chan *events.LogMessage),
+				"app-guid-2": make(chan *events.LogMessage),
+			}
+			errStreams = map[string]chan error{
+				"app-guid-1": make(chan error),
+				"app-guid-2": make(chan error),
+			}
+
+			fakeNOAAClient.TailingLogsStub = func(appGUID string, _ string) (<-chan *events.LogMessage, <-chan error) {
+				return eventStreams[appGUID], errStreams[appGUID]
+			}
+		})
+
+		JustBeforeEach(func() {
+			messages, logErrs = actor.GetStreamingLogsForApplications(
+				[]Application{
+					{Name: "app-1", GUID: "app-guid-1"},
+					{Name: "app-2", GUID: "app-guid-2"},
+				},
+				fakeNOAAClient,
+				fakeConfig,
+			)
+		})
+
+		It("opens a stream for each application", func() {
+			Expect(fakeNOAAClient.TailingLogsCallCount()).To(Equal(2))
+
+			for _, guid := range []string{"app-guid-1", "app-guid-2"} {
+				close(eventStreams[guid])
+				close(errStreams[guid])
+			}
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		It("merges the messages of all the streams by timestamp", func() {
+			outMessage := events.LogMessage_OUT
+			ts1 := int64(10)
+			ts2 := int64(20)
+
+			go func() {
+				eventStreams["app-guid-1"] <- &events.LogMessage{Message: []byte("message-2"), MessageType: &outMessage, Timestamp: &ts2}
+				eventStreams["app-guid-2"] <- &events.LogMessage{Message: []byte("message-1"), MessageType: &outMessage, Timestamp: &ts1}
+				for _, guid := range []string{"app-guid-1", "app-guid-2"} {
+					close(eventStreams[guid])
+					close(errStreams[guid])
+				}
+			}()
+
+			var message *LogMessage
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-1"))
+			Expect(message.AppName()).To(Equal("app-2"))
+
+			Eventually(messages).Should(Receive(&message))
+			Expect(message.Message()).To(Equal("message-2"))
+			Expect(message.AppName()).To(Equal("app-1"))
+
+			Eventually(messages).Should(BeClosed())
+			Eventually(logErrs).Should(BeClosed())
+		})
+
+		Context("when one of the streams errors", func() {
+			It("sends an ApplicationLogStreamError and keeps the other streams open", func() {
+				outMessage := events.LogMessage_OUT
+				ts := int64(10)
+				expectedErr := errors.New("some-error")
+
+				go func() {
+					errStreams["app-guid-1"] <- expectedErr
+					close(eventStreams["app-guid-1"])
+					close(errStreams["app-guid-1"])
+				}()
+
+				Eventually(logErrs).Should(Receive(MatchError(ApplicationLogStreamError{AppName: "app-1", Err: expectedErr})))
+
+				go func() {
+					eventStreams["app-guid-2"] <- &events.LogMessage{Message: []byte("message-1"), MessageType: &outMessage, Timestamp: &ts}
+					close(eventStreams["app-guid-2"])
+					close(errStreams["app-guid-2"])
+				}()
+
+				var message *LogMessage
+				Eventually(messages).Should(Receive(&message))
+				Expect(message.AppName()).To(Equal("app-2"))
+
+				Eventually(messages).Should(BeClosed())
+				Eventually(logErrs).Should(BeClosed())
+			})
+		})
+	})
 })
@@ -2,17 +2,279 @@ package v2action
 
 import (
 	"archive/zip"
+	"crypto/sha1"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/util"
+	"code.cloudfoundry.org/cli/util/glob"
+	"code.cloudfoundry.org/ykk"
 	log "github.com/Sirupsen/logrus"
 )
 
+// DefaultIgnoreFile is the name of the ignore file read from the root of an
+// application's directory when no other ignore file is given.
+const DefaultIgnoreFile = ".cfignore"
+
+// IgnoreFileNotFoundError is returned when a given ignore file does not
+// exist.
+type IgnoreFileNotFoundError struct {
+	Path string
+}
+
+func (e IgnoreFileNotFoundError) Error() string {
+	return fmt.Sprintf("Ignore file '%s' not found.", e.Path)
+}
+
 type Resource ccv2.Resource
 
+// GatherDirectoryResources returns a list of resources for the files and
+// directories in sourceDir, sorted by their path. Files include their SHA1,
+// size and mode so they can be matched against the resources cached by the
+// Cloud Controller.
+//
+// Paths matching the gitignore-style patterns in ignoreFile are skipped. When
+// ignoreFile is empty, the .cfignore in sourceDir is used if it exists.
+func (actor Actor) GatherDirectoryResources(sourceDir string, ignoreFile string) ([]Resource, error) {
+	log.WithFields(log.Fields{
+		"sourceDir":  sourceDir,
+		"ignoreFile": ignoreFile,
+	}).Info("gathering resources")
+
+	ignore, err := actor.readIgnoreFile(sourceDir, ignoreFile)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+
+	err = filepath.Walk(sourceDir, func(fullPath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if fullPath == sourceDir {
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(sourceDir, fullPath)
+		if err != nil {
+			return err
+		}
+
+		ignorePath := filepath.ToSlash(relativePath)
+		if info.IsDir() {
+			ignorePath += "/"
+		}
+		if ignore.Match(ignorePath) {
+			log.WithField("path", ignorePath).Debug("ignoring path")
+			if info.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		resource := Resource{
+			Filename: filepath.ToSlash(relativePath),
+			Mode:     fmt.Sprintf("%#o", fixMode(info.Mode()).Perm()),
+		}
+
+		if !info.IsDir() {
+			sha, err := util.NewSha1Checksum(fullPath).ComputeFileSha1()
+			if err != nil {
+				log.WithField("fullPath", fullPath).Errorln("computing sha1:", err)
+				return err
+			}
+			resource.SHA1 = fmt.Sprintf("%x", sha)
+			resource.Size = info.Size()
+		}
+
+		resources = append(resources, resource)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	log.WithField("number_of_resources", len(resources)).Debug("gathered resources")
+	return resources, nil
+}
+
+// GatherArchiveResources returns a list of resources for the files and
+// directories in the zip archive at archivePath, sorted by their path. Files
+// include their SHA1, size and mode so they can be matched against the
+// resources cached by the Cloud Controller.
+func (actor Actor) GatherArchiveResources(archivePath string) ([]Resource, error) {
+	log.WithField("archivePath", archivePath).Info("gathering archive resources")
+
+	archive, err := os.Open(archivePath)
+	if err != nil {
+		return nil, err
+	}
+	defer archive.Close()
+
+	reader, err := newArchiveReader(archive)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []Resource
+	for _, archivedFile := range reader.File {
+		info := archivedFile.FileInfo()
+		resource := Resource{
+			Filename: strings.TrimSuffix(archivedFile.Name, "/"),
+			Mode:     fmt.Sprintf("%#o", fixMode(info.Mode()).Perm()),
+		}
+
+		if !info.IsDir() {
+			sha, err := archivedFileSha1(archivedFile)
+			if err != nil {
+				log.WithField("file", archivedFile.Name).Errorln("computing sha1:", err)
+				return nil, err
+			}
+			resource.SHA1 = fmt.Sprintf("%x", sha)
+			resource.Size = info.Size()
+		}
+
+		resources = append(resources, resource)
+	}
+
+	sort.Slice(resources, func(i, j int) bool {
+		return resources[i].Filename < resources[j].Filename
+	})
+
+	log.WithField("number_of_resources", len(resources)).Debug("gathered archive resources")
+	return resources, nil
+}
+
+// IsArchive returns true when path is a zip archive rather than a directory.
+func (Actor) IsArchive(path string) bool {
+	archive, err := os.Open(path)
+	if err != nil {
+		return false
+	}
+	defer archive.Close()
+
+	info, err := archive.Stat()
+	if err != nil || info.IsDir() {
+		return false
+	}
+
+	_, err = ykk.NewReader(archive, info.Size())
+	return err == nil
+}
+
+// readIgnoreFile returns the patterns to ignore when gathering the resources
+// in sourceDir. A missing default ignore file is not an error.
+func (Actor) readIgnoreFile(sourceDir string, ignoreFile string) (glob.Ignore, error) {
+	path := ignoreFile
+	if path == "" {
+		path = filepath.Join(sourceDir, DefaultIgnoreFile)
+	}
+
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			if ignoreFile == "" {
+				return glob.NewDefaultIgnore(), nil
+			}
+			return glob.Ignore{}, IgnoreFileNotFoundError{Path: ignoreFile}
+		}
+		log.WithField("ignoreFile", path).Errorln("reading ignore file:", err)
+		return glob.Ignore{}, err
+	}
+
+	return glob.NewDefaultIgnore(string(contents)), nil
+}
+
+// ResourceMatch splits the resources into those that are already cached by
+// the Cloud Controller and those that need to be uploaded. Directories are
+// never matched.
+func (actor Actor) ResourceMatch(allResources []Resource) ([]Resource, []Resource, Warnings, error) {
+	var filesToMatch []ccv2.Resource
+	for _, resource := range allResources {
+		if resource.SHA1 != "" {
+			filesToMatch = append(filesToMatch, ccv2.Resource(resource))
+		}
+	}
+
+	if len(filesToMatch) == 0 {
+		log.Debug("no files to match")
+		return nil, allResources, nil, nil
+	}
+
+	log.WithField("number_of_files", len(filesToMatch)).Info("matching resources")
+	matchedResources, warnings, err := actor.CloudControllerClient.ResourceMatch(filesToMatch)
+	if err != nil {
+		log.Errorln("matching resources:", err)
+		return nil, nil, Warnings(warnings), err
+	}
+
+	cached := map[string]bool{}
+	for _, resource := range matchedResources {
+		cached[resourceKey(Resource(resource))] = true
+	}
+
+	var matched, unmatched []Resource
+	for _, resource := range allResources {
+		if resource.SHA1 != "" && cached[resourceKey(resource)] {
+			matched = append(matched, resource)
+		} else {
+			unmatched = append(unmatched, resource)
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"matched":   len(matched),
+		"unmatched": len(unmatched),
+	}).Debug("matched resources")
+	return matched, unmatched, Warnings(warnings), nil
+}
+
+// UploadApplication uploads the zip of new files, along with the list of
+// cached resources, as the bits of the application. It waits for the Cloud
+// Controller to finish processing the upload.
+func (actor Actor) UploadApplication(appGUID string, existingResources []Resource, zipPath string) (Warnings, error) {
+	zipFile, err := os.Open(zipPath)
+	if err != nil {
+		return nil, err
+	}
+	defer zipFile.Close()
+
+	zipInfo, err := zipFile.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []ccv2.Resource
+	for _, resource := range existingResources {
+		resources = append(resources, ccv2.Resource(resource))
+	}
+
+	log.WithFields(log.Fields{
+		"appGUID":  appGUID,
+		"zip_size": zipInfo.Size(),
+	}).Info("uploading application")
+	job, allWarnings, err := actor.CloudControllerClient.UploadApplication(appGUID, resources, zipFile, zipInfo.Size())
+	if err != nil {
+		log.Errorln("uploading application:", err)
+		return Warnings(allWarnings), err
+	}
+
+	warnings, err := actor.CloudControllerClient.PollJob(job)
+	allWarnings = append(allWarnings, warnings...)
+	return Warnings(allWarnings), err
+}
+
+func resourceKey(resource Resource) string {
+	return fmt.Sprintf("%s:%d", resource.SHA1, resource.Size)
+}
+
 // ZipResources zips a directory and a sorted (based on full path/filename)
 // list of resources and returns the location. On Windows, the filemode for
 // user is forced to be readable and executable.
@@ -44,6 +306,108 @@ func (actor Actor) ZipResources(sourceDir string, filesToInclude []Resource) (st
 	return zipFile.Name(), nil
 }
 
+// ZipArchiveResources copies the given resources from the zip archive at
+// sourceArchivePath into a new zip file and returns its location.
+func (actor Actor) ZipArchiveResources(sourceArchivePath string, filesToInclude []Resource) (string, error) {
+	log.WithField("sourceArchivePath", sourceArchivePath).Info("zipping archived files")
+
+	archive, err := os.Open(sourceArchivePath)
+	if err != nil {
+		return "", err
+	}
+	defer archive.Close()
+
+	reader, err := newArchiveReader(archive)
+	if err != nil {
+		return "", err
+	}
+
+	archivedFiles := map[string]*zip.File{}
+	for _, archivedFile := range reader.File {
+		archivedFiles[strings.TrimSuffix(archivedFile.Name, "/")] = archivedFile
+	}
+
+	zipFile, err := ioutil.TempFile("", "cf-cli-")
+	if err != nil {
+		return "", err
+	}
+	defer zipFile.Close()
+
+	writer := zip.NewWriter(zipFile)
+	defer writer.Close()
+
+	for _, resource := range filesToInclude {
+		archivedFile, ok := archivedFiles[resource.Filename]
+		if !ok {
+			return "", fmt.Errorf("%s not found in %s", resource.Filename, sourceArchivePath)
+		}
+
+		log.WithField("file", resource.Filename).Debug("zipping archived file")
+		err := actor.addArchivedFileToZip(archivedFile, resource.Filename, writer)
+		if err != nil {
+			log.WithField("file", resource.Filename).Errorln("zipping archived file:", err)
+			return "", err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"zip_file_location": zipFile.Name(),
+		"zipped_file_count": len(filesToInclude),
+	}).Info("zip file created")
+	return zipFile.Name(), nil
+}
+
+func (_ Actor) addArchivedFileToZip(archivedFile *zip.File, destPath string, zipFile *zip.Writer) error {
+	header := archivedFile.FileHeader
+	header.Name = destPath
+	header.Method = zip.Deflate
+	header.SetMode(fixMode(archivedFile.Mode()))
+
+	destFileWriter, err := zipFile.CreateHeader(&header)
+	if err != nil {
+		log.Errorln("creating header:", err)
+		return err
+	}
+
+	if archivedFile.FileInfo().IsDir() {
+		return nil
+	}
+
+	srcFile, err := archivedFile.Open()
+	if err != nil {
+		return err
+	}
+	defer srcFile.Close()
+
+	_, err = io.Copy(destFileWriter, srcFile)
+	return err
+}
+
+// newArchiveReader returns a zip reader for the archive, which may have data
+// in front of the first file, such as a self-extracting archive.
+func newArchiveReader(archive *os.File) (*zip.Reader, error) {
+	info, err := archive.Stat()
+	if err != nil {
+		return nil, err
+	}
+	return ykk.NewReader(archive, info.Size())
+}
+
+func archivedFileSha1(archivedFile *zip.File) ([]byte, error) {
+	srcFile, err := archivedFile.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer srcFile.Close()
+
+	hash := sha1.New()
+	_, err = io.Copy(hash, srcFile)
+	if err != nil {
+		return nil, err
+	}
+	return hash.Sum(nil), nil
+}
+
 func (_ Actor) addFileToZip(srcPath string, destPath string, zipFile *zip.Writer) error {
 	srcFile, err := os.Open(srcPath)
 	if err != nil {
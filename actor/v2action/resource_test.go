@@ -2,12 +2,16 @@ package v2action_test
 
 import (
 	"archive/zip"
+	"errors"
+	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	. "code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	"code.cloudfoundry.org/ykk"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -99,6 +103,362 @@ var _ = Describe("Resource Actions", func() {
 			}
 		})
 	})
+
+	Describe("GatherDirectoryResources", func() {
+		var srcDir string
+
+		BeforeEach(func() {
+			var err error
+			srcDir, err = ioutil.TempDir("", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			subDir := filepath.Join(srcDir, "level1", "level2")
+			err = os.MkdirAll(subDir, 0777)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(subDir, "tmpFile1"), []byte("why hello"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(srcDir, "tmpFile2"), []byte("Hello, Binky"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := os.RemoveAll(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the directories and files with the SHA1 and size of each file", func() {
+			resources, err := actor.GatherDirectoryResources(srcDir, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resources).To(HaveLen(4))
+			Expect(resources[0].Filename).To(Equal("level1"))
+			Expect(resources[0].SHA1).To(BeEmpty())
+			Expect(resources[1].Filename).To(Equal("level1/level2"))
+			Expect(resources[2].Filename).To(Equal("level1/level2/tmpFile1"))
+			Expect(resources[2].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+			Expect(resources[2].Size).To(BeEquivalentTo(9))
+			Expect(resources[3].Filename).To(Equal("tmpFile2"))
+			Expect(resources[3].SHA1).To(Equal("e594bdc795bb293a0e55724137e53a36dc0d9e95"))
+			Expect(resources[3].Size).To(BeEquivalentTo(12))
+		})
+
+		Context("when the directory does not exist", func() {
+			It("returns the error", func() {
+				_, err := actor.GatherDirectoryResources(filepath.Join(srcDir, "banana"), "")
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+
+		Context("when the directory contains a .cfignore", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("level1/\n"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+
+				err = os.Mkdir(filepath.Join(srcDir, ".git"), 0777)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("skips the ignored paths and the default ignored paths", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(resources).To(HaveLen(1))
+				Expect(resources[0].Filename).To(Equal("tmpFile2"))
+			})
+		})
+
+		Context("when an ignore file is provided", func() {
+			var ignoreFile string
+
+			BeforeEach(func() {
+				err := ioutil.WriteFile(filepath.Join(srcDir, ".cfignore"), []byte("tmpFile2\n"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+
+				ignoreFile = filepath.Join(srcDir, "some-ignore-file")
+				err = ioutil.WriteFile(ignoreFile, []byte("tmp*\n!tmpFile1\nsome-ignore-file\n"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("uses the provided ignore file instead of the .cfignore", func() {
+				resources, err := actor.GatherDirectoryResources(srcDir, ignoreFile)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(resources).To(HaveLen(3))
+				Expect(resources[0].Filename).To(Equal("level1"))
+				Expect(resources[1].Filename).To(Equal("level1/level2"))
+				Expect(resources[2].Filename).To(Equal("level1/level2/tmpFile1"))
+			})
+
+			Context("when the ignore file does not exist", func() {
+				It("returns an IgnoreFileNotFoundError", func() {
+					_, err := actor.GatherDirectoryResources(srcDir, "banana")
+					Expect(err).To(MatchError(IgnoreFileNotFoundError{Path: "banana"}))
+				})
+			})
+		})
+	})
+
+	Describe("archives", func() {
+		var (
+			srcDir      string
+			archivePath string
+		)
+
+		BeforeEach(func() {
+			var err error
+			srcDir, err = ioutil.TempDir("", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			archivePath = filepath.Join(srcDir, "app.zip")
+			archive, err := os.Create(archivePath)
+			Expect(err).ToNot(HaveOccurred())
+			defer archive.Close()
+
+			writer := zip.NewWriter(archive)
+			_, err = writer.Create("level1/")
+			Expect(err).ToNot(HaveOccurred())
+			for name, contents := range map[string]string{
+				"tmpFile2":        "Hello, Binky",
+				"level1/tmpFile1": "why hello",
+			} {
+				fileWriter, err := writer.Create(name)
+				Expect(err).ToNot(HaveOccurred())
+				_, err = fileWriter.Write([]byte(contents))
+				Expect(err).ToNot(HaveOccurred())
+			}
+			Expect(writer.Close()).To(Succeed())
+		})
+
+		AfterEach(func() {
+			err := os.RemoveAll(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Describe("IsArchive", func() {
+			It("returns true for a zip archive", func() {
+				Expect(actor.IsArchive(archivePath)).To(BeTrue())
+			})
+
+			It("returns false for a directory", func() {
+				Expect(actor.IsArchive(srcDir)).To(BeFalse())
+			})
+
+			It("returns false for a file that is not a zip archive", func() {
+				path := filepath.Join(srcDir, "not-a-zip")
+				err := ioutil.WriteFile(path, []byte("banana"), 0600)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(actor.IsArchive(path)).To(BeFalse())
+			})
+		})
+
+		Describe("GatherArchiveResources", func() {
+			It("returns the directories and files in the archive with the SHA1 and size of each file", func() {
+				resources, err := actor.GatherArchiveResources(archivePath)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(resources).To(HaveLen(3))
+				Expect(resources[0].Filename).To(Equal("level1"))
+				Expect(resources[0].SHA1).To(BeEmpty())
+				Expect(resources[1].Filename).To(Equal("level1/tmpFile1"))
+				Expect(resources[1].SHA1).To(Equal("9e36efec86d571de3a38389ea799a796fe4782f4"))
+				Expect(resources[1].Size).To(BeEquivalentTo(9))
+				Expect(resources[2].Filename).To(Equal("tmpFile2"))
+				Expect(resources[2].SHA1).To(Equal("e594bdc795bb293a0e55724137e53a36dc0d9e95"))
+				Expect(resources[2].Size).To(BeEquivalentTo(12))
+			})
+
+			Context("when the archive does not exist", func() {
+				It("returns the error", func() {
+					_, err := actor.GatherArchiveResources(filepath.Join(srcDir, "banana.zip"))
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
+		})
+
+		Describe("ZipArchiveResources", func() {
+			It("zips the given files from the archive", func() {
+				resultZip, err := actor.ZipArchiveResources(archivePath, []Resource{
+					{Filename: "level1"},
+					{Filename: "level1/tmpFile1"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				defer os.Remove(resultZip)
+
+				zipFile, err := os.Open(resultZip)
+				Expect(err).ToNot(HaveOccurred())
+				defer zipFile.Close()
+
+				zipInfo, err := zipFile.Stat()
+				Expect(err).ToNot(HaveOccurred())
+
+				reader, err := ykk.NewReader(zipFile, zipInfo.Size())
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(reader.File).To(HaveLen(2))
+				Expect(reader.File[0].Name).To(Equal("level1"))
+				Expect(reader.File[1].Name).To(Equal("level1/tmpFile1"))
+				expectFileContentsToEqual(reader.File[1], "why hello")
+
+				for _, file := range reader.File {
+					Expect(file.Method).To(Equal(zip.Deflate))
+				}
+			})
+
+			Context("when a file is not in the archive", func() {
+				It("returns an error", func() {
+					_, err := actor.ZipArchiveResources(archivePath, []Resource{{Filename: "banana"}})
+					Expect(err).To(MatchError(fmt.Sprintf("banana not found in %s", archivePath)))
+				})
+			})
+		})
+	})
+
+	Describe("ResourceMatch", func() {
+		var resources []Resource
+
+		BeforeEach(func() {
+			resources = []Resource{
+				{Filename: "level1", Mode: "0755"},
+				{Filename: "level1/file1", SHA1: "some-sha-1", Size: 1, Mode: "0644"},
+				{Filename: "file2", SHA1: "some-sha-2", Size: 2, Mode: "0644"},
+			}
+		})
+
+		Context("when the resource match is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.ResourceMatchReturns(
+					[]ccv2.Resource{{SHA1: "some-sha-2", Size: 2}},
+					ccv2.Warnings{"resource-match-warning"},
+					nil,
+				)
+			})
+
+			It("returns the matched and unmatched resources and warnings", func() {
+				matched, unmatched, warnings, err := actor.ResourceMatch(resources)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(matched).To(Equal([]Resource{resources[2]}))
+				Expect(unmatched).To(Equal([]Resource{resources[0], resources[1]}))
+				Expect(warnings).To(ConsistOf("resource-match-warning"))
+
+				Expect(fakeCloudControllerClient.ResourceMatchCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.ResourceMatchArgsForCall(0)).To(Equal([]ccv2.Resource{
+					ccv2.Resource(resources[1]),
+					ccv2.Resource(resources[2]),
+				}))
+			})
+		})
+
+		Context("when there are no files", func() {
+			It("does not call the cloud controller", func() {
+				matched, unmatched, _, err := actor.ResourceMatch(resources[:1])
+				Expect(err).ToNot(HaveOccurred())
+				Expect(matched).To(BeEmpty())
+				Expect(unmatched).To(Equal(resources[:1]))
+				Expect(fakeCloudControllerClient.ResourceMatchCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the resource match errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("resource match error")
+				fakeCloudControllerClient.ResourceMatchReturns(nil, ccv2.Warnings{"resource-match-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, _, warnings, err := actor.ResourceMatch(resources)
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("resource-match-warning"))
+			})
+		})
+	})
+
+	Describe("UploadApplication", func() {
+		var (
+			zipPath           string
+			existingResources []Resource
+			warnings          Warnings
+			executeErr        error
+		)
+
+		BeforeEach(func() {
+			zipFile, err := ioutil.TempFile("", "")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = zipFile.WriteString("some-zip-contents")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zipFile.Close()).To(Succeed())
+			zipPath = zipFile.Name()
+
+			existingResources = []Resource{{Filename: "file1", SHA1: "some-sha-1", Size: 1, Mode: "0644"}}
+		})
+
+		JustBeforeEach(func() {
+			warnings, executeErr = actor.UploadApplication("some-app-guid", existingResources, zipPath)
+		})
+
+		AfterEach(func() {
+			os.Remove(zipPath)
+		})
+
+		Context("when the upload and job are successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.UploadApplicationStub = func(_ string, _ []ccv2.Resource, zip io.ReadSeeker, zipSize int64) (ccv2.Job, ccv2.Warnings, error) {
+					contents, err := ioutil.ReadAll(zip)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-zip-contents"))
+					Expect(zipSize).To(BeEquivalentTo(len(contents)))
+					return ccv2.Job{GUID: "some-job-guid"}, ccv2.Warnings{"upload-warning"}, nil
+				}
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, nil)
+			})
+
+			It("uploads the zip and existing resources and waits for the job", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("upload-warning", "poll-warning"))
+
+				Expect(fakeCloudControllerClient.UploadApplicationCallCount()).To(Equal(1))
+				appGUID, resources, _, _ := fakeCloudControllerClient.UploadApplicationArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(resources).To(Equal([]ccv2.Resource{ccv2.Resource(existingResources[0])}))
+
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.PollJobArgsForCall(0)).To(Equal(ccv2.Job{GUID: "some-job-guid"}))
+			})
+		})
+
+		Context("when the upload errors", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("upload error")
+				fakeCloudControllerClient.UploadApplicationReturns(ccv2.Job{}, ccv2.Warnings{"upload-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings without polling", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("upload-warning"))
+				Expect(fakeCloudControllerClient.PollJobCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the job fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("job failed")
+				fakeCloudControllerClient.UploadApplicationReturns(ccv2.Job{}, ccv2.Warnings{"upload-warning"}, nil)
+				fakeCloudControllerClient.PollJobReturns(ccv2.Warnings{"poll-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("upload-warning", "poll-warning"))
+			})
+		})
+	})
 })
 
 func expectFileContentsToEqual(file *zip.File, expectedContents string) {
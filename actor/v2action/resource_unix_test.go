@@ -1,3 +1,4 @@
+//go:build !windows
 // +build !windows
 
 package v2action_test
@@ -98,4 +99,38 @@ var _ = Describe("Resource Actions", func() {
 			})
 		})
 	})
+
+	Describe("GatherDirectoryResources", func() {
+		var srcDir string
+
+		BeforeEach(func() {
+			var err error
+			srcDir, err = ioutil.TempDir("", "")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = os.Mkdir(filepath.Join(srcDir, "level1"), 0755)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(srcDir, "level1", "tmpFile1"), []byte("why hello"), 0644)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = ioutil.WriteFile(filepath.Join(srcDir, "tmpFile2"), []byte("Hello, Binky"), 0751)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			err := os.RemoveAll(srcDir)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("keeps the file permissions in the resources", func() {
+			resources, err := actor.GatherDirectoryResources(srcDir, "")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resources).To(HaveLen(3))
+			Expect(resources[0].Mode).To(Equal("0755"))
+			Expect(resources[1].Mode).To(Equal("0644"))
+			Expect(resources[2].Mode).To(Equal("0751"))
+		})
+	})
 })
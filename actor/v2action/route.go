@@ -2,6 +2,7 @@ package v2action
 
 import (
 	"fmt"
+	"strconv"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
@@ -74,6 +75,12 @@ func (actor Actor) BindRouteToApplication(routeGUID string, appGUID string) (War
 	return Warnings(warnings), err
 }
 
+// UnbindRouteFromApplication unbinds the route from the application.
+func (actor Actor) UnbindRouteFromApplication(routeGUID string, appGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.DeleteRouteApplication(routeGUID, appGUID)
+	return Warnings(warnings), err
+}
+
 func (actor Actor) CreateRoute(route Route, generatePort bool) (Route, Warnings, error) {
 	returnedRoute, warnings, err := actor.CloudControllerClient.CreateRoute(actorToCCRoute(route), generatePort)
 	return ccToActorRoute(returnedRoute, route.Domain), Warnings(warnings), err
@@ -171,6 +178,46 @@ func (actor Actor) GetRouteByHostAndDomain(host string, domainGUID string) (Rout
 	return routes[0], append(Warnings(warnings), domainWarnings...), err
 }
 
+// GetRouteByComponents returns the route with the same host, domain, path and
+// port as the provided route.
+func (actor Actor) GetRouteByComponents(route Route) (Route, Warnings, error) {
+	queries := []ccv2.Query{
+		{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: route.Host},
+		{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: route.Domain.GUID},
+	}
+	if route.Path != "" {
+		queries = append(queries, ccv2.Query{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: route.Path})
+	}
+	if route.Port != 0 {
+		queries = append(queries, ccv2.Query{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Value: strconv.Itoa(route.Port)})
+	}
+
+	ccv2Routes, warnings, err := actor.CloudControllerClient.GetRoutes(queries)
+	if err != nil {
+		return Route{}, Warnings(warnings), err
+	}
+
+	// Routes without a path or port are not filtered on them, so routes with
+	// a different path or port are skipped here.
+	var matchingRoutes []ccv2.Route
+	for _, ccv2Route := range ccv2Routes {
+		if ccv2Route.Path == route.Path && ccv2Route.Port == route.Port {
+			matchingRoutes = append(matchingRoutes, ccv2Route)
+		}
+	}
+
+	if len(matchingRoutes) == 0 {
+		return Route{}, Warnings(warnings), RouteNotFoundError{Host: route.Host, DomainGUID: route.Domain.GUID}
+	}
+
+	routes, domainWarnings, err := actor.applyDomain(matchingRoutes[:1])
+	if err != nil {
+		return Route{}, append(Warnings(warnings), domainWarnings...), err
+	}
+
+	return routes[0], append(Warnings(warnings), domainWarnings...), err
+}
+
 func (actor Actor) CheckRoute(route Route) (bool, Warnings, error) {
 	exists, warnings, err := actor.CloudControllerClient.CheckRoute(actorToCCRoute(route))
 	return exists, Warnings(warnings), err
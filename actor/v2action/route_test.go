@@ -80,6 +80,44 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("UnbindRouteFromApplication", func() {
+		Context("when no errors are encountered", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.DeleteRouteApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					nil)
+			})
+
+			It("unbinds the route from the application and returns all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("unbind warning"))
+
+				Expect(fakeCloudControllerClient.DeleteRouteApplicationCallCount()).To(Equal(1))
+				routeGUID, appGUID := fakeCloudControllerClient.DeleteRouteApplicationArgsForCall(0)
+				Expect(routeGUID).To(Equal("some-route-guid"))
+				Expect(appGUID).To(Equal("some-app-guid"))
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("unbind error")
+				fakeCloudControllerClient.DeleteRouteApplicationReturns(
+					ccv2.Warnings{"unbind warning"},
+					expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.UnbindRouteFromApplication("some-route-guid", "some-app-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("unbind warning"))
+			})
+		})
+	})
+
 	Describe("CreateRoute", func() {
 		Context("when no errors are encountered", func() {
 			BeforeEach(func() {
@@ -612,6 +650,89 @@ var _ = Describe("Route Actions", func() {
 		})
 	})
 
+	Describe("GetRouteByComponents", func() {
+		var (
+			route Route
+
+			foundRoute Route
+			warnings   Warnings
+			executeErr error
+		)
+
+		BeforeEach(func() {
+			route = Route{
+				Domain: Domain{GUID: "domain-1-guid"},
+				Host:   "host",
+				Path:   "/path",
+				Port:   1234,
+			}
+			fakeCloudControllerClient.GetSharedDomainReturns(ccv2.Domain{Name: "domain.com"}, ccv2.Warnings{"get-domain-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			foundRoute, warnings, executeErr = actor.GetRouteByComponents(route)
+		})
+
+		Context("when a route with the same components exists", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "other-path-route-guid", Host: "host", Path: "/other", Port: 1234, DomainGUID: "domain-1-guid"},
+					{GUID: "route-guid", SpaceGUID: "some-space-guid", Host: "host", Path: "/path", Port: 1234, DomainGUID: "domain-1-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("filters on the host, domain, path and port and returns the route", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("get-routes-warning", "get-domain-warning"))
+				Expect(foundRoute).To(Equal(Route{
+					Domain:    Domain{Name: "domain.com"},
+					GUID:      "route-guid",
+					Host:      "host",
+					Path:      "/path",
+					Port:      1234,
+					SpaceGUID: "some-space-guid",
+				}))
+
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: "host"},
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: "domain-1-guid"},
+					{Filter: ccv2.PathFilter, Operator: ccv2.EqualOperator, Value: "/path"},
+					{Filter: ccv2.PortFilter, Operator: ccv2.EqualOperator, Value: "1234"},
+				}))
+			})
+		})
+
+		Context("when only a route with a path exists and the route has no path", func() {
+			BeforeEach(func() {
+				route.Path = ""
+				route.Port = 0
+				fakeCloudControllerClient.GetRoutesReturns([]ccv2.Route{
+					{GUID: "path-route-guid", Host: "host", Path: "/path", DomainGUID: "domain-1-guid"},
+				}, ccv2.Warnings{"get-routes-warning"}, nil)
+			})
+
+			It("does not filter on path or port, and returns a RouteNotFoundError", func() {
+				Expect(executeErr).To(MatchError(RouteNotFoundError{Host: "host", DomainGUID: "domain-1-guid"}))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+				Expect(fakeCloudControllerClient.GetRoutesArgsForCall(0)).To(Equal([]ccv2.Query{
+					{Filter: ccv2.HostFilter, Operator: ccv2.EqualOperator, Value: "host"},
+					{Filter: ccv2.DomainGUIDFilter, Operator: ccv2.EqualOperator, Value: "domain-1-guid"},
+				}))
+			})
+		})
+
+		Context("when getting the routes fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetRoutesReturns(nil, ccv2.Warnings{"get-routes-warning"}, errors.New("get routes error"))
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(executeErr).To(MatchError("get routes error"))
+				Expect(warnings).To(ConsistOf("get-routes-warning"))
+			})
+		})
+	})
+
 	Describe("GetRouteByHostAndDomain", func() {
 		var (
 			host       string
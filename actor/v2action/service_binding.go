@@ -21,6 +21,13 @@ func (e ServiceBindingNotFoundError) Error() string {
 	return fmt.Sprintf("Service binding for application GUID '%s', and service instance GUID '%s' not found.", e.AppGUID, e.ServiceInstanceGUID)
 }
 
+// BindServiceByApplicationAndServiceInstance binds the service instance to an
+// application.
+func (actor Actor) BindServiceByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (Warnings, error) {
+	_, warnings, err := actor.CloudControllerClient.CreateServiceBinding(appGUID, serviceInstanceGUID, nil)
+	return Warnings(warnings), err
+}
+
 // GetServiceBindingByApplicationAndServiceInstance returns a service binding
 // given an application GUID and and service instance GUID.
 func (actor Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID string, serviceInstanceGUID string) (ServiceBinding, Warnings, error) {
@@ -51,6 +58,28 @@ func (actor Actor) GetServiceBindingByApplicationAndServiceInstance(appGUID stri
 	return ServiceBinding(serviceBindings[0]), Warnings(warnings), err
 }
 
+// GetServiceBindingsByApplication returns the service bindings of the given
+// application.
+func (actor Actor) GetServiceBindingsByApplication(appGUID string) ([]ServiceBinding, Warnings, error) {
+	ccServiceBindings, warnings, err := actor.CloudControllerClient.GetServiceBindings([]ccv2.Query{
+		ccv2.Query{
+			Filter:   ccv2.AppGUIDFilter,
+			Operator: ccv2.EqualOperator,
+			Value:    appGUID,
+		},
+	})
+	if err != nil {
+		return nil, Warnings(warnings), err
+	}
+
+	var serviceBindings []ServiceBinding
+	for _, serviceBinding := range ccServiceBindings {
+		serviceBindings = append(serviceBindings, ServiceBinding(serviceBinding))
+	}
+
+	return serviceBindings, Warnings(warnings), nil
+}
+
 // UnbindServiceBySpace deletes the service binding between an application and
 // service instance for a given space.
 func (actor Actor) UnbindServiceBySpace(appName string, serviceInstanceName string, spaceGUID string) (Warnings, error) {
@@ -22,6 +22,41 @@ var _ = Describe("Service Binding Actions", func() {
 		actor = NewActor(fakeCloudControllerClient, nil)
 	})
 
+	Describe("BindServiceByApplicationAndServiceInstance", func() {
+		Context("when the binding is successful", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"some-warnings"}, nil)
+			})
+
+			It("creates the binding and returns all warnings", func() {
+				warnings, err := actor.BindServiceByApplicationAndServiceInstance("some-app-guid", "some-service-instance-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("some-warnings"))
+
+				Expect(fakeCloudControllerClient.CreateServiceBindingCallCount()).To(Equal(1))
+				appGUID, serviceInstanceGUID, parameters := fakeCloudControllerClient.CreateServiceBindingArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(serviceInstanceGUID).To(Equal("some-service-instance-guid"))
+				Expect(parameters).To(BeNil())
+			})
+		})
+
+		Context("when the binding fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCloudControllerClient.CreateServiceBindingReturns(ccv2.ServiceBinding{}, ccv2.Warnings{"some-warnings"}, expectedErr)
+			})
+
+			It("returns the error and all warnings", func() {
+				warnings, err := actor.BindServiceByApplicationAndServiceInstance("some-app-guid", "some-service-instance-guid")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("some-warnings"))
+			})
+		})
+	})
+
 	Describe("GetServiceBindingByApplicationAndServiceInstance", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
@@ -89,6 +124,55 @@ var _ = Describe("Service Binding Actions", func() {
 		})
 	})
 
+	Describe("GetServiceBindingsByApplication", func() {
+		Context("when the application has service bindings", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServiceBindingsReturns(
+					[]ccv2.ServiceBinding{
+						{GUID: "some-service-binding-guid-1", ServiceInstanceGUID: "some-service-instance-guid-1"},
+						{GUID: "some-service-binding-guid-2", ServiceInstanceGUID: "some-service-instance-guid-2"},
+					},
+					ccv2.Warnings{"foo"},
+					nil,
+				)
+			})
+
+			It("returns the service bindings and warnings", func() {
+				serviceBindings, warnings, err := actor.GetServiceBindingsByApplication("some-app-guid")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(serviceBindings).To(Equal([]ServiceBinding{
+					{GUID: "some-service-binding-guid-1", ServiceInstanceGUID: "some-service-instance-guid-1"},
+					{GUID: "some-service-binding-guid-2", ServiceInstanceGUID: "some-service-instance-guid-2"},
+				}))
+				Expect(warnings).To(Equal(Warnings{"foo"}))
+
+				Expect(fakeCloudControllerClient.GetServiceBindingsCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetServiceBindingsArgsForCall(0)).To(ConsistOf([]ccv2.Query{
+					ccv2.Query{
+						Filter:   ccv2.AppGUIDFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-app-guid",
+					},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedError error
+
+			BeforeEach(func() {
+				expectedError = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetServiceBindingsReturns(nil, ccv2.Warnings{"foo"}, expectedError)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetServiceBindingsByApplication("some-app-guid")
+				Expect(err).To(MatchError(expectedError))
+				Expect(warnings).To(Equal(Warnings{"foo"}))
+			})
+		})
+	})
+
 	Describe("UnbindServiceBySpace", func() {
 		Context("when the service binding exists", func() {
 			BeforeEach(func() {
@@ -57,3 +57,46 @@ func (actor Actor) GetServiceInstancesBySpace(spaceGUID string) ([]ServiceInstan
 
 	return serviceInstances, Warnings(warnings), nil
 }
+
+// CreateServiceInstance creates a managed service instance with the name,
+// space and service plan of the provided service instance.
+func (actor Actor) CreateServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	created, warnings, err := actor.CloudControllerClient.CreateServiceInstance(ccv2.ServiceInstance(serviceInstance))
+	return ServiceInstance(created), Warnings(warnings), err
+}
+
+// UpdateServiceInstance changes the service plan of a managed service
+// instance.
+func (actor Actor) UpdateServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	updated, warnings, err := actor.CloudControllerClient.UpdateServiceInstance(ccv2.ServiceInstance(serviceInstance))
+	return ServiceInstance(updated), Warnings(warnings), err
+}
+
+// CreateUserProvidedServiceInstance creates a user provided service instance
+// with the properties of the provided service instance.
+func (actor Actor) CreateUserProvidedServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	created, warnings, err := actor.CloudControllerClient.CreateUserProvidedServiceInstance(ccv2.ServiceInstance(serviceInstance))
+	return ServiceInstance(created), Warnings(warnings), err
+}
+
+// UpdateUserProvidedServiceInstance replaces the credentials, syslog drain URL
+// and route service URL of a user provided service instance.
+func (actor Actor) UpdateUserProvidedServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	updated, warnings, err := actor.CloudControllerClient.UpdateUserProvidedServiceInstance(ccv2.ServiceInstance(serviceInstance))
+	return ServiceInstance(updated), Warnings(warnings), err
+}
+
+// DeleteServiceInstance deletes a managed or user provided service instance
+// along with its service bindings.
+func (actor Actor) DeleteServiceInstance(serviceInstance ServiceInstance) (Warnings, error) {
+	var (
+		warnings ccv2.Warnings
+		err      error
+	)
+	if ccv2.ServiceInstance(serviceInstance).UserProvided() {
+		warnings, err = actor.CloudControllerClient.DeleteUserProvidedServiceInstance(serviceInstance.GUID)
+	} else {
+		warnings, err = actor.CloudControllerClient.DeleteServiceInstance(serviceInstance.GUID)
+	}
+	return Warnings(warnings), err
+}
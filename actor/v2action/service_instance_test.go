@@ -148,4 +148,75 @@ var _ = Describe("Service Instance Actions", func() {
 			})
 		})
 	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.CreateUserProvidedServiceInstanceReturns(
+				ccv2.ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		It("creates the service instance and returns warnings", func() {
+			serviceInstance, warnings, err := actor.CreateUserProvidedServiceInstance(ServiceInstance{
+				Name:        "some-service-instance",
+				SpaceGUID:   "some-space-guid",
+				Credentials: map[string]interface{}{"username": "admin"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serviceInstance).To(Equal(ServiceInstance{GUID: "some-service-instance-guid", Name: "some-service-instance"}))
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			Expect(fakeCloudControllerClient.CreateUserProvidedServiceInstanceCallCount()).To(Equal(1))
+			Expect(fakeCloudControllerClient.CreateUserProvidedServiceInstanceArgsForCall(0)).To(Equal(ccv2.ServiceInstance{
+				Name:        "some-service-instance",
+				SpaceGUID:   "some-space-guid",
+				Credentials: map[string]interface{}{"username": "admin"},
+			}))
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		var (
+			serviceInstance ServiceInstance
+			warnings        Warnings
+			err             error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.DeleteServiceInstanceReturns(ccv2.Warnings{"managed-warning"}, nil)
+			fakeCloudControllerClient.DeleteUserProvidedServiceInstanceReturns(ccv2.Warnings{"user-provided-warning"}, nil)
+		})
+
+		JustBeforeEach(func() {
+			warnings, err = actor.DeleteServiceInstance(serviceInstance)
+		})
+
+		Context("when the service instance is managed", func() {
+			BeforeEach(func() {
+				serviceInstance = ServiceInstance{GUID: "some-service-instance-guid", Type: ccv2.ManagedService}
+			})
+
+			It("deletes the managed service instance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("managed-warning"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the service instance is user provided", func() {
+			BeforeEach(func() {
+				serviceInstance = ServiceInstance{GUID: "some-service-instance-guid", Type: ccv2.UserProvidedService}
+			})
+
+			It("deletes the user provided service instance", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("user-provided-warning"))
+				Expect(fakeCloudControllerClient.DeleteUserProvidedServiceInstanceArgsForCall(0)).To(Equal("some-service-instance-guid"))
+				Expect(fakeCloudControllerClient.DeleteServiceInstanceCallCount()).To(Equal(0))
+			})
+		})
+	})
 })
@@ -0,0 +1,68 @@
+package v2action
+
+import (
+	"fmt"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+)
+
+// ServicePlan represents a plan of a service.
+type ServicePlan ccv2.ServicePlan
+
+// ServiceNotFoundError is returned when a service is not available to a
+// space.
+type ServiceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	return fmt.Sprintf("Service '%s' not found.", e.Name)
+}
+
+// ServicePlanNotFoundError is returned when a service does not offer a plan.
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return fmt.Sprintf("Service plan '%s' not found for service '%s'.", e.PlanName, e.ServiceName)
+}
+
+// GetServicePlanByNameServiceAndSpace returns the named plan of a service
+// that is available to the space.
+func (actor Actor) GetServicePlanByNameServiceAndSpace(planName string, serviceName string, spaceGUID string) (ServicePlan, Warnings, error) {
+	var allWarnings Warnings
+
+	services, warnings, err := actor.CloudControllerClient.GetSpaceServices(spaceGUID, []ccv2.Query{{
+		Filter:   ccv2.LabelFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    serviceName,
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServicePlan{}, allWarnings, err
+	}
+
+	if len(services) == 0 {
+		return ServicePlan{}, allWarnings, ServiceNotFoundError{Name: serviceName}
+	}
+
+	plans, warnings, err := actor.CloudControllerClient.GetServicePlans([]ccv2.Query{{
+		Filter:   ccv2.ServiceGUIDFilter,
+		Operator: ccv2.EqualOperator,
+		Value:    services[0].GUID,
+	}})
+	allWarnings = append(allWarnings, warnings...)
+	if err != nil {
+		return ServicePlan{}, allWarnings, err
+	}
+
+	for _, plan := range plans {
+		if plan.Name == planName {
+			return ServicePlan(plan), allWarnings, nil
+		}
+	}
+
+	return ServicePlan{}, allWarnings, ServicePlanNotFoundError{PlanName: planName, ServiceName: serviceName}
+}
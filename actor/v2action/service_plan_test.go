@@ -0,0 +1,106 @@
+package v2action_test
+
+import (
+	"errors"
+
+	. "code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/actor/v2action/v2actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Service Plan Actions", func() {
+	var (
+		actor                     Actor
+		fakeCloudControllerClient *v2actionfakes.FakeCloudControllerClient
+	)
+
+	BeforeEach(func() {
+		fakeCloudControllerClient = new(v2actionfakes.FakeCloudControllerClient)
+		actor = NewActor(fakeCloudControllerClient, nil)
+	})
+
+	Describe("GetServicePlanByNameServiceAndSpace", func() {
+		var (
+			servicePlan ServicePlan
+			warnings    Warnings
+			err         error
+		)
+
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetSpaceServicesReturns(
+				[]ccv2.Service{{GUID: "some-service-guid", Label: "some-service"}},
+				ccv2.Warnings{"services-warning"},
+				nil,
+			)
+			fakeCloudControllerClient.GetServicePlansReturns(
+				[]ccv2.ServicePlan{
+					{GUID: "small-guid", Name: "small", ServiceGUID: "some-service-guid"},
+					{GUID: "large-guid", Name: "large", ServiceGUID: "some-service-guid"},
+				},
+				ccv2.Warnings{"plans-warning"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			servicePlan, warnings, err = actor.GetServicePlanByNameServiceAndSpace("large", "some-service", "some-space-guid")
+		})
+
+		It("returns the plan and all warnings", func() {
+			Expect(err).ToNot(HaveOccurred())
+			Expect(servicePlan).To(Equal(ServicePlan{GUID: "large-guid", Name: "large", ServiceGUID: "some-service-guid"}))
+			Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+
+			spaceGUID, queries := fakeCloudControllerClient.GetSpaceServicesArgsForCall(0)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+			Expect(queries).To(Equal([]ccv2.Query{{
+				Filter:   ccv2.LabelFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-service",
+			}}))
+			Expect(fakeCloudControllerClient.GetServicePlansArgsForCall(0)).To(Equal([]ccv2.Query{{
+				Filter:   ccv2.ServiceGUIDFilter,
+				Operator: ccv2.EqualOperator,
+				Value:    "some-service-guid",
+			}}))
+		})
+
+		Context("when the service is not available to the space", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetSpaceServicesReturns(nil, ccv2.Warnings{"services-warning"}, nil)
+			})
+
+			It("returns a ServiceNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(ServiceNotFoundError{Name: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning"))
+			})
+		})
+
+		Context("when the service does not offer the plan", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, nil)
+			})
+
+			It("returns a ServicePlanNotFoundError and warnings", func() {
+				Expect(err).To(MatchError(ServicePlanNotFoundError{PlanName: "large", ServiceName: "some-service"}))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+			})
+		})
+
+		Context("when listing the plans fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("plans error")
+				fakeCloudControllerClient.GetServicePlansReturns(nil, ccv2.Warnings{"plans-warning"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("services-warning", "plans-warning"))
+			})
+		})
+	})
+})
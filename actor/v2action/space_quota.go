@@ -11,9 +11,13 @@ type SpaceQuota ccv2.SpaceQuota
 
 type SpaceQuotaNotFoundError struct {
 	GUID string
+	Name string
 }
 
 func (e SpaceQuotaNotFoundError) Error() string {
+	if e.Name != "" {
+		return fmt.Sprintf("Space quota '%s' not found.", e.Name)
+	}
 	return fmt.Sprintf("Space quota with GUID '%s' not found.", e.GUID)
 }
 
@@ -26,3 +30,26 @@ func (actor Actor) GetSpaceQuota(guid string) (SpaceQuota, Warnings, error) {
 
 	return SpaceQuota(spaceQuota), Warnings(warnings), err
 }
+
+// GetSpaceQuotaByName returns the organization's space quota with the
+// provided name.
+func (actor Actor) GetSpaceQuotaByName(orgGUID string, name string) (SpaceQuota, Warnings, error) {
+	spaceQuotas, warnings, err := actor.CloudControllerClient.GetOrganizationSpaceQuotas(orgGUID)
+	if err != nil {
+		return SpaceQuota{}, Warnings(warnings), err
+	}
+
+	for _, spaceQuota := range spaceQuotas {
+		if spaceQuota.Name == name {
+			return SpaceQuota(spaceQuota), Warnings(warnings), nil
+		}
+	}
+
+	return SpaceQuota{}, Warnings(warnings), SpaceQuotaNotFoundError{Name: name}
+}
+
+// SetSpaceQuota assigns the space quota to the space.
+func (actor Actor) SetSpaceQuota(spaceGUID string, spaceQuotaGUID string) (Warnings, error) {
+	warnings, err := actor.CloudControllerClient.SetSpaceQuota(spaceQuotaGUID, spaceGUID)
+	return Warnings(warnings), err
+}
@@ -76,4 +76,66 @@ var _ = Describe("SpaceQuota Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetSpaceQuotaByName", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(
+				[]ccv2.SpaceQuota{
+					{GUID: "small-guid", Name: "small"},
+					{GUID: "large-guid", Name: "large"},
+				},
+				ccv2.Warnings{"warning-1"},
+				nil,
+			)
+		})
+
+		Context("when the organization has a space quota with the name", func() {
+			It("returns the space quota and warnings", func() {
+				spaceQuota, warnings, err := actor.GetSpaceQuotaByName("some-org-guid", "large")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(spaceQuota).To(Equal(SpaceQuota{GUID: "large-guid", Name: "large"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+				Expect(fakeCloudControllerClient.GetOrganizationSpaceQuotasArgsForCall(0)).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when the organization has no space quota with the name", func() {
+			It("returns a SpaceQuotaNotFoundError and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotaByName("some-org-guid", "medium")
+				Expect(err).To(MatchError(SpaceQuotaNotFoundError{Name: "medium"}))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+
+		Context("when listing the space quotas fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("list space quotas error")
+				fakeCloudControllerClient.GetOrganizationSpaceQuotasReturns(nil, ccv2.Warnings{"warning-1"}, expectedErr)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := actor.GetSpaceQuotaByName("some-org-guid", "large")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1"))
+			})
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		BeforeEach(func() {
+			fakeCloudControllerClient.SetSpaceQuotaReturns(ccv2.Warnings{"warning-1"}, nil)
+		})
+
+		It("assigns the space quota to the space", func() {
+			warnings, err := actor.SetSpaceQuota("some-space-guid", "some-space-quota-guid")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(warnings).To(ConsistOf("warning-1"))
+
+			spaceQuotaGUID, spaceGUID := fakeCloudControllerClient.SetSpaceQuotaArgsForCall(0)
+			Expect(spaceQuotaGUID).To(Equal("some-space-quota-guid"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
 })
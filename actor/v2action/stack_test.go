@@ -80,4 +80,77 @@ var _ = Describe("Stack Actions", func() {
 			})
 		})
 	})
+
+	Describe("GetStackByName", func() {
+		Context("when the CC API client does not return any errors", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					[]ccv2.Stack{
+						{
+							GUID:        "some-stack-guid",
+							Name:        "some-stack",
+							Description: "some stack description",
+						},
+					},
+					ccv2.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns the stack and all warnings", func() {
+				stack, warnings, err := actor.GetStackByName("some-stack")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stack).To(Equal(Stack{
+					GUID:        "some-stack-guid",
+					Name:        "some-stack",
+					Description: "some stack description",
+				}))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+
+				Expect(fakeCloudControllerClient.GetStacksCallCount()).To(Equal(1))
+				Expect(fakeCloudControllerClient.GetStacksArgsForCall(0)).To(Equal([]ccv2.Query{
+					{
+						Filter:   ccv2.NameFilter,
+						Operator: ccv2.EqualOperator,
+						Value:    "some-stack",
+					},
+				}))
+			})
+		})
+
+		Context("when the stack does not exist", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetStacksReturns(
+					nil,
+					ccv2.Warnings{"get-stacks-warning"},
+					nil,
+				)
+			})
+
+			It("returns a StackNotFoundError and all warnings", func() {
+				_, warnings, err := actor.GetStackByName("some-stack")
+				Expect(err).To(MatchError(StackNotFoundError{Name: "some-stack"}))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+
+		Context("when the CC API client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some get stacks error")
+				fakeCloudControllerClient.GetStacksReturns(
+					nil,
+					ccv2.Warnings{"get-stacks-warning"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				_, warnings, err := actor.GetStackByName("some-stack")
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("get-stacks-warning"))
+			})
+		})
+	})
 })
@@ -5,5 +5,6 @@ import "code.cloudfoundry.org/cli/api/uaa"
 //go:generate counterfeiter . UAAClient
 
 type UAAClient interface {
+	Authenticate(credentials map[string]string, grantType uaa.GrantType) (string, string, error)
 	CreateUser(username string, password string, origin string) (uaa.User, error)
 }
@@ -1,6 +1,7 @@
 package v3action
 
 import (
+	"io"
 	"net/url"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -29,5 +30,5 @@ type CloudControllerClient interface {
 	GetSpaceIsolationSegment(spaceGUID string) (ccv3.Relationship, ccv3.Warnings, error)
 	RevokeIsolationSegmentFromOrganization(isolationSegmentGUID string, organizationGUID string) (ccv3.Warnings, error)
 	UpdateTask(taskGUID string) (ccv3.Task, ccv3.Warnings, error)
-	UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
+	UploadPackage(pkg ccv3.Package, bits io.ReadSeeker, size int64) (ccv3.Package, ccv3.Warnings, error)
 }
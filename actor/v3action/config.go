@@ -6,4 +6,5 @@ import "time"
 
 type Config interface {
 	PollingInterval() time.Duration
+	UploadStateDirectory() string
 }
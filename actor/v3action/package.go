@@ -2,6 +2,8 @@ package v3action
 
 import (
 	"archive/zip"
+	"crypto/sha1"
+	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
@@ -9,9 +11,11 @@ import (
 	"runtime"
 	"time"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	"code.cloudfoundry.org/cli/cf/errors"
 	"code.cloudfoundry.org/gofileutils/fileutils"
+	log "github.com/Sirupsen/logrus"
 )
 
 type PackageProcessingFailedError struct{}
@@ -28,42 +32,85 @@ func (e PackageProcessingExpiredError) Error() string {
 
 type Package ccv3.Package
 
-func (actor Actor) CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (Package, Warnings, error) {
+// CreateAndUploadPackageByApplicationNameAndSpace zips the bits at bitsPath,
+// uploads them to a new package for the application and waits for the package
+// to finish processing. Progress of the upload is reported to progressBar.
+//
+// The package being uploaded to is recorded in the upload state directory
+// until it is ready. If the same bits are uploaded again after an interrupted
+// upload, the recorded package is reused: the bits are only sent if the
+// package is still awaiting them.
+func (actor Actor) CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, progressBar ProgressBar) (Package, Warnings, error) {
 	app, allWarnings, err := actor.GetApplicationByNameAndSpace(appName, spaceGUID)
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
 
-	inputPackage := ccv3.Package{
-		Type: ccv3.PackageTypeBits,
-		Relationships: ccv3.PackageRelationships{
-			Application: ccv3.Relationship{GUID: app.GUID},
-		},
-	}
-
 	tmpZipFilepath, err := ioutil.TempFile("", "cli-package-upload")
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
 	defer os.Remove(tmpZipFilepath.Name())
+	defer tmpZipFilepath.Close()
 
 	err = writeZipFile(bitsPath, tmpZipFilepath)
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
 
-	pkg, warnings, err := actor.CloudControllerClient.CreatePackage(inputPackage)
-	allWarnings = append(allWarnings, warnings...)
+	sha, size, err := fileSHA1AndSize(tmpZipFilepath)
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
 
-	_, warnings, err = actor.CloudControllerClient.UploadPackage(pkg, tmpZipFilepath.Name())
+	pkg, warnings, err := actor.resumablePackage(app.GUID, sha, size)
 	allWarnings = append(allWarnings, warnings...)
 	if err != nil {
 		return Package{}, allWarnings, err
 	}
 
+	if pkg.GUID == "" {
+		inputPackage := ccv3.Package{
+			Type: ccv3.PackageTypeBits,
+			Relationships: ccv3.PackageRelationships{
+				Application: ccv3.Relationship{GUID: app.GUID},
+			},
+		}
+
+		pkg, warnings, err = actor.CloudControllerClient.CreatePackage(inputPackage)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Package{}, allWarnings, err
+		}
+
+		err = actor.saveUploadState(uploadState{
+			AppGUID:     app.GUID,
+			PackageGUID: pkg.GUID,
+			SHA1:        sha,
+			Size:        size,
+		})
+		if err != nil {
+			log.Errorln("saving upload state:", err)
+		}
+	}
+
+	if pkg.State == ccv3.PackageStateAwaitingUpload {
+		_, err = tmpZipFilepath.Seek(0, io.SeekStart)
+		if err != nil {
+			return Package{}, allWarnings, err
+		}
+
+		reader := progressBar.NewProgressBarWrapper(tmpZipFilepath, size)
+		_, warnings, err = actor.CloudControllerClient.UploadPackage(pkg, reader, size)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Package{}, allWarnings, err
+		}
+		progressBar.Complete()
+	} else {
+		log.WithField("package_guid", pkg.GUID).Info("bits already uploaded, skipping upload")
+	}
+
 	for pkg.State != ccv3.PackageStateReady &&
 		pkg.State != ccv3.PackageStateFailed &&
 		pkg.State != ccv3.PackageStateExpired {
@@ -75,6 +122,8 @@ func (actor Actor) CreateAndUploadPackageByApplicationNameAndSpace(appName strin
 		}
 	}
 
+	actor.removeUploadState(app.GUID)
+
 	if pkg.State == ccv3.PackageStateFailed {
 		return Package{}, allWarnings, PackageProcessingFailedError{}
 	} else if pkg.State == ccv3.PackageStateExpired {
@@ -84,6 +133,49 @@ func (actor Actor) CreateAndUploadPackageByApplicationNameAndSpace(appName strin
 	return Package(pkg), allWarnings, err
 }
 
+// resumablePackage returns the package recorded for an earlier upload of the
+// same bits, if that package can still be used. An empty package is returned
+// when a new package needs to be created.
+func (actor Actor) resumablePackage(appGUID string, sha string, size int64) (ccv3.Package, ccv3.Warnings, error) {
+	state, ok := actor.loadUploadState(appGUID)
+	if !ok || state.SHA1 != sha || state.Size != size {
+		return ccv3.Package{}, nil, nil
+	}
+
+	pkg, warnings, err := actor.CloudControllerClient.GetPackage(state.PackageGUID)
+	if err != nil {
+		if _, isNotFound := err.(ccerror.ResourceNotFoundError); isNotFound {
+			actor.removeUploadState(appGUID)
+			return ccv3.Package{}, warnings, nil
+		}
+		return ccv3.Package{}, warnings, err
+	}
+
+	switch pkg.State {
+	case ccv3.PackageStateAwaitingUpload, ccv3.PackageStateProcessingUpload, ccv3.PackageStateReady:
+		log.WithField("package_guid", pkg.GUID).Info("resuming upload to existing package")
+		return pkg, warnings, nil
+	default:
+		actor.removeUploadState(appGUID)
+		return ccv3.Package{}, warnings, nil
+	}
+}
+
+func fileSHA1AndSize(file *os.File) (string, int64, error) {
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return "", 0, err
+	}
+
+	hash := sha1.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return "", 0, err
+	}
+
+	return fmt.Sprintf("%x", hash.Sum(nil)), size, nil
+}
+
 func writeZipFile(dir string, targetFile *os.File) error {
 	isEmpty, err := fileutils.IsDirEmpty(dir)
 	if err != nil {
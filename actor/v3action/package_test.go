@@ -2,7 +2,9 @@ package v3action_test
 
 import (
 	"archive/zip"
+	"bytes"
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/url"
 	"os"
@@ -10,6 +12,7 @@ import (
 
 	. "code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 
 	. "github.com/onsi/ginkgo"
@@ -32,12 +35,28 @@ var _ = Describe("Package Actions", func() {
 		actor                     Actor
 		fakeCloudControllerClient *v3actionfakes.FakeCloudControllerClient
 		fakeConfig                *v3actionfakes.FakeConfig
+		fakeProgressBar           *v3actionfakes.FakeProgressBar
+		uploadStateDir            string
 	)
 
 	BeforeEach(func() {
 		fakeCloudControllerClient = new(v3actionfakes.FakeCloudControllerClient)
 		fakeConfig = new(v3actionfakes.FakeConfig)
 		actor = NewActor(fakeCloudControllerClient, fakeConfig)
+
+		var err error
+		uploadStateDir, err = ioutil.TempDir("", "upload-state")
+		Expect(err).ToNot(HaveOccurred())
+		fakeConfig.UploadStateDirectoryReturns(uploadStateDir)
+
+		fakeProgressBar = new(v3actionfakes.FakeProgressBar)
+		fakeProgressBar.NewProgressBarWrapperStub = func(reader io.ReadSeeker, _ int64) io.ReadSeeker {
+			return reader
+		}
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(uploadStateDir)
 	})
 
 	Describe("CreateAndUploadPackageByApplicationNameAndSpace", func() {
@@ -124,9 +143,13 @@ var _ = Describe("Package Actions", func() {
 							})
 
 							It("correctly constructs the zip", func() {
-								fakeCloudControllerClient.UploadPackageStub = func(pkg ccv3.Package, zipFilePart string) (ccv3.Package, ccv3.Warnings, error) {
+								fakeCloudControllerClient.UploadPackageStub = func(pkg ccv3.Package, bits io.ReadSeeker, size int64) (ccv3.Package, ccv3.Warnings, error) {
+									raw, err := ioutil.ReadAll(bits)
+									Expect(err).ToNot(HaveOccurred())
+									Expect(raw).To(HaveLen(int(size)))
+
 									filestats := map[string]int64{}
-									reader, err := zip.OpenReader(zipFilePart)
+									reader, err := zip.NewReader(bytes.NewReader(raw), size)
 									Expect(err).ToNot(HaveOccurred())
 
 									for _, file := range reader.File {
@@ -137,19 +160,19 @@ var _ = Describe("Package Actions", func() {
 
 									return ccv3.Package{}, nil, nil
 								}
-								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 								Expect(err).NotTo(HaveOccurred())
 								Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(1))
 							})
 
 							It("collects all warnings", func() {
-								_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+								_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 								Expect(err).NotTo(HaveOccurred())
 								Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning", "some-get-pkg-warning"))
 							})
 
 							It("successfully resolves the app name", func() {
-								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 								Expect(err).ToNot(HaveOccurred())
 
 								Expect(fakeCloudControllerClient.GetApplicationsCallCount()).To(Equal(1))
@@ -162,7 +185,7 @@ var _ = Describe("Package Actions", func() {
 							})
 
 							It("successfully creates the Package", func() {
-								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 								Expect(err).ToNot(HaveOccurred())
 
 								Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(1))
@@ -176,7 +199,7 @@ var _ = Describe("Package Actions", func() {
 							})
 
 							It("returns the package", func() {
-								pkg, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+								pkg, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 								Expect(err).ToNot(HaveOccurred())
 
 								expectedPackage := ccv3.Package{
@@ -189,6 +212,25 @@ var _ = Describe("Package Actions", func() {
 								Expect(fakeCloudControllerClient.GetPackageArgsForCall(0)).To(Equal("some-pkg-guid"))
 							})
 
+							It("reports the upload progress", func() {
+								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
+								Expect(err).ToNot(HaveOccurred())
+
+								Expect(fakeProgressBar.NewProgressBarWrapperCallCount()).To(Equal(1))
+								_, size := fakeProgressBar.NewProgressBarWrapperArgsForCall(0)
+								_, _, uploadSize := fakeCloudControllerClient.UploadPackageArgsForCall(0)
+								Expect(size).To(Equal(uploadSize))
+								Expect(fakeProgressBar.CompleteCallCount()).To(Equal(1))
+							})
+
+							It("removes the upload state once the package is ready", func() {
+								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
+								Expect(err).ToNot(HaveOccurred())
+
+								_, err = os.Stat(filepath.Join(uploadStateDir, "some-app-guid.json"))
+								Expect(os.IsNotExist(err)).To(BeTrue())
+							})
+
 							DescribeTable("polls until terminal state is reached",
 								func(finalState ccv3.PackageState, expectedErr error) {
 									fakeCloudControllerClient.GetPackageReturns(
@@ -203,7 +245,7 @@ var _ = Describe("Package Actions", func() {
 										nil,
 									)
 
-									_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+									_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 
 									if expectedErr == nil {
 										Expect(err).ToNot(HaveOccurred())
@@ -235,7 +277,7 @@ var _ = Describe("Package Actions", func() {
 							})
 
 							It("returns the error and warnings", func() {
-								_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+								_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 								Expect(err).To(MatchError(expectedErr))
 								Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning", "some-get-pkg-warning"))
 							})
@@ -251,9 +293,70 @@ var _ = Describe("Package Actions", func() {
 						})
 
 						It("returns the warnings and the error", func() {
-							_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+							_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 							Expect(err).To(MatchError(expectedErr))
 							Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning", "some-upload-pkg-warning"))
+							Expect(fakeProgressBar.CompleteCallCount()).To(Equal(0))
+						})
+
+						Context("when the same bits are uploaded again", func() {
+							BeforeEach(func() {
+								_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
+								Expect(err).To(MatchError(expectedErr))
+								fakeCloudControllerClient.UploadPackageReturns(ccv3.Package{}, ccv3.Warnings{"some-upload-pkg-warning"}, nil)
+							})
+
+							Context("when the recorded package is still awaiting upload", func() {
+								BeforeEach(func() {
+									fakeCloudControllerClient.GetPackageReturnsOnCall(0, createdPackage, ccv3.Warnings{"some-resume-pkg-warning"}, nil)
+									fakeCloudControllerClient.GetPackageReturnsOnCall(1, ccv3.Package{GUID: "some-pkg-guid", State: ccv3.PackageStateReady}, nil, nil)
+								})
+
+								It("uploads to the recorded package instead of creating a new one", func() {
+									pkg, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
+									Expect(err).ToNot(HaveOccurred())
+									Expect(pkg.State).To(Equal(ccv3.PackageStateReady))
+									Expect(warnings).To(ContainElement("some-resume-pkg-warning"))
+
+									Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(1))
+									Expect(fakeCloudControllerClient.GetPackageArgsForCall(0)).To(Equal("some-pkg-guid"))
+									Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(2))
+									uploadedPkg, _, _ := fakeCloudControllerClient.UploadPackageArgsForCall(1)
+									Expect(uploadedPkg.GUID).To(Equal("some-pkg-guid"))
+								})
+							})
+
+							Context("when the recorded package has already received the bits", func() {
+								BeforeEach(func() {
+									fakeCloudControllerClient.GetPackageReturnsOnCall(0, ccv3.Package{GUID: "some-pkg-guid", State: ccv3.PackageStateProcessingUpload}, nil, nil)
+									fakeCloudControllerClient.GetPackageReturnsOnCall(1, ccv3.Package{GUID: "some-pkg-guid", State: ccv3.PackageStateReady}, nil, nil)
+								})
+
+								It("skips the upload and waits for the package", func() {
+									pkg, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
+									Expect(err).ToNot(HaveOccurred())
+									Expect(pkg.State).To(Equal(ccv3.PackageStateReady))
+
+									Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(1))
+									Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(1))
+									Expect(fakeCloudControllerClient.GetPackageCallCount()).To(Equal(2))
+								})
+							})
+
+							Context("when the recorded package no longer exists", func() {
+								BeforeEach(func() {
+									fakeCloudControllerClient.GetPackageReturnsOnCall(0, ccv3.Package{}, nil, ccerror.ResourceNotFoundError{})
+									fakeCloudControllerClient.GetPackageReturnsOnCall(1, ccv3.Package{GUID: "some-pkg-guid", State: ccv3.PackageStateReady}, nil, nil)
+								})
+
+								It("creates a new package", func() {
+									_, _, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
+									Expect(err).ToNot(HaveOccurred())
+
+									Expect(fakeCloudControllerClient.CreatePackageCallCount()).To(Equal(2))
+									Expect(fakeCloudControllerClient.UploadPackageCallCount()).To(Equal(2))
+								})
+							})
 						})
 					})
 				})
@@ -271,7 +374,7 @@ var _ = Describe("Package Actions", func() {
 					})
 
 					It("returns the warnings and the error", func() {
-						_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath)
+						_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", bitsPath, fakeProgressBar)
 						Expect(err).To(MatchError(expectedErr))
 						Expect(warnings).To(ConsistOf("some-app-warning", "some-pkg-warning"))
 					})
@@ -280,7 +383,7 @@ var _ = Describe("Package Actions", func() {
 
 			Context("when creating the zip errors", func() {
 				It("returns the warnings and the error", func() {
-					_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "/banana")
+					_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "/banana", fakeProgressBar)
 					// Windows returns back a different error message
 					Expect(err.Error()).To(MatchRegexp("open /banana: no such file or directory|The system cannot find the file specified"))
 					Expect(warnings).To(ConsistOf("some-app-warning"))
@@ -300,7 +403,7 @@ var _ = Describe("Package Actions", func() {
 			})
 
 			It("returns the warnings and the error", func() {
-				_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "some-path")
+				_, warnings, err := actor.CreateAndUploadPackageByApplicationNameAndSpace("some-app-name", "some-space-guid", "some-path", fakeProgressBar)
 				Expect(err).To(MatchError(expectedErr))
 				Expect(warnings).To(ConsistOf("some-warning"))
 			})
@@ -0,0 +1,14 @@
+package v3action
+
+import "io"
+
+//go:generate counterfeiter . ProgressBar
+
+// ProgressBar reports the progress of reading bits while they are uploaded.
+type ProgressBar interface {
+	// NewProgressBarWrapper returns a reader that reports progress as the
+	// given reader is read. Rewinding the returned reader resets the progress.
+	NewProgressBarWrapper(reader io.ReadSeeker, size int64) io.ReadSeeker
+	// Complete marks the upload as finished.
+	Complete()
+}
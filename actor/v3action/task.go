@@ -3,9 +3,9 @@ package v3action
 import (
 	"fmt"
 	"net/url"
-	"strconv"
-
 	"sort"
+	"strconv"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
@@ -33,6 +33,17 @@ func (e TaskNotFoundError) Error() string {
 	return fmt.Sprintf("Task sequence ID %d not found.", e.SequenceID)
 }
 
+// TaskFailedError is returned when a task finishes in the FAILED state.
+type TaskFailedError struct {
+	Name       string
+	SequenceID int
+	Reason     string
+}
+
+func (e TaskFailedError) Error() string {
+	return fmt.Sprintf("Task %d (%s) failed: %s", e.SequenceID, e.Name, e.Reason)
+}
+
 // RunTask runs the provided command in the application environment associated
 // with the provided application GUID.
 func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
@@ -49,8 +60,19 @@ func (actor Actor) RunTask(appGUID string, task Task) (Task, Warnings, error) {
 // GetApplicationTasks returns a list of tasks associated with the provided
 // appplication GUID.
 func (actor Actor) GetApplicationTasks(appGUID string, sortOrder SortOrder) ([]Task, Warnings, error) {
-	query := url.Values{}
+	return actor.getApplicationTasks(appGUID, url.Values{}, sortOrder)
+}
+
+// GetApplicationTasksByName returns the tasks with the provided name that are
+// associated with the provided application GUID.
+func (actor Actor) GetApplicationTasksByName(appGUID string, name string, sortOrder SortOrder) ([]Task, Warnings, error) {
+	query := url.Values{
+		ccv3.NameFilter: []string{name},
+	}
+	return actor.getApplicationTasks(appGUID, query, sortOrder)
+}
 
+func (actor Actor) getApplicationTasks(appGUID string, query url.Values, sortOrder SortOrder) ([]Task, Warnings, error) {
 	tasks, warnings, err := actor.CloudControllerClient.GetApplicationTasks(appGUID, query)
 	actorWarnings := Warnings(warnings)
 	if err != nil {
@@ -88,6 +110,33 @@ func (actor Actor) GetTaskBySequenceIDAndApplication(sequenceID int, appGUID str
 	return Task(tasks[0]), Warnings(warnings), nil
 }
 
+// PollTask waits for the task to finish, checking its state every polling
+// interval. It returns the finished task, or a TaskFailedError if the task
+// failed.
+func (actor Actor) PollTask(appGUID string, task Task) (Task, Warnings, error) {
+	var allWarnings Warnings
+	for {
+		currentTask, warnings, err := actor.GetTaskBySequenceIDAndApplication(task.SequenceID, appGUID)
+		allWarnings = append(allWarnings, warnings...)
+		if err != nil {
+			return Task{}, allWarnings, err
+		}
+
+		switch currentTask.State {
+		case ccv3.TaskStateSucceeded:
+			return currentTask, allWarnings, nil
+		case ccv3.TaskStateFailed:
+			failedErr := TaskFailedError{Name: currentTask.Name, SequenceID: currentTask.SequenceID}
+			if currentTask.Result != nil {
+				failedErr.Reason = currentTask.Result.FailureReason
+			}
+			return currentTask, allWarnings, failedErr
+		}
+
+		time.Sleep(actor.Config.PollingInterval())
+	}
+}
+
 func (actor Actor) TerminateTask(taskGUID string) (Task, Warnings, error) {
 	task, warnings, err := actor.CloudControllerClient.UpdateTask(taskGUID)
 	return Task(task), Warnings(warnings), err
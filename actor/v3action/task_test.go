@@ -202,6 +202,65 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("GetApplicationTasksByName", func() {
+		var (
+			tasks      []Task
+			warnings   Warnings
+			executeErr error
+		)
+
+		JustBeforeEach(func() {
+			tasks, warnings, executeErr = actor.GetApplicationTasksByName("some-app-guid", "some-task-name", Descending)
+		})
+
+		Context("when the cloud controller client does not return an error", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					[]ccv3.Task{
+						{GUID: "task-1-guid", SequenceID: 1, Name: "some-task-name"},
+						{GUID: "task-2-guid", SequenceID: 2, Name: "some-task-name"},
+					},
+					ccv3.Warnings{"warning-1", "warning-2"},
+					nil,
+				)
+			})
+
+			It("returns the tasks with the name, sorted, and all warnings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+				Expect(tasks).To(Equal([]Task{
+					{GUID: "task-2-guid", SequenceID: 2, Name: "some-task-name"},
+					{GUID: "task-1-guid", SequenceID: 1, Name: "some-task-name"},
+				}))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(1))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(0)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{
+					ccv3.NameFilter: []string{"some-task-name"},
+				}))
+			})
+		})
+
+		Context("when the cloud controller client returns an error", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("I am a CloudControllerClient Error")
+				fakeCloudControllerClient.GetApplicationTasksReturns(
+					nil,
+					ccv3.Warnings{"warning-1", "warning-2"},
+					expectedErr,
+				)
+			})
+
+			It("returns the same error and all warnings", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("warning-1", "warning-2"))
+			})
+		})
+	})
+
 	Describe("GetTaskBySequenceIDAndApplication", func() {
 		Context("when the cloud controller client does not return an error", func() {
 			Context("when the task is found", func() {
@@ -264,6 +323,100 @@ var _ = Describe("Task Actions", func() {
 		})
 	})
 
+	Describe("PollTask", func() {
+		var (
+			fakeConfig *v3actionfakes.FakeConfig
+
+			task     Task
+			warnings Warnings
+			err      error
+		)
+
+		BeforeEach(func() {
+			fakeConfig = new(v3actionfakes.FakeConfig)
+			actor = NewActor(fakeCloudControllerClient, fakeConfig)
+
+			fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(0,
+				[]ccv3.Task{{GUID: "task-guid", SequenceID: 3, Name: "some-task", State: "PENDING"}},
+				ccv3.Warnings{"poll-warning-1"},
+				nil,
+			)
+			fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(1,
+				[]ccv3.Task{{GUID: "task-guid", SequenceID: 3, Name: "some-task", State: "RUNNING"}},
+				ccv3.Warnings{"poll-warning-2"},
+				nil,
+			)
+		})
+
+		JustBeforeEach(func() {
+			task, warnings, err = actor.PollTask("some-app-guid", Task{GUID: "task-guid", SequenceID: 3})
+		})
+
+		Context("when the task succeeds", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(2,
+					[]ccv3.Task{{GUID: "task-guid", SequenceID: 3, Name: "some-task", State: "SUCCEEDED"}},
+					ccv3.Warnings{"poll-warning-3"},
+					nil,
+				)
+			})
+
+			It("polls the task by sequence ID until it finishes", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(task.State).To(Equal("SUCCEEDED"))
+				Expect(warnings).To(ConsistOf("poll-warning-1", "poll-warning-2", "poll-warning-3"))
+
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(3))
+				appGUID, query := fakeCloudControllerClient.GetApplicationTasksArgsForCall(2)
+				Expect(appGUID).To(Equal("some-app-guid"))
+				Expect(query).To(Equal(url.Values{"sequence_ids": []string{"3"}}))
+
+				Expect(fakeConfig.PollingIntervalCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when the task fails", func() {
+			BeforeEach(func() {
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(2,
+					[]ccv3.Task{{
+						GUID:       "task-guid",
+						SequenceID: 3,
+						Name:       "some-task",
+						State:      "FAILED",
+						Result:     &ccv3.TaskResult{FailureReason: "Exited with status 1"},
+					}},
+					ccv3.Warnings{"poll-warning-3"},
+					nil,
+				)
+			})
+
+			It("returns a TaskFailedError with the failure reason", func() {
+				Expect(err).To(MatchError(TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"}))
+				Expect(task.State).To(Equal("FAILED"))
+				Expect(warnings).To(ConsistOf("poll-warning-1", "poll-warning-2", "poll-warning-3"))
+			})
+		})
+
+		Context("when getting the task fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("poll-error")
+				fakeCloudControllerClient.GetApplicationTasksReturnsOnCall(1,
+					nil,
+					ccv3.Warnings{"poll-warning-2"},
+					expectedErr,
+				)
+			})
+
+			It("returns the error and all warnings", func() {
+				Expect(err).To(MatchError(expectedErr))
+				Expect(warnings).To(ConsistOf("poll-warning-1", "poll-warning-2"))
+				Expect(fakeCloudControllerClient.GetApplicationTasksCallCount()).To(Equal(2))
+			})
+		})
+	})
+
 	Describe("TerminateTask", func() {
 		Context("when the task exists", func() {
 			var returnedTask ccv3.Task
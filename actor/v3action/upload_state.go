@@ -0,0 +1,88 @@
+package v3action
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	log "github.com/Sirupsen/logrus"
+)
+
+// uploadState records the package that the bits of an application are being
+// uploaded to, so that an interrupted upload can reuse the package instead of
+// creating a new one.
+type uploadState struct {
+	AppGUID     string `json:"app_guid"`
+	PackageGUID string `json:"package_guid"`
+	SHA1        string `json:"sha1"`
+	Size        int64  `json:"size"`
+}
+
+// uploadStatePath returns the location of the upload state for the given
+// application, or an empty string when no upload state directory is
+// configured.
+func (actor Actor) uploadStatePath(appGUID string) string {
+	dir := actor.Config.UploadStateDirectory()
+	if dir == "" {
+		return ""
+	}
+	return filepath.Join(dir, appGUID+".json")
+}
+
+// loadUploadState returns the recorded upload state for the given
+// application. A missing or unreadable state is treated as no state.
+func (actor Actor) loadUploadState(appGUID string) (uploadState, bool) {
+	path := actor.uploadStatePath(appGUID)
+	if path == "" {
+		return uploadState{}, false
+	}
+
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			log.WithField("path", path).Errorln("reading upload state:", err)
+		}
+		return uploadState{}, false
+	}
+
+	var state uploadState
+	err = json.Unmarshal(raw, &state)
+	if err != nil || state.AppGUID != appGUID {
+		log.WithField("path", path).Errorln("ignoring invalid upload state:", err)
+		return uploadState{}, false
+	}
+
+	return state, true
+}
+
+func (actor Actor) saveUploadState(state uploadState) error {
+	path := actor.uploadStatePath(state.AppGUID)
+	if path == "" {
+		return nil
+	}
+
+	raw, err := json.Marshal(state)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return err
+	}
+
+	return ioutil.WriteFile(path, raw, 0600)
+}
+
+func (actor Actor) removeUploadState(appGUID string) {
+	path := actor.uploadStatePath(appGUID)
+	if path == "" {
+		return
+	}
+
+	err := os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		log.WithField("path", path).Errorln("removing upload state:", err)
+	}
+}
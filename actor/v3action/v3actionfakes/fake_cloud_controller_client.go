@@ -2,6 +2,7 @@
 package v3actionfakes
 
 import (
+	"io"
 	"net/url"
 	"sync"
 
@@ -290,11 +291,12 @@ type FakeCloudControllerClient struct {
 		result2 ccv3.Warnings
 		result3 error
 	}
-	UploadPackageStub        func(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error)
+	UploadPackageStub        func(pkg ccv3.Package, bits io.ReadSeeker, size int64) (ccv3.Package, ccv3.Warnings, error)
 	uploadPackageMutex       sync.RWMutex
 	uploadPackageArgsForCall []struct {
-		pkg         ccv3.Package
-		zipFilepath string
+		pkg  ccv3.Package
+		bits io.ReadSeeker
+		size int64
 	}
 	uploadPackageReturns struct {
 		result1 ccv3.Package
@@ -1326,17 +1328,18 @@ func (fake *FakeCloudControllerClient) UpdateTaskReturnsOnCall(i int, result1 cc
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCloudControllerClient) UploadPackage(pkg ccv3.Package, zipFilepath string) (ccv3.Package, ccv3.Warnings, error) {
+func (fake *FakeCloudControllerClient) UploadPackage(pkg ccv3.Package, bits io.ReadSeeker, size int64) (ccv3.Package, ccv3.Warnings, error) {
 	fake.uploadPackageMutex.Lock()
 	ret, specificReturn := fake.uploadPackageReturnsOnCall[len(fake.uploadPackageArgsForCall)]
 	fake.uploadPackageArgsForCall = append(fake.uploadPackageArgsForCall, struct {
-		pkg         ccv3.Package
-		zipFilepath string
-	}{pkg, zipFilepath})
-	fake.recordInvocation("UploadPackage", []interface{}{pkg, zipFilepath})
+		pkg  ccv3.Package
+		bits io.ReadSeeker
+		size int64
+	}{pkg, bits, size})
+	fake.recordInvocation("UploadPackage", []interface{}{pkg, bits, size})
 	fake.uploadPackageMutex.Unlock()
 	if fake.UploadPackageStub != nil {
-		return fake.UploadPackageStub(pkg, zipFilepath)
+		return fake.UploadPackageStub(pkg, bits, size)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -1350,10 +1353,10 @@ func (fake *FakeCloudControllerClient) UploadPackageCallCount() int {
 	return len(fake.uploadPackageArgsForCall)
 }
 
-func (fake *FakeCloudControllerClient) UploadPackageArgsForCall(i int) (ccv3.Package, string) {
+func (fake *FakeCloudControllerClient) UploadPackageArgsForCall(i int) (ccv3.Package, io.ReadSeeker, int64) {
 	fake.uploadPackageMutex.RLock()
 	defer fake.uploadPackageMutex.RUnlock()
-	return fake.uploadPackageArgsForCall[i].pkg, fake.uploadPackageArgsForCall[i].zipFilepath
+	return fake.uploadPackageArgsForCall[i].pkg, fake.uploadPackageArgsForCall[i].bits, fake.uploadPackageArgsForCall[i].size
 }
 
 func (fake *FakeCloudControllerClient) UploadPackageReturns(result1 ccv3.Package, result2 ccv3.Warnings, result3 error) {
@@ -18,6 +18,15 @@ type FakeConfig struct {
 	pollingIntervalReturnsOnCall map[int]struct {
 		result1 time.Duration
 	}
+	UploadStateDirectoryStub        func() string
+	uploadStateDirectoryMutex       sync.RWMutex
+	uploadStateDirectoryArgsForCall []struct{}
+	uploadStateDirectoryReturns     struct {
+		result1 string
+	}
+	uploadStateDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -62,11 +71,53 @@ func (fake *FakeConfig) PollingIntervalReturnsOnCall(i int, result1 time.Duratio
 	}{result1}
 }
 
+func (fake *FakeConfig) UploadStateDirectory() string {
+	fake.uploadStateDirectoryMutex.Lock()
+	ret, specificReturn := fake.uploadStateDirectoryReturnsOnCall[len(fake.uploadStateDirectoryArgsForCall)]
+	fake.uploadStateDirectoryArgsForCall = append(fake.uploadStateDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("UploadStateDirectory", []interface{}{})
+	fake.uploadStateDirectoryMutex.Unlock()
+	if fake.UploadStateDirectoryStub != nil {
+		return fake.UploadStateDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uploadStateDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) UploadStateDirectoryCallCount() int {
+	fake.uploadStateDirectoryMutex.RLock()
+	defer fake.uploadStateDirectoryMutex.RUnlock()
+	return len(fake.uploadStateDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) UploadStateDirectoryReturns(result1 string) {
+	fake.UploadStateDirectoryStub = nil
+	fake.uploadStateDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UploadStateDirectoryReturnsOnCall(i int, result1 string) {
+	fake.UploadStateDirectoryStub = nil
+	if fake.uploadStateDirectoryReturnsOnCall == nil {
+		fake.uploadStateDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uploadStateDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
 	fake.pollingIntervalMutex.RLock()
 	defer fake.pollingIntervalMutex.RUnlock()
+	fake.uploadStateDirectoryMutex.RLock()
+	defer fake.uploadStateDirectoryMutex.RUnlock()
 	return fake.invocations
 }
 
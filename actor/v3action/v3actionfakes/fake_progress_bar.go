@@ -0,0 +1,118 @@
+// This file was generated by counterfeiter
+package v3actionfakes
+
+import (
+	"io"
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v3action"
+)
+
+type FakeProgressBar struct {
+	NewProgressBarWrapperStub        func(reader io.ReadSeeker, size int64) io.ReadSeeker
+	newProgressBarWrapperMutex       sync.RWMutex
+	newProgressBarWrapperArgsForCall []struct {
+		reader io.ReadSeeker
+		size   int64
+	}
+	newProgressBarWrapperReturns struct {
+		result1 io.ReadSeeker
+	}
+	newProgressBarWrapperReturnsOnCall map[int]struct {
+		result1 io.ReadSeeker
+	}
+	CompleteStub        func()
+	completeMutex       sync.RWMutex
+	completeArgsForCall []struct{}
+	invocations         map[string][][]interface{}
+	invocationsMutex    sync.RWMutex
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapper(reader io.ReadSeeker, size int64) io.ReadSeeker {
+	fake.newProgressBarWrapperMutex.Lock()
+	ret, specificReturn := fake.newProgressBarWrapperReturnsOnCall[len(fake.newProgressBarWrapperArgsForCall)]
+	fake.newProgressBarWrapperArgsForCall = append(fake.newProgressBarWrapperArgsForCall, struct {
+		reader io.ReadSeeker
+		size   int64
+	}{reader, size})
+	fake.recordInvocation("NewProgressBarWrapper", []interface{}{reader, size})
+	fake.newProgressBarWrapperMutex.Unlock()
+	if fake.NewProgressBarWrapperStub != nil {
+		return fake.NewProgressBarWrapperStub(reader, size)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.newProgressBarWrapperReturns.result1
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperCallCount() int {
+	fake.newProgressBarWrapperMutex.RLock()
+	defer fake.newProgressBarWrapperMutex.RUnlock()
+	return len(fake.newProgressBarWrapperArgsForCall)
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperArgsForCall(i int) (io.ReadSeeker, int64) {
+	fake.newProgressBarWrapperMutex.RLock()
+	defer fake.newProgressBarWrapperMutex.RUnlock()
+	return fake.newProgressBarWrapperArgsForCall[i].reader, fake.newProgressBarWrapperArgsForCall[i].size
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperReturns(result1 io.ReadSeeker) {
+	fake.NewProgressBarWrapperStub = nil
+	fake.newProgressBarWrapperReturns = struct {
+		result1 io.ReadSeeker
+	}{result1}
+}
+
+func (fake *FakeProgressBar) NewProgressBarWrapperReturnsOnCall(i int, result1 io.ReadSeeker) {
+	fake.NewProgressBarWrapperStub = nil
+	if fake.newProgressBarWrapperReturnsOnCall == nil {
+		fake.newProgressBarWrapperReturnsOnCall = make(map[int]struct {
+			result1 io.ReadSeeker
+		})
+	}
+	fake.newProgressBarWrapperReturnsOnCall[i] = struct {
+		result1 io.ReadSeeker
+	}{result1}
+}
+
+func (fake *FakeProgressBar) Complete() {
+	fake.completeMutex.Lock()
+	fake.completeArgsForCall = append(fake.completeArgsForCall, struct{}{})
+	fake.recordInvocation("Complete", []interface{}{})
+	fake.completeMutex.Unlock()
+	if fake.CompleteStub != nil {
+		fake.CompleteStub()
+	}
+}
+
+func (fake *FakeProgressBar) CompleteCallCount() int {
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return len(fake.completeArgsForCall)
+}
+
+func (fake *FakeProgressBar) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.newProgressBarWrapperMutex.RLock()
+	defer fake.newProgressBarWrapperMutex.RUnlock()
+	fake.completeMutex.RLock()
+	defer fake.completeMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeProgressBar) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v3action.ProgressBar = new(FakeProgressBar)
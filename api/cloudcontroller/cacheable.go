@@ -0,0 +1,23 @@
+package cloudcontroller
+
+import (
+	"context"
+	"net/http"
+)
+
+type cacheableKey struct{}
+
+// MarkCacheable returns a copy of the request that is flagged as safe to
+// answer from the response cache. Only list requests are flagged; the
+// resources that are polled while waiting on an operation, such as jobs and
+// application instances, are expected to change between requests.
+func MarkCacheable(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), cacheableKey{}, true))
+}
+
+// IsCacheable returns true if the request has been flagged with
+// MarkCacheable.
+func IsCacheable(request *http.Request) bool {
+	marked, _ := request.Context().Value(cacheableKey{}).(bool)
+	return marked
+}
@@ -3,11 +3,13 @@ package ccv2
 import (
 	"bytes"
 	"encoding/json"
+	"net/url"
 	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+	"code.cloudfoundry.org/cli/types"
 )
 
 // ApplicationState is the running state of an application.
@@ -31,56 +33,119 @@ const (
 // Application represents a Cloud Controller Application.
 type Application struct {
 	// Buildpack is the buildpack set by the user.
-	Buildpack string `json:"buildpack,omitempty"`
+	Buildpack types.FilteredString
+
+	// Command is the user specified start command.
+	Command types.FilteredString
 
 	// DetectedBuildpack is the buildpack automatically detected.
-	DetectedBuildpack string `json:"-"`
+	DetectedBuildpack string
 
 	// DetectedStartCommand is the command used to start the application.
-	DetectedStartCommand string `json:"-"`
+	DetectedStartCommand string
 
 	// DiskQuota is the disk given to each instance, in megabytes.
-	DiskQuota int `json:"-"`
+	DiskQuota int
 
-	// GUID is the unique application identifier.
-	GUID string `json:"guid,omitempty"`
+	// DockerImage is the docker image location.
+	DockerImage string
 
-	// HealthCheckType is the type of health check that will be done to the app.
-	HealthCheckType string `json:"health_check_type,omitempty"`
+	// EnvironmentVariables are the user provided environment variables. Values
+	// keep the JSON types they were given, such as numbers and objects.
+	EnvironmentVariables map[string]interface{}
+
+	// GUID is the unique application identifier.
+	GUID string
 
 	// HealthCheckHTTPEndpoint is the url of the http health check endpoint.
-	HealthCheckHTTPEndpoint string `json:"health_check_http_endpoint,omitempty"`
+	HealthCheckHTTPEndpoint string
+
+	// HealthCheckTimeout is the number of seconds for health checking of an
+	// staged app when starting up.
+	HealthCheckTimeout int
+
+	// HealthCheckType is the type of health check that will be done to the app.
+	HealthCheckType string
 
 	// Instances is the total number of app instances.
-	Instances int `json:"-"`
+	Instances types.NullInt
 
 	// Memory is the memory given to each instance, in megabytes.
-	Memory int `json:"-"`
+	Memory int
 
 	// Name is the name given to the application.
-	Name string `json:"name,omitempty"`
+	Name string
 
 	// PackageState represents the staging state of the application bits.
-	PackageState ApplicationPackageState `json:"-"`
+	PackageState ApplicationPackageState
 
 	// PackageUpdatedAt is the last time the app bits were updated. In RFC3339.
-	PackageUpdatedAt time.Time `json:"-"`
+	PackageUpdatedAt time.Time
 
 	// SpaceGUID is the GUID of the app's space.
-	SpaceGUID string `json:"space_guid,omitempty"`
+	SpaceGUID string
 
 	// StackGUID is the GUID for the Stack the application is running on.
-	StackGUID string `json:"-"`
+	StackGUID string
 
 	// StagingFailedDescription is the verbose description of why the package
 	// failed to stage.
-	StagingFailedDescription string `json:"-"`
+	StagingFailedDescription string
 
 	// StagingFailedReason is the reason why the package failed to stage.
-	StagingFailedReason string `json:"-"`
+	StagingFailedReason string
 
 	// State is the desired state of the application.
-	State ApplicationState `json:"state,omitempty"`
+	State ApplicationState
+}
+
+// MarshalJSON converts an application into a Cloud Controller Application.
+// Only the fields that have been set are included in the request body.
+func (application Application) MarshalJSON() ([]byte, error) {
+	ccApp := struct {
+		Buildpack               *string                `json:"buildpack,omitempty"`
+		Command                 *string                `json:"command,omitempty"`
+		DiskQuota               int                    `json:"disk_quota,omitempty"`
+		DockerImage             string                 `json:"docker_image,omitempty"`
+		EnvironmentVariables    map[string]interface{} `json:"environment_json,omitempty"`
+		GUID                    string                 `json:"guid,omitempty"`
+		HealthCheckHTTPEndpoint string                 `json:"health_check_http_endpoint,omitempty"`
+		HealthCheckTimeout      int                    `json:"health_check_timeout,omitempty"`
+		HealthCheckType         string                 `json:"health_check_type,omitempty"`
+		Instances               *int                   `json:"instances,omitempty"`
+		Memory                  int                    `json:"memory,omitempty"`
+		Name                    string                 `json:"name,omitempty"`
+		SpaceGUID               string                 `json:"space_guid,omitempty"`
+		StackGUID               string                 `json:"stack_guid,omitempty"`
+		State                   ApplicationState       `json:"state,omitempty"`
+	}{
+		DiskQuota:               application.DiskQuota,
+		DockerImage:             application.DockerImage,
+		EnvironmentVariables:    application.EnvironmentVariables,
+		GUID:                    application.GUID,
+		HealthCheckHTTPEndpoint: application.HealthCheckHTTPEndpoint,
+		HealthCheckTimeout:      application.HealthCheckTimeout,
+		HealthCheckType:         application.HealthCheckType,
+		Memory:                  application.Memory,
+		Name:                    application.Name,
+		SpaceGUID:               application.SpaceGUID,
+		StackGUID:               application.StackGUID,
+		State:                   application.State,
+	}
+
+	if application.Buildpack.IsSet {
+		ccApp.Buildpack = &application.Buildpack.Value
+	}
+
+	if application.Command.IsSet {
+		ccApp.Command = &application.Command.Value
+	}
+
+	if application.Instances.IsSet {
+		ccApp.Instances = &application.Instances.Value
+	}
+
+	return json.Marshal(ccApp)
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Application response.
@@ -88,21 +153,26 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	var ccApp struct {
 		Metadata internal.Metadata `json:"metadata"`
 		Entity   struct {
-			Buildpack                string     `json:"buildpack"`
-			DetectedBuildpack        string     `json:"detected_buildpack"`
-			DetectedStartCommand     string     `json:"detected_start_command"`
-			DiskQuota                int        `json:"disk_quota"`
-			HealthCheckType          string     `json:"health_check_type"`
-			HealthCheckHTTPEndpoint  string     `json:"health_check_http_endpoint"`
-			Instances                int        `json:"instances"`
-			Memory                   int        `json:"memory"`
-			Name                     string     `json:"name"`
-			PackageState             string     `json:"package_state"`
-			PackageUpdatedAt         *time.Time `json:"package_updated_at"`
-			StackGUID                string     `json:"stack_guid"`
-			StagingFailedDescription string     `json:"staging_failed_description"`
-			StagingFailedReason      string     `json:"staging_failed_reason"`
-			State                    string     `json:"state"`
+			Buildpack                string                 `json:"buildpack"`
+			Command                  string                 `json:"command"`
+			DetectedBuildpack        string                 `json:"detected_buildpack"`
+			DetectedStartCommand     string                 `json:"detected_start_command"`
+			DiskQuota                int                    `json:"disk_quota"`
+			DockerImage              string                 `json:"docker_image"`
+			EnvironmentVariables     map[string]interface{} `json:"environment_json"`
+			HealthCheckType          string                 `json:"health_check_type"`
+			HealthCheckHTTPEndpoint  string                 `json:"health_check_http_endpoint"`
+			HealthCheckTimeout       int                    `json:"health_check_timeout"`
+			Instances                *int                   `json:"instances"`
+			Memory                   int                    `json:"memory"`
+			Name                     string                 `json:"name"`
+			PackageState             string                 `json:"package_state"`
+			PackageUpdatedAt         *time.Time             `json:"package_updated_at"`
+			SpaceGUID                string                 `json:"space_guid"`
+			StackGUID                string                 `json:"stack_guid"`
+			StagingFailedDescription string                 `json:"staging_failed_description"`
+			StagingFailedReason      string                 `json:"staging_failed_reason"`
+			State                    string                 `json:"state"`
 		} `json:"entity"`
 	}
 	if err := json.Unmarshal(data, &ccApp); err != nil {
@@ -110,21 +180,32 @@ func (application *Application) UnmarshalJSON(data []byte) error {
 	}
 
 	application.GUID = ccApp.Metadata.GUID
-	application.Buildpack = ccApp.Entity.Buildpack
+	application.Buildpack.ParseValue(ccApp.Entity.Buildpack)
+	application.Command.ParseValue(ccApp.Entity.Command)
 	application.DetectedBuildpack = ccApp.Entity.DetectedBuildpack
 	application.DetectedStartCommand = ccApp.Entity.DetectedStartCommand
 	application.DiskQuota = ccApp.Entity.DiskQuota
+	application.DockerImage = ccApp.Entity.DockerImage
 	application.HealthCheckType = ccApp.Entity.HealthCheckType
 	application.HealthCheckHTTPEndpoint = ccApp.Entity.HealthCheckHTTPEndpoint
-	application.Instances = ccApp.Entity.Instances
+	application.HealthCheckTimeout = ccApp.Entity.HealthCheckTimeout
 	application.Memory = ccApp.Entity.Memory
 	application.Name = ccApp.Entity.Name
 	application.PackageState = ApplicationPackageState(ccApp.Entity.PackageState)
+	application.SpaceGUID = ccApp.Entity.SpaceGUID
 	application.StackGUID = ccApp.Entity.StackGUID
 	application.StagingFailedDescription = ccApp.Entity.StagingFailedDescription
 	application.StagingFailedReason = ccApp.Entity.StagingFailedReason
 	application.State = ApplicationState(ccApp.Entity.State)
 
+	if ccApp.Entity.Instances != nil {
+		application.Instances = types.NullInt{Value: *ccApp.Entity.Instances, IsSet: true}
+	}
+
+	if len(ccApp.Entity.EnvironmentVariables) > 0 {
+		application.EnvironmentVariables = ccApp.Entity.EnvironmentVariables
+	}
+
 	if ccApp.Entity.PackageUpdatedAt != nil {
 		application.PackageUpdatedAt = *ccApp.Entity.PackageUpdatedAt
 	}
@@ -156,6 +237,23 @@ func (client *Client) CreateApplication(app Application) (Application, Warnings,
 	return updatedApp, response.Warnings, err
 }
 
+// DeleteApplication deletes the application with the given GUID, along with
+// its service bindings and route mappings.
+func (client *Client) DeleteApplication(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteAppRequest,
+		URIParams:   Params{"app_guid": guid},
+		Query:       url.Values{"recursive": {"true"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // GetApplication returns back an Application.
 func (client *Client) GetApplication(guid string) (Application, Warnings, error) {
 	request, err := client.newHTTPRequest(requestOptions{
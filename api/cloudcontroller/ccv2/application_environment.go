@@ -0,0 +1,103 @@
+package ccv2
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ApplicationEnvironment represents the environment variables of a Cloud
+// Controller Application, grouped by where they come from.
+type ApplicationEnvironment struct {
+	// EnvironmentVariables are the variables set by the user.
+	EnvironmentVariables map[string]interface{}
+
+	// RunningEnvironmentVariables and StagingEnvironmentVariables are the
+	// variables from the running and staging environment variable groups.
+	RunningEnvironmentVariables map[string]interface{}
+	StagingEnvironmentVariables map[string]interface{}
+
+	// SystemEnvironmentVariables are the variables provided by the system,
+	// such as VCAP_SERVICES.
+	SystemEnvironmentVariables map[string]interface{}
+
+	// ApplicationEnvironmentVariables are the variables describing the
+	// application, such as VCAP_APPLICATION.
+	ApplicationEnvironmentVariables map[string]interface{}
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Application Environment
+// response.
+func (environment *ApplicationEnvironment) UnmarshalJSON(data []byte) error {
+	var ccEnvironment struct {
+		EnvironmentJSON    map[string]interface{} `json:"environment_json"`
+		RunningEnvJSON     map[string]interface{} `json:"running_env_json"`
+		StagingEnvJSON     map[string]interface{} `json:"staging_env_json"`
+		SystemEnvJSON      map[string]interface{} `json:"system_env_json"`
+		ApplicationEnvJSON map[string]interface{} `json:"application_env_json"`
+	}
+	if err := json.Unmarshal(data, &ccEnvironment); err != nil {
+		return err
+	}
+
+	environment.EnvironmentVariables = ccEnvironment.EnvironmentJSON
+	environment.RunningEnvironmentVariables = ccEnvironment.RunningEnvJSON
+	environment.StagingEnvironmentVariables = ccEnvironment.StagingEnvJSON
+	environment.SystemEnvironmentVariables = ccEnvironment.SystemEnvJSON
+	environment.ApplicationEnvironmentVariables = ccEnvironment.ApplicationEnvJSON
+	return nil
+}
+
+// GetApplicationEnvironment returns the environment variables of the
+// application with the provided GUID.
+func (client *Client) GetApplicationEnvironment(appGUID string) (ApplicationEnvironment, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetAppEnvRequest,
+		URIParams:   Params{"app_guid": appGUID},
+	})
+	if err != nil {
+		return ApplicationEnvironment{}, nil, err
+	}
+
+	var environment ApplicationEnvironment
+	response := cloudcontroller.Response{
+		Result: &environment,
+	}
+
+	err = client.connection.Make(request, &response)
+	return environment, response.Warnings, err
+}
+
+// UpdateApplicationEnvironmentVariables replaces the user provided
+// environment variables of the application with the provided GUID, in a
+// single update of the application.
+func (client *Client) UpdateApplicationEnvironmentVariables(appGUID string, variables map[string]interface{}) (Warnings, error) {
+	if variables == nil {
+		variables = map[string]interface{}{}
+	}
+
+	body, err := json.Marshal(struct {
+		EnvironmentJSON map[string]interface{} `json:"environment_json"`
+	}{
+		EnvironmentJSON: variables,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppRequest,
+		URIParams:   Params{"app_guid": appGUID},
+		Body:        bytes.NewBuffer(body),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	response := cloudcontroller.Response{}
+
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
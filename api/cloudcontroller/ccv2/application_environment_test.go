@@ -0,0 +1,156 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Application Environment", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetApplicationEnvironment", func() {
+		Context("when the application exists", func() {
+			BeforeEach(func() {
+				response := `{
+					"staging_env_json": {
+						"STAGING_VAR": "staging"
+					},
+					"running_env_json": {
+						"RUNNING_VAR": "running"
+					},
+					"environment_json": {
+						"USER_VAR": "user",
+						"DEBUG": true
+					},
+					"system_env_json": {
+						"VCAP_SERVICES": {
+							"p-mysql": [
+								{"name": "some-db"}
+							]
+						}
+					},
+					"application_env_json": {
+						"VCAP_APPLICATION": {
+							"name": "some-app"
+						}
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/env"),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the environment variables and warnings", func() {
+				environment, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(environment).To(Equal(ApplicationEnvironment{
+					EnvironmentVariables:        map[string]interface{}{"USER_VAR": "user", "DEBUG": true},
+					RunningEnvironmentVariables: map[string]interface{}{"RUNNING_VAR": "running"},
+					StagingEnvironmentVariables: map[string]interface{}{"STAGING_VAR": "staging"},
+					SystemEnvironmentVariables: map[string]interface{}{
+						"VCAP_SERVICES": map[string]interface{}{
+							"p-mysql": []interface{}{map[string]interface{}{"name": "some-db"}},
+						},
+					},
+					ApplicationEnvironmentVariables: map[string]interface{}{
+						"VCAP_APPLICATION": map[string]interface{}{"name": "some-app"},
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/apps/some-app-guid/env"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				_, warnings, err := client.GetApplicationEnvironment("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found: some-app-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateApplicationEnvironmentVariables", func() {
+		Context("when the update is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+						VerifyJSON(`{"environment_json": {"USER_VAR": "user", "DEBUG": true}}`),
+						RespondWith(http.StatusCreated, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("replaces the environment variables and returns warnings", func() {
+				warnings, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", map[string]interface{}{"USER_VAR": "user", "DEBUG": true})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when no variables are provided", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+						VerifyJSON(`{"environment_json": {}}`),
+						RespondWith(http.StatusCreated, `{}`, nil),
+					),
+				)
+			})
+
+			It("removes all the environment variables", func() {
+				_, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", nil)
+				Expect(err).NotTo(HaveOccurred())
+			})
+		})
+
+		Context("when the application does not exist", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns a ResourceNotFoundError and warnings", func() {
+				warnings, err := client.UpdateApplicationEnvironmentVariables("some-app-guid", map[string]interface{}{})
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found: some-app-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	"code.cloudfoundry.org/cli/types"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/ghttp"
@@ -53,8 +54,9 @@ var _ = Describe("Application", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(app).To(Equal(Application{
-						GUID: "some-app-guid",
-						Name: "some-app-name",
+						GUID:      "some-app-guid",
+						Name:      "some-app-name",
+						SpaceGUID: "some-space-guid",
 					}))
 					Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
 				})
@@ -86,6 +88,47 @@ var _ = Describe("Application", func() {
 		})
 	})
 
+	Describe("DeleteApplication", func() {
+		Context("when the deletion is successful", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid", "recursive=true"),
+						RespondWith(http.StatusNoContent, nil, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("deletes the app and returns all warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the cc returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 100004,
+					"description": "The app could not be found: some-app-guid",
+					"error_code": "CF-AppNotFound"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodDelete, "/v2/apps/some-app-guid", "recursive=true"),
+						RespondWith(http.StatusNotFound, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				warnings, err := client.DeleteApplication("some-app-guid")
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "The app could not be found: some-app-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetApplication", func() {
 		BeforeEach(func() {
 			response := `{
@@ -98,6 +141,12 @@ var _ = Describe("Application", func() {
 							"detected_start_command": "echo 'I am a banana'",
 							"disk_quota": 586,
 							"detected_buildpack": null,
+							"environment_json": {
+								"NAME": "value",
+								"PORT": 8080,
+								"DEBUG": true,
+								"CONFIG": {"a": 1}
+							},
 							"health_check_type": "port",
 							"health_check_http_endpoint": "/",
 							"instances": 13,
@@ -120,7 +169,7 @@ var _ = Describe("Application", func() {
 		})
 
 		Context("when apps exist", func() {
-			It("returns the app", func() {
+			It("returns the app, keeping the types of env values", func() {
 				app, warnings, err := client.GetApplication("app-guid-1")
 				Expect(err).NotTo(HaveOccurred())
 
@@ -128,14 +177,20 @@ var _ = Describe("Application", func() {
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(app).To(Equal(Application{
-					Buildpack:                "ruby 1.6.29",
-					DetectedBuildpack:        "",
-					DetectedStartCommand:     "echo 'I am a banana'",
-					DiskQuota:                586,
+					Buildpack:            types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
+					DetectedBuildpack:    "",
+					DetectedStartCommand: "echo 'I am a banana'",
+					DiskQuota:            586,
+					EnvironmentVariables: map[string]interface{}{
+						"NAME":   "value",
+						"PORT":   float64(8080),
+						"DEBUG":  true,
+						"CONFIG": map[string]interface{}{"a": float64(1)},
+					},
 					GUID:                     "app-guid-1",
 					HealthCheckType:          "port",
 					HealthCheckHTTPEndpoint:  "/",
-					Instances:                13,
+					Instances:                types.NullInt{Value: 13, IsSet: true},
 					Memory:                   1024,
 					Name:                     "app-name-1",
 					PackageState:             ApplicationPackageFailed,
@@ -241,14 +296,14 @@ var _ = Describe("Application", func() {
 
 				Expect(apps).To(ConsistOf([]Application{
 					{
-						Buildpack:               "ruby 1.6.29",
+						Buildpack:               types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
 						DetectedBuildpack:       "",
 						DetectedStartCommand:    "echo 'I am a banana'",
 						DiskQuota:               586,
 						GUID:                    "app-guid-1",
 						HealthCheckType:         "port",
 						HealthCheckHTTPEndpoint: "/",
-						Instances:               13,
+						Instances:               types.NullInt{Value: 13, IsSet: true},
 						Memory:                  1024,
 						Name:                    "app-name-1",
 						PackageState:            ApplicationPackageFailed,
@@ -310,7 +365,7 @@ var _ = Describe("Application", func() {
 						GUID:                    "some-app-guid",
 						HealthCheckType:         "some-health-check-type",
 						HealthCheckHTTPEndpoint: "/anything",
-						State: ApplicationStarted,
+						State:                   ApplicationStarted,
 					})
 					Expect(err).NotTo(HaveOccurred())
 
@@ -318,14 +373,14 @@ var _ = Describe("Application", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(app).To(Equal(Application{
-						Buildpack:               "ruby 1.6.29",
+						Buildpack:               types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
 						DetectedBuildpack:       "",
 						DetectedStartCommand:    "echo 'I am a banana'",
 						DiskQuota:               586,
 						GUID:                    "some-app-guid",
 						HealthCheckType:         "some-health-check-type",
 						HealthCheckHTTPEndpoint: "/anything",
-						Instances:               13,
+						Instances:               types.NullInt{Value: 13, IsSet: true},
 						Memory:                  1024,
 						Name:                    "app-name-1",
 						PackageUpdatedAt:        updatedAt,
@@ -378,14 +433,14 @@ var _ = Describe("Application", func() {
 					Expect(err).NotTo(HaveOccurred())
 
 					Expect(app).To(Equal(Application{
-						Buildpack:               "ruby 1.6.29",
+						Buildpack:               types.FilteredString{IsSet: true, Value: "ruby 1.6.29"},
 						DetectedBuildpack:       "",
 						DetectedStartCommand:    "echo 'I am a banana'",
 						DiskQuota:               586,
 						GUID:                    "some-app-guid",
 						HealthCheckType:         "some-health-check-type",
 						HealthCheckHTTPEndpoint: "/",
-						Instances:               13,
+						Instances:               types.NullInt{Value: 13, IsSet: true},
 						Memory:                  1024,
 						Name:                    "app-name-1",
 						PackageUpdatedAt:        updatedAt,
@@ -0,0 +1,129 @@
+package ccv2
+
+import (
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Event represents a Cloud Controller audit event.
+type Event struct {
+	// GUID is the unique event identifier.
+	GUID string
+
+	// Type is the type of event, such as 'audit.app.update'.
+	Type string
+
+	// ActorGUID, ActorType and ActorName describe who caused the event.
+	ActorGUID string
+	ActorType string
+	ActorName string
+
+	// ActeeGUID, ActeeType and ActeeName describe the resource the event
+	// happened to.
+	ActeeGUID string
+	ActeeType string
+	ActeeName string
+
+	// Timestamp is when the event occurred.
+	Timestamp time.Time
+
+	// Metadata holds details that depend on the type of event, such as the
+	// request that caused it.
+	Metadata map[string]interface{}
+
+	// SpaceGUID and OrganizationGUID are the space and organization the
+	// event happened in, if any.
+	SpaceGUID        string
+	OrganizationGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Event response.
+func (event *Event) UnmarshalJSON(data []byte) error {
+	var ccEvent struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Type             string                 `json:"type"`
+			Actor            string                 `json:"actor"`
+			ActorType        string                 `json:"actor_type"`
+			ActorName        string                 `json:"actor_name"`
+			Actee            string                 `json:"actee"`
+			ActeeType        string                 `json:"actee_type"`
+			ActeeName        string                 `json:"actee_name"`
+			Timestamp        *time.Time             `json:"timestamp"`
+			Metadata         map[string]interface{} `json:"metadata"`
+			SpaceGUID        string                 `json:"space_guid"`
+			OrganizationGUID string                 `json:"organization_guid"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccEvent); err != nil {
+		return err
+	}
+
+	event.GUID = ccEvent.Metadata.GUID
+	event.Type = ccEvent.Entity.Type
+	event.ActorGUID = ccEvent.Entity.Actor
+	event.ActorType = ccEvent.Entity.ActorType
+	event.ActorName = ccEvent.Entity.ActorName
+	event.ActeeGUID = ccEvent.Entity.Actee
+	event.ActeeType = ccEvent.Entity.ActeeType
+	event.ActeeName = ccEvent.Entity.ActeeName
+	if ccEvent.Entity.Timestamp != nil {
+		event.Timestamp = *ccEvent.Entity.Timestamp
+	}
+	event.Metadata = ccEvent.Entity.Metadata
+	event.SpaceGUID = ccEvent.Entity.SpaceGUID
+	event.OrganizationGUID = ccEvent.Entity.OrganizationGUID
+	return nil
+}
+
+// GetEvents returns back a list of Events based off of the provided queries,
+// newest first. When limit is positive, only the limit most recent events are
+// requested; otherwise every page of results is requested.
+func (client *Client) GetEvents(limit int, queries []Query) ([]Event, Warnings, error) {
+	query := FormatQueryParameters(queries)
+	query.Set("order-direction", "desc")
+	if limit > 0 {
+		query.Set("results-per-page", strconv.Itoa(limit))
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetEventsRequest,
+		Query:       query,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullEventsList []Event
+	appendEvent := func(item interface{}) error {
+		if event, ok := item.(Event); ok {
+			fullEventsList = append(fullEventsList, event)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Event{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	}
+
+	if limit > 0 {
+		firstPage := client.getPage(request, Event{})
+		if firstPage.Err != nil {
+			return nil, firstPage.Warnings, firstPage.Err
+		}
+		for _, item := range firstPage.Resources {
+			if err := appendEvent(item); err != nil {
+				return nil, firstPage.Warnings, err
+			}
+		}
+		return fullEventsList, firstPage.Warnings, nil
+	}
+
+	warnings, err := client.paginate(request, Event{}, appendEvent)
+	return fullEventsList, warnings, err
+}
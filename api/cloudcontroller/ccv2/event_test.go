@@ -0,0 +1,178 @@
+package ccv2_test
+
+import (
+	"net/http"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Event", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetEvents", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/events?q=type:audit.route.delete-request&q=timestamp%3E=2017-04-01T00:00:00Z&order-direction=desc&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "event-guid-1"
+						},
+						"entity": {
+							"type": "audit.route.delete-request",
+							"actor": "user-guid",
+							"actor_type": "user",
+							"actor_name": "admin",
+							"actee": "route-guid",
+							"actee_type": "route",
+							"actee_name": "some-host",
+							"timestamp": "2017-04-02T10:00:00Z",
+							"metadata": {
+								"request": {
+									"recursive": true
+								}
+							},
+							"space_guid": "some-space-guid",
+							"organization_guid": "some-org-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "event-guid-2"
+						},
+						"entity": {
+							"type": "audit.route.delete-request",
+							"actor": "other-user-guid",
+							"actor_type": "user",
+							"actor_name": "other-admin",
+							"actee": "other-route-guid",
+							"actee_type": "route",
+							"actee_name": "other-host",
+							"timestamp": "2017-04-03T10:00:00Z",
+							"metadata": {},
+							"space_guid": "some-space-guid",
+							"organization_guid": "some-org-guid"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/events", "q=type:audit.route.delete-request&q=timestamp%3E=2017-04-01T00:00:00Z&order-direction=desc"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/events", "q=type:audit.route.delete-request&q=timestamp%3E=2017-04-01T00:00:00Z&order-direction=desc&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried events and warnings", func() {
+			events, warnings, err := client.GetEvents(0, []Query{
+				{
+					Filter:   TypeFilter,
+					Operator: EqualOperator,
+					Value:    "audit.route.delete-request",
+				},
+				{
+					Filter:   TimestampFilter,
+					Operator: GreaterThanOrEqualOperator,
+					Value:    "2017-04-01T00:00:00Z",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal([]Event{
+				{
+					GUID:             "event-guid-1",
+					Type:             "audit.route.delete-request",
+					ActorGUID:        "user-guid",
+					ActorType:        "user",
+					ActorName:        "admin",
+					ActeeGUID:        "route-guid",
+					ActeeType:        "route",
+					ActeeName:        "some-host",
+					Timestamp:        time.Date(2017, 4, 2, 10, 0, 0, 0, time.UTC),
+					Metadata:         map[string]interface{}{"request": map[string]interface{}{"recursive": true}},
+					SpaceGUID:        "some-space-guid",
+					OrganizationGUID: "some-org-guid",
+				},
+				{
+					GUID:             "event-guid-2",
+					Type:             "audit.route.delete-request",
+					ActorGUID:        "other-user-guid",
+					ActorType:        "user",
+					ActorName:        "other-admin",
+					ActeeGUID:        "other-route-guid",
+					ActeeType:        "route",
+					ActeeName:        "other-host",
+					Timestamp:        time.Date(2017, 4, 3, 10, 0, 0, 0, time.UTC),
+					Metadata:         map[string]interface{}{},
+					SpaceGUID:        "some-space-guid",
+					OrganizationGUID: "some-org-guid",
+				},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+
+	Describe("GetEvents with a limit", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": "/v2/events?q=actor:user-guid&order-direction=desc&results-per-page=1&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "event-guid-2"
+						},
+						"entity": {
+							"type": "audit.route.delete-request",
+							"actor": "user-guid",
+							"timestamp": "2017-04-03T10:00:00Z"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/events", "q=actor:user-guid&order-direction=desc&results-per-page=1"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns only the most recent events and warnings", func() {
+			events, warnings, err := client.GetEvents(1, []Query{
+				{
+					Filter:   ActorFilter,
+					Operator: EqualOperator,
+					Value:    "user-guid",
+				},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(events).To(Equal([]Event{
+				{
+					GUID:      "event-guid-2",
+					Type:      "audit.route.delete-request",
+					ActorGUID: "user-guid",
+					Timestamp: time.Date(2017, 4, 3, 10, 0, 0, 0, time.UTC),
+				},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			Expect(server.ReceivedRequests()).To(HaveLen(2))
+		})
+	})
+})
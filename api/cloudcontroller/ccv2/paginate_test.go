@@ -0,0 +1,93 @@
+package ccv2_test
+
+import (
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paginate", func() {
+	var (
+		client     *Client
+		failedPage int
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+		failedPage = 0
+
+		server.RouteToHandler(http.MethodGet, "/v2/stacks", func(writer http.ResponseWriter, request *http.Request) {
+			page, err := strconv.Atoi(request.URL.Query().Get("page"))
+			if err != nil {
+				page = 1
+			}
+			writer.Header().Set("X-Cf-Warnings", fmt.Sprintf("warning-%d", page))
+
+			if page == failedPage {
+				writer.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(writer, `{"code": 10000, "description": "Page not found", "error_code": "CF-NotFound"}`)
+				return
+			}
+
+			nextURL := "null"
+			if page < 4 {
+				nextURL = fmt.Sprintf(`"/v2/stacks?q=name:some-stack-name&page=%d&results-per-page=1"`, page+1)
+			}
+			fmt.Fprintf(writer, `{
+				"total_results": 4,
+				"total_pages": 4,
+				"next_url": %s,
+				"resources": [
+					{
+						"metadata": {"guid": "some-stack-guid-%d"},
+						"entity": {"name": "some-stack-name"}
+					}
+				]
+			}`, nextURL, page)
+		})
+	})
+
+	Context("when the first page reports the total number of pages", func() {
+		It("returns the resources from every page in order, and all warnings", func() {
+			stacks, warnings, err := client.GetStacks([]Query{{
+				Filter:   NameFilter,
+				Operator: EqualOperator,
+				Value:    "some-stack-name",
+			}})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(stacks).To(Equal([]Stack{
+				{GUID: "some-stack-guid-1", Name: "some-stack-name"},
+				{GUID: "some-stack-guid-2", Name: "some-stack-name"},
+				{GUID: "some-stack-guid-3", Name: "some-stack-name"},
+				{GUID: "some-stack-guid-4", Name: "some-stack-name"},
+			}))
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+
+			// The first request is for the API information.
+			requests := server.ReceivedRequests()
+			Expect(requests).To(HaveLen(5))
+			for _, request := range requests[2:] {
+				Expect(request.URL.Query().Get("q")).To(Equal("name:some-stack-name"))
+				Expect(request.URL.Query().Get("results-per-page")).To(Equal("1"))
+			}
+		})
+
+		Context("when a page cannot be fetched", func() {
+			BeforeEach(func() {
+				failedPage = 3
+			})
+
+			It("returns the error and the warnings up to and including that page", func() {
+				_, warnings, err := client.GetStacks(nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Page not found"}))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+			})
+		})
+	})
+})
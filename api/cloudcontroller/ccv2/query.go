@@ -12,6 +12,10 @@ type QueryFilter string
 type QueryOperator string
 
 const (
+	// ActeeFilter is the name of the 'actee' filter.
+	ActeeFilter QueryFilter = "actee"
+	// ActorFilter is the name of the 'actor' filter.
+	ActorFilter QueryFilter = "actor"
 	// AppGUIDFilter is the name of the 'app_guid' filter.
 	AppGUIDFilter QueryFilter = "app_guid"
 	// DomainGUIDFilter is the name of the 'domain_guid' filter.
@@ -20,6 +24,8 @@ const (
 	OrganizationGUIDFilter QueryFilter = "organization_guid"
 	// RouteGUIDFilter is the name of the 'route_guid' filter.
 	RouteGUIDFilter QueryFilter = "route_guid"
+	// ServiceGUIDFilter is the name of the 'service_guid' filter.
+	ServiceGUIDFilter QueryFilter = "service_guid"
 	// ServiceInstanceGUIDFilter is the name of the 'service_instance_guid' filter.
 	ServiceInstanceGUIDFilter QueryFilter = "service_instance_guid"
 	// SpaceGUIDFilter is the name of the 'space_guid' filter.
@@ -29,11 +35,25 @@ const (
 	NameFilter QueryFilter = "name"
 	// HostFilter is the name of the 'host' filter.
 	HostFilter QueryFilter = "host"
+	// LabelFilter is the name of the 'label' filter.
+	LabelFilter QueryFilter = "label"
+	// PathFilter is the name of the 'path' filter.
+	PathFilter QueryFilter = "path"
+	// PortFilter is the name of the 'port' filter.
+	PortFilter QueryFilter = "port"
+	// TimestampFilter is the name of the 'timestamp' filter.
+	TimestampFilter QueryFilter = "timestamp"
+	// TypeFilter is the name of the 'type' filter.
+	TypeFilter QueryFilter = "type"
 )
 
 const (
 	// EqualOperator is the query equal operator.
 	EqualOperator QueryOperator = ":"
+	// GreaterThanOrEqualOperator is the query greater than or equal operator.
+	GreaterThanOrEqualOperator QueryOperator = ">="
+	// LessThanOrEqualOperator is the query less than or equal operator.
+	LessThanOrEqualOperator QueryOperator = "<="
 )
 
 // Query is a type of filter that can be passed to specific request to narrow
@@ -1,8 +1,94 @@
 package ccv2
 
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Resource represents a file in an application's bits. Resources are used to
+// find the files the Cloud Controller already has cached.
 type Resource struct {
 	Filename string `json:"fn,omitempty"`
 	Size     int64  `json:"size"`
 	SHA1     string `json:"sha1"`
 	Mode     string `json:"mode,omitempty"`
 }
+
+// ResourceMatch returns the resources that are already cached by the Cloud
+// Controller. Only the SHA1 and size of each resource are sent.
+func (client *Client) ResourceMatch(resourcesToMatch []Resource) ([]Resource, Warnings, error) {
+	type matchResource struct {
+		Size int64  `json:"size"`
+		SHA1 string `json:"sha1"`
+	}
+
+	toMatch := make([]matchResource, 0, len(resourcesToMatch))
+	for _, resource := range resourcesToMatch {
+		toMatch = append(toMatch, matchResource{Size: resource.Size, SHA1: resource.SHA1})
+	}
+
+	body, err := json.Marshal(toMatch)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutResourceMatchRequest,
+		Body:        bytes.NewReader(body),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var matchedResources []Resource
+	response := cloudcontroller.Response{
+		Result: &matchedResources,
+	}
+
+	err = client.connection.Make(request, &response)
+	return matchedResources, response.Warnings, err
+}
+
+// UploadApplication uploads the bits of an application. existingResources
+// are the files already cached by the Cloud Controller, and zip contains the
+// remaining files. The upload is processed asynchronously; the returned job
+// can be polled until it finishes.
+func (client *Client) UploadApplication(appGUID string, existingResources []Resource, zip io.ReadSeeker, zipSize int64) (Job, Warnings, error) {
+	if existingResources == nil {
+		existingResources = []Resource{}
+	}
+	rawResources, err := json.Marshal(existingResources)
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	body, err := cloudcontroller.NewUploadBody(
+		map[string]string{"resources": string(rawResources)},
+		"application", "application.zip", zip, zipSize)
+	if err != nil {
+		return Job{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PutAppBitsRequest,
+		URIParams:   Params{"app_guid": appGUID},
+		Query:       url.Values{"async": {"true"}},
+	})
+	if err != nil {
+		return Job{}, nil, err
+	}
+	request = body.Attach(request)
+
+	var job Job
+	response := cloudcontroller.Response{
+		Result: &job,
+	}
+
+	err = client.connection.Make(request, &response)
+	return job, response.Warnings, err
+}
@@ -0,0 +1,124 @@
+package ccv2_test
+
+import (
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Resource", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("ResourceMatch", func() {
+		Context("when the resource match is successful", func() {
+			BeforeEach(func() {
+				expectedBody := `[
+					{"size": 11, "sha1": "some-sha-1"},
+					{"size": 22, "sha1": "some-sha-2"}
+				]`
+				response := `[
+					{"size": 22, "sha1": "some-sha-2"}
+				]`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/resource_match"),
+						VerifyJSON(expectedBody),
+						RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the matched resources and warnings", func() {
+				matched, warnings, err := client.ResourceMatch([]Resource{
+					{Filename: "some-file-1", Size: 11, SHA1: "some-sha-1", Mode: "0644"},
+					{Filename: "some-file-2", Size: 22, SHA1: "some-sha-2", Mode: "0755"},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(matched).To(ConsistOf(Resource{Size: 22, SHA1: "some-sha-2"}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+
+		Context("when the cloud controller returns an error", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/resource_match"),
+						RespondWith(http.StatusTeapot, `{}`, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.ResourceMatch([]Resource{{SHA1: "some-sha-1"}})
+				Expect(err).To(HaveOccurred())
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+
+	Describe("UploadApplication", func() {
+		Context("when the upload is successful", func() {
+			BeforeEach(func() {
+				verifyBody := func(_ http.ResponseWriter, req *http.Request) {
+					Expect(req.URL.Query().Get("async")).To(Equal("true"))
+
+					err := req.ParseMultipartForm(1024)
+					Expect(err).ToNot(HaveOccurred())
+					defer req.MultipartForm.RemoveAll()
+
+					Expect(req.MultipartForm.Value["resources"]).To(HaveLen(1))
+					Expect(req.MultipartForm.Value["resources"][0]).To(MatchJSON(`[
+						{"fn": "some-file", "size": 11, "sha1": "some-sha", "mode": "0644"}
+					]`))
+
+					Expect(req.MultipartForm.File["application"]).To(HaveLen(1))
+					file, err := req.MultipartForm.File["application"][0].Open()
+					Expect(err).ToNot(HaveOccurred())
+					defer file.Close()
+					contents, err := ioutil.ReadAll(file)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(string(contents)).To(Equal("some-zip-contents"))
+				}
+
+				response := `{
+					"metadata": {
+						"guid": "some-job-guid"
+					},
+					"entity": {
+						"guid": "some-job-guid",
+						"status": "queued"
+					}
+				}`
+
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPut, "/v2/apps/some-app-guid/bits"),
+						verifyBody,
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the upload job and warnings", func() {
+				zip := strings.NewReader("some-zip-contents")
+				job, warnings, err := client.UploadApplication("some-app-guid", []Resource{
+					{Filename: "some-file", Size: 11, SHA1: "some-sha", Mode: "0644"},
+				}, zip, zip.Size())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(job).To(Equal(Job{GUID: "some-job-guid", Status: JobStatusQueued}))
+				Expect(warnings).To(ConsistOf("this is a warning"))
+			})
+		})
+	})
+})
@@ -200,6 +200,24 @@ func (client *Client) DeleteRoute(routeGUID string) (Warnings, error) {
 	return response.Warnings, err
 }
 
+// DeleteRouteApplication removes the link between the route and application.
+func (client *Client) DeleteRouteApplication(routeGUID string, appGUID string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteRouteAppRequest,
+		URIParams: map[string]string{
+			"app_guid":   appGUID,
+			"route_guid": routeGUID,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
 // CheckRoute returns true if the route exists in the CF instance. DomainGUID
 // is required for check. This call will only work for CC API 2.55 or higher.
 func (client *Client) CheckRoute(route Route) (bool, Warnings, error) {
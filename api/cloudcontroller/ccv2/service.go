@@ -0,0 +1,59 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// Service represents a Cloud Controller Service.
+type Service struct {
+	GUID  string
+	Label string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service response.
+func (service *Service) UnmarshalJSON(data []byte) error {
+	var ccService struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Label string `json:"label"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccService); err != nil {
+		return err
+	}
+
+	service.GUID = ccService.Metadata.GUID
+	service.Label = ccService.Entity.Label
+	return nil
+}
+
+// GetSpaceServices returns back a list of Services that are available to the
+// provided space, based off of the provided queries.
+func (client *Client) GetSpaceServices(spaceGUID string, queries []Query) ([]Service, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetSpaceServicesRequest,
+		URIParams:   Params{"space_guid": spaceGUID},
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicesList []Service
+	warnings, err := client.paginate(request, Service{}, func(item interface{}) error {
+		if service, ok := item.(Service); ok {
+			fullServicesList = append(fullServicesList, service)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   Service{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicesList, warnings, err
+}
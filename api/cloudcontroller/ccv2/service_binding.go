@@ -1,6 +1,7 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
@@ -10,13 +11,17 @@ import (
 
 // ServiceBinding represents a Cloud Controller Service Binding.
 type ServiceBinding struct {
-	GUID string
+	GUID                string
+	ServiceInstanceGUID string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Binding response.
 func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	var ccServiceBinding struct {
 		Metadata internal.Metadata
+		Entity   struct {
+			ServiceInstanceGUID string `json:"service_instance_guid"`
+		}
 	}
 	err := json.Unmarshal(data, &ccServiceBinding)
 	if err != nil {
@@ -24,9 +29,49 @@ func (serviceBinding *ServiceBinding) UnmarshalJSON(data []byte) error {
 	}
 
 	serviceBinding.GUID = ccServiceBinding.Metadata.GUID
+	serviceBinding.ServiceInstanceGUID = ccServiceBinding.Entity.ServiceInstanceGUID
 	return nil
 }
 
+// serviceBindingRequestBody represents the body of a service binding create
+// request.
+type serviceBindingRequestBody struct {
+	ServiceInstanceGUID string                 `json:"service_instance_guid"`
+	AppGUID             string                 `json:"app_guid"`
+	Parameters          map[string]interface{} `json:"parameters"`
+}
+
+// CreateServiceBinding will create a service binding between the provided
+// application and service instance, passing along the provided parameters.
+func (client *Client) CreateServiceBinding(appGUID string, serviceInstanceGUID string, parameters map[string]interface{}) (ServiceBinding, Warnings, error) {
+	requestBody := serviceBindingRequestBody{
+		ServiceInstanceGUID: serviceInstanceGUID,
+		AppGUID:             appGUID,
+		Parameters:          parameters,
+	}
+
+	bodyBytes, err := json.Marshal(requestBody)
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.PostServiceBindingRequest,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return ServiceBinding{}, nil, err
+	}
+
+	var serviceBinding ServiceBinding
+	response := cloudcontroller.Response{
+		Result: &serviceBinding,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceBinding, response.Warnings, err
+}
+
 // GetServiceBindings returns back a list of Service Bindings based off of the
 // provided queries.
 func (client *Client) GetServiceBindings(queries []Query) ([]ServiceBinding, Warnings, error) {
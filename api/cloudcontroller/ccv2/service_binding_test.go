@@ -17,6 +17,68 @@ var _ = Describe("Service Binding", func() {
 		client = NewTestClient()
 	})
 
+	Describe("CreateServiceBinding", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `
+						{
+							"metadata": {
+								"guid": "some-service-binding-guid"
+							}
+						}`
+				requestBody := map[string]interface{}{
+					"service_instance_guid": "some-service-instance-guid",
+					"app_guid":              "some-app-guid",
+					"parameters": map[string]interface{}{
+						"the-service-broker": "wants this object",
+					},
+				}
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_bindings"),
+						VerifyJSONRepresenting(requestBody),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created object and warnings", func() {
+				parameters := map[string]interface{}{
+					"the-service-broker": "wants this object",
+				}
+				serviceBinding, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", parameters)
+				Expect(err).NotTo(HaveOccurred())
+
+				Expect(serviceBinding).To(Equal(ServiceBinding{GUID: "some-service-binding-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `
+				{
+					  "description": "The app space binding to service is taken: some-app-guid some-service-instance-guid",
+					  "error_code": "CF-ServiceBindingAppServiceTaken",
+					  "code": 90003
+				}
+			`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_bindings"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceBinding("some-app-guid", "some-service-instance-guid", nil)
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The app space binding to service is taken: some-app-guid some-service-instance-guid"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
 	Describe("GetServiceBindings", func() {
 		BeforeEach(func() {
 			response1 := `{
@@ -25,11 +87,17 @@ var _ = Describe("Service Binding", func() {
 					{
 						"metadata": {
 							"guid": "service-binding-guid-1"
+						},
+						"entity": {
+							"service_instance_guid": "service-instance-guid-1"
 						}
 					},
 					{
 						"metadata": {
 							"guid": "service-binding-guid-2"
+						},
+						"entity": {
+							"service_instance_guid": "service-instance-guid-2"
 						}
 					}
 				]
@@ -40,11 +108,17 @@ var _ = Describe("Service Binding", func() {
 					{
 						"metadata": {
 							"guid": "service-binding-guid-3"
+						},
+						"entity": {
+							"service_instance_guid": "service-instance-guid-3"
 						}
 					},
 					{
 						"metadata": {
 							"guid": "service-binding-guid-4"
+						},
+						"entity": {
+							"service_instance_guid": "service-instance-guid-4"
 						}
 					}
 				]
@@ -72,10 +146,10 @@ var _ = Describe("Service Binding", func() {
 				}})
 				Expect(err).NotTo(HaveOccurred())
 				Expect(serviceBindings).To(ConsistOf([]ServiceBinding{
-					{GUID: "service-binding-guid-1"},
-					{GUID: "service-binding-guid-2"},
-					{GUID: "service-binding-guid-3"},
-					{GUID: "service-binding-guid-4"},
+					{GUID: "service-binding-guid-1", ServiceInstanceGUID: "service-instance-guid-1"},
+					{GUID: "service-binding-guid-2", ServiceInstanceGUID: "service-instance-guid-2"},
+					{GUID: "service-binding-guid-3", ServiceInstanceGUID: "service-instance-guid-3"},
+					{GUID: "service-binding-guid-4", ServiceInstanceGUID: "service-instance-guid-4"},
 				}))
 				Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
 			})
@@ -1,8 +1,11 @@
 package ccv2
 
 import (
+	"bytes"
 	"encoding/json"
+	"net/url"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
 )
@@ -19,10 +22,18 @@ const (
 )
 
 // ServiceInstance represents a Cloud Controller Service Instance.
+// ServicePlanGUID is only set for managed service instances; Credentials,
+// SyslogDrainURL and RouteServiceURL are only set for user provided service
+// instances.
 type ServiceInstance struct {
-	GUID string
-	Name string
-	Type ServiceInstanceType
+	GUID            string
+	Name            string
+	Type            ServiceInstanceType
+	SpaceGUID       string
+	ServicePlanGUID string
+	Credentials     map[string]interface{}
+	SyslogDrainURL  string
+	RouteServiceURL string
 }
 
 // UnmarshalJSON helps unmarshal a Cloud Controller Service Instance response.
@@ -30,8 +41,13 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	var ccServiceInstance struct {
 		Metadata internal.Metadata
 		Entity   struct {
-			Name string
-			Type string
+			Name            string                 `json:"name"`
+			Type            string                 `json:"type"`
+			SpaceGUID       string                 `json:"space_guid"`
+			ServicePlanGUID string                 `json:"service_plan_guid"`
+			Credentials     map[string]interface{} `json:"credentials"`
+			SyslogDrainURL  string                 `json:"syslog_drain_url"`
+			RouteServiceURL string                 `json:"route_service_url"`
 		}
 	}
 	err := json.Unmarshal(data, &ccServiceInstance)
@@ -42,6 +58,11 @@ func (serviceInstance *ServiceInstance) UnmarshalJSON(data []byte) error {
 	serviceInstance.GUID = ccServiceInstance.Metadata.GUID
 	serviceInstance.Name = ccServiceInstance.Entity.Name
 	serviceInstance.Type = ServiceInstanceType(ccServiceInstance.Entity.Type)
+	serviceInstance.SpaceGUID = ccServiceInstance.Entity.SpaceGUID
+	serviceInstance.ServicePlanGUID = ccServiceInstance.Entity.ServicePlanGUID
+	serviceInstance.Credentials = ccServiceInstance.Entity.Credentials
+	serviceInstance.SyslogDrainURL = ccServiceInstance.Entity.SyslogDrainURL
+	serviceInstance.RouteServiceURL = ccServiceInstance.Entity.RouteServiceURL
 	return nil
 }
 
@@ -117,3 +138,74 @@ func (client *Client) GetSpaceServiceInstances(spaceGUID string, includeUserProv
 
 	return fullInstancesList, warnings, err
 }
+
+// serviceInstanceRequestBody represents the body of a managed service
+// instance create or update request.
+type serviceInstanceRequestBody struct {
+	Name            string `json:"name,omitempty"`
+	SpaceGUID       string `json:"space_guid,omitempty"`
+	ServicePlanGUID string `json:"service_plan_guid"`
+}
+
+// CreateServiceInstance creates a managed service instance with the name,
+// space and service plan of the provided service instance.
+func (client *Client) CreateServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	return client.sendServiceInstance(internal.PostServiceInstanceRequest, nil, serviceInstanceRequestBody{
+		Name:            serviceInstance.Name,
+		SpaceGUID:       serviceInstance.SpaceGUID,
+		ServicePlanGUID: serviceInstance.ServicePlanGUID,
+	})
+}
+
+// UpdateServiceInstance changes the service plan of a managed service
+// instance.
+func (client *Client) UpdateServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	return client.sendServiceInstance(
+		internal.PutServiceInstanceRequest,
+		Params{"service_instance_guid": serviceInstance.GUID},
+		serviceInstanceRequestBody{ServicePlanGUID: serviceInstance.ServicePlanGUID},
+	)
+}
+
+// DeleteServiceInstance deletes a managed service instance along with its
+// service bindings and service keys.
+func (client *Client) DeleteServiceInstance(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteServiceInstanceRequest,
+		URIParams:   Params{"service_instance_guid": guid},
+		Query:       url.Values{"recursive": []string{"true"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
+
+// sendServiceInstance sends body to the named service instance request and
+// returns the resulting service instance.
+func (client *Client) sendServiceInstance(requestName string, uriParams Params, body interface{}) (ServiceInstance, Warnings, error) {
+	bodyBytes, err := json.Marshal(body)
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: requestName,
+		URIParams:   uriParams,
+		Body:        bytes.NewBuffer(bodyBytes),
+	})
+	if err != nil {
+		return ServiceInstance{}, nil, err
+	}
+
+	var serviceInstance ServiceInstance
+	response := cloudcontroller.Response{
+		Result: &serviceInstance,
+	}
+
+	err = client.connection.Make(request, &response)
+	return serviceInstance, response.Warnings, err
+}
@@ -3,6 +3,7 @@ package ccv2_test
 import (
 	"net/http"
 
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -273,4 +274,124 @@ var _ = Describe("Service Instance", func() {
 			})
 		})
 	})
+
+	Describe("CreateServiceInstance", func() {
+		Context("when the create is successful", func() {
+			BeforeEach(func() {
+				response := `{
+					"metadata": {
+						"guid": "some-service-instance-guid"
+					},
+					"entity": {
+						"name": "some-service-instance",
+						"type": "managed_service_instance",
+						"space_guid": "some-space-guid",
+						"service_plan_guid": "some-service-plan-guid"
+					}
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances"),
+						VerifyJSONRepresenting(map[string]interface{}{
+							"name":              "some-service-instance",
+							"space_guid":        "some-space-guid",
+							"service_plan_guid": "some-service-plan-guid",
+						}),
+						RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the created service instance and warnings", func() {
+				serviceInstance, warnings, err := client.CreateServiceInstance(ServiceInstance{
+					Name:            "some-service-instance",
+					SpaceGUID:       "some-space-guid",
+					ServicePlanGUID: "some-service-plan-guid",
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(serviceInstance).To(Equal(ServiceInstance{
+					GUID:            "some-service-instance-guid",
+					Name:            "some-service-instance",
+					Type:            ManagedService,
+					SpaceGUID:       "some-space-guid",
+					ServicePlanGUID: "some-service-plan-guid",
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+
+		Context("when the create returns an error", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 60002,
+					"description": "The service instance name is taken: some-service-instance",
+					"error_code": "CF-ServiceInstanceNameTaken"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/v2/service_instances"),
+						RespondWith(http.StatusBadRequest, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+					),
+				)
+			})
+
+			It("returns the error and warnings", func() {
+				_, warnings, err := client.CreateServiceInstance(ServiceInstance{Name: "some-service-instance"})
+				Expect(err).To(MatchError(ccerror.BadRequestError{Message: "The service instance name is taken: some-service-instance"}))
+				Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+			})
+		})
+	})
+
+	Describe("UpdateServiceInstance", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"type": "managed_service_instance",
+					"service_plan_guid": "other-service-plan-guid"
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/service_instances/some-service-instance-guid"),
+					VerifyJSONRepresenting(map[string]interface{}{
+						"service_plan_guid": "other-service-plan-guid",
+					}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("changes the service plan and returns warnings", func() {
+			serviceInstance, warnings, err := client.UpdateServiceInstance(ServiceInstance{
+				GUID:            "some-service-instance-guid",
+				Name:            "some-service-instance",
+				ServicePlanGUID: "other-service-plan-guid",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance.ServicePlanGUID).To(Equal("other-service-plan-guid"))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("DeleteServiceInstance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/service_instances/some-service-instance-guid", "recursive=true"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service instance and its bindings, and returns warnings", func() {
+			warnings, err := client.DeleteServiceInstance("some-service-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
 })
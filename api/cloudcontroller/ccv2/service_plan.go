@@ -0,0 +1,61 @@
+package ccv2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// ServicePlan represents a Cloud Controller Service Plan.
+type ServicePlan struct {
+	GUID        string
+	Name        string
+	ServiceGUID string
+}
+
+// UnmarshalJSON helps unmarshal a Cloud Controller Service Plan response.
+func (servicePlan *ServicePlan) UnmarshalJSON(data []byte) error {
+	var ccServicePlan struct {
+		Metadata internal.Metadata `json:"metadata"`
+		Entity   struct {
+			Name        string `json:"name"`
+			ServiceGUID string `json:"service_guid"`
+		} `json:"entity"`
+	}
+	if err := json.Unmarshal(data, &ccServicePlan); err != nil {
+		return err
+	}
+
+	servicePlan.GUID = ccServicePlan.Metadata.GUID
+	servicePlan.Name = ccServicePlan.Entity.Name
+	servicePlan.ServiceGUID = ccServicePlan.Entity.ServiceGUID
+	return nil
+}
+
+// GetServicePlans returns back a list of Service Plans based off of the
+// provided queries.
+func (client *Client) GetServicePlans(queries []Query) ([]ServicePlan, Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.GetServicePlansRequest,
+		Query:       FormatQueryParameters(queries),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var fullServicePlansList []ServicePlan
+	warnings, err := client.paginate(request, ServicePlan{}, func(item interface{}) error {
+		if servicePlan, ok := item.(ServicePlan); ok {
+			fullServicePlansList = append(fullServicePlansList, servicePlan)
+		} else {
+			return ccerror.UnknownObjectInListError{
+				Expected:   ServicePlan{},
+				Unexpected: item,
+			}
+		}
+		return nil
+	})
+
+	return fullServicePlansList, warnings, err
+}
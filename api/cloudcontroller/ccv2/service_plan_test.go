@@ -0,0 +1,75 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service Plan", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetServicePlans", func() {
+		BeforeEach(func() {
+			response1 := `{
+				"next_url": "/v2/service_plans?q=service_guid:some-service-guid&page=2",
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-plan-guid-1"
+						},
+						"entity": {
+							"name": "small",
+							"service_guid": "some-service-guid"
+						}
+					}
+				]
+			}`
+			response2 := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "service-plan-guid-2"
+						},
+						"entity": {
+							"name": "large",
+							"service_guid": "some-service-guid"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid"),
+					RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/service_plans", "q=service_guid:some-service-guid&page=2"),
+					RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+				),
+			)
+		})
+
+		It("returns all the queried service plans and warnings", func() {
+			servicePlans, warnings, err := client.GetServicePlans([]Query{{
+				Filter:   ServiceGUIDFilter,
+				Operator: EqualOperator,
+				Value:    "some-service-guid",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(servicePlans).To(Equal([]ServicePlan{
+				{GUID: "service-plan-guid-1", Name: "small", ServiceGUID: "some-service-guid"},
+				{GUID: "service-plan-guid-2", Name: "large", ServiceGUID: "some-service-guid"},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+		})
+	})
+})
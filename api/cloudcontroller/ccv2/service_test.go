@@ -0,0 +1,53 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Service", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("GetSpaceServices", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "some-service-guid"
+						},
+						"entity": {
+							"label": "some-service"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/spaces/some-space-guid/services", "q=label:some-service"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the queried services and warnings", func() {
+			services, warnings, err := client.GetSpaceServices("some-space-guid", []Query{{
+				Filter:   LabelFilter,
+				Operator: EqualOperator,
+				Value:    "some-service",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(services).To(Equal([]Service{{GUID: "some-service-guid", Label: "some-service"}}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
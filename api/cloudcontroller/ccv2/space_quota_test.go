@@ -71,4 +71,63 @@ var _ = Describe("Space Quotas", func() {
 			})
 		})
 	})
+
+	Describe("GetOrganizationSpaceQuotas", func() {
+		BeforeEach(func() {
+			response := `{
+				"next_url": null,
+				"resources": [
+					{
+						"metadata": {
+							"guid": "space-quota-guid-1"
+						},
+						"entity": {
+							"name": "small"
+						}
+					},
+					{
+						"metadata": {
+							"guid": "space-quota-guid-2"
+						},
+						"entity": {
+							"name": "large"
+						}
+					}
+				]
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodGet, "/v2/organizations/some-org-guid/space_quota_definitions"),
+					RespondWith(http.StatusOK, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the organization's space quotas and warnings", func() {
+			spaceQuotas, warnings, err := client.GetOrganizationSpaceQuotas("some-org-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(spaceQuotas).To(Equal([]SpaceQuota{
+				{GUID: "space-quota-guid-1", Name: "small"},
+				{GUID: "space-quota-guid-2", Name: "large"},
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("SetSpaceQuota", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/space_quota_definitions/some-space-quota-guid/spaces/some-space-guid"),
+					RespondWith(http.StatusCreated, "{}", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("assigns the space quota to the space and returns warnings", func() {
+			warnings, err := client.SetSpaceQuota("some-space-quota-guid", "some-space-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
 })
@@ -74,4 +74,120 @@ var _ = Describe("Stack", func() {
 			})
 		})
 	})
+
+	Describe("GetStacks", func() {
+		Context("when no errors are encountered", func() {
+			Context("when results are paginated", func() {
+				BeforeEach(func() {
+					response1 := `{
+						"next_url": "/v2/stacks?q=name:some-stack-name&page=2",
+						"resources": [
+							{
+								"metadata": {
+									"guid": "some-stack-guid-1"
+								},
+								"entity": {
+									"name": "some-stack-name-1",
+									"description": "some stack description"
+								}
+							},
+							{
+								"metadata": {
+									"guid": "some-stack-guid-2"
+								},
+								"entity": {
+									"name": "some-stack-name-2",
+									"description": "some stack description"
+								}
+							}
+						]
+					}`
+					response2 := `{
+						"next_url": null,
+						"resources": [
+							{
+								"metadata": {
+									"guid": "some-stack-guid-3"
+								},
+								"entity": {
+									"name": "some-stack-name-3",
+									"description": "some stack description"
+								}
+							}
+						]
+					}`
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/stacks", "q=name:some-stack-name"),
+							RespondWith(http.StatusOK, response1, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+						),
+					)
+					server.AppendHandlers(
+						CombineHandlers(
+							VerifyRequest(http.MethodGet, "/v2/stacks", "q=name:some-stack-name&page=2"),
+							RespondWith(http.StatusOK, response2, http.Header{"X-Cf-Warnings": {"this is another warning"}}),
+						),
+					)
+				})
+
+				It("returns paginated results and all warnings", func() {
+					stacks, warnings, err := client.GetStacks([]Query{{
+						Filter:   NameFilter,
+						Operator: EqualOperator,
+						Value:    "some-stack-name",
+					}})
+
+					Expect(err).NotTo(HaveOccurred())
+					Expect(stacks).To(Equal([]Stack{
+						{
+							Description: "some stack description",
+							GUID:        "some-stack-guid-1",
+							Name:        "some-stack-name-1",
+						},
+						{
+							Description: "some stack description",
+							GUID:        "some-stack-guid-2",
+							Name:        "some-stack-name-2",
+						},
+						{
+							Description: "some stack description",
+							GUID:        "some-stack-guid-3",
+							Name:        "some-stack-name-3",
+						},
+					}))
+					Expect(warnings).To(ConsistOf(Warnings{"this is a warning", "this is another warning"}))
+				})
+			})
+		})
+
+		Context("when an error is encountered", func() {
+			BeforeEach(func() {
+				response := `{
+					"code": 10001,
+					"description": "Some Error",
+					"error_code": "CF-SomeError"
+				}`
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodGet, "/v2/stacks"),
+						RespondWith(http.StatusTeapot, response, http.Header{"X-Cf-Warnings": {"warning-1, warning-2"}}),
+					),
+				)
+			})
+
+			It("returns an error and all warnings", func() {
+				_, warnings, err := client.GetStacks(nil)
+
+				Expect(err).To(MatchError(ccerror.V2UnexpectedResponseError{
+					ResponseCode: http.StatusTeapot,
+					V2ErrorResponse: ccerror.V2ErrorResponse{
+						Code:        10001,
+						Description: "Some Error",
+						ErrorCode:   "CF-SomeError",
+					},
+				}))
+				Expect(warnings).To(ConsistOf(Warnings{"warning-1", "warning-2"}))
+			})
+		})
+	})
 })
@@ -0,0 +1,74 @@
+package ccv2
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv2/internal"
+)
+
+// userProvidedServiceInstanceRequestBody represents the body of a user
+// provided service instance create or update request.
+type userProvidedServiceInstanceRequestBody struct {
+	Name            string                 `json:"name"`
+	SpaceGUID       string                 `json:"space_guid,omitempty"`
+	Credentials     map[string]interface{} `json:"credentials"`
+	SyslogDrainURL  string                 `json:"syslog_drain_url"`
+	RouteServiceURL string                 `json:"route_service_url"`
+}
+
+func newUserProvidedServiceInstanceRequestBody(serviceInstance ServiceInstance) userProvidedServiceInstanceRequestBody {
+	credentials := serviceInstance.Credentials
+	if credentials == nil {
+		credentials = map[string]interface{}{}
+	}
+
+	return userProvidedServiceInstanceRequestBody{
+		Name:            serviceInstance.Name,
+		SpaceGUID:       serviceInstance.SpaceGUID,
+		Credentials:     credentials,
+		SyslogDrainURL:  serviceInstance.SyslogDrainURL,
+		RouteServiceURL: serviceInstance.RouteServiceURL,
+	}
+}
+
+// CreateUserProvidedServiceInstance creates a user provided service instance
+// with the name, space, credentials, syslog drain URL and route service URL
+// of the provided service instance.
+func (client *Client) CreateUserProvidedServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	return client.sendServiceInstance(
+		internal.PostUserProvidedServiceInstanceRequest,
+		nil,
+		newUserProvidedServiceInstanceRequestBody(serviceInstance),
+	)
+}
+
+// UpdateUserProvidedServiceInstance replaces the name, credentials, syslog
+// drain URL and route service URL of a user provided service instance.
+func (client *Client) UpdateUserProvidedServiceInstance(serviceInstance ServiceInstance) (ServiceInstance, Warnings, error) {
+	body := newUserProvidedServiceInstanceRequestBody(serviceInstance)
+	body.SpaceGUID = ""
+
+	return client.sendServiceInstance(
+		internal.PutUserProvidedServiceInstanceRequest,
+		Params{"user_provided_service_instance_guid": serviceInstance.GUID},
+		body,
+	)
+}
+
+// DeleteUserProvidedServiceInstance deletes a user provided service instance
+// along with its service bindings.
+func (client *Client) DeleteUserProvidedServiceInstance(guid string) (Warnings, error) {
+	request, err := client.newHTTPRequest(requestOptions{
+		RequestName: internal.DeleteUserProvidedServiceInstanceRequest,
+		URIParams:   Params{"user_provided_service_instance_guid": guid},
+		Query:       url.Values{"recursive": []string{"true"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var response cloudcontroller.Response
+	err = client.connection.Make(request, &response)
+	return response.Warnings, err
+}
@@ -0,0 +1,122 @@
+package ccv2_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("User Provided Service Instance", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestClient()
+	})
+
+	Describe("CreateUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"type": "user_provided_service_instance",
+					"space_guid": "some-space-guid",
+					"credentials": {"username": "admin"},
+					"syslog_drain_url": "syslog://example.com",
+					"route_service_url": ""
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/v2/user_provided_service_instances"),
+					VerifyJSONRepresenting(map[string]interface{}{
+						"name":              "some-service-instance",
+						"space_guid":        "some-space-guid",
+						"credentials":       map[string]interface{}{"username": "admin"},
+						"syslog_drain_url":  "syslog://example.com",
+						"route_service_url": "",
+					}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("returns the created service instance and warnings", func() {
+			serviceInstance, warnings, err := client.CreateUserProvidedServiceInstance(ServiceInstance{
+				Name:           "some-service-instance",
+				SpaceGUID:      "some-space-guid",
+				Credentials:    map[string]interface{}{"username": "admin"},
+				SyslogDrainURL: "syslog://example.com",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(serviceInstance).To(Equal(ServiceInstance{
+				GUID:           "some-service-instance-guid",
+				Name:           "some-service-instance",
+				Type:           UserProvidedService,
+				SpaceGUID:      "some-space-guid",
+				Credentials:    map[string]interface{}{"username": "admin"},
+				SyslogDrainURL: "syslog://example.com",
+			}))
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("UpdateUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			response := `{
+				"metadata": {
+					"guid": "some-service-instance-guid"
+				},
+				"entity": {
+					"name": "some-service-instance",
+					"type": "user_provided_service_instance",
+					"credentials": {}
+				}
+			}`
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPut, "/v2/user_provided_service_instances/some-service-instance-guid"),
+					VerifyJSONRepresenting(map[string]interface{}{
+						"name":              "some-service-instance",
+						"credentials":       map[string]interface{}{},
+						"syslog_drain_url":  "",
+						"route_service_url": "",
+					}),
+					RespondWith(http.StatusCreated, response, http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("replaces the properties of the service instance and returns warnings", func() {
+			_, warnings, err := client.UpdateUserProvidedServiceInstance(ServiceInstance{
+				GUID:      "some-service-instance-guid",
+				Name:      "some-service-instance",
+				SpaceGUID: "some-space-guid",
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+
+	Describe("DeleteUserProvidedServiceInstance", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodDelete, "/v2/user_provided_service_instances/some-service-instance-guid", "recursive=true"),
+					RespondWith(http.StatusNoContent, "", http.Header{"X-Cf-Warnings": {"this is a warning"}}),
+				),
+			)
+		})
+
+		It("deletes the service instance and its bindings, and returns warnings", func() {
+			warnings, err := client.DeleteUserProvidedServiceInstance("some-service-instance-guid")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(warnings).To(ConsistOf(Warnings{"this is a warning"}))
+		})
+	})
+})
@@ -4,9 +4,6 @@ import (
 	"bytes"
 	"encoding/json"
 	"io"
-	"mime/multipart"
-	"os"
-	"path/filepath"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -84,14 +81,17 @@ func (client *Client) CreatePackage(pkg Package) (Package, Warnings, error) {
 	return responsePackage, response.Warnings, err
 }
 
-// UploadPackage uploads a file to a given package's Upload resource.
-func (client *Client) UploadPackage(pkg Package, fileToUpload string) (Package, Warnings, error) {
+// UploadPackage uploads the zipped bits to a given package's Upload resource.
+// The bits are streamed from the reader rather than being held in memory, and
+// the request is marked as idempotent so that it is rewound and sent again
+// when a connection wrapper retries it.
+func (client *Client) UploadPackage(pkg Package, bits io.ReadSeeker, size int64) (Package, Warnings, error) {
 	link, ok := pkg.Links["upload"]
 	if !ok {
 		return Package{}, nil, ccerror.UploadLinkNotFoundError{PackageGUID: pkg.GUID}
 	}
 
-	body, contentType, err := client.createUploadStream(fileToUpload, "bits")
+	body, err := cloudcontroller.NewUploadBody(nil, "bits", "package.zip", bits, size)
 	if err != nil {
 		return Package{}, nil, err
 	}
@@ -99,9 +99,11 @@ func (client *Client) UploadPackage(pkg Package, fileToUpload string) (Package,
 	request, err := client.newHTTPRequest(requestOptions{
 		URL:    link.HREF,
 		Method: link.Method,
-		Body:   body,
 	})
-	request.Header.Set("Content-Type", contentType)
+	if err != nil {
+		return Package{}, nil, err
+	}
+	request = body.Attach(request)
 
 	var responsePackage Package
 	response := cloudcontroller.Response{
@@ -111,26 +113,3 @@ func (client *Client) UploadPackage(pkg Package, fileToUpload string) (Package,
 
 	return responsePackage, response.Warnings, err
 }
-
-func (client *Client) createUploadStream(path string, paramName string) (io.Reader, string, error) {
-	file, err := os.Open(path)
-	if err != nil {
-		return nil, "", err
-	}
-	defer file.Close()
-
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	part, err := writer.CreateFormFile(paramName, filepath.Base(path))
-	if err != nil {
-		return nil, "", err
-	}
-	_, err = io.Copy(part, file)
-
-	err = writer.Close()
-	if err != nil {
-		return nil, "", err
-	}
-
-	return body, writer.FormDataContentType(), nil
-}
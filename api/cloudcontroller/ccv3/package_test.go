@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
-	"os"
 	"strings"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -214,18 +213,12 @@ var _ = Describe("Package", func() {
 
 	Describe("UploadPackage", func() {
 		Context("when the package successfully is created", func() {
-			var tempFile *os.File
+			var bits *strings.Reader
 
 			BeforeEach(func() {
-				var err error
-				tempFile, err = ioutil.TempFile("", "package-upload")
-				Expect(err).ToNot(HaveOccurred())
-				defer tempFile.Close()
-
 				fileSize := 1024
 				contents := strings.Repeat("A", fileSize)
-				err = ioutil.WriteFile(tempFile.Name(), []byte(contents), 0666)
-				Expect(err).NotTo(HaveOccurred())
+				bits = strings.NewReader(contents)
 
 				verifyHeaderAndBody := func(_ http.ResponseWriter, req *http.Request) {
 					contentType := req.Header.Get("Content-Type")
@@ -236,6 +229,7 @@ var _ = Describe("Package", func() {
 					defer req.Body.Close()
 					rawBody, err := ioutil.ReadAll(req.Body)
 					Expect(err).NotTo(HaveOccurred())
+					Expect(req.ContentLength).To(BeEquivalentTo(len(rawBody)))
 					body := BufferWithBytes(rawBody)
 					Expect(body).To(Say("--%s", boundary))
 					Expect(body).To(Say(`name="bits"`))
@@ -263,12 +257,6 @@ var _ = Describe("Package", func() {
 				)
 			})
 
-			AfterEach(func() {
-				if tempFile != nil {
-					os.Remove(tempFile.Name())
-				}
-			})
-
 			It("returns the created package and warnings", func() {
 				pkg, warnings, err := client.UploadPackage(Package{
 					State: PackageStateAwaitingUpload,
@@ -278,7 +266,7 @@ var _ = Describe("Package", func() {
 							Method: http.MethodPost,
 						},
 					},
-				}, tempFile.Name())
+				}, bits, bits.Size())
 
 				Expect(err).NotTo(HaveOccurred())
 
@@ -296,7 +284,7 @@ var _ = Describe("Package", func() {
 
 		Context("when the package does not have an upload link", func() {
 			It("returns an UploadLinkNotFoundError", func() {
-				_, _, err := client.UploadPackage(Package{GUID: "some-pkg-guid", State: PackageStateAwaitingUpload}, "/path/to/foo")
+				_, _, err := client.UploadPackage(Package{GUID: "some-pkg-guid", State: PackageStateAwaitingUpload}, strings.NewReader(""), 0)
 				Expect(err).To(MatchError(ccerror.UploadLinkNotFoundError{PackageGUID: "some-pkg-guid"}))
 			})
 		})
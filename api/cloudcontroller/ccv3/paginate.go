@@ -6,45 +6,47 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 )
 
+// paginate requests every page of the list starting with request, and calls
+// appendToExternalList with each resource in order.
 func (client Client) paginate(request *http.Request, obj interface{}, appendToExternalList func(interface{}) error) (Warnings, error) {
-	fullWarningsList := Warnings{}
-
-	for {
-		wrapper := NewPaginatedResources(obj)
-		response := cloudcontroller.Response{
-			Result: &wrapper,
-		}
-
-		err := client.connection.Make(request, &response)
-		fullWarningsList = append(fullWarningsList, response.Warnings...)
-		if err != nil {
-			return fullWarningsList, err
-		}
-
-		list, err := wrapper.Resources()
-		if err != nil {
-			return fullWarningsList, err
-		}
-
-		for _, item := range list {
-			err = appendToExternalList(item)
-			if err != nil {
-				return fullWarningsList, err
-			}
-		}
-
-		if wrapper.NextPage() == "" {
-			break
-		}
-
-		request, err = client.newHTTPRequest(requestOptions{
-			URL:    wrapper.NextPage(),
-			Method: http.MethodGet,
-		})
-		if err != nil {
-			return fullWarningsList, err
-		}
+	warnings, err := cloudcontroller.Paginate(client.getPage(request, obj), func(url string) cloudcontroller.Page {
+		return client.getPageByURL(url, obj)
+	}, appendToExternalList)
+	return Warnings(warnings), err
+}
+
+// getPageByURL requests the page at the given URL.
+func (client Client) getPageByURL(url string, obj interface{}) cloudcontroller.Page {
+	request, err := client.newHTTPRequest(requestOptions{
+		URL:    url,
+		Method: http.MethodGet,
+	})
+	if err != nil {
+		return cloudcontroller.Page{Err: err}
 	}
 
-	return fullWarningsList, nil
+	return client.getPage(request, obj)
+}
+
+// getPage makes the request, which may be answered from the response cache,
+// and returns the page.
+func (client Client) getPage(request *http.Request, obj interface{}) cloudcontroller.Page {
+	wrapper := NewPaginatedResources(obj)
+	response := cloudcontroller.Response{
+		Result: &wrapper,
+	}
+
+	err := client.connection.Make(cloudcontroller.MarkCacheable(request), &response)
+	if err != nil {
+		return cloudcontroller.Page{Warnings: response.Warnings, Err: err}
+	}
+
+	list, err := wrapper.Resources()
+	return cloudcontroller.Page{
+		Resources:  list,
+		NextURL:    wrapper.NextPage(),
+		TotalPages: wrapper.Pagination.TotalPages,
+		Warnings:   response.Warnings,
+		Err:        err,
+	}
 }
@@ -0,0 +1,83 @@
+package ccv3_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Paginate", func() {
+	var (
+		client     *Client
+		failedPage int
+	)
+
+	BeforeEach(func() {
+		client = NewTestClient()
+		failedPage = 0
+
+		server.RouteToHandler(http.MethodGet, "/v3/apps", func(writer http.ResponseWriter, request *http.Request) {
+			page, err := strconv.Atoi(request.URL.Query().Get("page"))
+			if err != nil {
+				page = 1
+			}
+			writer.Header().Set("X-Cf-Warnings", fmt.Sprintf("warning-%d", page))
+
+			if page == failedPage {
+				writer.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(writer, `{"errors": [{"code": 10010, "detail": "Page not found", "title": "CF-ResourceNotFound"}]}`)
+				return
+			}
+
+			next := "null"
+			if page < 4 {
+				next = fmt.Sprintf(`{"href": "%s/v3/apps?names=some-app-name&page=%d&per_page=1"}`, server.URL(), page+1)
+			}
+			fmt.Fprintf(writer, `{
+				"pagination": {
+					"total_results": 4,
+					"total_pages": 4,
+					"next": %s
+				},
+				"resources": [
+					{"name": "some-app-name", "guid": "app-guid-%d"}
+				]
+			}`, next, page)
+		})
+	})
+
+	Context("when the first page reports the total number of pages", func() {
+		It("returns the resources from every page in order, and all warnings", func() {
+			apps, warnings, err := client.GetApplications(url.Values{
+				NameFilter: []string{"some-app-name"},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(apps).To(Equal([]Application{
+				{Name: "some-app-name", GUID: "app-guid-1"},
+				{Name: "some-app-name", GUID: "app-guid-2"},
+				{Name: "some-app-name", GUID: "app-guid-3"},
+				{Name: "some-app-name", GUID: "app-guid-4"},
+			}))
+			Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3", "warning-4"}))
+		})
+
+		Context("when a page cannot be fetched", func() {
+			BeforeEach(func() {
+				failedPage = 3
+			})
+
+			It("returns the error and the warnings up to and including that page", func() {
+				_, warnings, err := client.GetApplications(nil)
+				Expect(err).To(MatchError(ccerror.ResourceNotFoundError{Message: "Page not found"}))
+				Expect(warnings).To(Equal(Warnings{"warning-1", "warning-2", "warning-3"}))
+			})
+		})
+	})
+})
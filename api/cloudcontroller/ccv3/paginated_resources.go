@@ -17,7 +17,8 @@ func NewPaginatedResources(exampleResource interface{}) PaginatedResources {
 // Controller.
 type PaginatedResources struct {
 	Pagination struct {
-		Next struct {
+		TotalPages int `json:"total_pages"`
+		Next       struct {
 			HREF string `json:"href"`
 		} `json:"next"`
 	} `json:"pagination"`
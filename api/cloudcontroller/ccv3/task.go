@@ -10,6 +10,19 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3/internal"
 )
 
+const (
+	// TaskStateSucceeded is the state of a task that finished successfully.
+	TaskStateSucceeded = "SUCCEEDED"
+	// TaskStateFailed is the state of a task that failed or was terminated.
+	TaskStateFailed = "FAILED"
+)
+
+// TaskResult is the outcome of a task that has finished running.
+type TaskResult struct {
+	// FailureReason describes why a failed task failed.
+	FailureReason string `json:"failure_reason"`
+}
+
 // Task represents a Cloud Controller V3 Task.
 type Task struct {
 	GUID       string `json:"guid,omitempty"`
@@ -20,6 +33,8 @@ type Task struct {
 	CreatedAt  string `json:"created_at,omitempty"`
 	MemoryInMB uint64 `json:"memory_in_mb,omitempty"`
 	DiskInMB   uint64 `json:"disk_in_mb,omitempty"`
+	// Result is only set by the Cloud Controller.
+	Result *TaskResult `json:"result,omitempty"`
 }
 
 // CreateApplicationTask runs a command in the Application environment
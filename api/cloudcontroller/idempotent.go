@@ -0,0 +1,22 @@
+package cloudcontroller
+
+import (
+	"net/http"
+
+	"code.cloudfoundry.org/cli/api/retrypolicy"
+)
+
+// MarkIdempotent returns a copy of the request that is flagged as safe to
+// repeat. Connection wrappers only retry POST requests that are flagged or
+// carry a request ID, and the request's GetBody must be set so that the body
+// can be sent again.
+func MarkIdempotent(request *http.Request) *http.Request {
+	return retrypolicy.MarkIdempotent(request)
+}
+
+// IsIdempotent returns true if the request can safely be repeated. Every
+// request except a POST is idempotent; a POST is only idempotent when it
+// carries a request ID or has been flagged with MarkIdempotent.
+func IsIdempotent(request *http.Request) bool {
+	return retrypolicy.IsIdempotent(request)
+}
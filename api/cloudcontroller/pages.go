@@ -0,0 +1,152 @@
+package cloudcontroller
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// maxConcurrentPages is the number of pages that are requested at once after
+// the first page of a list reports the total number of pages.
+const maxConcurrentPages = 4
+
+// Page is a single page of a paginated list.
+type Page struct {
+	Resources  []interface{}
+	NextURL    string
+	TotalPages int
+	Warnings   []string
+	Err        error
+}
+
+// Paginate lists every page starting with firstPage, requesting the pages
+// after it with fetch, and calls appendToExternalList with each resource in
+// order. When the first page reports its total number of pages, the remaining
+// pages are requested concurrently. The warnings of every page requested are
+// returned.
+func Paginate(firstPage Page, fetch func(url string) Page, appendToExternalList func(interface{}) error) ([]string, error) {
+	fullWarningsList := []string{}
+
+	appendPage := func(currentPage Page) error {
+		fullWarningsList = append(fullWarningsList, currentPage.Warnings...)
+		if currentPage.Err != nil {
+			return currentPage.Err
+		}
+
+		for _, item := range currentPage.Resources {
+			err := appendToExternalList(item)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := appendPage(firstPage)
+	if err != nil {
+		return fullWarningsList, err
+	}
+
+	nextURL := firstPage.NextURL
+	if pageURLs := RemainingPageURLs(nextURL, firstPage.TotalPages); len(pageURLs) > 0 {
+		pages := make([]Page, len(pageURLs))
+		FetchPages(len(pageURLs), maxConcurrentPages, func(i int) bool {
+			pages[i] = fetch(pageURLs[i])
+			return pages[i].Err == nil
+		})
+
+		for _, currentPage := range pages {
+			err = appendPage(currentPage)
+			if err != nil {
+				return fullWarningsList, err
+			}
+		}
+		nextURL = pages[len(pages)-1].NextURL
+	}
+
+	// Pages added since the first page was listed are followed one at a time.
+	for nextURL != "" {
+		currentPage := fetch(nextURL)
+		err = appendPage(currentPage)
+		if err != nil {
+			return fullWarningsList, err
+		}
+		nextURL = currentPage.NextURL
+	}
+
+	return fullWarningsList, nil
+}
+
+// RemainingPageURLs returns the URLs of every page from nextURL up to and
+// including totalPages. It returns nil when nextURL does not select its page
+// with a "page" query parameter, or when there are no pages left to list.
+func RemainingPageURLs(nextURL string, totalPages int) []string {
+	parsedURL, err := url.Parse(nextURL)
+	if err != nil {
+		return nil
+	}
+
+	nextPage, err := strconv.Atoi(parsedURL.Query().Get("page"))
+	if err != nil || nextPage < 1 || nextPage > totalPages {
+		return nil
+	}
+
+	// The other parameters are kept as they were sent, so that only the page
+	// differs between the URLs.
+	parameters := strings.Split(parsedURL.RawQuery, "&")
+	urls := make([]string, 0, totalPages-nextPage+1)
+	for page := nextPage; page <= totalPages; page++ {
+		for i, parameter := range parameters {
+			if strings.HasPrefix(parameter, "page=") {
+				parameters[i] = "page=" + strconv.Itoa(page)
+			}
+		}
+		parsedURL.RawQuery = strings.Join(parameters, "&")
+		urls = append(urls, parsedURL.String())
+	}
+	return urls
+}
+
+// FetchPages calls fetch for every index from 0 to count-1, with at most
+// maxInFlight calls running at once. Calls are started in index order, and
+// once a call returns false no further calls are started. FetchPages returns
+// after every started call has returned.
+func FetchPages(count int, maxInFlight int, fetch func(index int) bool) {
+	if maxInFlight < 1 {
+		maxInFlight = 1
+	}
+
+	var (
+		wg      sync.WaitGroup
+		mutex   sync.Mutex
+		stopped bool
+	)
+	slots := make(chan struct{}, maxInFlight)
+
+	for index := 0; index < count; index++ {
+		slots <- struct{}{}
+
+		mutex.Lock()
+		stop := stopped
+		mutex.Unlock()
+		if stop {
+			<-slots
+			break
+		}
+
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			defer func() { <-slots }()
+
+			if !fetch(index) {
+				mutex.Lock()
+				stopped = true
+				mutex.Unlock()
+			}
+		}(index)
+	}
+
+	wg.Wait()
+}
@@ -0,0 +1,162 @@
+package cloudcontroller_test
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/cloudcontroller"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pages", func() {
+	DescribeTable("RemainingPageURLs",
+		func(nextURL string, totalPages int, expectedURLs []string) {
+			Expect(RemainingPageURLs(nextURL, totalPages)).To(Equal(expectedURLs))
+		},
+
+		Entry("lists every remaining page", "/v2/apps?q=name:banana&page=2&results-per-page=50", 4, []string{
+			"/v2/apps?q=name:banana&page=2&results-per-page=50",
+			"/v2/apps?q=name:banana&page=3&results-per-page=50",
+			"/v2/apps?q=name:banana&page=4&results-per-page=50",
+		}),
+		Entry("keeps the host of a full URL", "https://api.foo.com/v3/apps?page=3&per_page=2", 3, []string{
+			"https://api.foo.com/v3/apps?page=3&per_page=2",
+		}),
+		Entry("returns nil when there is no page parameter", "/v2/apps?order-by=name", 4, nil),
+		Entry("returns nil when the page is not a number", "/v2/apps?page=two", 4, nil),
+		Entry("returns nil when the total is unknown", "/v2/apps?page=2", 0, nil),
+		Entry("returns nil when the next page is past the total", "/v2/apps?page=5", 4, nil),
+	)
+
+	Describe("FetchPages", func() {
+		It("fetches every page with at most maxInFlight at once", func() {
+			var (
+				mutex       sync.Mutex
+				inFlight    int
+				maxInFlight int
+				fetched     []int
+			)
+
+			FetchPages(10, 3, func(index int) bool {
+				mutex.Lock()
+				inFlight++
+				if inFlight > maxInFlight {
+					maxInFlight = inFlight
+				}
+				mutex.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				mutex.Lock()
+				inFlight--
+				fetched = append(fetched, index)
+				mutex.Unlock()
+				return true
+			})
+
+			Expect(fetched).To(ConsistOf(0, 1, 2, 3, 4, 5, 6, 7, 8, 9))
+			Expect(maxInFlight).To(BeNumerically("<=", 3))
+			Expect(maxInFlight).To(BeNumerically(">", 1))
+		})
+
+		It("stops starting pages after a fetch fails", func() {
+			var (
+				mutex   sync.Mutex
+				fetched []int
+			)
+
+			FetchPages(10, 1, func(index int) bool {
+				mutex.Lock()
+				defer mutex.Unlock()
+				fetched = append(fetched, index)
+				return index != 2
+			})
+
+			Expect(fetched).To(Equal([]int{0, 1, 2}))
+		})
+	})
+
+	Describe("Paginate", func() {
+		var (
+			pages          map[string]Page
+			fetchedMu      sync.Mutex
+			fetched        []string
+			fetch          func(url string) Page
+			resources      []interface{}
+			appendErr      error
+			appendResource func(interface{}) error
+		)
+
+		BeforeEach(func() {
+			pages = map[string]Page{}
+			fetched = nil
+			fetch = func(url string) Page {
+				fetchedMu.Lock()
+				defer fetchedMu.Unlock()
+				fetched = append(fetched, url)
+				return pages[url]
+			}
+			resources = nil
+			appendErr = nil
+			appendResource = func(item interface{}) error {
+				resources = append(resources, item)
+				return appendErr
+			}
+		})
+
+		It("appends the resources of every page in order and returns every warning", func() {
+			pages["/v2/apps?page=2"] = Page{Resources: []interface{}{"c"}, NextURL: "/v2/apps?page=3", Warnings: []string{"warning-2"}}
+			pages["/v2/apps?page=3"] = Page{Resources: []interface{}{"d"}, NextURL: "/v2/apps?page=4", Warnings: []string{"warning-3"}}
+			pages["/v2/apps?page=4"] = Page{Resources: []interface{}{"e"}, Warnings: []string{"warning-4"}}
+
+			warnings, err := Paginate(Page{
+				Resources:  []interface{}{"a", "b"},
+				NextURL:    "/v2/apps?page=2",
+				TotalPages: 3,
+				Warnings:   []string{"warning-1"},
+			}, fetch, appendResource)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(Equal([]interface{}{"a", "b", "c", "d", "e"}))
+			Expect(warnings).To(Equal([]string{"warning-1", "warning-2", "warning-3", "warning-4"}))
+			Expect(fetched).To(ConsistOf("/v2/apps?page=2", "/v2/apps?page=3", "/v2/apps?page=4"))
+		})
+
+		It("follows the next URLs when the total is unknown", func() {
+			pages["/v3/apps?after=b"] = Page{Resources: []interface{}{"c"}}
+
+			_, err := Paginate(Page{Resources: []interface{}{"a", "b"}, NextURL: "/v3/apps?after=b"}, fetch, appendResource)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resources).To(Equal([]interface{}{"a", "b", "c"}))
+		})
+
+		Context("when a page fails", func() {
+			It("returns the error and the warnings so far", func() {
+				pages["/v2/apps?page=2"] = Page{Warnings: []string{"warning-2"}, Err: errors.New("page error")}
+
+				warnings, err := Paginate(Page{
+					Resources:  []interface{}{"a"},
+					NextURL:    "/v2/apps?page=2",
+					TotalPages: 2,
+					Warnings:   []string{"warning-1"},
+				}, fetch, appendResource)
+				Expect(err).To(MatchError("page error"))
+				Expect(warnings).To(Equal([]string{"warning-1", "warning-2"}))
+				Expect(resources).To(Equal([]interface{}{"a"}))
+			})
+		})
+
+		Context("when appending a resource fails", func() {
+			It("returns the error without fetching further pages", func() {
+				appendErr = errors.New("append error")
+
+				_, err := Paginate(Page{Resources: []interface{}{"a"}, NextURL: "/v2/apps?page=2", TotalPages: 2}, fetch, appendResource)
+				Expect(err).To(MatchError("append error"))
+				Expect(fetched).To(BeEmpty())
+			})
+		})
+	})
+})
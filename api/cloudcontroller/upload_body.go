@@ -0,0 +1,108 @@
+package cloudcontroller
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"mime/multipart"
+	"net/http"
+	"sort"
+)
+
+// UploadBody is a multipart request body that streams a file instead of
+// holding it in memory. It can be rewound, so requests using it can be
+// retried.
+type UploadBody struct {
+	contentType string
+	length      int64
+	parts       []io.ReadSeeker
+	current     int
+}
+
+// NewUploadBody returns a multipart body containing the given form fields,
+// followed by a file part that is read from bits. size must be the number of
+// bytes in bits.
+func NewUploadBody(fields map[string]string, fileParam string, fileName string, bits io.ReadSeeker, size int64) (*UploadBody, error) {
+	buffer := &bytes.Buffer{}
+	writer := multipart.NewWriter(buffer)
+
+	var names []string
+	for name := range fields {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		err := writer.WriteField(name, fields[name])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err := writer.CreateFormFile(fileParam, fileName)
+	if err != nil {
+		return nil, err
+	}
+	header := append([]byte{}, buffer.Bytes()...)
+
+	buffer.Reset()
+	err = writer.Close()
+	if err != nil {
+		return nil, err
+	}
+	trailer := append([]byte{}, buffer.Bytes()...)
+
+	return &UploadBody{
+		contentType: writer.FormDataContentType(),
+		length:      int64(len(header)) + size + int64(len(trailer)),
+		parts: []io.ReadSeeker{
+			bytes.NewReader(header),
+			bits,
+			bytes.NewReader(trailer),
+		},
+	}, nil
+}
+
+// Attach sets the body, content type and content length of the request. The
+// returned request is marked as idempotent, and rewinds the body when it is
+// sent again.
+func (body *UploadBody) Attach(request *http.Request) *http.Request {
+	request.Body = ioutil.NopCloser(body)
+	request.ContentLength = body.length
+	request.GetBody = func() (io.ReadCloser, error) {
+		_, err := body.Seek(0, io.SeekStart)
+		return ioutil.NopCloser(body), err
+	}
+	request.Header.Set("Content-Type", body.contentType)
+	return MarkIdempotent(request)
+}
+
+// Read reads each part of the body in turn.
+func (body *UploadBody) Read(p []byte) (int, error) {
+	for body.current < len(body.parts) {
+		n, err := body.parts[body.current].Read(p)
+		if err == io.EOF {
+			body.current++
+			err = nil
+		}
+		if n > 0 || err != nil {
+			return n, err
+		}
+	}
+	return 0, io.EOF
+}
+
+// Seek rewinds the body. It can only be rewound to the start.
+func (body *UploadBody) Seek(offset int64, whence int) (int64, error) {
+	if offset != 0 || whence != io.SeekStart {
+		return 0, errors.New("upload body can only be rewound to the start")
+	}
+
+	for _, part := range body.parts {
+		if _, err := part.Seek(0, io.SeekStart); err != nil {
+			return 0, err
+		}
+	}
+	body.current = 0
+	return 0, nil
+}
@@ -0,0 +1,260 @@
+package wrapper
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+)
+
+// CachedResponse is a response to a GET request stored by the ResponseCache.
+type CachedResponse struct {
+	URL          string
+	StoredAt     time.Time
+	ETag         string
+	LastModified string
+	StatusCode   int
+	Header       http.Header
+	Warnings     []string
+	Body         []byte
+}
+
+// ResponseCache is a wrapper that caches the responses to GET requests on
+// disk, keyed by the URL and the subject of the request's access token. Only
+// requests flagged with cloudcontroller.MarkCacheable, which are the pages of
+// lists, are cached, so that polling requests always reach the server.
+//
+// Cached responses are returned without contacting the server until they are
+// older than the TTL. Older responses are revalidated with If-None-Match or
+// If-Modified-Since when the server sent an ETag or Last-Modified header.
+// Any other successful request clears the cache, since it may have changed
+// the cached resources.
+type ResponseCache struct {
+	connection cloudcontroller.Connection
+	dir        string
+	ttl        time.Duration
+}
+
+// NewResponseCache returns a pointer to a ResponseCache wrapper that stores
+// responses in dir for ttl.
+func NewResponseCache(dir string, ttl time.Duration) *ResponseCache {
+	return &ResponseCache{
+		dir: dir,
+		ttl: ttl,
+	}
+}
+
+// Wrap sets the connection in the ResponseCache and returns itself.
+func (cache *ResponseCache) Wrap(innerconnection cloudcontroller.Connection) cloudcontroller.Connection {
+	cache.connection = innerconnection
+	return cache
+}
+
+// Make returns the cached response to cacheable GET requests when there is
+// one that is still fresh, and otherwise makes the request and caches the
+// response.
+func (cache *ResponseCache) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
+	if request.Method != http.MethodGet {
+		err := cache.connection.Make(request, passedResponse)
+		if err == nil {
+			_ = cache.Clear()
+		}
+		return err
+	}
+
+	if !cloudcontroller.IsCacheable(request) {
+		return cache.connection.Make(request, passedResponse)
+	}
+
+	key := responseCacheKey(request)
+	cached, found := cache.load(key)
+	if found && time.Since(cached.StoredAt) < cache.ttl {
+		return populateCachedResponse(request, cached, passedResponse)
+	}
+
+	if found {
+		if cached.ETag != "" {
+			request.Header.Set("If-None-Match", cached.ETag)
+		}
+		if cached.LastModified != "" {
+			request.Header.Set("If-Modified-Since", cached.LastModified)
+		}
+		defer request.Header.Del("If-None-Match")
+		defer request.Header.Del("If-Modified-Since")
+	}
+
+	var response cloudcontroller.Response
+	err := cache.connection.Make(request, &response)
+	if err != nil {
+		passedResponse.RawResponse = response.RawResponse
+		passedResponse.Warnings = response.Warnings
+		passedResponse.HTTPResponse = response.HTTPResponse
+		return err
+	}
+
+	if response.HTTPResponse == nil {
+		return populateResponse(response.RawResponse, response.Warnings, nil, passedResponse)
+	}
+
+	if found && response.HTTPResponse.StatusCode == http.StatusNotModified {
+		cached.StoredAt = time.Now()
+		cache.store(key, cached)
+		return populateCachedResponse(request, cached, passedResponse)
+	}
+
+	if response.HTTPResponse.StatusCode == http.StatusOK {
+		cache.store(key, CachedResponse{
+			URL:          request.URL.String(),
+			StoredAt:     time.Now(),
+			ETag:         response.HTTPResponse.Header.Get("ETag"),
+			LastModified: response.HTTPResponse.Header.Get("Last-Modified"),
+			StatusCode:   response.HTTPResponse.StatusCode,
+			Header:       response.HTTPResponse.Header,
+			Warnings:     response.Warnings,
+			Body:         response.RawResponse,
+		})
+	}
+
+	return populateResponse(response.RawResponse, response.Warnings, response.HTTPResponse, passedResponse)
+}
+
+// Entries returns the cached responses sorted by URL.
+func (cache *ResponseCache) Entries() ([]CachedResponse, error) {
+	files, err := ioutil.ReadDir(cache.dir)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []CachedResponse
+	for _, file := range files {
+		if filepath.Ext(file.Name()) != ".json" {
+			continue
+		}
+		entry, ok := cache.load(strings.TrimSuffix(file.Name(), ".json"))
+		if ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].URL < entries[j].URL
+	})
+	return entries, nil
+}
+
+// Clear removes all cached responses.
+func (cache *ResponseCache) Clear() error {
+	return os.RemoveAll(cache.dir)
+}
+
+func (cache *ResponseCache) load(key string) (CachedResponse, bool) {
+	raw, err := ioutil.ReadFile(filepath.Join(cache.dir, key+".json"))
+	if err != nil {
+		return CachedResponse{}, false
+	}
+
+	var entry CachedResponse
+	if err := json.Unmarshal(raw, &entry); err != nil {
+		return CachedResponse{}, false
+	}
+	return entry, true
+}
+
+// store writes the entry to a temporary file that is renamed into place, so
+// that concurrent CLI processes never read a partial entry. The cache is
+// best effort, so failures are ignored.
+func (cache *ResponseCache) store(key string, entry CachedResponse) {
+	raw, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(cache.dir, 0700); err != nil {
+		return
+	}
+
+	tempFile, err := ioutil.TempFile(cache.dir, key)
+	if err != nil {
+		return
+	}
+	defer os.Remove(tempFile.Name())
+
+	_, err = tempFile.Write(raw)
+	closeErr := tempFile.Close()
+	if err != nil || closeErr != nil {
+		return
+	}
+
+	_ = os.Rename(tempFile.Name(), filepath.Join(cache.dir, key+".json"))
+}
+
+func populateCachedResponse(request *http.Request, entry CachedResponse, passedResponse *cloudcontroller.Response) error {
+	httpResponse := &http.Response{
+		Status:     fmt.Sprintf("%d %s", entry.StatusCode, http.StatusText(entry.StatusCode)),
+		StatusCode: entry.StatusCode,
+		Header:     entry.Header,
+		Request:    request,
+	}
+	return populateResponse(entry.Body, entry.Warnings, httpResponse, passedResponse)
+}
+
+func populateResponse(rawResponse []byte, warnings []string, httpResponse *http.Response, passedResponse *cloudcontroller.Response) error {
+	passedResponse.RawResponse = rawResponse
+	passedResponse.Warnings = warnings
+	passedResponse.HTTPResponse = httpResponse
+
+	if passedResponse.Result == nil {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewBuffer(rawResponse))
+	decoder.UseNumber()
+	return decoder.Decode(passedResponse.Result)
+}
+
+// responseCacheKey identifies a request by its URL and the user or client the
+// access token was issued to, so that refreshed tokens reuse the same entries.
+func responseCacheKey(request *http.Request) string {
+	hash := sha256.Sum256([]byte(tokenSubject(request.Header.Get("Authorization")) + "\n" + request.URL.String()))
+	return fmt.Sprintf("%x", hash)
+}
+
+// tokenSubject returns the subject of a bearer JWT, or the whole header when
+// it cannot be parsed.
+func tokenSubject(authorization string) string {
+	fields := strings.Fields(authorization)
+	if len(fields) != 2 {
+		return authorization
+	}
+
+	segments := strings.Split(fields[1], ".")
+	if len(segments) != 3 {
+		return authorization
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(segments[1], "="))
+	if err != nil {
+		return authorization
+	}
+
+	var claims struct {
+		Subject string `json:"sub"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil || claims.Subject == "" {
+		return authorization
+	}
+	return claims.Subject
+}
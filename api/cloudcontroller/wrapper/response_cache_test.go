@@ -0,0 +1,238 @@
+package wrapper_test
+
+import (
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"time"
+
+	"code.cloudfoundry.org/cli/api/cloudcontroller"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
+	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
+	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Response Cache", func() {
+	var (
+		fakeConnection *cloudcontrollerfakes.FakeConnection
+		cacheDir       string
+		ttl            time.Duration
+
+		cache   *ResponseCache
+		wrapper cloudcontroller.Connection
+	)
+
+	token := func(subject string) string {
+		payload := base64.RawURLEncoding.EncodeToString([]byte(`{"sub":"` + subject + `"}`))
+		return "bearer header." + payload + ".signature"
+	}
+
+	newRequest := func(method string, authorization string) *http.Request {
+		request, err := http.NewRequest(method, "https://api.foo.com/v2/apps?q=name:banana", nil)
+		Expect(err).ToNot(HaveOccurred())
+		request.Header.Set("Authorization", authorization)
+		return cloudcontroller.MarkCacheable(request)
+	}
+
+	respondWith := func(statusCode int, header http.Header, body string) func(*http.Request, *cloudcontroller.Response) error {
+		return func(_ *http.Request, response *cloudcontroller.Response) error {
+			if header == nil {
+				header = http.Header{}
+			}
+			response.HTTPResponse = &http.Response{StatusCode: statusCode, Header: header}
+			response.RawResponse = []byte(body)
+			response.Warnings = []string{"some-warning"}
+			return nil
+		}
+	}
+
+	makeRequest := func(request *http.Request) (map[string]string, cloudcontroller.Response, error) {
+		result := map[string]string{}
+		response := cloudcontroller.Response{Result: &result}
+		err := wrapper.Make(request, &response)
+		return result, response, err
+	}
+
+	BeforeEach(func() {
+		var err error
+		cacheDir, err = ioutil.TempDir("", "cli-response-cache")
+		Expect(err).ToNot(HaveOccurred())
+
+		fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeStub = respondWith(http.StatusOK, nil, `{"name":"banana"}`)
+		ttl = time.Hour
+	})
+
+	JustBeforeEach(func() {
+		cache = NewResponseCache(cacheDir, ttl)
+		wrapper = cache.Wrap(fakeConnection)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(cacheDir)).To(Succeed())
+	})
+
+	Context("when the response is not cached", func() {
+		It("makes the request and returns the response", func() {
+			result, response, err := makeRequest(newRequest(http.MethodGet, token("some-user")))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(result).To(Equal(map[string]string{"name": "banana"}))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+		})
+	})
+
+	Context("when a fresh response is cached", func() {
+		JustBeforeEach(func() {
+			_, _, err := makeRequest(newRequest(http.MethodGet, token("some-user")))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the cached response without making the request", func() {
+			result, response, err := makeRequest(newRequest(http.MethodGet, token("some-user")))
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+			Expect(result).To(Equal(map[string]string{"name": "banana"}))
+			Expect(response.RawResponse).To(MatchJSON(`{"name":"banana"}`))
+			Expect(response.Warnings).To(ConsistOf("some-warning"))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusOK))
+		})
+
+		It("keys the cache by the subject of the token", func() {
+			_, _, err := makeRequest(newRequest(http.MethodGet, token("some-user")+"-refreshed"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+			_, _, err = makeRequest(newRequest(http.MethodGet, token("other-user")))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+		})
+
+		Context("when the request is not cacheable", func() {
+			It("makes the request without using the cache", func() {
+				request, err := http.NewRequest(http.MethodGet, "https://api.foo.com/v2/apps?q=name:banana", nil)
+				Expect(err).ToNot(HaveOccurred())
+				request.Header.Set("Authorization", token("some-user"))
+
+				_, _, err = makeRequest(request)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+
+				_, _, err = makeRequest(newRequest(http.MethodGet, token("some-user")))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			})
+		})
+
+		Context("when a request changes a resource", func() {
+			It("clears the cache", func() {
+				_, _, err := makeRequest(newRequest(http.MethodDelete, token("some-user")))
+				Expect(err).ToNot(HaveOccurred())
+
+				_, _, err = makeRequest(newRequest(http.MethodGet, token("some-user")))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			})
+		})
+	})
+
+	Context("when the cached response is older than the TTL", func() {
+		BeforeEach(func() {
+			ttl = 0
+			fakeConnection.MakeStub = respondWith(http.StatusOK, http.Header{
+				"Etag":          {`"some-etag"`},
+				"Last-Modified": {"Wed, 21 Oct 2015 07:28:00 GMT"},
+			}, `{"name":"banana"}`)
+		})
+
+		JustBeforeEach(func() {
+			_, _, err := makeRequest(newRequest(http.MethodGet, token("some-user")))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("revalidates the response", func() {
+			var ifNoneMatch, ifModifiedSince string
+			fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+				ifNoneMatch = request.Header.Get("If-None-Match")
+				ifModifiedSince = request.Header.Get("If-Modified-Since")
+				return respondWith(http.StatusNotModified, nil, "")(request, response)
+			}
+
+			request := newRequest(http.MethodGet, token("some-user"))
+			result, _, err := makeRequest(request)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			Expect(ifNoneMatch).To(Equal(`"some-etag"`))
+			Expect(ifModifiedSince).To(Equal("Wed, 21 Oct 2015 07:28:00 GMT"))
+			Expect(request.Header.Get("If-None-Match")).To(BeEmpty())
+			Expect(result).To(Equal(map[string]string{"name": "banana"}))
+		})
+
+		Context("when the resource has changed", func() {
+			It("returns and caches the new response", func() {
+				fakeConnection.MakeStub = respondWith(http.StatusOK, nil, `{"name":"pineapple"}`)
+
+				result, _, err := makeRequest(newRequest(http.MethodGet, token("some-user")))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result).To(Equal(map[string]string{"name": "pineapple"}))
+
+				entries, err := cache.Entries()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(entries).To(HaveLen(1))
+				Expect(entries[0].Body).To(MatchJSON(`{"name":"pineapple"}`))
+			})
+		})
+	})
+
+	Context("when the request fails", func() {
+		var expectedErr error
+
+		BeforeEach(func() {
+			expectedErr = ccerror.ResourceNotFoundError{}
+			fakeConnection.MakeStub = func(_ *http.Request, response *cloudcontroller.Response) error {
+				response.HTTPResponse = &http.Response{StatusCode: http.StatusNotFound}
+				return expectedErr
+			}
+		})
+
+		It("returns the error and does not cache the response", func() {
+			_, response, err := makeRequest(newRequest(http.MethodGet, token("some-user")))
+			Expect(err).To(MatchError(expectedErr))
+			Expect(response.HTTPResponse.StatusCode).To(Equal(http.StatusNotFound))
+
+			entries, err := cache.Entries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+
+	Describe("Entries and Clear", func() {
+		It("lists and removes the cached responses", func() {
+			entries, err := cache.Entries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+
+			_, _, err = makeRequest(newRequest(http.MethodGet, token("some-user")))
+			Expect(err).ToNot(HaveOccurred())
+
+			entries, err = cache.Entries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(HaveLen(1))
+			Expect(entries[0].URL).To(Equal("https://api.foo.com/v2/apps?q=name:banana"))
+
+			Expect(cache.Clear()).To(Succeed())
+
+			entries, err = cache.Entries()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(entries).To(BeEmpty())
+		})
+	})
+})
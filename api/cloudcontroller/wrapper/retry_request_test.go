@@ -1,14 +1,17 @@
 package wrapper_test
 
 import (
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"time"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/cloudcontrollerfakes"
 	. "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/retrypolicy"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -55,11 +58,13 @@ var _ = Describe("Retry Request", func() {
 		Entry("maxRetries for Non-Post (502) Bad Gateway", http.MethodGet, http.StatusBadGateway, 3),
 		Entry("maxRetries for Non-Post (503) Service Unavailable", http.MethodGet, http.StatusServiceUnavailable, 3),
 		Entry("maxRetries for Non-Post (504) Gateway Timeout", http.MethodGet, http.StatusGatewayTimeout, 3),
+		Entry("maxRetries for Non-Post (429) Too Many Requests", http.MethodGet, http.StatusTooManyRequests, 3),
 
 		Entry("1 for Post (500) Internal Server Error", http.MethodPost, http.StatusInternalServerError, 1),
 		Entry("1 for Post (502) Bad Gateway", http.MethodPost, http.StatusBadGateway, 1),
 		Entry("1 for Post (503) Service Unavailable", http.MethodPost, http.StatusServiceUnavailable, 1),
 		Entry("1 for Post (504) Gateway Timeout", http.MethodPost, http.StatusGatewayTimeout, 1),
+		Entry("1 for Post (429) Too Many Requests", http.MethodPost, http.StatusTooManyRequests, 1),
 
 		Entry("1 for Post 4XX Errors", http.MethodGet, http.StatusNotFound, 1),
 	)
@@ -80,4 +85,93 @@ var _ = Describe("Retry Request", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
 	})
+
+	Context("when a POST request is marked as idempotent", func() {
+		var (
+			request        *http.Request
+			fakeConnection *cloudcontrollerfakes.FakeConnection
+			bodies         []string
+		)
+
+		BeforeEach(func() {
+			var err error
+			request, err = http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", strings.NewReader("banana pants"))
+			Expect(err).NotTo(HaveOccurred())
+			request = cloudcontroller.MarkIdempotent(request)
+
+			bodies = nil
+			fakeConnection = new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).ToNot(HaveOccurred())
+				bodies = append(bodies, string(body))
+
+				if fakeConnection.MakeCallCount() < 3 {
+					return ccerror.RequestError{Err: errors.New("connection reset by peer")}
+				}
+				return nil
+			}
+		})
+
+		It("rewinds the body and retries connection failures with backoff", func() {
+			wrapper := NewRetryRequestWithBackoff(2, 10*time.Millisecond).Wrap(fakeConnection)
+
+			start := time.Now()
+			err := wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", 30*time.Millisecond))
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(3))
+			Expect(bodies).To(Equal([]string{"banana pants", "banana pants", "banana pants"}))
+		})
+	})
+
+	Context("when a POST request carries a request ID", func() {
+		It("retries the request, waiting as long as the server asks", func() {
+			request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", strings.NewReader("banana pants"))
+			Expect(err).NotTo(HaveOccurred())
+			request.Header.Set(retrypolicy.RequestIDHeader, "some-request-id")
+
+			var bodies []string
+			fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+			fakeConnection.MakeStub = func(req *http.Request, passedResponse *cloudcontroller.Response) error {
+				body, err := ioutil.ReadAll(req.Body)
+				Expect(err).ToNot(HaveOccurred())
+				bodies = append(bodies, string(body))
+
+				if fakeConnection.MakeCallCount() == 1 {
+					passedResponse.HTTPResponse = &http.Response{
+						StatusCode: http.StatusTooManyRequests,
+						Header:     http.Header{"Retry-After": {"1"}},
+					}
+					return ccerror.RawHTTPStatusError{StatusCode: http.StatusTooManyRequests}
+				}
+				passedResponse.HTTPResponse = &http.Response{StatusCode: http.StatusCreated}
+				return nil
+			}
+
+			wrapper := NewRetryRequestWithPolicy(retrypolicy.Policy{MaxRetries: 2, MaxWait: time.Minute}).Wrap(fakeConnection)
+
+			start := time.Now()
+			err = wrapper.Make(request, &cloudcontroller.Response{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(time.Since(start)).To(BeNumerically(">=", time.Second))
+
+			Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+			Expect(bodies).To(Equal([]string{"banana pants", "banana pants"}))
+		})
+	})
+
+	It("does not retry connection failures for POST requests that are not marked as idempotent", func() {
+		request, err := http.NewRequest(http.MethodPost, "https://foo.bar.com/banana", strings.NewReader("banana pants"))
+		Expect(err).NotTo(HaveOccurred())
+
+		fakeConnection := new(cloudcontrollerfakes.FakeConnection)
+		fakeConnection.MakeReturns(ccerror.RequestError{Err: errors.New("connection reset by peer")})
+
+		wrapper := NewRetryRequest(2).Wrap(fakeConnection)
+		err = wrapper.Make(request, &cloudcontroller.Response{})
+		Expect(err).To(MatchError(ccerror.RequestError{Err: errors.New("connection reset by peer")}))
+		Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+	})
 })
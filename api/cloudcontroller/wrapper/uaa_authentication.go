@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"net/http"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -27,12 +28,26 @@ type TokenCache interface {
 	SetRefreshToken(token string)
 }
 
+// SharedTokenCache is a TokenCache that is shared with other processes, which
+// may refresh the tokens too.
+type SharedTokenCache interface {
+	TokenCache
+
+	// LockTokens runs refresh while holding a lock shared with the other
+	// processes, after loading any tokens they refreshed.
+	LockTokens(refresh func() error) error
+}
+
 // UAAAuthentication wraps connections and adds authentication headers to all
-// requests
+// requests. It is safe for concurrent use: when several requests fail with an
+// invalid token at the same time, the token is only refreshed once.
 type UAAAuthentication struct {
 	connection cloudcontroller.Connection
 	client     UAAClient
 	cache      TokenCache
+
+	// tokenLock guards the cache.
+	tokenLock sync.RWMutex
 }
 
 // NewUAAAuthentication returns a pointer to a UAAAuthentication wrapper with
@@ -58,6 +73,10 @@ func (t *UAAAuthentication) SetClient(client UAAClient) {
 // Make adds authentication headers to the passed in request and then calls the
 // wrapped connection's Make. If the client is not set on the wrapper, it will
 // not add any header or handle any authentication errors.
+//
+// The request is resent once the token is refreshed. Requests with GetBody
+// set, such as uploads, are rewound with GetBody instead of being buffered in
+// memory.
 func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcontroller.Response) error {
 	if t.client == nil {
 		return t.connection.Make(request, passedResponse)
@@ -68,7 +87,7 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcon
 		rawRequestBody []byte
 	)
 
-	if request.Body != nil {
+	if request.Body != nil && request.GetBody == nil {
 		rawRequestBody, err = ioutil.ReadAll(request.Body)
 		defer request.Body.Close()
 		if err != nil {
@@ -77,25 +96,85 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *cloudcon
 		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 	}
 
-	request.Header.Set("Authorization", t.cache.AccessToken())
+	accessToken := t.accessToken()
+	request.Header.Set("Authorization", accessToken)
 
 	err = t.connection.Make(request, passedResponse)
 	if _, ok := err.(ccerror.InvalidAuthTokenError); ok {
-		var token uaa.RefreshToken
-		token, err = t.client.RefreshAccessToken(t.cache.RefreshToken())
+		accessToken, err = t.refreshToken(accessToken)
 		if err != nil {
 			return err
 		}
 
-		t.cache.SetAccessToken(token.AuthorizationToken())
-		t.cache.SetRefreshToken(token.RefreshToken)
-
-		if rawRequestBody != nil {
-			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+		err = rewindBody(request, rawRequestBody)
+		if err != nil {
+			return err
 		}
-		request.Header.Set("Authorization", t.cache.AccessToken())
+		request.Header.Set("Authorization", accessToken)
 		err = t.connection.Make(request, passedResponse)
 	}
 
 	return err
 }
+
+// rewindBody resets the request body so that the request can be sent again,
+// using GetBody when it is set and the buffered body otherwise.
+func rewindBody(request *http.Request, rawRequestBody []byte) error {
+	if request.Body == nil {
+		return nil
+	}
+
+	if request.GetBody != nil {
+		body, err := request.GetBody()
+		if err != nil {
+			return err
+		}
+		request.Body = body
+		return nil
+	}
+
+	request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+	return nil
+}
+
+func (t *UAAAuthentication) accessToken() string {
+	t.tokenLock.RLock()
+	defer t.tokenLock.RUnlock()
+	return t.cache.AccessToken()
+}
+
+// refreshToken refreshes the access token that was rejected by the server and
+// returns the new access token. If another request, or another process
+// sharing the cache, already replaced the rejected token, the replacement is
+// returned without refreshing again.
+func (t *UAAAuthentication) refreshToken(rejectedToken string) (string, error) {
+	t.tokenLock.Lock()
+	defer t.tokenLock.Unlock()
+
+	refresh := func() error {
+		if t.cache.AccessToken() != rejectedToken {
+			return nil
+		}
+
+		token, err := t.client.RefreshAccessToken(t.cache.RefreshToken())
+		if err != nil {
+			return err
+		}
+
+		t.cache.SetAccessToken(token.AuthorizationToken())
+		t.cache.SetRefreshToken(token.RefreshToken)
+		return nil
+	}
+
+	var err error
+	if sharedCache, ok := t.cache.(SharedTokenCache); ok {
+		err = sharedCache.LockTokens(refresh)
+	} else {
+		err = refresh()
+	}
+	if err != nil {
+		return "", err
+	}
+
+	return t.cache.AccessToken(), nil
+}
@@ -2,9 +2,11 @@ package wrapper_test
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/api/cloudcontroller"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -152,5 +154,185 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(inMemoryCache.RefreshToken()).To(Equal("bananananananana"))
 			})
 		})
+
+		Context("when the token is invalid and the request can be rewound", func() {
+			var (
+				expectedBody string
+				originalBody io.ReadCloser
+				firstBody    io.ReadCloser
+				getBodyCalls int
+			)
+
+			BeforeEach(func() {
+				expectedBody = "this body content should be streamed"
+				originalBody = ioutil.NopCloser(strings.NewReader(expectedBody))
+				request.Body = originalBody
+				getBodyCalls = 0
+				request.GetBody = func() (io.ReadCloser, error) {
+					getBodyCalls++
+					return ioutil.NopCloser(strings.NewReader(expectedBody)), nil
+				}
+
+				fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+					if fakeConnection.MakeCallCount() == 1 {
+						firstBody = request.Body
+					}
+
+					body, err := ioutil.ReadAll(request.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(Equal(expectedBody))
+
+					if fakeConnection.MakeCallCount() == 1 {
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				inMemoryCache.SetAccessToken("what")
+				fakeClient.RefreshAccessTokenReturns(uaa.RefreshToken{AccessToken: "foobar-2", Type: "bearer"}, nil)
+			})
+
+			It("sends the original body without buffering it and rewinds it with GetBody", func() {
+				err := wrapper.Make(request, nil)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2))
+				Expect(firstBody).To(BeIdenticalTo(originalBody))
+				Expect(getBodyCalls).To(Equal(1))
+			})
+
+			Context("when GetBody returns an error", func() {
+				BeforeEach(func() {
+					request.GetBody = func() (io.ReadCloser, error) {
+						return nil, errors.New("rewind failed")
+					}
+				})
+
+				It("returns the error without resending the request", func() {
+					err := wrapper.Make(request, nil)
+					Expect(err).To(MatchError("rewind failed"))
+					Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the token is invalid and the token cache is shared", func() {
+			var sharedCache *sharedTokenCache
+
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+				sharedCache = &sharedTokenCache{InMemoryCache: inMemoryCache}
+				inner = NewUAAAuthentication(fakeClient, sharedCache)
+				wrapper = inner.Wrap(fakeConnection)
+
+				fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "what" {
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token while holding the cache's lock", func() {
+				Expect(wrapper.Make(request, nil)).To(Succeed())
+
+				Expect(sharedCache.lockTokensCallCount).To(Equal(1))
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(1)
+				Expect(request.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+			})
+
+			Context("when another process already refreshed the token", func() {
+				BeforeEach(func() {
+					sharedCache.reloadedAccessToken = "bearer reloaded"
+				})
+
+				It("reuses the reloaded token without refreshing", func() {
+					Expect(wrapper.Make(request, nil)).To(Succeed())
+
+					Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(0))
+
+					request, _ := fakeConnection.MakeArgsForCall(1)
+					Expect(request.Header.Get("Authorization")).To(Equal("bearer reloaded"))
+				})
+			})
+		})
+
+		Context("when concurrent requests are made with an invalid token", func() {
+			const requestCount = 5
+
+			BeforeEach(func() {
+				inMemoryCache.SetAccessToken("what")
+
+				var rejected sync.WaitGroup
+				rejected.Add(requestCount)
+				fakeConnection.MakeStub = func(request *http.Request, response *cloudcontroller.Response) error {
+					if request.Header.Get("Authorization") == "what" {
+						rejected.Done()
+						rejected.Wait()
+						return ccerror.InvalidAuthTokenError{}
+					}
+					return nil
+				}
+
+				fakeClient.RefreshAccessTokenReturns(
+					uaa.RefreshToken{
+						AccessToken:  "foobar-2",
+						RefreshToken: "bananananananana",
+						Type:         "bearer",
+					},
+					nil,
+				)
+			})
+
+			It("refreshes the token once and resends every request", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < requestCount; i++ {
+					wg.Add(1)
+					go func() {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						err := wrapper.Make(&http.Request{Header: http.Header{}}, nil)
+						Expect(err).ToNot(HaveOccurred())
+					}()
+				}
+				wg.Wait()
+
+				Expect(fakeClient.RefreshAccessTokenCallCount()).To(Equal(1))
+				Expect(fakeConnection.MakeCallCount()).To(Equal(2 * requestCount))
+				for i := 0; i < fakeConnection.MakeCallCount(); i++ {
+					request, _ := fakeConnection.MakeArgsForCall(i)
+					Expect(request.Header.Get("Authorization")).To(Equal("bearer foobar-2"))
+				}
+			})
+		})
 	})
 })
+
+// sharedTokenCache is an in-memory token cache that reloads
+// reloadedAccessToken, when set, as if another process had refreshed it.
+type sharedTokenCache struct {
+	*util.InMemoryCache
+
+	reloadedAccessToken string
+	lockTokensCallCount int
+}
+
+func (cache *sharedTokenCache) LockTokens(refresh func() error) error {
+	cache.lockTokensCallCount++
+	if cache.reloadedAccessToken != "" {
+		cache.SetAccessToken(cache.reloadedAccessToken)
+	}
+	return refresh()
+}
@@ -1,24 +1,31 @@
 package wrapper
 
 import (
-	"bytes"
-	"io/ioutil"
 	"net/http"
 
 	"code.cloudfoundry.org/cli/api/plugin"
+	"code.cloudfoundry.org/cli/api/plugin/pluginerror"
+	"code.cloudfoundry.org/cli/api/retrypolicy"
 )
 
-// RetryRequest is a wrapper that retries failed requests if they contain a 5XX
-// status code.
+// RetryRequest is a wrapper that retries failed requests if they contain a
+// 429 or 5XX status code or the connection failed.
 type RetryRequest struct {
-	maxRetries int
+	policy     retrypolicy.Policy
 	connection plugin.Connection
 }
 
-// NewRetryRequest returns a pointer to a RetryRequest wrapper.
+// NewRetryRequest returns a pointer to a RetryRequest wrapper that retries
+// without waiting.
 func NewRetryRequest(maxRetries int) *RetryRequest {
+	return NewRetryRequestWithPolicy(retrypolicy.Policy{MaxRetries: maxRetries})
+}
+
+// NewRetryRequestWithPolicy returns a pointer to a RetryRequest wrapper that
+// retries according to the given policy.
+func NewRetryRequestWithPolicy(policy retrypolicy.Policy) *RetryRequest {
 	return &RetryRequest{
-		maxRetries: maxRetries,
+		policy: policy,
 	}
 }
 
@@ -28,36 +35,13 @@ func (retry *RetryRequest) Wrap(innerconnection plugin.Connection) plugin.Connec
 	return retry
 }
 
-// Make retries the request if it comes back with a 5XX status code.
+// Make retries the request if it comes back with a 429 or 5XX status code or
+// the connection fails. POST requests are only retried when they carry a
+// request ID or are marked as idempotent.
 func (retry *RetryRequest) Make(request *http.Request, passedResponse *plugin.Response) error {
-	var err error
-	var rawRequestBody []byte
-
-	if request.Body != nil {
-		rawRequestBody, err = ioutil.ReadAll(request.Body)
-		defer request.Body.Close()
-		if err != nil {
-			return err
-		}
-	}
-
-	for i := 0; i < retry.maxRetries+1; i += 1 {
-		if rawRequestBody != nil {
-			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
-		}
-		err = retry.connection.Make(request, passedResponse)
-		if err == nil {
-			return nil
-		}
-
-		if request.Method == http.MethodPost ||
-			passedResponse.HTTPResponse != nil &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusInternalServerError &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusBadGateway &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusServiceUnavailable &&
-				passedResponse.HTTPResponse.StatusCode != http.StatusGatewayTimeout {
-			break
-		}
-	}
-	return err
+	return retry.policy.Do(request, func() (*http.Response, bool, error) {
+		err := retry.connection.Make(request, passedResponse)
+		_, connectionFailed := err.(pluginerror.RequestError)
+		return passedResponse.HTTPResponse, connectionFailed, err
+	})
 }
@@ -0,0 +1,203 @@
+// Package retrypolicy decides when failed HTTP requests to the Cloud
+// Controller, UAA and plugin repositories are retried, and how long to wait
+// between attempts.
+package retrypolicy
+
+import (
+	"bytes"
+	"context"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	// DefaultMaxRetries is the number of times a request is retried by the
+	// default policy.
+	DefaultMaxRetries = 2
+
+	// DefaultBackoff is how long the default policy waits before the first
+	// retry.
+	DefaultBackoff = 500 * time.Millisecond
+
+	// DefaultMaxWait is the longest the default policy waits between attempts.
+	DefaultMaxWait = 30 * time.Second
+
+	// RequestIDHeader is the header that identifies a request to the server.
+	// POST requests that set it can be retried, since the server can recognise
+	// a repeated request.
+	RequestIDHeader = "X-Vcap-Request-Id"
+)
+
+// Policy describes how failed requests are retried. The wait before each
+// retry starts at Backoff and doubles after every retry, with up to half as
+// much again added at random so that clients do not retry in lockstep. When
+// the server sends a Retry-After header, that wait is used instead.
+type Policy struct {
+	// MaxRetries is the number of times a request is retried.
+	MaxRetries int
+
+	// Backoff is the wait before the first retry.
+	Backoff time.Duration
+
+	// MaxWait caps the wait between attempts. A request is not retried when
+	// the server asks for a longer wait. Zero means no cap.
+	MaxWait time.Duration
+}
+
+// Default returns the policy used by the CLI.
+func Default() Policy {
+	return Policy{
+		MaxRetries: DefaultMaxRetries,
+		Backoff:    DefaultBackoff,
+		MaxWait:    DefaultMaxWait,
+	}
+}
+
+// Attempt makes a single attempt at a request. It returns the HTTP response,
+// if one was received, whether the connection failed before a response was
+// received, and the error from the attempt.
+type Attempt func() (response *http.Response, connectionFailed bool, err error)
+
+// Do calls attempt until it succeeds, the failure cannot be retried, or the
+// retries are used up, and returns the error from the last attempt. Request
+// bodies are rewound with GetBody when it is set, and are otherwise read into
+// memory; bodies of requests that cannot be retried are left untouched.
+func (policy Policy) Do(request *http.Request, attempt Attempt) error {
+	var err error
+	var rawRequestBody []byte
+
+	idempotent := IsIdempotent(request)
+	rewindable := idempotent && request.GetBody != nil
+
+	if request.Body != nil && idempotent && !rewindable {
+		rawRequestBody, err = ioutil.ReadAll(request.Body)
+		defer request.Body.Close()
+		if err != nil {
+			return err
+		}
+	}
+
+	for retry := 0; ; retry++ {
+		if rawRequestBody != nil {
+			request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
+		}
+
+		response, connectionFailed, attemptErr := attempt()
+		if attemptErr == nil {
+			return nil
+		}
+
+		if retry >= policy.MaxRetries || !idempotent || !Retryable(response, connectionFailed) {
+			return attemptErr
+		}
+
+		wait, ok := policy.Wait(retry, response)
+		if !ok {
+			return attemptErr
+		}
+		time.Sleep(wait)
+
+		if rewindable {
+			request.Body, err = request.GetBody()
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Wait returns how long to wait before the given retry, counting from 0. It
+// returns false when the server asked for a longer wait than MaxWait.
+func (policy Policy) Wait(retry int, response *http.Response) (time.Duration, bool) {
+	if wait, ok := retryAfter(response); ok {
+		if policy.MaxWait > 0 && wait > policy.MaxWait {
+			return 0, false
+		}
+		return wait, true
+	}
+
+	wait := policy.Backoff
+	for i := 0; i < retry && (policy.MaxWait == 0 || wait < policy.MaxWait); i++ {
+		wait *= 2
+	}
+	if wait > 0 {
+		wait += time.Duration(rand.Int63n(int64(wait)/2 + 1))
+	}
+	if policy.MaxWait > 0 && wait > policy.MaxWait {
+		wait = policy.MaxWait
+	}
+	return wait, true
+}
+
+// Retryable returns true if a failed attempt may succeed when repeated: the
+// connection failed, the server is rate limiting requests, or the server
+// returned a 5XX status code that indicates a temporary problem.
+func Retryable(response *http.Response, connectionFailed bool) bool {
+	if response == nil {
+		return connectionFailed
+	}
+
+	switch response.StatusCode {
+	case http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+type idempotentKey struct{}
+
+// MarkIdempotent returns a copy of the request that is flagged as safe to
+// repeat. The request's GetBody should be set so that the body can be sent
+// again without being read into memory.
+func MarkIdempotent(request *http.Request) *http.Request {
+	return request.WithContext(context.WithValue(request.Context(), idempotentKey{}, true))
+}
+
+// IsIdempotent returns true if the request can safely be repeated. Every
+// request except a POST is idempotent; a POST is only idempotent when it
+// carries a request ID or has been flagged with MarkIdempotent.
+func IsIdempotent(request *http.Request) bool {
+	if request.Method != http.MethodPost {
+		return true
+	}
+	if request.Header.Get(RequestIDHeader) != "" {
+		return true
+	}
+	marked, _ := request.Context().Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// retryAfter returns the wait requested by the response's Retry-After header,
+// which is either a number of seconds or an HTTP date.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+
+	return 0, false
+}
@@ -0,0 +1,167 @@
+package retrypolicy_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/api/retrypolicy"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Policy", func() {
+	Describe("Do", func() {
+		var (
+			policy  Policy
+			request *http.Request
+			bodies  []string
+			results []error
+		)
+
+		attempt := func() (*http.Response, bool, error) {
+			body, err := ioutil.ReadAll(request.Body)
+			Expect(err).ToNot(HaveOccurred())
+			bodies = append(bodies, string(body))
+
+			err = results[0]
+			results = results[1:]
+			if err == nil {
+				return &http.Response{StatusCode: http.StatusOK}, false, nil
+			}
+			return nil, true, err
+		}
+
+		BeforeEach(func() {
+			policy = Policy{MaxRetries: 2, Backoff: 10 * time.Millisecond}
+			bodies = nil
+
+			var err error
+			request, err = http.NewRequest(http.MethodPut, "https://foo.bar.com/banana", ioutil.NopCloser(strings.NewReader("banana pants")))
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("retries with a growing wait and resends the body", func() {
+			results = []error{errors.New("reset"), errors.New("reset"), nil}
+
+			start := time.Now()
+			Expect(policy.Do(request, attempt)).To(Succeed())
+			Expect(time.Since(start)).To(BeNumerically(">=", 30*time.Millisecond))
+			Expect(bodies).To(Equal([]string{"banana pants", "banana pants", "banana pants"}))
+		})
+
+		It("returns the last error once the retries are used up", func() {
+			results = []error{errors.New("first"), errors.New("second"), errors.New("third")}
+
+			Expect(policy.Do(request, attempt)).To(MatchError("third"))
+			Expect(bodies).To(HaveLen(3))
+		})
+
+		Context("when the request is a POST", func() {
+			BeforeEach(func() {
+				request.Method = http.MethodPost
+				results = []error{errors.New("reset"), nil}
+			})
+
+			It("does not retry the request", func() {
+				Expect(policy.Do(request, attempt)).To(MatchError("reset"))
+				Expect(bodies).To(HaveLen(1))
+			})
+
+			Context("when the request carries a request ID", func() {
+				BeforeEach(func() {
+					request.Header.Set(RequestIDHeader, "some-request-id")
+				})
+
+				It("retries the request", func() {
+					Expect(policy.Do(request, attempt)).To(Succeed())
+					Expect(bodies).To(Equal([]string{"banana pants", "banana pants"}))
+				})
+			})
+
+			Context("when the request is marked as idempotent", func() {
+				BeforeEach(func() {
+					request = MarkIdempotent(request)
+				})
+
+				It("retries the request", func() {
+					Expect(policy.Do(request, attempt)).To(Succeed())
+					Expect(bodies).To(HaveLen(2))
+				})
+			})
+		})
+	})
+
+	Describe("Wait", func() {
+		var policy Policy
+
+		BeforeEach(func() {
+			policy = Policy{MaxRetries: 5, Backoff: time.Second, MaxWait: 10 * time.Second}
+		})
+
+		It("doubles the wait after each retry and adds up to half again", func() {
+			for retry, base := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second} {
+				wait, ok := policy.Wait(retry, nil)
+				Expect(ok).To(BeTrue())
+				Expect(wait).To(BeNumerically(">=", base))
+				Expect(wait).To(BeNumerically("<=", base+base/2))
+			}
+		})
+
+		It("does not wait longer than MaxWait", func() {
+			wait, ok := policy.Wait(10, nil)
+			Expect(ok).To(BeTrue())
+			Expect(wait).To(Equal(10 * time.Second))
+		})
+
+		Context("when the response has a Retry-After header", func() {
+			var response *http.Response
+
+			BeforeEach(func() {
+				response = &http.Response{
+					StatusCode: http.StatusTooManyRequests,
+					Header:     http.Header{},
+				}
+			})
+
+			It("waits the given number of seconds", func() {
+				response.Header.Set("Retry-After", "3")
+				wait, ok := policy.Wait(0, response)
+				Expect(ok).To(BeTrue())
+				Expect(wait).To(Equal(3 * time.Second))
+			})
+
+			It("waits until the given date", func() {
+				response.Header.Set("Retry-After", time.Now().Add(5*time.Second).UTC().Format(http.TimeFormat))
+				wait, ok := policy.Wait(0, response)
+				Expect(ok).To(BeTrue())
+				Expect(wait).To(BeNumerically("~", 5*time.Second, time.Second))
+			})
+
+			It("does not retry when the wait is longer than MaxWait", func() {
+				response.Header.Set("Retry-After", "60")
+				_, ok := policy.Wait(0, response)
+				Expect(ok).To(BeFalse())
+			})
+		})
+	})
+
+	DescribeTable("Retryable",
+		func(response *http.Response, connectionFailed bool, expected bool) {
+			Expect(Retryable(response, connectionFailed)).To(Equal(expected))
+		},
+
+		Entry("connection failures", nil, true, true),
+		Entry("other errors without a response", nil, false, false),
+		Entry("(429) Too Many Requests", &http.Response{StatusCode: http.StatusTooManyRequests}, false, true),
+		Entry("(500) Internal Server Error", &http.Response{StatusCode: http.StatusInternalServerError}, false, true),
+		Entry("(502) Bad Gateway", &http.Response{StatusCode: http.StatusBadGateway}, false, true),
+		Entry("(503) Service Unavailable", &http.Response{StatusCode: http.StatusServiceUnavailable}, false, true),
+		Entry("(504) Gateway Timeout", &http.Response{StatusCode: http.StatusGatewayTimeout}, false, true),
+		Entry("(404) Not Found", &http.Response{StatusCode: http.StatusNotFound}, false, false),
+	)
+})
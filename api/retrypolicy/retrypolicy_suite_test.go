@@ -0,0 +1,13 @@
+package retrypolicy_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Retry Policy Suite")
+}
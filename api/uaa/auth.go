@@ -0,0 +1,54 @@
+package uaa
+
+import (
+	"net/url"
+
+	"code.cloudfoundry.org/cli/api/uaa/internal"
+)
+
+// GrantType is the OAuth grant used to obtain tokens from the UAA.
+type GrantType string
+
+const (
+	// GrantTypePassword authenticates a user with a username and either a
+	// password or a one-time passcode.
+	GrantTypePassword GrantType = "password"
+
+	// GrantTypeClientCredentials authenticates a (non-user) service account
+	// with a client ID and secret.
+	GrantTypeClientCredentials GrantType = "client_credentials"
+
+	// GrantTypeJWTBearer exchanges a JWT assertion issued by a trusted
+	// identity provider for tokens.
+	GrantTypeJWTBearer GrantType = "urn:ietf:params:oauth:grant-type:jwt-bearer"
+)
+
+// Authenticate requests tokens from the UAA with the provided grant and
+// returns the access token, formatted for use in an authorization header, and
+// the refresh token. The credentials depend on the grant:
+//
+//   - GrantTypePassword: "username" and "password", or "passcode"
+//   - GrantTypeClientCredentials: "client_id" and "client_secret"
+//   - GrantTypeJWTBearer: "assertion"
+//
+// Grants other than client credentials are requested with the client's own
+// client ID and secret.
+func (client *Client) Authenticate(credentials map[string]string, grantType GrantType) (string, string, error) {
+	values := url.Values{
+		"grant_type": {string(grantType)},
+	}
+	if grantType != GrantTypeClientCredentials {
+		values.Set("client_id", client.id)
+		values.Set("client_secret", client.secret)
+	}
+	for key, value := range credentials {
+		values.Set(key, value)
+	}
+
+	token, err := client.requestToken(values, internal.PostOAuthTokenRequest)
+	if err != nil {
+		return "", "", err
+	}
+
+	return token.AuthorizationToken(), token.RefreshToken, nil
+}
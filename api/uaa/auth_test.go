@@ -0,0 +1,82 @@
+package uaa_test
+
+import (
+	"net/http"
+
+	. "code.cloudfoundry.org/cli/api/uaa"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Auth", func() {
+	var client *Client
+
+	BeforeEach(func() {
+		client = NewTestUAAClientAndStore()
+	})
+
+	Describe("Authenticate", func() {
+		const response = `{
+			"access_token": "some-access-token",
+			"token_type": "bearer",
+			"refresh_token": "some-refresh-token",
+			"expires_in": 599
+		}`
+
+		DescribeTable("requests tokens with the grant",
+			func(credentials map[string]string, grantType GrantType, expectedBody string) {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						VerifyHeaderKV("Content-Type", "application/x-www-form-urlencoded"),
+						VerifyBody([]byte(expectedBody)),
+						RespondWith(http.StatusOK, response),
+					))
+
+				accessToken, refreshToken, err := client.Authenticate(credentials, grantType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(accessToken).To(Equal("bearer some-access-token"))
+				Expect(refreshToken).To(Equal("some-refresh-token"))
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+			},
+
+			Entry("password",
+				map[string]string{"username": "some-user", "password": "some-password"},
+				GrantTypePassword,
+				"client_id=client-id&client_secret=client-secret&grant_type=password&password=some-password&username=some-user"),
+
+			Entry("one-time passcode",
+				map[string]string{"passcode": "some-passcode"},
+				GrantTypePassword,
+				"client_id=client-id&client_secret=client-secret&grant_type=password&passcode=some-passcode"),
+
+			Entry("client credentials",
+				map[string]string{"client_id": "some-client", "client_secret": "some-secret"},
+				GrantTypeClientCredentials,
+				"client_id=some-client&client_secret=some-secret&grant_type=client_credentials"),
+
+			Entry("JWT bearer",
+				map[string]string{"assertion": "some-jwt"},
+				GrantTypeJWTBearer,
+				"assertion=some-jwt&client_id=client-id&client_secret=client-secret&grant_type=urn%3Aietf%3Aparams%3Aoauth%3Agrant-type%3Ajwt-bearer"),
+		)
+
+		Context("when the credentials are rejected", func() {
+			BeforeEach(func() {
+				server.AppendHandlers(
+					CombineHandlers(
+						VerifyRequest(http.MethodPost, "/oauth/token"),
+						RespondWith(http.StatusUnauthorized, `{"error": "unauthorized", "error_description": "Bad credentials"}`),
+					))
+			})
+
+			It("returns a BadCredentialsError", func() {
+				_, _, err := client.Authenticate(map[string]string{"username": "some-user", "password": "wrong"}, GrantTypePassword)
+				Expect(err).To(MatchError(BadCredentialsError{Message: "Bad credentials"}))
+			})
+		})
+	})
+})
@@ -16,9 +16,10 @@ import (
 
 // Client is the UAA client
 type Client struct {
-	URL    string
-	id     string
-	secret string
+	URL       string
+	id        string
+	secret    string
+	grantType GrantType
 
 	connection Connection
 	router     *rata.RequestGenerator
@@ -43,6 +44,11 @@ type Config struct {
 	// ClientSecret is the UAA client secret the client will use.
 	ClientSecret string
 
+	// GrantType is the grant the current tokens were obtained with. When it is
+	// GrantTypeClientCredentials, tokens are renewed by authenticating with the
+	// client ID and secret instead of using a refresh token.
+	GrantType GrantType
+
 	// SkipSSLValidation controls whether a client verifies the server's
 	// certificate chain and host name. If SkipSSLValidation is true, TLS accepts
 	// any certificate presented by the server and any host name in that
@@ -67,9 +73,10 @@ func NewClient(config Config) *Client {
 	)
 
 	client := Client{
-		URL:    config.URL,
-		id:     config.ClientID,
-		secret: config.ClientSecret,
+		URL:       config.URL,
+		id:        config.ClientID,
+		secret:    config.ClientSecret,
+		grantType: config.GrantType,
 
 		router:     rata.NewRequestGenerator(config.URL, internal.Routes),
 		connection: NewConnection(config.SkipSSLValidation, config.DialTimeout),
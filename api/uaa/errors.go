@@ -62,6 +62,16 @@ func (e InvalidAuthTokenError) Error() string {
 	return e.Message
 }
 
+// BadCredentialsError is returned when the UAA rejects the credentials used to
+// request a token.
+type BadCredentialsError struct {
+	Message string
+}
+
+func (e BadCredentialsError) Error() string {
+	return e.Message
+}
+
 // InsufficientScopeError is returned when the client has insufficient scope
 type InsufficientScopeError struct {
 	Message string
@@ -21,21 +21,35 @@ func (refreshTokenResponse RefreshToken) AuthorizationToken() string {
 	return fmt.Sprintf("%s %s", refreshTokenResponse.Type, refreshTokenResponse.AccessToken)
 }
 
-// RefreshAccessToken refreshes the current access token.
+// RefreshAccessToken refreshes the current access token. When the client
+// authenticates with client credentials, a new token is requested with the
+// client credentials instead, since that grant does not issue refresh tokens.
 func (client *Client) RefreshAccessToken(refreshToken string) (RefreshToken, error) {
-	body := strings.NewReader(url.Values{
+	if client.grantType == GrantTypeClientCredentials {
+		return client.requestToken(url.Values{
+			"client_id":     {client.id},
+			"client_secret": {client.secret},
+			"grant_type":    {string(GrantTypeClientCredentials)},
+		}, internal.RefreshTokenRequest)
+	}
+
+	return client.requestToken(url.Values{
 		"client_id":     {client.id},
 		"client_secret": {client.secret},
 		"grant_type":    {"refresh_token"},
 		"refresh_token": {refreshToken},
-	}.Encode())
+	}, internal.RefreshTokenRequest)
+}
 
+// requestToken posts the form values to the UAA token endpoint and returns the
+// issued tokens.
+func (client *Client) requestToken(values url.Values, requestName string) (RefreshToken, error) {
 	request, err := client.newRequest(requestOptions{
-		RequestName: internal.RefreshTokenRequest,
+		RequestName: requestName,
 		Header: http.Header{
 			"Content-Type": {"application/x-www-form-urlencoded"},
 		},
-		Body: body,
+		Body: strings.NewReader(values.Encode()),
 	})
 	if err != nil {
 		return RefreshToken{}, err
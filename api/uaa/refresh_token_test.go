@@ -61,4 +61,36 @@ var _ = Describe("UAA Client", func() {
 			Expect(server.ReceivedRequests()).To(HaveLen(1))
 		})
 	})
+
+	Describe("RefreshAccessToken with client credentials", func() {
+		BeforeEach(func() {
+			client = NewClient(Config{
+				AppName:           "CF CLI UAA API Test",
+				AppVersion:        "Unknown",
+				ClientID:          "client-id",
+				ClientSecret:      "client-secret",
+				GrantType:         GrantTypeClientCredentials,
+				SkipSSLValidation: true,
+				URL:               server.URL(),
+			})
+
+			server.AppendHandlers(
+				CombineHandlers(
+					VerifyRequest(http.MethodPost, "/oauth/token"),
+					VerifyBody([]byte("client_id=client-id&client_secret=client-secret&grant_type=client_credentials")),
+					RespondWith(http.StatusOK, `{"access_token": "I-ACCESS-TOKEN", "token_type": "bearer"}`),
+				))
+		})
+
+		It("requests a new token with the client credentials", func() {
+			token, err := client.RefreshAccessToken("")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal(RefreshToken{
+				AccessToken: "I-ACCESS-TOKEN",
+				Type:        "bearer",
+			}))
+
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
 })
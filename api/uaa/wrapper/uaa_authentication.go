@@ -64,10 +64,11 @@ func (t *UAAAuthentication) Make(request *http.Request, passedResponse *uaa.Resp
 
 		request.Body = ioutil.NopCloser(bytes.NewBuffer(rawRequestBody))
 
-		// The authentication header is not added to the token refresh request.
+		// The authentication header is not added to token requests, which
+		// authenticate with the credentials in their body.
 		if strings.Contains(request.URL.String(), "/oauth/token") &&
 			request.Method == http.MethodPost &&
-			strings.Contains(string(rawRequestBody), "grant_type=refresh_token") {
+			strings.Contains(string(rawRequestBody), "grant_type=") {
 			return t.connection.Make(request, passedResponse)
 		}
 	}
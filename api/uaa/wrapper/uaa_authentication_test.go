@@ -162,5 +162,27 @@ var _ = Describe("UAA Authentication", func() {
 				Expect(request.Header.Get("Authorization")).To(BeEmpty())
 			})
 		})
+
+		Context("when authenticating with a grant", func() {
+			BeforeEach(func() {
+				body := strings.NewReader(url.Values{
+					"grant_type":    {"client_credentials"},
+					"client_id":     {"some-client"},
+					"client_secret": {"some-secret"},
+				}.Encode())
+
+				request, err := http.NewRequest("POST", fmt.Sprintf("%s/oauth/token", server.URL()), body)
+				Expect(err).NotTo(HaveOccurred())
+
+				wrapper.Make(request, nil)
+			})
+
+			It("should not set the 'Authorization' header", func() {
+				Expect(fakeConnection.MakeCallCount()).To(Equal(1))
+
+				request, _ := fakeConnection.MakeArgsForCall(0)
+				Expect(request.Header.Get("Authorization")).To(BeEmpty())
+			})
+		})
 	})
 })
@@ -21,6 +21,9 @@ type FakePushActor struct {
 	uploadAppReturns struct {
 		result1 error
 	}
+	uploadAppReturnsOnCall map[int]struct {
+		result1 error
+	}
 	ProcessPathStub        func(dirOrZipFile string, f func(string) error) error
 	processPathMutex       sync.RWMutex
 	processPathArgsForCall []struct {
@@ -30,12 +33,16 @@ type FakePushActor struct {
 	processPathReturns struct {
 		result1 error
 	}
-	GatherFilesStub        func(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error)
+	processPathReturnsOnCall map[int]struct {
+		result1 error
+	}
+	GatherFilesStub        func(localFiles []models.AppFileFields, appDir string, uploadDir string, ignoreFile string, useCache bool) ([]resources.AppFileResource, bool, error)
 	gatherFilesMutex       sync.RWMutex
 	gatherFilesArgsForCall []struct {
 		localFiles []models.AppFileFields
 		appDir     string
 		uploadDir  string
+		ignoreFile string
 		useCache   bool
 	}
 	gatherFilesReturns struct {
@@ -43,6 +50,11 @@ type FakePushActor struct {
 		result2 bool
 		result3 error
 	}
+	gatherFilesReturnsOnCall map[int]struct {
+		result1 []resources.AppFileResource
+		result2 bool
+		result3 error
+	}
 	ValidateAppParamsStub        func(apps []models.AppParams) []error
 	validateAppParamsMutex       sync.RWMutex
 	validateAppParamsArgsForCall []struct {
@@ -51,6 +63,9 @@ type FakePushActor struct {
 	validateAppParamsReturns struct {
 		result1 []error
 	}
+	validateAppParamsReturnsOnCall map[int]struct {
+		result1 []error
+	}
 	MapManifestRouteStub        func(routeName string, app models.Application, appParamsFromContext models.AppParams) error
 	mapManifestRouteMutex       sync.RWMutex
 	mapManifestRouteArgsForCall []struct {
@@ -61,6 +76,9 @@ type FakePushActor struct {
 	mapManifestRouteReturns struct {
 		result1 error
 	}
+	mapManifestRouteReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -72,6 +90,7 @@ func (fake *FakePushActor) UploadApp(appGUID string, zipFile *os.File, presentFi
 		copy(presentFilesCopy, presentFiles)
 	}
 	fake.uploadAppMutex.Lock()
+	ret, specificReturn := fake.uploadAppReturnsOnCall[len(fake.uploadAppArgsForCall)]
 	fake.uploadAppArgsForCall = append(fake.uploadAppArgsForCall, struct {
 		appGUID      string
 		zipFile      *os.File
@@ -81,9 +100,11 @@ func (fake *FakePushActor) UploadApp(appGUID string, zipFile *os.File, presentFi
 	fake.uploadAppMutex.Unlock()
 	if fake.UploadAppStub != nil {
 		return fake.UploadAppStub(appGUID, zipFile, presentFiles)
-	} else {
-		return fake.uploadAppReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uploadAppReturns.result1
 }
 
 func (fake *FakePushActor) UploadAppCallCount() int {
@@ -105,8 +126,21 @@ func (fake *FakePushActor) UploadAppReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakePushActor) UploadAppReturnsOnCall(i int, result1 error) {
+	fake.UploadAppStub = nil
+	if fake.uploadAppReturnsOnCall == nil {
+		fake.uploadAppReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uploadAppReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePushActor) ProcessPath(dirOrZipFile string, f func(string) error) error {
 	fake.processPathMutex.Lock()
+	ret, specificReturn := fake.processPathReturnsOnCall[len(fake.processPathArgsForCall)]
 	fake.processPathArgsForCall = append(fake.processPathArgsForCall, struct {
 		dirOrZipFile string
 		f            func(string) error
@@ -115,9 +149,11 @@ func (fake *FakePushActor) ProcessPath(dirOrZipFile string, f func(string) error
 	fake.processPathMutex.Unlock()
 	if fake.ProcessPathStub != nil {
 		return fake.ProcessPathStub(dirOrZipFile, f)
-	} else {
-		return fake.processPathReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.processPathReturns.result1
 }
 
 func (fake *FakePushActor) ProcessPathCallCount() int {
@@ -139,26 +175,42 @@ func (fake *FakePushActor) ProcessPathReturns(result1 error) {
 	}{result1}
 }
 
-func (fake *FakePushActor) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error) {
+func (fake *FakePushActor) ProcessPathReturnsOnCall(i int, result1 error) {
+	fake.ProcessPathStub = nil
+	if fake.processPathReturnsOnCall == nil {
+		fake.processPathReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.processPathReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakePushActor) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, ignoreFile string, useCache bool) ([]resources.AppFileResource, bool, error) {
 	var localFilesCopy []models.AppFileFields
 	if localFiles != nil {
 		localFilesCopy = make([]models.AppFileFields, len(localFiles))
 		copy(localFilesCopy, localFiles)
 	}
 	fake.gatherFilesMutex.Lock()
+	ret, specificReturn := fake.gatherFilesReturnsOnCall[len(fake.gatherFilesArgsForCall)]
 	fake.gatherFilesArgsForCall = append(fake.gatherFilesArgsForCall, struct {
 		localFiles []models.AppFileFields
 		appDir     string
 		uploadDir  string
+		ignoreFile string
 		useCache   bool
-	}{localFilesCopy, appDir, uploadDir, useCache})
-	fake.recordInvocation("GatherFiles", []interface{}{localFilesCopy, appDir, uploadDir, useCache})
+	}{localFilesCopy, appDir, uploadDir, ignoreFile, useCache})
+	fake.recordInvocation("GatherFiles", []interface{}{localFilesCopy, appDir, uploadDir, ignoreFile, useCache})
 	fake.gatherFilesMutex.Unlock()
 	if fake.GatherFilesStub != nil {
-		return fake.GatherFilesStub(localFiles, appDir, uploadDir, useCache)
-	} else {
-		return fake.gatherFilesReturns.result1, fake.gatherFilesReturns.result2, fake.gatherFilesReturns.result3
+		return fake.GatherFilesStub(localFiles, appDir, uploadDir, ignoreFile, useCache)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
 	}
+	return fake.gatherFilesReturns.result1, fake.gatherFilesReturns.result2, fake.gatherFilesReturns.result3
 }
 
 func (fake *FakePushActor) GatherFilesCallCount() int {
@@ -167,10 +219,10 @@ func (fake *FakePushActor) GatherFilesCallCount() int {
 	return len(fake.gatherFilesArgsForCall)
 }
 
-func (fake *FakePushActor) GatherFilesArgsForCall(i int) ([]models.AppFileFields, string, string, bool) {
+func (fake *FakePushActor) GatherFilesArgsForCall(i int) ([]models.AppFileFields, string, string, string, bool) {
 	fake.gatherFilesMutex.RLock()
 	defer fake.gatherFilesMutex.RUnlock()
-	return fake.gatherFilesArgsForCall[i].localFiles, fake.gatherFilesArgsForCall[i].appDir, fake.gatherFilesArgsForCall[i].uploadDir, fake.gatherFilesArgsForCall[i].useCache
+	return fake.gatherFilesArgsForCall[i].localFiles, fake.gatherFilesArgsForCall[i].appDir, fake.gatherFilesArgsForCall[i].uploadDir, fake.gatherFilesArgsForCall[i].ignoreFile, fake.gatherFilesArgsForCall[i].useCache
 }
 
 func (fake *FakePushActor) GatherFilesReturns(result1 []resources.AppFileResource, result2 bool, result3 error) {
@@ -182,6 +234,22 @@ func (fake *FakePushActor) GatherFilesReturns(result1 []resources.AppFileResourc
 	}{result1, result2, result3}
 }
 
+func (fake *FakePushActor) GatherFilesReturnsOnCall(i int, result1 []resources.AppFileResource, result2 bool, result3 error) {
+	fake.GatherFilesStub = nil
+	if fake.gatherFilesReturnsOnCall == nil {
+		fake.gatherFilesReturnsOnCall = make(map[int]struct {
+			result1 []resources.AppFileResource
+			result2 bool
+			result3 error
+		})
+	}
+	fake.gatherFilesReturnsOnCall[i] = struct {
+		result1 []resources.AppFileResource
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakePushActor) ValidateAppParams(apps []models.AppParams) []error {
 	var appsCopy []models.AppParams
 	if apps != nil {
@@ -189,6 +257,7 @@ func (fake *FakePushActor) ValidateAppParams(apps []models.AppParams) []error {
 		copy(appsCopy, apps)
 	}
 	fake.validateAppParamsMutex.Lock()
+	ret, specificReturn := fake.validateAppParamsReturnsOnCall[len(fake.validateAppParamsArgsForCall)]
 	fake.validateAppParamsArgsForCall = append(fake.validateAppParamsArgsForCall, struct {
 		apps []models.AppParams
 	}{appsCopy})
@@ -196,9 +265,11 @@ func (fake *FakePushActor) ValidateAppParams(apps []models.AppParams) []error {
 	fake.validateAppParamsMutex.Unlock()
 	if fake.ValidateAppParamsStub != nil {
 		return fake.ValidateAppParamsStub(apps)
-	} else {
-		return fake.validateAppParamsReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.validateAppParamsReturns.result1
 }
 
 func (fake *FakePushActor) ValidateAppParamsCallCount() int {
@@ -220,8 +291,21 @@ func (fake *FakePushActor) ValidateAppParamsReturns(result1 []error) {
 	}{result1}
 }
 
+func (fake *FakePushActor) ValidateAppParamsReturnsOnCall(i int, result1 []error) {
+	fake.ValidateAppParamsStub = nil
+	if fake.validateAppParamsReturnsOnCall == nil {
+		fake.validateAppParamsReturnsOnCall = make(map[int]struct {
+			result1 []error
+		})
+	}
+	fake.validateAppParamsReturnsOnCall[i] = struct {
+		result1 []error
+	}{result1}
+}
+
 func (fake *FakePushActor) MapManifestRoute(routeName string, app models.Application, appParamsFromContext models.AppParams) error {
 	fake.mapManifestRouteMutex.Lock()
+	ret, specificReturn := fake.mapManifestRouteReturnsOnCall[len(fake.mapManifestRouteArgsForCall)]
 	fake.mapManifestRouteArgsForCall = append(fake.mapManifestRouteArgsForCall, struct {
 		routeName            string
 		app                  models.Application
@@ -231,9 +315,11 @@ func (fake *FakePushActor) MapManifestRoute(routeName string, app models.Applica
 	fake.mapManifestRouteMutex.Unlock()
 	if fake.MapManifestRouteStub != nil {
 		return fake.MapManifestRouteStub(routeName, app, appParamsFromContext)
-	} else {
-		return fake.mapManifestRouteReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.mapManifestRouteReturns.result1
 }
 
 func (fake *FakePushActor) MapManifestRouteCallCount() int {
@@ -255,6 +341,18 @@ func (fake *FakePushActor) MapManifestRouteReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakePushActor) MapManifestRouteReturnsOnCall(i int, result1 error) {
+	fake.MapManifestRouteStub = nil
+	if fake.mapManifestRouteReturnsOnCall == nil {
+		fake.mapManifestRouteReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.mapManifestRouteReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakePushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -22,7 +22,7 @@ const windowsPathPrefix = `\\?\`
 type PushActor interface {
 	UploadApp(appGUID string, zipFile *os.File, presentFiles []resources.AppFileResource) error
 	ProcessPath(dirOrZipFile string, f func(string) error) error
-	GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error)
+	GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, ignoreFile string, useCache bool) ([]resources.AppFileResource, bool, error)
 	ValidateAppParams(apps []models.AppParams) []error
 	MapManifestRoute(routeName string, app models.Application, appParamsFromContext models.AppParams) error
 }
@@ -105,7 +105,11 @@ func (actor PushActorImpl) ProcessPath(dirOrZipFile string, f func(string) error
 	return nil
 }
 
-func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error) {
+// GatherFiles copies the local files that the Cloud Controller does not have
+// cached to uploadDir, along with the ignore file so that the files are
+// zipped with the same patterns. The ignore file is ignoreFile, or the
+// .cfignore in appDir when ignoreFile is empty.
+func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir string, uploadDir string, ignoreFile string, useCache bool) ([]resources.AppFileResource, bool, error) {
 	appFileResource := []resources.AppFileResource{}
 	for _, file := range localFiles {
 		appFileResource = append(appFileResource, resources.AppFileResource{
@@ -142,9 +146,12 @@ func (actor PushActorImpl) GatherFiles(localFiles []models.AppFileFields, appDir
 		return []resources.AppFileResource{}, false, err
 	}
 
-	_, err = os.Stat(filepath.Join(appDir, ".cfignore"))
+	if ignoreFile == "" {
+		ignoreFile = filepath.Join(appDir, ".cfignore")
+	}
+	_, err = os.Stat(ignoreFile)
 	if err == nil {
-		err = fileutils.CopyPathToPath(filepath.Join(appDir, ".cfignore"), filepath.Join(uploadDir, ".cfignore"))
+		err = fileutils.CopyPathToPath(ignoreFile, filepath.Join(uploadDir, ".cfignore"))
 		if err != nil {
 			return []resources.AppFileResource{}, false, err
 		}
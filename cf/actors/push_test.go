@@ -78,7 +78,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns an error if we cannot reach the cc", func() {
-				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, "", true)
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(expectedErr))
 			})
@@ -93,7 +93,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns an error", func() {
-				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, "", true)
 				Expect(err).To(HaveOccurred())
 				Expect(err).To(Equal(expectedErr))
 			})
@@ -107,12 +107,38 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("copies the .cfignore file to the upload directory", func() {
-				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 
 				_, err = os.Stat(filepath.Join(tmpDir, ".cfignore"))
 				Expect(os.IsNotExist(err)).To(BeFalse())
 			})
+
+			Context("when an ignore file is given", func() {
+				var ignoreFile string
+
+				BeforeEach(func() {
+					f, err := ioutil.TempFile("", "ignore-file")
+					Expect(err).NotTo(HaveOccurred())
+					_, err = f.WriteString("*.log\n")
+					Expect(err).NotTo(HaveOccurred())
+					Expect(f.Close()).To(Succeed())
+					ignoreFile = f.Name()
+				})
+
+				AfterEach(func() {
+					Expect(os.Remove(ignoreFile)).To(Succeed())
+				})
+
+				It("copies the ignore file to the upload directory as the .cfignore", func() {
+					_, _, err := actor.GatherFiles(allFiles, appDir, tmpDir, ignoreFile, true)
+					Expect(err).NotTo(HaveOccurred())
+
+					contents, err := ioutil.ReadFile(filepath.Join(tmpDir, ".cfignore"))
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(contents)).To(Equal("*.log\n"))
+				})
+			})
 		})
 
 		It("returns files to upload with file mode unchanged on non-Windows platforms", func() {
@@ -125,7 +151,7 @@ var _ = Describe("Push Actor", func() {
 
 			expectedFileMode := fmt.Sprintf("%#o", info.Mode())
 
-			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 			Expect(err).NotTo(HaveOccurred())
 
 			expectedFiles := []resources.AppFileResource{
@@ -148,7 +174,7 @@ var _ = Describe("Push Actor", func() {
 
 			expectedFileMode := fmt.Sprintf("%#o", info.Mode()|0700)
 
-			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+			actualFiles, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 			Expect(err).NotTo(HaveOccurred())
 
 			expectedFiles := []resources.AppFileResource{
@@ -167,7 +193,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns true for hasFileToUpload", func() {
-				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(hasFileToUpload).To(BeTrue())
 			})
@@ -182,7 +208,7 @@ var _ = Describe("Push Actor", func() {
 					{Path: "example-app/ignore-me"},
 					{Path: "example-app/manifest.yml"},
 				}
-				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(appFiles.CopyFilesCallCount()).To(Equal(1))
@@ -205,7 +231,7 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns true for hasFileToUpload", func() {
-				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(hasFileToUpload).To(BeTrue())
 			})
@@ -219,7 +245,7 @@ var _ = Describe("Push Actor", func() {
 					{Path: "example-app/Gemfile.lock"},
 					{Path: "example-app/ignore-me"},
 				}
-				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(appFiles.CopyFilesCallCount()).To(Equal(1))
@@ -246,13 +272,13 @@ var _ = Describe("Push Actor", func() {
 			})
 
 			It("returns false for hasFileToUpload", func() {
-				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, hasFileToUpload, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(hasFileToUpload).To(BeFalse())
 			})
 
 			It("copies nothing to the upload dir", func() {
-				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, true)
+				_, _, err := actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", true)
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(appFiles.CopyFilesCallCount()).To(Equal(1))
@@ -265,7 +291,7 @@ var _ = Describe("Push Actor", func() {
 
 		Context("when told not to use the remote cache", func() {
 			It("does not use the remote cache", func() {
-				actor.GatherFiles(allFiles, fixturesDir, tmpDir, false)
+				actor.GatherFiles(allFiles, fixturesDir, tmpDir, "", false)
 				Expect(appBitsRepo.GetApplicationFilesCallCount()).To(Equal(0))
 			})
 		})
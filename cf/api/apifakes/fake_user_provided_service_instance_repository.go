@@ -9,7 +9,7 @@ import (
 )
 
 type FakeUserProvidedServiceInstanceRepository struct {
-	CreateStub        func(name, drainURL string, routeServiceURL string, params map[string]interface{}) (apiErr error)
+	CreateStub        func(name string, drainURL string, routeServiceURL string, params map[string]interface{}) error
 	createMutex       sync.RWMutex
 	createArgsForCall []struct {
 		name            string
@@ -20,7 +20,10 @@ type FakeUserProvidedServiceInstanceRepository struct {
 	createReturns struct {
 		result1 error
 	}
-	UpdateStub        func(serviceInstanceFields models.ServiceInstanceFields) (apiErr error)
+	createReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UpdateStub        func(serviceInstanceFields models.ServiceInstanceFields) error
 	updateMutex       sync.RWMutex
 	updateArgsForCall []struct {
 		serviceInstanceFields models.ServiceInstanceFields
@@ -28,6 +31,9 @@ type FakeUserProvidedServiceInstanceRepository struct {
 	updateReturns struct {
 		result1 error
 	}
+	updateReturnsOnCall map[int]struct {
+		result1 error
+	}
 	GetSummariesStub        func() (models.UserProvidedServiceSummary, error)
 	getSummariesMutex       sync.RWMutex
 	getSummariesArgsForCall []struct{}
@@ -35,12 +41,28 @@ type FakeUserProvidedServiceInstanceRepository struct {
 		result1 models.UserProvidedServiceSummary
 		result2 error
 	}
+	getSummariesReturnsOnCall map[int]struct {
+		result1 models.UserProvidedServiceSummary
+		result2 error
+	}
+	ListForCurrentSpaceStub        func() ([]models.UserProvidedService, error)
+	listForCurrentSpaceMutex       sync.RWMutex
+	listForCurrentSpaceArgsForCall []struct{}
+	listForCurrentSpaceReturns     struct {
+		result1 []models.UserProvidedService
+		result2 error
+	}
+	listForCurrentSpaceReturnsOnCall map[int]struct {
+		result1 []models.UserProvidedService
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeUserProvidedServiceInstanceRepository) Create(name string, drainURL string, routeServiceURL string, params map[string]interface{}) (apiErr error) {
+func (fake *FakeUserProvidedServiceInstanceRepository) Create(name string, drainURL string, routeServiceURL string, params map[string]interface{}) error {
 	fake.createMutex.Lock()
+	ret, specificReturn := fake.createReturnsOnCall[len(fake.createArgsForCall)]
 	fake.createArgsForCall = append(fake.createArgsForCall, struct {
 		name            string
 		drainURL        string
@@ -51,9 +73,11 @@ func (fake *FakeUserProvidedServiceInstanceRepository) Create(name string, drain
 	fake.createMutex.Unlock()
 	if fake.CreateStub != nil {
 		return fake.CreateStub(name, drainURL, routeServiceURL, params)
-	} else {
-		return fake.createReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.createReturns.result1
 }
 
 func (fake *FakeUserProvidedServiceInstanceRepository) CreateCallCount() int {
@@ -75,8 +99,21 @@ func (fake *FakeUserProvidedServiceInstanceRepository) CreateReturns(result1 err
 	}{result1}
 }
 
-func (fake *FakeUserProvidedServiceInstanceRepository) Update(serviceInstanceFields models.ServiceInstanceFields) (apiErr error) {
+func (fake *FakeUserProvidedServiceInstanceRepository) CreateReturnsOnCall(i int, result1 error) {
+	fake.CreateStub = nil
+	if fake.createReturnsOnCall == nil {
+		fake.createReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.createReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeUserProvidedServiceInstanceRepository) Update(serviceInstanceFields models.ServiceInstanceFields) error {
 	fake.updateMutex.Lock()
+	ret, specificReturn := fake.updateReturnsOnCall[len(fake.updateArgsForCall)]
 	fake.updateArgsForCall = append(fake.updateArgsForCall, struct {
 		serviceInstanceFields models.ServiceInstanceFields
 	}{serviceInstanceFields})
@@ -84,9 +121,11 @@ func (fake *FakeUserProvidedServiceInstanceRepository) Update(serviceInstanceFie
 	fake.updateMutex.Unlock()
 	if fake.UpdateStub != nil {
 		return fake.UpdateStub(serviceInstanceFields)
-	} else {
-		return fake.updateReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.updateReturns.result1
 }
 
 func (fake *FakeUserProvidedServiceInstanceRepository) UpdateCallCount() int {
@@ -108,16 +147,31 @@ func (fake *FakeUserProvidedServiceInstanceRepository) UpdateReturns(result1 err
 	}{result1}
 }
 
+func (fake *FakeUserProvidedServiceInstanceRepository) UpdateReturnsOnCall(i int, result1 error) {
+	fake.UpdateStub = nil
+	if fake.updateReturnsOnCall == nil {
+		fake.updateReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUserProvidedServiceInstanceRepository) GetSummaries() (models.UserProvidedServiceSummary, error) {
 	fake.getSummariesMutex.Lock()
+	ret, specificReturn := fake.getSummariesReturnsOnCall[len(fake.getSummariesArgsForCall)]
 	fake.getSummariesArgsForCall = append(fake.getSummariesArgsForCall, struct{}{})
 	fake.recordInvocation("GetSummaries", []interface{}{})
 	fake.getSummariesMutex.Unlock()
 	if fake.GetSummariesStub != nil {
 		return fake.GetSummariesStub()
-	} else {
-		return fake.getSummariesReturns.result1, fake.getSummariesReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getSummariesReturns.result1, fake.getSummariesReturns.result2
 }
 
 func (fake *FakeUserProvidedServiceInstanceRepository) GetSummariesCallCount() int {
@@ -134,6 +188,63 @@ func (fake *FakeUserProvidedServiceInstanceRepository) GetSummariesReturns(resul
 	}{result1, result2}
 }
 
+func (fake *FakeUserProvidedServiceInstanceRepository) GetSummariesReturnsOnCall(i int, result1 models.UserProvidedServiceSummary, result2 error) {
+	fake.GetSummariesStub = nil
+	if fake.getSummariesReturnsOnCall == nil {
+		fake.getSummariesReturnsOnCall = make(map[int]struct {
+			result1 models.UserProvidedServiceSummary
+			result2 error
+		})
+	}
+	fake.getSummariesReturnsOnCall[i] = struct {
+		result1 models.UserProvidedServiceSummary
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvidedServiceInstanceRepository) ListForCurrentSpace() ([]models.UserProvidedService, error) {
+	fake.listForCurrentSpaceMutex.Lock()
+	ret, specificReturn := fake.listForCurrentSpaceReturnsOnCall[len(fake.listForCurrentSpaceArgsForCall)]
+	fake.listForCurrentSpaceArgsForCall = append(fake.listForCurrentSpaceArgsForCall, struct{}{})
+	fake.recordInvocation("ListForCurrentSpace", []interface{}{})
+	fake.listForCurrentSpaceMutex.Unlock()
+	if fake.ListForCurrentSpaceStub != nil {
+		return fake.ListForCurrentSpaceStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listForCurrentSpaceReturns.result1, fake.listForCurrentSpaceReturns.result2
+}
+
+func (fake *FakeUserProvidedServiceInstanceRepository) ListForCurrentSpaceCallCount() int {
+	fake.listForCurrentSpaceMutex.RLock()
+	defer fake.listForCurrentSpaceMutex.RUnlock()
+	return len(fake.listForCurrentSpaceArgsForCall)
+}
+
+func (fake *FakeUserProvidedServiceInstanceRepository) ListForCurrentSpaceReturns(result1 []models.UserProvidedService, result2 error) {
+	fake.ListForCurrentSpaceStub = nil
+	fake.listForCurrentSpaceReturns = struct {
+		result1 []models.UserProvidedService
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeUserProvidedServiceInstanceRepository) ListForCurrentSpaceReturnsOnCall(i int, result1 []models.UserProvidedService, result2 error) {
+	fake.ListForCurrentSpaceStub = nil
+	if fake.listForCurrentSpaceReturnsOnCall == nil {
+		fake.listForCurrentSpaceReturnsOnCall = make(map[int]struct {
+			result1 []models.UserProvidedService
+			result2 error
+		})
+	}
+	fake.listForCurrentSpaceReturnsOnCall[i] = struct {
+		result1 []models.UserProvidedService
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeUserProvidedServiceInstanceRepository) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -143,6 +254,8 @@ func (fake *FakeUserProvidedServiceInstanceRepository) Invocations() map[string]
 	defer fake.updateMutex.RUnlock()
 	fake.getSummariesMutex.RLock()
 	defer fake.getSummariesMutex.RUnlock()
+	fake.listForCurrentSpaceMutex.RLock()
+	defer fake.listForCurrentSpaceMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -187,6 +187,16 @@ func (uaa UAARepository) RefreshAuthToken() (string, error) {
 		"scope":         {""},
 	}
 
+	// Service accounts are not issued refresh tokens, so a new token is
+	// requested with the client credentials instead.
+	if uaa.config.UAAGrantType() == "client_credentials" {
+		data = url.Values{
+			"client_id":     {uaa.config.UAAOAuthClient()},
+			"client_secret": {uaa.config.UAAOAuthClientSecret()},
+			"grant_type":    {"client_credentials"},
+		}
+	}
+
 	apiErr := uaa.getAuthToken(data)
 	updatedToken := uaa.config.AccessToken()
 
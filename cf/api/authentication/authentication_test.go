@@ -3,8 +3,11 @@ package authentication_test
 import (
 	"encoding/base64"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/net"
@@ -221,6 +224,44 @@ var _ = Describe("AuthenticationRepository", func() {
 					Expect(apiErr).NotTo(BeNil())
 				})
 			})
+
+			Context("when authenticated with client credentials", func() {
+				var configDir string
+
+				BeforeEach(func() {
+					var err error
+					configDir, err = ioutil.TempDir("", "cf-config")
+					Expect(err).NotTo(HaveOccurred())
+
+					configPath := filepath.Join(configDir, "config.json")
+					err = ioutil.WriteFile(configPath, []byte(`{
+						"ConfigVersion": 3,
+						"UAAOAuthClient": "some-client",
+						"UAAOAuthClientSecret": "some-secret",
+						"UAAGrantType": "client_credentials"
+					}`), 0600)
+					Expect(err).NotTo(HaveOccurred())
+
+					config = coreconfig.NewRepositoryFromFilepath(configPath, func(err error) {
+						panic(err)
+					})
+					gateway = net.NewUAAGateway(config, new(terminalfakes.FakeUI), fakePrinter, "")
+					auth = NewUAARepository(gateway, config, dumper)
+
+					testServer, handler = testnet.NewServer([]testnet.TestRequest{clientCredentialsRequest})
+					config.SetAuthenticationEndpoint(testServer.URL)
+				})
+
+				AfterEach(func() {
+					os.RemoveAll(configDir)
+				})
+
+				It("requests a new token with the client credentials", func() {
+					Expect(handler).To(HaveAllRequestsCalled())
+					Expect(apiErr).NotTo(HaveOccurred())
+					Expect(config.AccessToken()).To(Equal("BEARER my_access_token"))
+				})
+			})
 		})
 	})
 
@@ -361,6 +402,35 @@ var successfulLoginMatcher = func(request *http.Request) {
 	Expect(request.Form.Get("scope")).To(Equal(""))
 }
 
+var clientCredentialsRequest = testnet.TestRequest{
+	Method: "POST",
+	Path:   "/oauth/token",
+	Header: http.Header{
+		"authorization": {"Basic " + base64.StdEncoding.EncodeToString([]byte("some-client:some-secret"))},
+	},
+	Matcher: func(request *http.Request) {
+		err := request.ParseForm()
+		if err != nil {
+			Fail(fmt.Sprintf("Failed to parse form: %s", err))
+			return
+		}
+
+		Expect(request.Form.Get("grant_type")).To(Equal("client_credentials"))
+		Expect(request.Form.Get("client_id")).To(Equal("some-client"))
+		Expect(request.Form.Get("client_secret")).To(Equal("some-secret"))
+		Expect(request.Form).NotTo(HaveKey("refresh_token"))
+	},
+	Response: testnet.TestResponse{
+		Status: http.StatusOK,
+		Body: `
+{
+  "access_token": "my_access_token",
+  "token_type": "BEARER",
+  "scope": "openid",
+  "expires_in": 98765
+} `},
+}
+
 var unsuccessfulLoginRequest = testnet.TestRequest{
 	Method: "POST",
 	Path:   "/oauth/token",
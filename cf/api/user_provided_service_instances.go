@@ -16,6 +16,7 @@ type UserProvidedServiceInstanceRepository interface {
 	Create(name, drainURL string, routeServiceURL string, params map[string]interface{}) (apiErr error)
 	Update(serviceInstanceFields models.ServiceInstanceFields) (apiErr error)
 	GetSummaries() (models.UserProvidedServiceSummary, error)
+	ListForCurrentSpace() ([]models.UserProvidedService, error)
 }
 
 type CCUserProvidedServiceInstanceRepository struct {
@@ -77,3 +78,19 @@ func (repo CCUserProvidedServiceInstanceRepository) GetSummaries() (models.UserP
 
 	return model, nil
 }
+
+func (repo CCUserProvidedServiceInstanceRepository) ListForCurrentSpace() ([]models.UserProvidedService, error) {
+	services := []models.UserProvidedService{}
+	err := repo.gateway.ListPaginatedResources(
+		repo.config.APIEndpoint(),
+		fmt.Sprintf("/v2/spaces/%s/user_provided_service_instances", repo.config.SpaceFields().GUID),
+		models.UserProvidedServiceEntity{},
+		func(resource interface{}) bool {
+			if entity, ok := resource.(models.UserProvidedServiceEntity); ok {
+				services = append(services, entity.UserProvidedService)
+			}
+			return true
+		},
+	)
+	return services, err
+}
@@ -182,6 +182,69 @@ var _ = Describe("UserProvidedServiceRepository", func() {
 		})
 	})
 
+	Context("ListForCurrentSpace()", func() {
+		It("returns every user provided service in the targeted space", func() {
+			firstPage := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/spaces/my-space-guid/user_provided_service_instances",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+					"next_url": "/v2/spaces/my-space-guid/user_provided_service_instances?page=2",
+					"resources": [
+						{
+							"metadata": {"guid": "service-guid-1"},
+							"entity": {
+								"name": "test_service",
+								"credentials": {"username": "admin"},
+								"space_guid": "my-space-guid",
+								"syslog_drain_url": "syslog://example.com",
+								"route_service_url": ""
+							}
+						}
+					]
+				}`},
+			})
+			secondPage := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/spaces/my-space-guid/user_provided_service_instances?page=2",
+				Response: testnet.TestResponse{Status: http.StatusOK, Body: `{
+					"next_url": null,
+					"resources": [
+						{
+							"metadata": {"guid": "service-guid-2"},
+							"entity": {
+								"name": "test_service2",
+								"credentials": {},
+								"space_guid": "my-space-guid",
+								"syslog_drain_url": "",
+								"route_service_url": "https://example.com"
+							}
+						}
+					]
+				}`},
+			})
+
+			ts, handler, repo := createUserProvidedServiceInstanceRepo([]testnet.TestRequest{firstPage, secondPage})
+			defer ts.Close()
+
+			services, apiErr := repo.ListForCurrentSpace()
+			Expect(apiErr).NotTo(HaveOccurred())
+			Expect(handler).To(HaveAllRequestsCalled())
+			Expect(services).To(Equal([]models.UserProvidedService{
+				{
+					Name:           "test_service",
+					Credentials:    map[string]interface{}{"username": "admin"},
+					SpaceGUID:      "my-space-guid",
+					SysLogDrainURL: "syslog://example.com",
+				},
+				{
+					Name:            "test_service2",
+					Credentials:     map[string]interface{}{},
+					SpaceGUID:       "my-space-guid",
+					RouteServiceURL: "https://example.com",
+				},
+			}))
+		})
+	})
 })
 
 func createUserProvidedServiceInstanceRepo(req []testnet.TestRequest) (ts *httptest.Server, handler *testnet.TestHandler, repo UserProvidedServiceInstanceRepository) {
@@ -18,7 +18,7 @@ const windowsPathPrefix = `\\?\`
 //go:generate counterfeiter . AppFiles
 
 type AppFiles interface {
-	AppFilesInDir(dir string) (appFiles []models.AppFileFields, err error)
+	AppFilesInDir(dir string, ignoreFile string) (appFiles []models.AppFileFields, err error)
 	CopyFiles(appFiles []models.AppFileFields, fromDir, toDir string) (err error)
 	CountFiles(directory string) int64
 	WalkAppFiles(dir string, onEachFile func(string, string) error) (err error)
@@ -26,7 +26,10 @@ type AppFiles interface {
 
 type ApplicationFiles struct{}
 
-func (appfiles ApplicationFiles) AppFilesInDir(dir string) ([]models.AppFileFields, error) {
+// AppFilesInDir returns the files in dir that are not ignored. The patterns
+// are read from ignoreFile, or from the .cfignore in dir when ignoreFile is
+// empty.
+func (appfiles ApplicationFiles) AppFilesInDir(dir string, ignoreFile string) ([]models.AppFileFields, error) {
 	appFiles := []models.AppFileFields{}
 
 	fullDirPath, toplevelErr := filepath.Abs(dir)
@@ -34,7 +37,16 @@ func (appfiles ApplicationFiles) AppFilesInDir(dir string) ([]models.AppFileFiel
 		return appFiles, toplevelErr
 	}
 
-	toplevelErr = appfiles.WalkAppFiles(fullDirPath, func(fileName string, fullPath string) error {
+	cfIgnore := loadIgnoreFile(fullDirPath)
+	if ignoreFile != "" {
+		fileContents, err := ioutil.ReadFile(ignoreFile)
+		if err != nil {
+			return appFiles, err
+		}
+		cfIgnore = NewCfIgnore(string(fileContents))
+	}
+
+	toplevelErr = walkAppFiles(fullDirPath, cfIgnore, func(fileName string, fullPath string) error {
 		fileInfo, err := os.Lstat(fullPath)
 		if err != nil {
 			return err
@@ -163,7 +175,10 @@ func (appfiles ApplicationFiles) CountFiles(directory string) int64 {
 }
 
 func (appfiles ApplicationFiles) WalkAppFiles(dir string, onEachFile func(string, string) error) error {
-	cfIgnore := loadIgnoreFile(dir)
+	return walkAppFiles(dir, loadIgnoreFile(dir), onEachFile)
+}
+
+func walkAppFiles(dir string, cfIgnore CfIgnore, onEachFile func(string, string) error) error {
 	walkFunc := func(fullPath string, f os.FileInfo, err error) error {
 		fileRelativePath, _ := filepath.Rel(dir, fullPath)
 		fileRelativeUnixPath := filepath.ToSlash(fileRelativePath)
@@ -180,7 +195,12 @@ func (appfiles ApplicationFiles) WalkAppFiles(dir string, onEachFile func(string
 			return nil
 		}
 
-		if cfIgnore.FileShouldBeIgnored(fileRelativeUnixPath) {
+		ignorePath := fileRelativeUnixPath
+		if err == nil && f.IsDir() {
+			ignorePath += "/"
+		}
+
+		if cfIgnore.FileShouldBeIgnored(ignorePath) {
 			if err == nil && f.IsDir() {
 				return filepath.SkipDir
 			}
@@ -46,7 +46,7 @@ var _ = Describe("AppFiles", func() {
 
 	Describe("AppFilesInDir", func() {
 		It("all files have '/' path separators", func() {
-			files, err := appFiles.AppFilesInDir(fixturePath)
+			files, err := appFiles.AppFilesInDir(fixturePath, "")
 			Expect(err).NotTo(HaveOccurred())
 
 			for _, afile := range files {
@@ -59,7 +59,7 @@ var _ = Describe("AppFiles", func() {
 
 			BeforeEach(func() {
 				appPath := filepath.Join(fixturePath, "app-with-cfignore")
-				files, err := appFiles.AppFilesInDir(appPath)
+				files, err := appFiles.AppFilesInDir(appPath, "")
 				Expect(err).NotTo(HaveOccurred())
 
 				paths = []string{}
@@ -69,18 +69,65 @@ var _ = Describe("AppFiles", func() {
 			})
 
 			It("excludes ignored files", func() {
+				// As with gitignore, dir1/child-dir/file3.txt cannot be
+				// re-included because dir1/child-dir is excluded.
 				Expect(paths).To(Equal([]string{
 					"dir1",
-					"dir1/child-dir",
-					"dir1/child-dir/file3.txt",
 					"dir1/file1.txt",
 					"dir2",
+				}))
+			})
+		})
+
+		Context("when an ignore file is given", func() {
+			var (
+				ignoreFile string
+				paths      []string
+				err        error
+			)
+
+			BeforeEach(func() {
+				f, tempErr := ioutil.TempFile("", "ignore-file")
+				Expect(tempErr).NotTo(HaveOccurred())
+				_, tempErr = f.WriteString("dir1/\n.*\n")
+				Expect(tempErr).NotTo(HaveOccurred())
+				Expect(f.Close()).To(Succeed())
+				ignoreFile = f.Name()
+			})
+
+			JustBeforeEach(func() {
+				var files []models.AppFileFields
+				files, err = appFiles.AppFilesInDir(filepath.Join(fixturePath, "app-with-cfignore"), ignoreFile)
+
+				paths = []string{}
+				for _, file := range files {
+					paths = append(paths, file.Path)
+				}
+			})
 
-					// TODO: this should be excluded.
-					// .cfignore doesn't handle ** patterns right now
+			AfterEach(func() {
+				os.Remove(ignoreFile)
+			})
+
+			It("uses its patterns instead of the .cfignore", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(paths).To(Equal([]string{
+					"dir2",
 					"dir2/child-dir2",
+					"dir2/child-dir2/grandchild-dir2",
+					"dir2/child-dir2/grandchild-dir2/file4.txt",
 				}))
 			})
+
+			Context("when the ignore file does not exist", func() {
+				BeforeEach(func() {
+					Expect(os.Remove(ignoreFile)).To(Succeed())
+				})
+
+				It("returns an error", func() {
+					Expect(os.IsNotExist(err)).To(BeTrue())
+				})
+			})
 		})
 
 		// NB: on windows, you can never rely on the size of a directory being zero
@@ -93,7 +140,7 @@ var _ = Describe("AppFiles", func() {
 				err = os.Mkdir(filepath.Join(tempdir, "nothing"), 0600)
 				Expect(err).ToNot(HaveOccurred())
 
-				files, err := appFiles.AppFilesInDir(tempdir)
+				files, err := appFiles.AppFilesInDir(tempdir, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				sizes := []int64{}
@@ -9,16 +9,21 @@ import (
 )
 
 type FakeAppFiles struct {
-	AppFilesInDirStub        func(dir string) (appFiles []models.AppFileFields, err error)
+	AppFilesInDirStub        func(dir string, ignoreFile string) ([]models.AppFileFields, error)
 	appFilesInDirMutex       sync.RWMutex
 	appFilesInDirArgsForCall []struct {
-		dir string
+		dir        string
+		ignoreFile string
 	}
 	appFilesInDirReturns struct {
 		result1 []models.AppFileFields
 		result2 error
 	}
-	CopyFilesStub        func(appFiles []models.AppFileFields, fromDir, toDir string) (err error)
+	appFilesInDirReturnsOnCall map[int]struct {
+		result1 []models.AppFileFields
+		result2 error
+	}
+	CopyFilesStub        func(appFiles []models.AppFileFields, fromDir string, toDir string) error
 	copyFilesMutex       sync.RWMutex
 	copyFilesArgsForCall []struct {
 		appFiles []models.AppFileFields
@@ -28,6 +33,9 @@ type FakeAppFiles struct {
 	copyFilesReturns struct {
 		result1 error
 	}
+	copyFilesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CountFilesStub        func(directory string) int64
 	countFilesMutex       sync.RWMutex
 	countFilesArgsForCall []struct {
@@ -36,7 +44,10 @@ type FakeAppFiles struct {
 	countFilesReturns struct {
 		result1 int64
 	}
-	WalkAppFilesStub        func(dir string, onEachFile func(string, string) error) (err error)
+	countFilesReturnsOnCall map[int]struct {
+		result1 int64
+	}
+	WalkAppFilesStub        func(dir string, onEachFile func(string, string) error) error
 	walkAppFilesMutex       sync.RWMutex
 	walkAppFilesArgsForCall []struct {
 		dir        string
@@ -45,22 +56,29 @@ type FakeAppFiles struct {
 	walkAppFilesReturns struct {
 		result1 error
 	}
+	walkAppFilesReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAppFiles) AppFilesInDir(dir string) (appFiles []models.AppFileFields, err error) {
+func (fake *FakeAppFiles) AppFilesInDir(dir string, ignoreFile string) ([]models.AppFileFields, error) {
 	fake.appFilesInDirMutex.Lock()
+	ret, specificReturn := fake.appFilesInDirReturnsOnCall[len(fake.appFilesInDirArgsForCall)]
 	fake.appFilesInDirArgsForCall = append(fake.appFilesInDirArgsForCall, struct {
-		dir string
-	}{dir})
-	fake.recordInvocation("AppFilesInDir", []interface{}{dir})
+		dir        string
+		ignoreFile string
+	}{dir, ignoreFile})
+	fake.recordInvocation("AppFilesInDir", []interface{}{dir, ignoreFile})
 	fake.appFilesInDirMutex.Unlock()
 	if fake.AppFilesInDirStub != nil {
-		return fake.AppFilesInDirStub(dir)
-	} else {
-		return fake.appFilesInDirReturns.result1, fake.appFilesInDirReturns.result2
+		return fake.AppFilesInDirStub(dir, ignoreFile)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
 	}
+	return fake.appFilesInDirReturns.result1, fake.appFilesInDirReturns.result2
 }
 
 func (fake *FakeAppFiles) AppFilesInDirCallCount() int {
@@ -69,10 +87,10 @@ func (fake *FakeAppFiles) AppFilesInDirCallCount() int {
 	return len(fake.appFilesInDirArgsForCall)
 }
 
-func (fake *FakeAppFiles) AppFilesInDirArgsForCall(i int) string {
+func (fake *FakeAppFiles) AppFilesInDirArgsForCall(i int) (string, string) {
 	fake.appFilesInDirMutex.RLock()
 	defer fake.appFilesInDirMutex.RUnlock()
-	return fake.appFilesInDirArgsForCall[i].dir
+	return fake.appFilesInDirArgsForCall[i].dir, fake.appFilesInDirArgsForCall[i].ignoreFile
 }
 
 func (fake *FakeAppFiles) AppFilesInDirReturns(result1 []models.AppFileFields, result2 error) {
@@ -83,13 +101,28 @@ func (fake *FakeAppFiles) AppFilesInDirReturns(result1 []models.AppFileFields, r
 	}{result1, result2}
 }
 
-func (fake *FakeAppFiles) CopyFiles(appFiles []models.AppFileFields, fromDir string, toDir string) (err error) {
+func (fake *FakeAppFiles) AppFilesInDirReturnsOnCall(i int, result1 []models.AppFileFields, result2 error) {
+	fake.AppFilesInDirStub = nil
+	if fake.appFilesInDirReturnsOnCall == nil {
+		fake.appFilesInDirReturnsOnCall = make(map[int]struct {
+			result1 []models.AppFileFields
+			result2 error
+		})
+	}
+	fake.appFilesInDirReturnsOnCall[i] = struct {
+		result1 []models.AppFileFields
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeAppFiles) CopyFiles(appFiles []models.AppFileFields, fromDir string, toDir string) error {
 	var appFilesCopy []models.AppFileFields
 	if appFiles != nil {
 		appFilesCopy = make([]models.AppFileFields, len(appFiles))
 		copy(appFilesCopy, appFiles)
 	}
 	fake.copyFilesMutex.Lock()
+	ret, specificReturn := fake.copyFilesReturnsOnCall[len(fake.copyFilesArgsForCall)]
 	fake.copyFilesArgsForCall = append(fake.copyFilesArgsForCall, struct {
 		appFiles []models.AppFileFields
 		fromDir  string
@@ -99,9 +132,11 @@ func (fake *FakeAppFiles) CopyFiles(appFiles []models.AppFileFields, fromDir str
 	fake.copyFilesMutex.Unlock()
 	if fake.CopyFilesStub != nil {
 		return fake.CopyFilesStub(appFiles, fromDir, toDir)
-	} else {
-		return fake.copyFilesReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.copyFilesReturns.result1
 }
 
 func (fake *FakeAppFiles) CopyFilesCallCount() int {
@@ -123,8 +158,21 @@ func (fake *FakeAppFiles) CopyFilesReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAppFiles) CopyFilesReturnsOnCall(i int, result1 error) {
+	fake.CopyFilesStub = nil
+	if fake.copyFilesReturnsOnCall == nil {
+		fake.copyFilesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.copyFilesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAppFiles) CountFiles(directory string) int64 {
 	fake.countFilesMutex.Lock()
+	ret, specificReturn := fake.countFilesReturnsOnCall[len(fake.countFilesArgsForCall)]
 	fake.countFilesArgsForCall = append(fake.countFilesArgsForCall, struct {
 		directory string
 	}{directory})
@@ -132,9 +180,11 @@ func (fake *FakeAppFiles) CountFiles(directory string) int64 {
 	fake.countFilesMutex.Unlock()
 	if fake.CountFilesStub != nil {
 		return fake.CountFilesStub(directory)
-	} else {
-		return fake.countFilesReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.countFilesReturns.result1
 }
 
 func (fake *FakeAppFiles) CountFilesCallCount() int {
@@ -156,8 +206,21 @@ func (fake *FakeAppFiles) CountFilesReturns(result1 int64) {
 	}{result1}
 }
 
-func (fake *FakeAppFiles) WalkAppFiles(dir string, onEachFile func(string, string) error) (err error) {
+func (fake *FakeAppFiles) CountFilesReturnsOnCall(i int, result1 int64) {
+	fake.CountFilesStub = nil
+	if fake.countFilesReturnsOnCall == nil {
+		fake.countFilesReturnsOnCall = make(map[int]struct {
+			result1 int64
+		})
+	}
+	fake.countFilesReturnsOnCall[i] = struct {
+		result1 int64
+	}{result1}
+}
+
+func (fake *FakeAppFiles) WalkAppFiles(dir string, onEachFile func(string, string) error) error {
 	fake.walkAppFilesMutex.Lock()
+	ret, specificReturn := fake.walkAppFilesReturnsOnCall[len(fake.walkAppFilesArgsForCall)]
 	fake.walkAppFilesArgsForCall = append(fake.walkAppFilesArgsForCall, struct {
 		dir        string
 		onEachFile func(string, string) error
@@ -166,9 +229,11 @@ func (fake *FakeAppFiles) WalkAppFiles(dir string, onEachFile func(string, strin
 	fake.walkAppFilesMutex.Unlock()
 	if fake.WalkAppFilesStub != nil {
 		return fake.WalkAppFilesStub(dir, onEachFile)
-	} else {
-		return fake.walkAppFilesReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.walkAppFilesReturns.result1
 }
 
 func (fake *FakeAppFiles) WalkAppFilesCallCount() int {
@@ -190,6 +255,18 @@ func (fake *FakeAppFiles) WalkAppFilesReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeAppFiles) WalkAppFilesReturnsOnCall(i int, result1 error) {
+	fake.WalkAppFilesStub = nil
+	if fake.walkAppFilesReturnsOnCall == nil {
+		fake.walkAppFilesReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.walkAppFilesReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeAppFiles) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
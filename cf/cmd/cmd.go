@@ -90,16 +90,29 @@ func Main(traceEnv string, args []string) {
 	cmd := cmdRegistry.FindCommand(cmdName)
 	if cmd != nil {
 		meta := cmd.MetaData()
-		flagContext := flags.NewFlagContext(meta.Flags)
+		cmdFlags := meta.CommandLineFlags()
+		flagContext := flags.NewFlagContext(cmdFlags)
 		flagContext.SkipFlagParsing(meta.SkipFlagParsing)
 
 		cmdArgs := args[2:]
+
+		//the global --context flag is only honored by the rewritten commands
+		if !meta.SkipFlagParsing && hasContextFlag(cmdFlags, cmdArgs) {
+			deps.UI.Failed(T("The --context flag is not supported by this command. Run '{{.Command}}' to switch to the context first.",
+				map[string]interface{}{"Command": "cf use-context CONTEXT_NAME"}))
+			os.Exit(1)
+		}
+
 		err = flagContext.Parse(cmdArgs...)
 		if err != nil {
 			usage := cmdRegistry.CommandUsage(cmdName)
 			deps.UI.Failed(T("Incorrect Usage") + "\n\n" + err.Error() + "\n\n" + usage)
 		}
 
+		if meta.StructuredOutput {
+			deps.UI.SetOutputFormat(flagContext.String("output"))
+		}
+
 		cmd = cmd.SetDependency(deps, false)
 		cmdRegistry.SetCommand(cmd)
 
@@ -203,6 +216,31 @@ func handleHelp(args []string) []string {
 	}
 }
 
+// hasContextFlag parses the arguments with the command's own flags plus
+// --context, so that flag values and positional arguments which happen to
+// read "--context" are not mistaken for the flag.
+func hasContextFlag(cmdFlags map[string]flags.FlagSet, args []string) bool {
+	for i, arg := range args {
+		if arg == "--" {
+			args = args[:i]
+			break
+		}
+	}
+
+	contextFlags := map[string]flags.FlagSet{
+		"context": &flags.StringFlag{Name: "context"},
+	}
+	for name, flagSet := range cmdFlags {
+		contextFlags[name] = flagSet
+	}
+
+	flagContext := flags.NewFlagContext(contextFlags)
+	if err := flagContext.Parse(args...); err != nil {
+		return false
+	}
+	return flagContext.IsSet("context")
+}
+
 func handleVerbose(args []string) ([]string, bool) {
 	var verbose bool
 	idx := -1
@@ -15,13 +15,31 @@ type Command interface {
 }
 
 type CommandMetadata struct {
-	Name            string
-	ShortName       string
-	Usage           []string
-	Description     string
-	Flags           map[string]flags.FlagSet
-	SkipFlagParsing bool
-	TotalArgs       int //Optional: number of required arguments to skip for flag verification
-	Hidden          bool
-	Examples        []string
+	Name             string
+	ShortName        string
+	Usage            []string
+	Description      string
+	Flags            map[string]flags.FlagSet
+	SkipFlagParsing  bool
+	TotalArgs        int //Optional: number of required arguments to skip for flag verification
+	Hidden           bool
+	Examples         []string
+	StructuredOutput bool //Optional: the command can display its data in the format of the global --output flag
+}
+
+// CommandLineFlags returns the flags that the command line of the command is
+// parsed with: the flags of the command, together with the global --output
+// flag when the command supports structured output.
+func (meta CommandMetadata) CommandLineFlags() map[string]flags.FlagSet {
+	if !meta.StructuredOutput {
+		return meta.Flags
+	}
+
+	cmdFlags := map[string]flags.FlagSet{
+		"output": &flags.StringFlag{Name: "output"},
+	}
+	for name, flagSet := range meta.Flags {
+		cmdFlags[name] = flagSet
+	}
+	return cmdFlags
 }
@@ -0,0 +1,51 @@
+package commandregistry_test
+
+import (
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CommandMetadata", func() {
+	Describe("CommandLineFlags()", func() {
+		var meta commandregistry.CommandMetadata
+
+		BeforeEach(func() {
+			meta = commandregistry.CommandMetadata{
+				Name: "some-command",
+				Flags: map[string]flags.FlagSet{
+					"some-flag": &flags.BoolFlag{Name: "some-flag"},
+				},
+			}
+		})
+
+		It("returns the flags of the command", func() {
+			Expect(meta.CommandLineFlags()).To(Equal(meta.Flags))
+		})
+
+		Context("when the command supports structured output", func() {
+			BeforeEach(func() {
+				meta.StructuredOutput = true
+			})
+
+			It("adds the global --output flag", func() {
+				cmdFlags := meta.CommandLineFlags()
+				Expect(cmdFlags).To(HaveLen(2))
+				Expect(cmdFlags).To(HaveKey("some-flag"))
+				Expect(cmdFlags).To(HaveKey("output"))
+
+				flagContext := flags.NewFlagContext(cmdFlags)
+				Expect(flagContext.Parse("--some-flag", "--output", "json")).To(Succeed())
+				Expect(flagContext.Bool("some-flag")).To(BeTrue())
+				Expect(flagContext.String("output")).To(Equal("json"))
+			})
+
+			It("does not modify the flags of the command", func() {
+				meta.CommandLineFlags()
+				Expect(meta.Flags).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -18,6 +18,18 @@ import (
 	"code.cloudfoundry.org/cli/cf/uihelpers"
 )
 
+// applicationData is the structured output of the apps command.
+type applicationData struct {
+	Name             string   `json:"name"`
+	GUID             string   `json:"guid"`
+	RequestedState   string   `json:"requested_state"`
+	Instances        int      `json:"instances"`
+	RunningInstances *int     `json:"running_instances"`
+	Memory           int64    `json:"memory_in_mb"`
+	DiskQuota        int64    `json:"disk_quota_in_mb"`
+	Routes           []string `json:"routes"`
+}
+
 type ListApps struct {
 	ui             terminal.UI
 	config         coreconfig.Reader
@@ -39,6 +51,7 @@ func (cmd *ListApps) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME apps",
 		},
+		StructuredOutput: true,
 	}
 }
 
@@ -84,6 +97,10 @@ func (cmd *ListApps) Execute(c flags.FlagContext) error {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.ui.StructuredOutputEnabled() {
+		return cmd.displayStructuredData(apps)
+	}
+
 	if len(apps) == 0 {
 		cmd.ui.Say(T("No apps found"))
 		return nil
@@ -133,6 +150,36 @@ func (cmd *ListApps) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *ListApps) displayStructuredData(apps []models.Application) error {
+	data := []applicationData{}
+	for _, application := range apps {
+		routes := []string{}
+		for _, route := range application.Routes {
+			routes = append(routes, route.URL())
+		}
+
+		// the number of running instances is unknown when it is negative
+		var runningInstances *int
+		if application.RunningInstances >= 0 {
+			running := application.RunningInstances
+			runningInstances = &running
+		}
+
+		data = append(data, applicationData{
+			Name:             application.Name,
+			GUID:             application.GUID,
+			RequestedState:   strings.ToLower(application.State),
+			Instances:        application.InstanceCount,
+			RunningInstances: runningInstances,
+			Memory:           application.Memory,
+			DiskQuota:        application.DiskQuota,
+			Routes:           routes,
+		})
+	}
+
+	return cmd.ui.DisplayStructuredData("applications", data)
+}
+
 func (cmd *ListApps) populatePluginModel(apps []models.Application) {
 	for _, app := range apps {
 		appModel := plugin_models.GetAppsModel{}
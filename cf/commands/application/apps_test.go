@@ -54,7 +54,7 @@ var _ = Describe("list-apps command", func() {
 					Name:                   "cfapps.io",
 					Shared:                 true,
 					OwningOrganizationGUID: "org-123",
-					GUID: "domain-guid",
+					GUID:                   "domain-guid",
 				},
 			},
 			{
@@ -240,5 +240,88 @@ var _ = Describe("list-apps command", func() {
 				))
 			})
 		})
+
+		Context("when the --output flag is json", func() {
+			It("displays the apps as a JSON document instead of a table", func() {
+				runCommand("--output", "json")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Getting apps in", "my-org", "my-space", "my-user"},
+					[]string{"OK"},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Application-1"}))
+				Expect(ui.StructuredOutput).To(MatchJSON(`{
+					"schema_version": 1,
+					"kind": "applications",
+					"data": [
+						{
+							"name": "Application-1",
+							"guid": "Application-1-guid",
+							"requested_state": "started",
+							"instances": 1,
+							"running_instances": 1,
+							"memory_in_mb": 512,
+							"disk_quota_in_mb": 1024,
+							"routes": ["app1.cfapps.io", "app1.example.com"]
+						},
+						{
+							"name": "Application-2",
+							"guid": "Application-2-guid",
+							"requested_state": "started",
+							"instances": 2,
+							"running_instances": 1,
+							"memory_in_mb": 256,
+							"disk_quota_in_mb": 1024,
+							"routes": ["app2.cfapps.io"]
+						}
+					]
+				}`))
+			})
+
+			Context("when an app's running instances is unknown", func() {
+				It("displays null for running instances", func() {
+					app := models.Application{}
+					app.Name = "Application-1"
+					app.GUID = "Application-1-guid"
+					app.State = "stopped"
+					app.RunningInstances = -1
+					app.InstanceCount = 2
+					app.Memory = 512
+					app.DiskQuota = 1024
+
+					appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{app}
+
+					runCommand("--output", "json")
+
+					Expect(ui.StructuredOutput).To(MatchJSON(`{
+						"schema_version": 1,
+						"kind": "applications",
+						"data": [
+							{
+								"name": "Application-1",
+								"guid": "Application-1-guid",
+								"requested_state": "stopped",
+								"instances": 2,
+								"running_instances": null,
+								"memory_in_mb": 512,
+								"disk_quota_in_mb": 1024,
+								"routes": []
+							}
+						]
+					}`))
+				})
+			})
+
+			Context("when there are no apps", func() {
+				It("displays an empty list", func() {
+					appSummaryRepo.GetSummariesInCurrentSpaceApps = []models.Application{}
+
+					runCommand("--output", "json")
+
+					Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"No apps found"}))
+					Expect(ui.StructuredOutput).To(MatchJSON(`{"schema_version": 1, "kind": "applications", "data": []}`))
+				})
+			})
+		})
 	})
 })
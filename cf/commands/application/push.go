@@ -48,6 +48,9 @@ type Push struct {
 	routeActor    actors.RouteActor
 	zipper        appfiles.Zipper
 	appfiles      appfiles.AppFiles
+
+	// ignoreFile is the file of .cfignore patterns given with --ignore-file.
+	ignoreFile string
 }
 
 func init() {
@@ -61,6 +64,7 @@ func (cmd *Push) MetaData() commandregistry.CommandMetadata {
 	fs["d"] = &flags.StringFlag{ShortName: "d", Usage: T("Domain (e.g. example.com)")}
 	fs["f"] = &flags.StringFlag{ShortName: "f", Usage: T("Path to manifest")}
 	fs["i"] = &flags.IntFlag{ShortName: "i", Usage: T("Number of instances")}
+	fs["ignore-file"] = &flags.StringFlag{Name: "ignore-file", Usage: T("Path to a file of .cfignore patterns to use instead of the .cfignore in the app directory")}
 	fs["k"] = &flags.StringFlag{ShortName: "k", Usage: T("Disk limit (e.g. 256M, 1024M, 1G)")}
 	fs["m"] = &flags.StringFlag{ShortName: "m", Usage: T("Memory limit (e.g. 256M, 1024M, 1G)")}
 	fs["hostname"] = &flags.StringFlag{Name: "hostname", ShortName: "n", Usage: T("Hostname (e.g. my-subdomain)")}
@@ -178,6 +182,13 @@ func (cmd *Push) SetDependency(deps commandregistry.Dependency, pluginCall bool)
 }
 
 func (cmd *Push) Execute(c flags.FlagContext) error {
+	cmd.ignoreFile = c.String("ignore-file")
+	if cmd.ignoreFile != "" {
+		if _, err := os.Stat(cmd.ignoreFile); err != nil {
+			return errors.New(T("Ignore file '{{.Path}}' not found.", map[string]interface{}{"Path": cmd.ignoreFile}))
+		}
+	}
+
 	appsFromManifest, err := cmd.getAppParamsFromManifest(c)
 	if err != nil {
 		return err
@@ -331,7 +342,7 @@ func (cmd *Push) Execute(c flags.FlagContext) error {
 
 func (cmd *Push) processPathCallback(path string, app models.Application) func(string) error {
 	return func(appDir string) error {
-		localFiles, err := cmd.appfiles.AppFilesInDir(appDir)
+		localFiles, err := cmd.appfiles.AppFilesInDir(appDir, cmd.ignoreFile)
 		if err != nil {
 			return errors.New(
 				T("Error processing app files in '{{.Path}}': {{.Error}}",
@@ -833,11 +844,11 @@ func (cmd *Push) uploadApp(appGUID, appDir, appDirOrZipFile string, localFiles [
 		return err
 	}
 
-	remoteFiles, hasFileToUpload, err := cmd.actor.GatherFiles(localFiles, appDir, uploadDir, true)
+	remoteFiles, hasFileToUpload, err := cmd.actor.GatherFiles(localFiles, appDir, uploadDir, cmd.ignoreFile, true)
 
 	if httpError, isHTTPError := err.(errors.HTTPError); isHTTPError && httpError.StatusCode() == 504 {
 		cmd.ui.Warn("Resource matching API timed out; pushing all app files.")
-		remoteFiles, hasFileToUpload, err = cmd.actor.GatherFiles(localFiles, appDir, uploadDir, false)
+		remoteFiles, hasFileToUpload, err = cmd.actor.GatherFiles(localFiles, appDir, uploadDir, cmd.ignoreFile, false)
 	}
 
 	if err != nil {
@@ -1,6 +1,7 @@
 package application_test
 
 import (
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"syscall"
@@ -408,7 +409,7 @@ var _ = Describe("Push Command", func() {
 				Context("when the CC returns 504 Gateway timeout", func() {
 					BeforeEach(func() {
 						var callCount int
-						actor.GatherFilesStub = func(localFiles []models.AppFileFields, appDir string, uploadDir string, useCache bool) ([]resources.AppFileResource, bool, error) {
+						actor.GatherFilesStub = func(localFiles []models.AppFileFields, appDir string, uploadDir string, ignoreFile string, useCache bool) ([]resources.AppFileResource, bool, error) {
 							callCount += 1
 							if callCount == 1 {
 								return []resources.AppFileResource{}, false, errors.NewHTTPError(504, "", "")
@@ -423,10 +424,10 @@ var _ = Describe("Push Command", func() {
 
 						Expect(actor.GatherFilesCallCount()).To(Equal(2))
 
-						localFiles, appDir, uploadDir, useCache := actor.GatherFilesArgsForCall(0)
+						localFiles, appDir, uploadDir, _, useCache := actor.GatherFilesArgsForCall(0)
 						Expect(useCache).To(Equal(true))
 
-						localFilesRetry, appDirRetry, uploadDirRetry, useCacheRetry := actor.GatherFilesArgsForCall(1)
+						localFilesRetry, appDirRetry, uploadDirRetry, _, useCacheRetry := actor.GatherFilesArgsForCall(1)
 						Expect(localFilesRetry).To(Equal(localFiles))
 						Expect(appDirRetry).To(Equal(appDir))
 						Expect(uploadDirRetry).To(Equal(uploadDir))
@@ -975,8 +976,8 @@ var _ = Describe("Push Command", func() {
 							deps.UI = uiWithContents
 
 							expectedDomain = models.DomainFields{
-								GUID: "some-guid",
-								Name: "some-name",
+								GUID:                   "some-guid",
+								Name:                   "some-name",
 								OwningOrganizationGUID: "some-organization-guid",
 								RouterGroupGUID:        "some-router-group-guid",
 								RouterGroupType:        "tcp",
@@ -1045,7 +1046,7 @@ var _ = Describe("Push Command", func() {
 					It("includes the app files in dir", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
-						actualLocalFiles, _, _, _ := actor.GatherFilesArgsForCall(0)
+						actualLocalFiles, _, _, _, _ := actor.GatherFilesArgsForCall(0)
 						Expect(actualLocalFiles).To(Equal(expectedLocalFiles))
 					})
 				})
@@ -1076,6 +1077,44 @@ var _ = Describe("Push Command", func() {
 					})
 				})
 
+				Context("when an ignore file is specified with the --ignore-file flag", func() {
+					var ignoreFile string
+
+					BeforeEach(func() {
+						f, err := ioutil.TempFile("", "ignore-file")
+						Expect(err).NotTo(HaveOccurred())
+						Expect(f.Close()).To(Succeed())
+						ignoreFile = f.Name()
+						args = []string{"-p", "../some/path-to/an-app/file.zip", "--ignore-file", ignoreFile, "app-with-path"}
+					})
+
+					AfterEach(func() {
+						os.Remove(ignoreFile)
+					})
+
+					It("gathers and uploads the app files with the ignore file", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+
+						_, appFilesIgnoreFile := appfiles.AppFilesInDirArgsForCall(0)
+						Expect(appFilesIgnoreFile).To(Equal(ignoreFile))
+
+						_, _, _, gatherIgnoreFile, _ := actor.GatherFilesArgsForCall(0)
+						Expect(gatherIgnoreFile).To(Equal(ignoreFile))
+					})
+
+					Context("when the ignore file does not exist", func() {
+						BeforeEach(func() {
+							Expect(os.Remove(ignoreFile)).To(Succeed())
+						})
+
+						It("returns an error", func() {
+							Expect(executeErr).To(HaveOccurred())
+							Expect(executeErr.Error()).To(ContainSubstring("Ignore file"))
+							Expect(actor.GatherFilesCallCount()).To(Equal(0))
+						})
+					})
+				})
+
 				Context("when an app path is specified with the -p flag", func() {
 					BeforeEach(func() {
 						args = []string{"-p", "../some/path-to/an-app/file.zip", "app-with-path"}
@@ -1084,7 +1123,7 @@ var _ = Describe("Push Command", func() {
 					It("pushes the contents of the app directory or zip file specified", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
-						_, appDir, _, _ := actor.GatherFilesArgsForCall(0)
+						_, appDir, _, _, _ := actor.GatherFilesArgsForCall(0)
 						Expect(appDir).To(Equal("../some/path-to/an-app/file.zip"))
 					})
 				})
@@ -1120,7 +1159,7 @@ var _ = Describe("Push Command", func() {
 						Expect(executeErr).NotTo(HaveOccurred())
 
 						dir, _ := os.Getwd()
-						_, appDir, _, _ := actor.GatherFilesArgsForCall(0)
+						_, appDir, _, _, _ := actor.GatherFilesArgsForCall(0)
 						Expect(appDir).To(Equal(dir))
 					})
 				})
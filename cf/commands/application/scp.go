@@ -0,0 +1,196 @@
+package application
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	sshCmd "code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/scp"
+	sshTerminal "code.cloudfoundry.org/cli/cf/ssh/terminal"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+// remotePathPrefix marks the SOURCE or TARGET arguments that are paths in the
+// application instance.
+const remotePathPrefix = ":"
+
+type SCP struct {
+	ui            terminal.UI
+	config        coreconfig.Reader
+	gateway       net.Gateway
+	appReq        requirements.ApplicationRequirement
+	sshCodeGetter commands.SSHCodeGetter
+	opts          *options.SSHOptions
+	scpOpts       scp.Options
+	sources       []string
+	target        string
+	download      bool
+	secureShell   sshCmd.SecureShell
+}
+
+func init() {
+	commandregistry.Register(&SCP{})
+}
+
+func (cmd *SCP) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["app-instance-index"] = &flags.IntFlag{Name: "app-instance-index", ShortName: "i", Usage: T("Application instance index")}
+	fs["recursive"] = &flags.BoolFlag{Name: "recursive", ShortName: "r", Usage: T("Recursively copy directories")}
+	fs["preserve-times"] = &flags.BoolFlag{Name: "preserve-times", ShortName: "p", Usage: T("Preserve modification and access times of the copied files")}
+	fs["skip-host-validation"] = &flags.BoolFlag{Name: "skip-host-validation", ShortName: "k", Usage: T("Skip host key validation")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "scp",
+		Description: T("Copy files to or from an application container instance"),
+		Usage: []string{
+			T("CF_NAME scp APP_NAME [-i app-instance-index] [-r] [-p] [--skip-host-validation] SOURCE... TARGET\n\n"),
+			T("   Paths in the application container instance start with ':'. Either all of the SOURCE paths or the TARGET path must be in the instance."),
+		},
+		Examples: []string{
+			"CF_NAME scp my-app ./config.yml :/home/vcap/app/",
+			"CF_NAME scp my-app -r :/home/vcap/app/logs ./logs",
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *SCP) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) < 3 {
+		cmd.ui.Failed(T("Incorrect Usage. Requires APP_NAME, SOURCE and TARGET as arguments") + "\n\n" + commandregistry.Commands.CommandUsage("scp"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 3)
+	}
+
+	if fc.IsSet("i") && fc.Int("i") < 0 {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", T("Value for flag 'app-instance-index' cannot be negative"), commandregistry.Commands.CommandUsage("scp")))
+		return nil, fmt.Errorf("Incorrect usage: app-instance-index cannot be negative")
+	}
+
+	err := cmd.parsePaths(fc.Args()[1:])
+	if err != nil {
+		cmd.ui.Failed(fmt.Sprintf(T("Incorrect Usage:")+" %s\n\n%s", err.Error(), commandregistry.Commands.CommandUsage("scp")))
+		return nil, err
+	}
+
+	cmd.opts = &options.SSHOptions{
+		AppName:            fc.Args()[0],
+		Index:              uint(fc.Int("i")),
+		SkipHostValidation: fc.Bool("k"),
+	}
+	cmd.scpOpts = scp.Options{
+		Recursive:     fc.Bool("r"),
+		PreserveTimes: fc.Bool("p"),
+	}
+
+	cmd.appReq = requirementsFactory.NewApplicationRequirement(cmd.opts.AppName)
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+		cmd.appReq,
+	}
+
+	return reqs, nil
+}
+
+// parsePaths splits the paths into sources and a target and works out the
+// direction of the copy. Uploads may have several local sources; downloads
+// have exactly one remote source.
+func (cmd *SCP) parsePaths(paths []string) error {
+	cmd.sources = paths[:len(paths)-1]
+	cmd.target = paths[len(paths)-1]
+
+	var remoteSources int
+	for _, source := range cmd.sources {
+		if strings.HasPrefix(source, remotePathPrefix) {
+			remoteSources++
+		}
+	}
+	remoteTarget := strings.HasPrefix(cmd.target, remotePathPrefix)
+
+	switch {
+	case remoteTarget && remoteSources == 0:
+		cmd.download = false
+		cmd.target = strings.TrimPrefix(cmd.target, remotePathPrefix)
+	case !remoteTarget && remoteSources == 1 && len(cmd.sources) == 1:
+		cmd.download = true
+		cmd.sources[0] = strings.TrimPrefix(cmd.sources[0], remotePathPrefix)
+	case !remoteTarget && remoteSources > 1:
+		return errors.New(T("Only one SOURCE can be copied from the application instance"))
+	default:
+		return errors.New(T("Either all of the SOURCE paths or the TARGET path must start with ':'"))
+	}
+
+	return nil
+}
+
+func (cmd *SCP) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.gateway = deps.Gateways["cloud-controller"]
+
+	if deps.WildcardDependency != nil {
+		cmd.secureShell = deps.WildcardDependency.(sshCmd.SecureShell)
+	}
+
+	//get ssh-code for dependency
+	sshCodeGetter := commandregistry.Commands.FindCommand("ssh-code")
+	sshCodeGetter = sshCodeGetter.SetDependency(deps, false)
+	cmd.sshCodeGetter = sshCodeGetter.(commands.SSHCodeGetter)
+
+	return cmd
+}
+
+func (cmd *SCP) Execute(fc flags.FlagContext) error {
+	app := cmd.appReq.GetApplication()
+	info, err := getSSHEndpointInfo(cmd.gateway, cmd.config)
+	if err != nil {
+		return errors.New(T("Error getting SSH info:") + err.Error())
+	}
+
+	sshAuthCode, err := cmd.sshCodeGetter.Get()
+	if err != nil {
+		return errors.New(T("Error getting one time auth code: ") + err.Error())
+	}
+
+	//init secureShell if it is not already set by SetDependency() with fakes
+	if cmd.secureShell == nil {
+		cmd.secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			sshTerminal.DefaultHelper(),
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			app,
+			info.SSHEndpointFingerprint,
+			info.SSHEndpoint,
+			sshAuthCode,
+		)
+	}
+
+	err = cmd.secureShell.Connect(cmd.opts)
+	if err != nil {
+		return errors.New(T("Error opening SSH connection: ") + err.Error())
+	}
+	defer cmd.secureShell.Close()
+
+	cmd.scpOpts.Progress = cmd.ui.Writer()
+	if cmd.download {
+		err = cmd.secureShell.Download(cmd.sources[0], cmd.target, cmd.scpOpts)
+	} else {
+		err = cmd.secureShell.Upload(cmd.sources, cmd.target, cmd.scpOpts)
+	}
+	if err != nil {
+		return errors.New(T("Error copying files: ") + err.Error())
+	}
+
+	return nil
+}
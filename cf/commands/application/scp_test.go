@@ -0,0 +1,221 @@
+package application_test
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands/commandsfakes"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/net"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/sshfakes"
+	testcmd "code.cloudfoundry.org/cli/util/testhelpers/commands"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testnet "code.cloudfoundry.org/cli/util/testhelpers/net"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	"code.cloudfoundry.org/cli/cf/trace/tracefakes"
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("scp command", func() {
+	var (
+		ui *testterm.FakeUI
+
+		sshCodeGetter         *commandsfakes.FakeSSHCodeGetter
+		originalSSHCodeGetter commandregistry.Command
+
+		requirementsFactory *requirementsfakes.FakeFactory
+		configRepo          coreconfig.Repository
+		deps                commandregistry.Dependency
+
+		fakeSecureShell *sshfakes.FakeSecureShell
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		requirementsFactory = new(requirementsfakes.FakeFactory)
+		requirementsFactory.NewLoginRequirementReturns(requirements.Passing{})
+		requirementsFactory.NewTargetedSpaceRequirementReturns(requirements.Passing{})
+		deps.Gateways = make(map[string]net.Gateway)
+
+		originalSSHCodeGetter = commandregistry.Commands.FindCommand("ssh-code")
+
+		sshCodeGetter = new(commandsfakes.FakeSSHCodeGetter)
+		sshCodeGetter.SetDependencyStub = func(_ commandregistry.Dependency, _ bool) commandregistry.Command {
+			return sshCodeGetter
+		}
+		sshCodeGetter.MetaDataReturns(commandregistry.CommandMetadata{Name: "ssh-code"})
+
+		fakeSecureShell = new(sshfakes.FakeSecureShell)
+		deps.WildcardDependency = fakeSecureShell
+	})
+
+	AfterEach(func() {
+		commandregistry.Register(originalSSHCodeGetter)
+	})
+
+	updateCommandDependency := func(pluginCall bool) {
+		deps.UI = ui
+		deps.Config = configRepo
+
+		commandregistry.Register(sshCodeGetter)
+
+		commandregistry.Commands.SetCommand(commandregistry.Commands.FindCommand("scp").SetDependency(deps, pluginCall))
+	}
+
+	runCommand := func(args ...string) bool {
+		return testcmd.RunCLICommand("scp", args, requirementsFactory, updateCommandDependency, false, ui)
+	}
+
+	Describe("Requirements", func() {
+		It("fails with usage when not provided a source and a target", func() {
+			Expect(runCommand("my-app", ":/remote")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Requires", "arguments"},
+			))
+		})
+
+		It("fails with usage when the instance index is negative", func() {
+			Expect(runCommand("my-app", "-i", "-3", "local", ":/remote")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "cannot be negative"},
+			))
+		})
+
+		It("fails with usage when neither the sources nor the target are remote", func() {
+			Expect(runCommand("my-app", "local-1", "local-2")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "must start with ':'"},
+				[]string{"USAGE:"},
+			))
+		})
+
+		It("fails with usage when both a source and the target are remote", func() {
+			Expect(runCommand("my-app", ":/remote-1", ":/remote-2")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "must start with ':'"},
+			))
+		})
+
+		It("fails with usage when downloading several remote sources", func() {
+			Expect(runCommand("my-app", ":/remote-1", ":/remote-2", "local")).To(BeFalse())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Incorrect Usage", "Only one SOURCE"},
+			))
+		})
+
+		It("fails requirements when not logged in", func() {
+			requirementsFactory.NewLoginRequirementReturns(requirements.Failing{Message: "not logged in"})
+			Expect(runCommand("my-app", "local", ":/remote")).To(BeFalse())
+		})
+
+		It("fails if the application is not found", func() {
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.ExecuteReturns(errors.New("no app"))
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			Expect(runCommand("my-app", "local", ":/remote")).To(BeFalse())
+		})
+	})
+
+	Describe("copying files", func() {
+		var testServer *httptest.Server
+
+		BeforeEach(func() {
+			currentApp := models.Application{}
+			currentApp.Name = "my-app"
+			currentApp.State = "started"
+			currentApp.GUID = "my-app-guid"
+			currentApp.EnableSSH = true
+			currentApp.Diego = true
+
+			applicationReq := new(requirementsfakes.FakeApplicationRequirement)
+			applicationReq.GetApplicationReturns(currentApp)
+			requirementsFactory.NewApplicationRequirementReturns(applicationReq)
+
+			getRequest := apifakes.NewCloudControllerTestRequest(testnet.TestRequest{
+				Method: "GET",
+				Path:   "/v2/info",
+				Response: testnet.TestResponse{
+					Status: http.StatusOK,
+					Body:   getInfoResponseBody,
+				},
+			})
+
+			testServer, _ = testnet.NewServer([]testnet.TestRequest{getRequest})
+			configRepo.SetAPIEndpoint(testServer.URL)
+			deps.Gateways["cloud-controller"] = net.NewCloudControllerGateway(configRepo, time.Now, &testterm.FakeUI{}, new(tracefakes.FakePrinter), "")
+		})
+
+		AfterEach(func() {
+			testServer.Close()
+		})
+
+		It("uploads the local sources to the remote target", func() {
+			Expect(runCommand("my-app", "-i", "2", "-k", "-r", "-p", "local-1", "local-2", ":/home/vcap/app")).To(BeTrue())
+
+			Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+			Expect(fakeSecureShell.ConnectArgsForCall(0)).To(Equal(&options.SSHOptions{
+				AppName:            "my-app",
+				Index:              2,
+				SkipHostValidation: true,
+			}))
+
+			Expect(fakeSecureShell.UploadCallCount()).To(Equal(1))
+			localPaths, remotePath, opts := fakeSecureShell.UploadArgsForCall(0)
+			Expect(localPaths).To(Equal([]string{"local-1", "local-2"}))
+			Expect(remotePath).To(Equal("/home/vcap/app"))
+			Expect(opts.Recursive).To(BeTrue())
+			Expect(opts.PreserveTimes).To(BeTrue())
+
+			Expect(fakeSecureShell.DownloadCallCount()).To(Equal(0))
+			Expect(fakeSecureShell.CloseCallCount()).To(Equal(1))
+		})
+
+		It("downloads the remote source to the local target", func() {
+			Expect(runCommand("my-app", ":/home/vcap/app/file", "local")).To(BeTrue())
+
+			Expect(fakeSecureShell.DownloadCallCount()).To(Equal(1))
+			remotePath, localPath, opts := fakeSecureShell.DownloadArgsForCall(0)
+			Expect(remotePath).To(Equal("/home/vcap/app/file"))
+			Expect(localPath).To(Equal("local"))
+			Expect(opts.Recursive).To(BeFalse())
+			Expect(opts.PreserveTimes).To(BeFalse())
+
+			Expect(fakeSecureShell.UploadCallCount()).To(Equal(0))
+		})
+
+		Context("when connecting fails", func() {
+			It("notifies the user", func() {
+				fakeSecureShell.ConnectReturns(errors.New("dial error"))
+
+				Expect(runCommand("my-app", "local", ":/remote")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error opening SSH connection", "dial error"},
+				))
+			})
+		})
+
+		Context("when copying fails", func() {
+			It("notifies the user", func() {
+				fakeSecureShell.UploadReturns(errors.New("no space left on device"))
+
+				Expect(runCommand("my-app", "local", ":/remote")).To(BeFalse())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Error copying files", "no space left on device"},
+				))
+			})
+		})
+	})
+})
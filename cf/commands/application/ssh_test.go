@@ -296,6 +296,47 @@ var _ = Describe("SSH command", func() {
 				})
 			})
 
+			Context("Error port forwarding when -R is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.RemotePortForwardReturns(errors.New("tcpip-forward request denied"))
+
+					runCommand("my-app", "-R", "8000:localhost:8000")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error forwarding remote port", "tcpip-forward request denied"},
+					))
+					Expect(fakeSecureShell.DynamicPortForwardCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("Error starting the SOCKS proxy when -D is provided", func() {
+				It("notifies users", func() {
+					fakeSecureShell.DynamicPortForwardReturns(errors.New("address in use"))
+
+					runCommand("my-app", "-D", "1080")
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"Error starting SOCKS proxy", "address in use"},
+					))
+				})
+			})
+
+			Context("when -R and -D are provided", func() {
+				It("sets up all forwards before waiting", func() {
+					runCommand("my-app", "-N", "-R", "8000:localhost:8000", "-D", "1080")
+
+					Expect(fakeSecureShell.ConnectCallCount()).To(Equal(1))
+					opts := fakeSecureShell.ConnectArgsForCall(0)
+					Expect(opts.RemoteForwardSpecs).To(HaveLen(1))
+					Expect(opts.DynamicForwardAddresses).To(Equal([]string{"localhost:1080"}))
+
+					Expect(fakeSecureShell.LocalPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.RemotePortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.DynamicPortForwardCallCount()).To(Equal(1))
+					Expect(fakeSecureShell.WaitCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when -N is provided", func() {
 				It("calls secureShell.Wait()", func() {
 					fakeSecureShell.ConnectReturns(nil)
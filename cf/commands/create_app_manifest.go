@@ -118,40 +118,46 @@ func (cmd *CreateAppManifest) Execute(c flags.FlagContext) error {
 }
 
 func (cmd *CreateAppManifest) createManifest(app models.Application) error {
-	cmd.manifest.Memory(app.Name, app.Memory)
-	cmd.manifest.Instances(app.Name, app.InstanceCount)
-	cmd.manifest.Stack(app.Name, app.Stack.Name)
+	return addApplicationToManifest(cmd.manifest, app)
+}
+
+// addApplicationToManifest adds the settings of the application to the
+// manifest.
+func addApplicationToManifest(generator manifest.App, app models.Application) error {
+	generator.Memory(app.Name, app.Memory)
+	generator.Instances(app.Name, app.InstanceCount)
+	generator.Stack(app.Name, app.Stack.Name)
 
 	if len(app.AppPorts) > 0 {
-		cmd.manifest.AppPorts(app.Name, app.AppPorts)
+		generator.AppPorts(app.Name, app.AppPorts)
 	}
 
 	if app.Command != "" {
-		cmd.manifest.StartCommand(app.Name, app.Command)
+		generator.StartCommand(app.Name, app.Command)
 	}
 
 	if app.BuildpackURL != "" {
-		cmd.manifest.BuildpackURL(app.Name, app.BuildpackURL)
+		generator.BuildpackURL(app.Name, app.BuildpackURL)
 	}
 
 	if len(app.Services) > 0 {
 		for _, service := range app.Services {
-			cmd.manifest.Service(app.Name, service.Name)
+			generator.Service(app.Name, service.Name)
 		}
 	}
 
 	if app.HealthCheckTimeout > 0 {
-		cmd.manifest.HealthCheckTimeout(app.Name, app.HealthCheckTimeout)
+		generator.HealthCheckTimeout(app.Name, app.HealthCheckTimeout)
 	}
 
 	if app.HealthCheckType != "port" {
-		cmd.manifest.HealthCheckType(app.Name, app.HealthCheckType)
+		generator.HealthCheckType(app.Name, app.HealthCheckType)
 	}
 
 	if app.HealthCheckType == "http" &&
 		app.HealthCheckHTTPEndpoint != "" &&
 		app.HealthCheckHTTPEndpoint != "/" {
-		cmd.manifest.HealthCheckHTTPEndpoint(app.Name, app.HealthCheckHTTPEndpoint)
+		generator.HealthCheckHTTPEndpoint(app.Name, app.HealthCheckHTTPEndpoint)
 	}
 
 	if len(app.EnvironmentVars) > 0 {
@@ -163,23 +169,23 @@ func (cmd *CreateAppManifest) createManifest(app models.Application) error {
 			case float64:
 				//json.Unmarshal turn all numbers to float64
 				value := int(app.EnvironmentVars[envVarKey].(float64))
-				cmd.manifest.EnvironmentVars(app.Name, envVarKey, fmt.Sprintf("%d", value))
+				generator.EnvironmentVars(app.Name, envVarKey, fmt.Sprintf("%d", value))
 			case bool:
-				cmd.manifest.EnvironmentVars(app.Name, envVarKey, fmt.Sprintf("%t", app.EnvironmentVars[envVarKey].(bool)))
+				generator.EnvironmentVars(app.Name, envVarKey, fmt.Sprintf("%t", app.EnvironmentVars[envVarKey].(bool)))
 			case string:
-				cmd.manifest.EnvironmentVars(app.Name, envVarKey, app.EnvironmentVars[envVarKey].(string))
+				generator.EnvironmentVars(app.Name, envVarKey, app.EnvironmentVars[envVarKey].(string))
 			}
 		}
 	}
 
 	if len(app.Routes) > 0 {
 		for i := 0; i < len(app.Routes); i++ {
-			cmd.manifest.Route(app.Name, app.Routes[i].Host, app.Routes[i].Domain.Name, app.Routes[i].Path, app.Routes[i].Port)
+			generator.Route(app.Name, app.Routes[i].Host, app.Routes[i].Domain.Name, app.Routes[i].Path, app.Routes[i].Port)
 		}
 	}
 
 	if app.DiskQuota != 0 {
-		cmd.manifest.DiskQuota(app.Name, app.DiskQuota)
+		generator.DiskQuota(app.Name, app.DiskQuota)
 	}
 
 	return nil
@@ -0,0 +1,162 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+
+	"code.cloudfoundry.org/cli/cf"
+	"code.cloudfoundry.org/cli/cf/flags"
+	. "code.cloudfoundry.org/cli/cf/i18n"
+
+	"code.cloudfoundry.org/cli/cf/api"
+	"code.cloudfoundry.org/cli/cf/api/stacks"
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/terminal"
+)
+
+type CreateSpaceManifest struct {
+	ui                  terminal.UI
+	config              coreconfig.Reader
+	appSummaryRepo      api.AppSummaryRepository
+	stackRepo           stacks.StackRepository
+	userProvidedService api.UserProvidedServiceInstanceRepository
+	manifest            manifest.App
+}
+
+func init() {
+	commandregistry.Register(&CreateSpaceManifest{})
+}
+
+func (cmd *CreateSpaceManifest) MetaData() commandregistry.CommandMetadata {
+	fs := make(map[string]flags.FlagSet)
+	fs["p"] = &flags.StringFlag{ShortName: "p", Usage: T("Specify a path for the manifest file. If path not specified, the manifest file is created in the current working directory.")}
+	fs["s"] = &flags.StringFlag{ShortName: "s", Usage: T("Specify a path for the user-provided services file. If path not specified, the services file is created in the current working directory.")}
+
+	return commandregistry.CommandMetadata{
+		Name:        "create-space-manifest",
+		Description: T("Create a manifest for every app in the targeted space, and a file of its user-provided services"),
+		Usage: []string{
+			T("CF_NAME create-space-manifest [-p /path/to/<space-name>_manifest.yml] [-s /path/to/<space-name>_user_provided_services.yml]"),
+		},
+		Flags: fs,
+	}
+}
+
+func (cmd *CreateSpaceManifest) Requirements(requirementsFactory requirements.Factory, fc flags.FlagContext) ([]requirements.Requirement, error) {
+	if len(fc.Args()) != 0 {
+		cmd.ui.Failed(T("Incorrect Usage. No argument required\n\n") + commandregistry.Commands.CommandUsage("create-space-manifest"))
+		return nil, fmt.Errorf("Incorrect usage: %d arguments of %d required", len(fc.Args()), 0)
+	}
+
+	reqs := []requirements.Requirement{
+		requirementsFactory.NewLoginRequirement(),
+		requirementsFactory.NewTargetedSpaceRequirement(),
+	}
+
+	return reqs, nil
+}
+
+func (cmd *CreateSpaceManifest) SetDependency(deps commandregistry.Dependency, pluginCall bool) commandregistry.Command {
+	cmd.ui = deps.UI
+	cmd.config = deps.Config
+	cmd.appSummaryRepo = deps.RepoLocator.GetAppSummaryRepository()
+	cmd.stackRepo = deps.RepoLocator.GetStackRepository()
+	cmd.userProvidedService = deps.RepoLocator.GetUserProvidedServiceInstanceRepository()
+	cmd.manifest = deps.AppManifest
+	return cmd
+}
+
+func (cmd *CreateSpaceManifest) Execute(c flags.FlagContext) error {
+	spaceName := cmd.config.SpaceFields().Name
+
+	cmd.ui.Say(T("Creating a manifest from current settings of apps in space ") + spaceName + " ...")
+	cmd.ui.Say("")
+
+	summaries, err := cmd.appSummaryRepo.GetSummariesInCurrentSpace()
+	if err != nil {
+		return errors.New(T("Error getting application summaries: ") + err.Error())
+	}
+	sort.Slice(summaries, func(i, j int) bool {
+		return summaries[i].Name < summaries[j].Name
+	})
+
+	stacksByGUID := map[string]models.Stack{}
+	for _, summary := range summaries {
+		application, err := cmd.appSummaryRepo.GetSummary(summary.GUID)
+		if err != nil {
+			return errors.New(T("Error getting application summary: ") + err.Error())
+		}
+
+		stack, ok := stacksByGUID[application.StackGUID]
+		if !ok {
+			stack, err = cmd.stackRepo.FindByGUID(application.StackGUID)
+			if err != nil {
+				return errors.New(T("Error retrieving stack: ") + err.Error())
+			}
+			stacksByGUID[application.StackGUID] = stack
+		}
+		application.Stack = &stack
+
+		err = addApplicationToManifest(cmd.manifest, application)
+		if err != nil {
+			return err
+		}
+	}
+
+	services, err := cmd.userProvidedService.ListForCurrentSpace()
+	if err != nil {
+		return errors.New(T("Error getting user-provided services: ") + err.Error())
+	}
+
+	manifestPath := "./" + spaceName + "_manifest.yml"
+	if c.String("p") != "" {
+		manifestPath = c.String("p")
+	}
+
+	servicesPath := "./" + spaceName + "_user_provided_services.yml"
+	if c.String("s") != "" {
+		servicesPath = c.String("s")
+	}
+
+	err = writeFile(manifestPath, 0644, cmd.manifest.Save)
+	if err != nil {
+		return errors.New(T("Error creating manifest file: ") + err.Error())
+	}
+
+	err = writeFile(servicesPath, 0600, func(f io.Writer) error {
+		return manifest.SaveUserProvidedServices(f, services)
+	})
+	if err != nil {
+		return errors.New(T("Error creating user-provided services file: ") + err.Error())
+	}
+
+	cmd.ui.Ok()
+	cmd.ui.Say(T("Manifest file created successfully at ") + manifestPath)
+	cmd.ui.Say(T("User-provided services file created successfully at ") + servicesPath)
+	cmd.ui.Say("")
+	cmd.ui.Say(T("TIP: To rebuild the space, recreate the services with ") +
+		terminal.CommandColor(cf.Name+" create-user-provided-service") +
+		T(" and then run ") +
+		terminal.CommandColor(cf.Name+" push -f "+manifestPath))
+	return nil
+}
+
+// writeFile creates the file at path with the given permissions and passes it
+// to save. The services file is only readable by the user, since it holds
+// service credentials.
+func writeFile(path string, perm os.FileMode, save func(f io.Writer) error) error {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, perm)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return save(f)
+}
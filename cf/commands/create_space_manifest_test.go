@@ -0,0 +1,253 @@
+package commands_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"code.cloudfoundry.org/cli/cf/commandregistry"
+	"code.cloudfoundry.org/cli/cf/commands"
+	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
+	"code.cloudfoundry.org/cli/cf/flags"
+	"code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/requirements"
+	"code.cloudfoundry.org/cli/cf/requirements/requirementsfakes"
+
+	"code.cloudfoundry.org/cli/cf/api/apifakes"
+	"code.cloudfoundry.org/cli/cf/api/stacks/stacksfakes"
+	testconfig "code.cloudfoundry.org/cli/util/testhelpers/configuration"
+	testterm "code.cloudfoundry.org/cli/util/testhelpers/terminal"
+
+	. "code.cloudfoundry.org/cli/util/testhelpers/matchers"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CreateSpaceManifest", func() {
+	var (
+		ui                      *testterm.FakeUI
+		configRepo              coreconfig.Repository
+		appSummaryRepo          *apifakes.FakeAppSummaryRepository
+		stackRepo               *stacksfakes.FakeStackRepository
+		userProvidedServiceRepo *apifakes.FakeUserProvidedServiceInstanceRepository
+
+		cmd         commandregistry.Command
+		deps        commandregistry.Dependency
+		factory     *requirementsfakes.FakeFactory
+		flagContext flags.FlagContext
+
+		loginRequirement         requirements.Requirement
+		targetedSpaceRequirement requirements.Requirement
+
+		tempDir      string
+		manifestPath string
+		servicesPath string
+	)
+
+	BeforeEach(func() {
+		ui = &testterm.FakeUI{}
+		configRepo = testconfig.NewRepositoryWithDefaults()
+		appSummaryRepo = new(apifakes.FakeAppSummaryRepository)
+		repoLocator := deps.RepoLocator.SetAppSummaryRepository(appSummaryRepo)
+		stackRepo = new(stacksfakes.FakeStackRepository)
+		repoLocator = repoLocator.SetStackRepository(stackRepo)
+		userProvidedServiceRepo = new(apifakes.FakeUserProvidedServiceInstanceRepository)
+		repoLocator = repoLocator.SetUserProvidedServiceInstanceRepository(userProvidedServiceRepo)
+
+		deps = commandregistry.Dependency{
+			UI:          ui,
+			Config:      configRepo,
+			RepoLocator: repoLocator,
+			AppManifest: manifest.NewGenerator(),
+		}
+
+		cmd = &commands.CreateSpaceManifest{}
+		cmd.SetDependency(deps, false)
+
+		flagContext = flags.NewFlagContext(cmd.MetaData().Flags)
+		factory = new(requirementsfakes.FakeFactory)
+
+		loginRequirement = &passingRequirement{Name: "login-requirement"}
+		factory.NewLoginRequirementReturns(loginRequirement)
+
+		targetedSpaceRequirement = &passingRequirement{Name: "targeted-space-requirement"}
+		factory.NewTargetedSpaceRequirementReturns(targetedSpaceRequirement)
+
+		var err error
+		tempDir, err = ioutil.TempDir("", "create-space-manifest")
+		Expect(err).NotTo(HaveOccurred())
+		manifestPath = filepath.Join(tempDir, "manifest.yml")
+		servicesPath = filepath.Join(tempDir, "services.yml")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tempDir)).To(Succeed())
+	})
+
+	Describe("Requirements", func() {
+		Context("when provided an argument", func() {
+			BeforeEach(func() {
+				flagContext.Parse("extra-arg")
+			})
+
+			It("fails with usage", func() {
+				_, err := cmd.Requirements(factory, flagContext)
+				Expect(err).To(HaveOccurred())
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"FAILED"},
+					[]string{"Incorrect Usage. No argument required"},
+				))
+			})
+		})
+
+		Context("when provided no arguments", func() {
+			It("returns a LoginRequirement and a TargetedSpaceRequirement", func() {
+				actualRequirements, err := cmd.Requirements(factory, flagContext)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(actualRequirements).To(ConsistOf(loginRequirement, targetedSpaceRequirement))
+			})
+		})
+	})
+
+	Describe("Execute", func() {
+		var runCLIErr error
+
+		BeforeEach(func() {
+			err := flagContext.Parse("-p", manifestPath, "-s", servicesPath)
+			Expect(err).NotTo(HaveOccurred())
+
+			summaries := []models.Application{{}, {}}
+			summaries[0].Name = "app-b"
+			summaries[0].GUID = "app-b-guid"
+			summaries[1].Name = "app-a"
+			summaries[1].GUID = "app-a-guid"
+			appSummaryRepo.GetSummariesInCurrentSpaceReturns(summaries, nil)
+
+			appSummaryRepo.GetSummaryStub = func(guid string) (models.Application, error) {
+				application := models.Application{}
+				application.GUID = guid
+				application.Name = guid[:len("app-a")]
+				application.Memory = 256
+				application.DiskQuota = 1024
+				application.InstanceCount = 2
+				application.StackGUID = "stack-guid"
+				application.HealthCheckType = "port"
+				application.EnvironmentVars = map[string]interface{}{"SOME_VAR": "some-value"}
+				application.Routes = []models.RouteSummary{{
+					Host:   guid[:len("app-a")],
+					Domain: models.DomainFields{Name: "example.com"},
+				}}
+				application.Services = []models.ServicePlanSummary{{Name: "some-db"}}
+				return application, nil
+			}
+
+			stackRepo.FindByGUIDReturns(models.Stack{GUID: "stack-guid", Name: "cflinuxfs2"}, nil)
+
+			userProvidedServiceRepo.ListForCurrentSpaceReturns([]models.UserProvidedService{{
+				Name:           "some-ups",
+				Credentials:    map[string]interface{}{"username": "admin"},
+				SysLogDrainURL: "syslog://example.com",
+			}}, nil)
+		})
+
+		JustBeforeEach(func() {
+			runCLIErr = cmd.Execute(flagContext)
+		})
+
+		It("writes every app in the space to the manifest, sorted by name", func() {
+			Expect(runCLIErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(manifestPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(`applications:
+- name: app-a
+  instances: 2
+  memory: 256M
+  disk_quota: 1024M
+  routes:
+  - route: app-a.example.com
+  env:
+    SOME_VAR: some-value
+  services:
+  - some-db
+  stack: cflinuxfs2
+- name: app-b
+  instances: 2
+  memory: 256M
+  disk_quota: 1024M
+  routes:
+  - route: app-b.example.com
+  env:
+    SOME_VAR: some-value
+  services:
+  - some-db
+  stack: cflinuxfs2
+`))
+		})
+
+		It("looks up each stack once", func() {
+			Expect(runCLIErr).NotTo(HaveOccurred())
+			Expect(stackRepo.FindByGUIDCallCount()).To(Equal(1))
+		})
+
+		It("writes the user-provided services to the services file", func() {
+			Expect(runCLIErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(servicesPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal(`user-provided-services:
+- name: some-ups
+  credentials:
+    username: admin
+  syslog_drain_url: syslog://example.com
+`))
+		})
+
+		It("makes the services file readable only by the user", func() {
+			if runtime.GOOS == "windows" {
+				Skip("file permissions are not supported on Windows")
+			}
+			Expect(runCLIErr).NotTo(HaveOccurred())
+
+			info, err := os.Stat(servicesPath)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+		})
+
+		It("says OK and where the files were created", func() {
+			Expect(runCLIErr).NotTo(HaveOccurred())
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Creating a manifest from current settings of apps in space my-space ..."},
+				[]string{"OK"},
+				[]string{"Manifest file created successfully at " + manifestPath},
+				[]string{"User-provided services file created successfully at " + servicesPath},
+				[]string{"TIP:", "create-user-provided-service", "push -f " + manifestPath},
+			))
+		})
+
+		Context("when getting the app summaries fails", func() {
+			BeforeEach(func() {
+				appSummaryRepo.GetSummariesInCurrentSpaceReturns(nil, errors.New("get-summaries-err"))
+			})
+
+			It("returns an error", func() {
+				Expect(runCLIErr).To(MatchError("Error getting application summaries: get-summaries-err"))
+			})
+		})
+
+		Context("when getting the user-provided services fails", func() {
+			BeforeEach(func() {
+				userProvidedServiceRepo.ListForCurrentSpaceReturns(nil, errors.New("list-err"))
+			})
+
+			It("returns an error and does not write the manifest", func() {
+				Expect(runCLIErr).To(MatchError("Error getting user-provided services: list-err"))
+				_, err := os.Stat(manifestPath)
+				Expect(os.IsNotExist(err)).To(BeTrue())
+			})
+		})
+	})
+})
@@ -16,6 +16,12 @@ import (
 
 const orgLimit = 0
 
+// organizationData is the structured output of the orgs command.
+type organizationData struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
 type ListOrgs struct {
 	ui              terminal.UI
 	config          coreconfig.Reader
@@ -36,6 +42,7 @@ func (cmd *ListOrgs) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME orgs",
 		},
+		StructuredOutput: true,
 	}
 }
 
@@ -75,6 +82,15 @@ func (cmd ListOrgs) Execute(fc flags.FlagContext) error {
 	if err != nil {
 		return err
 	}
+
+	if cmd.ui.StructuredOutputEnabled() {
+		data := []organizationData{}
+		for _, org := range orgs {
+			data = append(data, organizationData{Name: org.Name, GUID: org.GUID})
+		}
+		return cmd.ui.DisplayStructuredData("organizations", data)
+	}
+
 	for _, org := range orgs {
 		table.Add(org.Name)
 		noOrgs = false
@@ -144,6 +144,28 @@ var _ = Describe("orgs command", func() {
 				[]string{"Organization-3"},
 			))
 		})
+
+		Context("when the --output flag is json", func() {
+			BeforeEach(func() {
+				org1 := models.Organization{}
+				org1.Name = "Organization-1"
+				org1.GUID = "org-1-guid"
+
+				orgRepo.ListOrgsReturns([]models.Organization{org1}, nil)
+			})
+
+			It("displays the orgs as a JSON document instead of a table", func() {
+				runCommand("--output", "json")
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Getting orgs as my-user"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"Organization-1"}))
+				Expect(ui.StructuredOutput).To(MatchJSON(`{
+					"schema_version": 1,
+					"kind": "organizations",
+					"data": [{"name": "Organization-1", "guid": "org-1-guid"}]
+				}`))
+			})
+		})
 	})
 
 	It("tells the user when no orgs were found", func() {
@@ -16,6 +16,19 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+// routeData is the structured output of the routes command.
+type routeData struct {
+	GUID    string   `json:"guid"`
+	Space   string   `json:"space"`
+	Host    string   `json:"host"`
+	Domain  string   `json:"domain"`
+	Port    int      `json:"port,omitempty"`
+	Path    string   `json:"path"`
+	Type    string   `json:"type"`
+	Apps    []string `json:"apps"`
+	Service string   `json:"service"`
+}
+
 type ListRoutes struct {
 	ui         terminal.UI
 	routeRepo  api.RouteRepository
@@ -38,7 +51,8 @@ func (cmd *ListRoutes) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME routes [--orglevel]",
 		},
-		Flags: fs,
+		Flags:            fs,
+		StructuredOutput: true,
 	}
 }
 
@@ -102,6 +116,7 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 	}
 
 	var routesFound bool
+	data := []routeData{}
 	cb := func(route models.Route) bool {
 		routesFound = true
 		appNames := []string{}
@@ -126,6 +141,17 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 			strings.Join(appNames, ","),
 			route.ServiceInstance.Name,
 		)
+		data = append(data, routeData{
+			GUID:    route.GUID,
+			Space:   route.Space.Name,
+			Host:    route.Host,
+			Domain:  route.Domain.Name,
+			Port:    route.Port,
+			Path:    route.Path,
+			Type:    domain.RouterGroupType,
+			Apps:    appNames,
+			Service: route.ServiceInstance.Name,
+		})
 		return true
 	}
 
@@ -138,6 +164,10 @@ func (cmd *ListRoutes) Execute(c flags.FlagContext) error {
 		return errors.New(T("Failed fetching routes.\n{{.Err}}", map[string]interface{}{"Err": err.Error()}))
 	}
 
+	if cmd.ui.StructuredOutputEnabled() {
+		return cmd.ui.DisplayStructuredData("routes", data)
+	}
+
 	err = table.Print()
 	if err != nil {
 		return err
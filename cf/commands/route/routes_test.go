@@ -106,6 +106,7 @@ var _ = Describe("routes command", func() {
 				app2 := models.ApplicationFields{Name: "bora"}
 
 				route := models.Route{
+					GUID: "route-1-guid",
 					Space: models.SpaceFields{
 						Name: "my-space",
 					},
@@ -119,6 +120,7 @@ var _ = Describe("routes command", func() {
 				}
 
 				route2 := models.Route{
+					GUID: "route-2-guid",
 					Space: models.SpaceFields{
 						Name: "my-space",
 					},
@@ -129,6 +131,7 @@ var _ = Describe("routes command", func() {
 				}
 
 				route3 := models.Route{
+					GUID: "route-3-guid",
 					Space: models.SpaceFields{
 						Name: "my-space",
 					},
@@ -161,6 +164,54 @@ var _ = Describe("routes command", func() {
 			Expect(terminal.Decolorize(ui.Outputs()[5])).To(MatchRegexp(`^my-space\s+cookieclicker\.co\s+9090\s+tcp\s+dora,bora\s*$`))
 
 		})
+
+		Context("when the --output flag is json", func() {
+			It("displays the routes as a JSON document instead of a table", func() {
+				runCommand("--output", "json")
+
+				Expect(ui.Outputs()).To(ContainSubstrings(
+					[]string{"Getting routes for org my-org / space my-space as my-user ..."},
+				))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"hostname-1"}))
+				Expect(ui.StructuredOutput).To(MatchJSON(`{
+					"schema_version": 1,
+					"kind": "routes",
+					"data": [
+						{
+							"guid": "route-1-guid",
+							"space": "my-space",
+							"host": "hostname-1",
+							"domain": "example.com",
+							"path": "",
+							"type": "",
+							"apps": ["dora"],
+							"service": "test-service"
+						},
+						{
+							"guid": "route-2-guid",
+							"space": "my-space",
+							"host": "hostname-2",
+							"domain": "cookieclicker.co",
+							"path": "/foo",
+							"type": "",
+							"apps": ["dora", "bora"],
+							"service": ""
+						},
+						{
+							"guid": "route-3-guid",
+							"space": "my-space",
+							"host": "",
+							"domain": "cookieclicker.co",
+							"port": 9090,
+							"path": "",
+							"type": "tcp",
+							"apps": ["dora", "bora"],
+							"service": ""
+						}
+					]
+				}`))
+			})
+		})
 	})
 
 	Context("when there are routes in different spaces", func() {
@@ -209,6 +260,35 @@ var _ = Describe("routes command", func() {
 				[]string{"space-2", "hostname-2", "cookieclicker.co", "dora", "bora"},
 			))
 		})
+
+		Context("when the --output flag is yaml", func() {
+			It("displays the routes at orglevel as a YAML document", func() {
+				runCommand("--orglevel", "--output=yaml")
+
+				Expect(ui.StructuredOutput).To(Equal(`schema_version: 1
+kind: routes
+data:
+- guid: ""
+  space: space-1
+  host: hostname-1
+  domain: example.com
+  path: ""
+  type: ""
+  apps:
+  - dora
+  service: test-service
+- guid: ""
+  space: space-2
+  host: hostname-2
+  domain: cookieclicker.co
+  path: /foo
+  type: ""
+  apps:
+  - dora
+  - bora
+  service: ""`))
+			})
+		})
 	})
 
 	Context("when there are not routes", func() {
@@ -220,6 +300,15 @@ var _ = Describe("routes command", func() {
 				[]string{"No routes found"},
 			))
 		})
+
+		Context("when the --output flag is json", func() {
+			It("displays an empty list", func() {
+				runCommand("--output", "json")
+
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"No routes found"}))
+				Expect(ui.StructuredOutput).To(MatchJSON(`{"schema_version": 1, "kind": "routes", "data": []}`))
+			})
+		})
 	})
 
 	Context("when there is an error listing routes", func() {
@@ -14,6 +14,18 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+// securityGroupData is the structured output of the security-groups command.
+type securityGroupData struct {
+	Name   string                   `json:"name"`
+	GUID   string                   `json:"guid"`
+	Spaces []securityGroupSpaceData `json:"spaces"`
+}
+
+type securityGroupSpaceData struct {
+	Org   string `json:"org"`
+	Space string `json:"space"`
+}
+
 type SecurityGroups struct {
 	ui                terminal.UI
 	securityGroupRepo securitygroups.SecurityGroupRepo
@@ -31,6 +43,7 @@ func (cmd *SecurityGroups) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME security-groups",
 		},
+		StructuredOutput: true,
 	}
 }
 
@@ -70,6 +83,10 @@ func (cmd *SecurityGroups) Execute(c flags.FlagContext) error {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.ui.StructuredOutputEnabled() {
+		return cmd.displayStructuredData(securityGroups)
+	}
+
 	if len(securityGroups) == 0 {
 		cmd.ui.Say(T("No security groups"))
 		return nil
@@ -91,6 +108,27 @@ func (cmd *SecurityGroups) Execute(c flags.FlagContext) error {
 	return nil
 }
 
+func (cmd *SecurityGroups) displayStructuredData(securityGroups []models.SecurityGroup) error {
+	data := []securityGroupData{}
+	for _, securityGroup := range securityGroups {
+		spaces := []securityGroupSpaceData{}
+		for _, space := range securityGroup.Spaces {
+			spaces = append(spaces, securityGroupSpaceData{
+				Org:   space.Organization.Name,
+				Space: space.Name,
+			})
+		}
+
+		data = append(data, securityGroupData{
+			Name:   securityGroup.Name,
+			GUID:   securityGroup.GUID,
+			Spaces: spaces,
+		})
+	}
+
+	return cmd.ui.DisplayStructuredData("security_groups", data)
+}
+
 type table interface {
 	Add(row ...string)
 	Print() error
@@ -151,6 +151,32 @@ var _ = Describe("list-security-groups command", func() {
 						[]string{"#0", "my-group", "org-2", "space-2"},
 					))
 				})
+
+				Context("when the --output flag is json", func() {
+					It("displays the security groups and their spaces as a JSON document", func() {
+						runCommand("--output", "json")
+
+						Expect(ui.Outputs()).To(ContainSubstrings(
+							[]string{"Getting", "security group", "my-user"},
+							[]string{"OK"},
+						))
+						Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"my-group"}))
+						Expect(ui.StructuredOutput).To(MatchJSON(`{
+							"schema_version": 1,
+							"kind": "security_groups",
+							"data": [
+								{
+									"name": "my-group",
+									"guid": "group-guid",
+									"spaces": [
+										{"org": "org-1", "space": "space-1"},
+										{"org": "org-2", "space": "space-2"}
+									]
+								}
+							]
+						}`))
+					})
+				})
 			})
 
 			Describe("Where there are no spaces assigned", func() {
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/cf/commandregistry"
 	"code.cloudfoundry.org/cli/cf/flags"
 	. "code.cloudfoundry.org/cli/cf/i18n"
+	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/plugin/models"
 
 	"code.cloudfoundry.org/cli/cf/api"
@@ -14,6 +15,22 @@ import (
 	"code.cloudfoundry.org/cli/cf/terminal"
 )
 
+// serviceInstanceData is the structured output of the services command.
+type serviceInstanceData struct {
+	Name          string             `json:"name"`
+	GUID          string             `json:"guid"`
+	Service       string             `json:"service"`
+	Plan          string             `json:"plan"`
+	UserProvided  bool               `json:"user_provided"`
+	BoundApps     []string           `json:"bound_apps"`
+	LastOperation *lastOperationData `json:"last_operation"`
+}
+
+type lastOperationData struct {
+	Type  string `json:"type"`
+	State string `json:"state"`
+}
+
 type ListServices struct {
 	ui                 terminal.UI
 	config             coreconfig.Reader
@@ -34,6 +51,7 @@ func (cmd *ListServices) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			"CF_NAME services",
 		},
+		StructuredOutput: true,
 	}
 }
 
@@ -80,6 +98,10 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) error {
 	cmd.ui.Ok()
 	cmd.ui.Say("")
 
+	if cmd.ui.StructuredOutputEnabled() {
+		return cmd.displayStructuredData(serviceInstances)
+	}
+
 	if len(serviceInstances) == 0 {
 		cmd.ui.Say(T("No services found"))
 		return nil
@@ -135,3 +157,31 @@ func (cmd *ListServices) Execute(fc flags.FlagContext) error {
 	}
 	return nil
 }
+
+func (cmd *ListServices) displayStructuredData(serviceInstances []models.ServiceInstance) error {
+	data := []serviceInstanceData{}
+	for _, instance := range serviceInstances {
+		boundApps := instance.ApplicationNames
+		if boundApps == nil {
+			boundApps = []string{}
+		}
+
+		instanceData := serviceInstanceData{
+			Name:         instance.Name,
+			GUID:         instance.GUID,
+			Service:      instance.ServiceOffering.Label,
+			Plan:         instance.ServicePlan.Name,
+			UserProvided: instance.IsUserProvided(),
+			BoundApps:    boundApps,
+		}
+		if instance.LastOperation.Type != "" {
+			instanceData.LastOperation = &lastOperationData{
+				Type:  instance.LastOperation.Type,
+				State: instance.LastOperation.State,
+			}
+		}
+		data = append(data, instanceData)
+	}
+
+	return cmd.ui.DisplayStructuredData("service_instances", data)
+}
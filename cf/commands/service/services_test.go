@@ -169,6 +169,66 @@ var _ = Describe("services", func() {
 		))
 	})
 
+	Context("when the --output flag is json", func() {
+		It("displays the service instances as a JSON document instead of a table", func() {
+			serviceInstance := models.ServiceInstance{}
+			serviceInstance.Name = "my-service-1"
+			serviceInstance.GUID = "my-service-1-guid"
+			serviceInstance.LastOperation.Type = "create"
+			serviceInstance.LastOperation.State = "in progress"
+			serviceInstance.ServicePlan = models.ServicePlanFields{GUID: "spark-guid", Name: "spark"}
+			serviceInstance.ApplicationNames = []string{"cli1", "cli2"}
+			serviceInstance.ServiceOffering = models.ServiceOfferingFields{Label: "cleardb"}
+
+			userProvidedServiceInstance := models.ServiceInstance{}
+			userProvidedServiceInstance.Name = "my-service-provided-by-user"
+			userProvidedServiceInstance.GUID = "my-service-provided-by-user-guid"
+
+			serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = []models.ServiceInstance{serviceInstance, userProvidedServiceInstance}
+
+			runCommand("--output", "json")
+
+			Expect(ui.Outputs()).To(ContainSubstrings(
+				[]string{"Getting services in org", "my-org", "my-space", "my-user"},
+				[]string{"OK"},
+			))
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"my-service-1"}))
+			Expect(ui.StructuredOutput).To(MatchJSON(`{
+				"schema_version": 1,
+				"kind": "service_instances",
+				"data": [
+					{
+						"name": "my-service-1",
+						"guid": "my-service-1-guid",
+						"service": "cleardb",
+						"plan": "spark",
+						"user_provided": false,
+						"bound_apps": ["cli1", "cli2"],
+						"last_operation": {"type": "create", "state": "in progress"}
+					},
+					{
+						"name": "my-service-provided-by-user",
+						"guid": "my-service-provided-by-user-guid",
+						"service": "",
+						"plan": "",
+						"user_provided": true,
+						"bound_apps": [],
+						"last_operation": null
+					}
+				]
+			}`))
+		})
+
+		It("displays an empty list when no services are found", func() {
+			serviceSummaryRepo.GetSummariesInCurrentSpaceInstances = []models.ServiceInstance{}
+
+			runCommand("--output", "json")
+
+			Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"No services found"}))
+			Expect(ui.StructuredOutput).To(MatchJSON(`{"schema_version": 1, "kind": "service_instances", "data": []}`))
+		})
+	})
+
 	Describe("when invoked by a plugin", func() {
 
 		var (
@@ -14,6 +14,12 @@ import (
 	"code.cloudfoundry.org/cli/plugin/models"
 )
 
+// spaceData is the structured output of the spaces command.
+type spaceData struct {
+	Name string `json:"name"`
+	GUID string `json:"guid"`
+}
+
 type ListSpaces struct {
 	ui        terminal.UI
 	config    coreconfig.Reader
@@ -34,6 +40,7 @@ func (cmd *ListSpaces) MetaData() commandregistry.CommandMetadata {
 		Usage: []string{
 			T("CF_NAME spaces"),
 		},
+		StructuredOutput: true,
 	}
 
 }
@@ -73,8 +80,10 @@ func (cmd *ListSpaces) Execute(c flags.FlagContext) error {
 
 	foundSpaces := false
 	table := cmd.ui.Table([]string{T("name")})
+	data := []spaceData{}
 	err := cmd.spaceRepo.ListSpaces(func(space models.Space) bool {
 		table.Add(space.Name)
+		data = append(data, spaceData{Name: space.Name, GUID: space.GUID})
 		foundSpaces = true
 
 		if cmd.pluginCall {
@@ -86,6 +95,17 @@ func (cmd *ListSpaces) Execute(c flags.FlagContext) error {
 
 		return true
 	})
+
+	if cmd.ui.StructuredOutputEnabled() {
+		if err != nil {
+			return errors.New(T("Failed fetching spaces.\n{{.ErrorDescription}}",
+				map[string]interface{}{
+					"ErrorDescription": err.Error(),
+				}))
+		}
+		return cmd.ui.DisplayStructuredData("spaces", data)
+	}
+
 	err = table.Print()
 	if err != nil {
 		return err
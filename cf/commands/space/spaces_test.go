@@ -173,5 +173,43 @@ var _ = Describe("spaces command", func() {
 				))
 			})
 		})
+
+		Context("when the --output flag is json", func() {
+			BeforeEach(func() {
+				space := models.Space{}
+				space.Name = "space1"
+				space.GUID = "space-1-guid"
+				spaceRepo.ListSpacesStub = listSpacesStub([]models.Space{space})
+			})
+
+			It("displays the spaces as a JSON document instead of a table", func() {
+				runCommand("--output", "json")
+
+				Expect(ui.Outputs()).To(ContainSubstrings([]string{"Getting spaces in org", "my-org", "my-user"}))
+				Expect(ui.Outputs()).NotTo(ContainSubstrings([]string{"space1"}))
+				Expect(ui.StructuredOutput).To(MatchJSON(`{
+					"schema_version": 1,
+					"kind": "spaces",
+					"data": [{"name": "space1", "guid": "space-1-guid"}]
+				}`))
+			})
+
+			Context("when listing the spaces fails", func() {
+				BeforeEach(func() {
+					spaceRepo.ListSpacesReturns(errors.New("some-error"))
+				})
+
+				It("fails without displaying the document", func() {
+					Expect(runCommand("--output", "json")).To(BeFalse())
+
+					Expect(ui.Outputs()).To(ContainSubstrings(
+						[]string{"FAILED"},
+						[]string{"Failed fetching spaces."},
+						[]string{"some-error"},
+					))
+					Expect(ui.StructuredOutput).To(BeEmpty())
+				})
+			})
+		})
 	})
 })
@@ -29,6 +29,7 @@ type Data struct {
 	AccessToken              string
 	UAAOAuthClient           string
 	UAAOAuthClientSecret     string
+	UAAGrantType             string
 	SSHOAuthClient           string
 	RefreshToken             string
 	OrganizationFields       models.OrganizationFields
@@ -41,6 +42,11 @@ type Data struct {
 	PluginRepos              []models.PluginRepo
 	MinCLIVersion            string
 	MinRecommendedCLIVersion string
+
+	// CurrentContext and Contexts are managed by the contexts commands and
+	// are kept as they are.
+	CurrentContext string          `json:",omitempty"`
+	Contexts       json.RawMessage `json:",omitempty"`
 }
 
 func NewData() *Data {
@@ -21,6 +21,7 @@ var _ = Describe("V3 Config files", func() {
 		"AccessToken": "the-access-token",
 		"UAAOAuthClient": "cf-oauth-client-id",
 		"UAAOAuthClientSecret": "cf-oauth-client-secret",
+		"UAAGrantType": "client_credentials",
 		"SSHOAuthClient": "ssh-oauth-client-id",
 		"RefreshToken": "the-refresh-token",
 		"OrganizationFields": {
@@ -125,6 +126,7 @@ var _ = Describe("V3 Config files", func() {
 				RefreshToken:             "the-refresh-token",
 				UAAOAuthClient:           "cf-oauth-client-id",
 				UAAOAuthClientSecret:     "cf-oauth-client-secret",
+				UAAGrantType:             "client_credentials",
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
@@ -156,6 +158,27 @@ var _ = Describe("V3 Config files", func() {
 		})
 	})
 
+	Describe("saved contexts", func() {
+		It("keeps the saved contexts when the config is rewritten", func() {
+			data := coreconfig.NewData()
+			err := data.JSONUnmarshalV3([]byte(`{
+				"ConfigVersion": 3,
+				"CurrentContext": "prod",
+				"Contexts": {"prod": {"Target": "https://api.prod.com"}}
+			}`))
+			Expect(err).NotTo(HaveOccurred())
+
+			jsonData, err := data.JSONMarshalV3()
+			Expect(err).NotTo(HaveOccurred())
+
+			rewritten := coreconfig.NewData()
+			err = rewritten.JSONUnmarshalV3(jsonData)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(rewritten.CurrentContext).To(Equal("prod"))
+			Expect(rewritten.Contexts).To(MatchJSON(`{"prod": {"Target": "https://api.prod.com"}}`))
+		})
+	})
+
 	Describe("JSONUnmarshalV3", func() {
 		It("returns an error when the JSON is invalid", func() {
 			configData := coreconfig.NewData()
@@ -176,6 +199,7 @@ var _ = Describe("V3 Config files", func() {
 				RefreshToken:             "the-refresh-token",
 				UAAOAuthClient:           "cf-oauth-client-id",
 				UAAOAuthClientSecret:     "cf-oauth-client-secret",
+				UAAGrantType:             "client_credentials",
 				SSHOAuthClient:           "ssh-oauth-client-id",
 				MinCLIVersion:            "6.0.0",
 				MinRecommendedCLIVersion: "6.9.0",
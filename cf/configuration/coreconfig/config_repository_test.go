@@ -120,6 +120,47 @@ var _ = Describe("Configuration Repository", func() {
 		Expect(config.MinRecommendedCLIVersion()).To(Equal("6.9.0"))
 	})
 
+	Describe("ClearSession", func() {
+		BeforeEach(func() {
+			config.SetAccessToken("the-token")
+			config.SetRefreshToken("the-refresh-token")
+			config.SetOrganizationFields(models.OrganizationFields{Name: "the-org"})
+			config.SetSpaceFields(models.SpaceFields{Name: "the-space"})
+			config.SetUAAOAuthClient("some-client")
+			config.SetUAAOAuthClientSecret("some-secret")
+		})
+
+		It("clears the tokens and target", func() {
+			config.ClearSession()
+
+			Expect(config.AccessToken()).To(BeEmpty())
+			Expect(config.RefreshToken()).To(BeEmpty())
+			Expect(config.OrganizationFields()).To(Equal(models.OrganizationFields{}))
+			Expect(config.SpaceFields()).To(Equal(models.SpaceFields{}))
+			Expect(config.UAAOAuthClient()).To(Equal("some-client"))
+			Expect(config.UAAOAuthClientSecret()).To(Equal("some-secret"))
+		})
+
+		Context("when logged in with client credentials", func() {
+			BeforeEach(func() {
+				persistor.LoadStub = func(data configuration.DataInterface) error {
+					data.(*coreconfig.Data).UAAGrantType = "client_credentials"
+					return nil
+				}
+				config = coreconfig.NewRepositoryFromPersistor(persistor, func(err error) { panic(err) })
+				config.SetUAAOAuthClient("some-client")
+				config.SetUAAOAuthClientSecret("some-secret")
+			})
+
+			It("resets the UAA client credentials", func() {
+				config.ClearSession()
+
+				Expect(config.UAAOAuthClient()).To(Equal("cf"))
+				Expect(config.UAAOAuthClientSecret()).To(BeEmpty())
+			})
+		})
+	})
+
 	Describe("HasAPIEndpoint", func() {
 		Context("when both endpoint and version are set", func() {
 			BeforeEach(func() {
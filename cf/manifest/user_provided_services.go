@@ -0,0 +1,48 @@
+package manifest
+
+import (
+	"io"
+	"sort"
+
+	"code.cloudfoundry.org/cli/cf/models"
+
+	"gopkg.in/yaml.v2"
+)
+
+type UserProvidedService struct {
+	Name            string                 `yaml:"name"`
+	Credentials     map[string]interface{} `yaml:"credentials,omitempty"`
+	SyslogDrainURL  string                 `yaml:"syslog_drain_url,omitempty"`
+	RouteServiceURL string                 `yaml:"route_service_url,omitempty"`
+}
+
+type UserProvidedServices struct {
+	UserProvidedServices []UserProvidedService `yaml:"user-provided-services"`
+}
+
+// SaveUserProvidedServices writes the definitions of the services, sorted by
+// name, so that they can be recreated with create-user-provided-service.
+func SaveUserProvidedServices(f io.Writer, services []models.UserProvidedService) error {
+	definitions := UserProvidedServices{
+		UserProvidedServices: []UserProvidedService{},
+	}
+	for _, service := range services {
+		definitions.UserProvidedServices = append(definitions.UserProvidedServices, UserProvidedService{
+			Name:            service.Name,
+			Credentials:     service.Credentials,
+			SyslogDrainURL:  service.SysLogDrainURL,
+			RouteServiceURL: service.RouteServiceURL,
+		})
+	}
+	sort.Slice(definitions.UserProvidedServices, func(i, j int) bool {
+		return definitions.UserProvidedServices[i].Name < definitions.UserProvidedServices[j].Name
+	})
+
+	contents, err := yaml.Marshal(definitions)
+	if err != nil {
+		return err
+	}
+
+	_, err = f.Write(contents)
+	return err
+}
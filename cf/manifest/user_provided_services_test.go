@@ -0,0 +1,50 @@
+package manifest_test
+
+import (
+	"bytes"
+
+	. "code.cloudfoundry.org/cli/cf/manifest"
+	"code.cloudfoundry.org/cli/cf/models"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"gopkg.in/yaml.v2"
+)
+
+var _ = Describe("SaveUserProvidedServices", func() {
+	It("writes the services sorted by name", func() {
+		f := &bytes.Buffer{}
+		err := SaveUserProvidedServices(f, []models.UserProvidedService{
+			{
+				Name:            "route-service",
+				Credentials:     map[string]interface{}{},
+				RouteServiceURL: "https://example.com",
+			},
+			{
+				Name:           "database",
+				Credentials:    map[string]interface{}{"username": "admin", "port": float64(5432)},
+				SysLogDrainURL: "syslog://example.com",
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		var services UserProvidedServices
+		Expect(yaml.Unmarshal(f.Bytes(), &services)).To(Succeed())
+		Expect(services.UserProvidedServices).To(HaveLen(2))
+
+		Expect(services.UserProvidedServices[0].Name).To(Equal("database"))
+		Expect(services.UserProvidedServices[0].Credentials).To(HaveKeyWithValue("username", "admin"))
+		Expect(services.UserProvidedServices[0].Credentials).To(HaveKeyWithValue("port", 5432))
+		Expect(services.UserProvidedServices[0].SyslogDrainURL).To(Equal("syslog://example.com"))
+
+		Expect(services.UserProvidedServices[1].Name).To(Equal("route-service"))
+		Expect(services.UserProvidedServices[1].Credentials).To(BeEmpty())
+		Expect(services.UserProvidedServices[1].RouteServiceURL).To(Equal("https://example.com"))
+	})
+
+	It("writes an empty list when there are no services", func() {
+		f := &bytes.Buffer{}
+		Expect(SaveUserProvidedServices(f, nil)).To(Succeed())
+		Expect(f.String()).To(Equal("user-provided-services: []\n"))
+	})
+})
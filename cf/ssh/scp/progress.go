@@ -0,0 +1,47 @@
+package scp
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// progressWriter displays how much of a file has been copied as the file's
+// contents are written to it.
+type progressWriter struct {
+	out     io.Writer
+	name    string
+	size    int64
+	written int64
+}
+
+func newProgressWriter(out io.Writer, name string, size int64) *progressWriter {
+	return &progressWriter{out: out, name: name, size: size}
+}
+
+func (p *progressWriter) Write(data []byte) (int, error) {
+	p.written += int64(len(data))
+	p.display()
+	return len(data), nil
+}
+
+// Done ends the progress line of the file.
+func (p *progressWriter) Done() {
+	if p.out == nil {
+		return
+	}
+
+	if p.size == 0 {
+		p.display()
+	}
+	fmt.Fprintln(p.out)
+}
+
+func (p *progressWriter) display() {
+	if p.out == nil {
+		return
+	}
+
+	fmt.Fprintf(p.out, "\r%s  %s / %s", p.name, bytefmt.ByteSize(uint64(p.written)), bytefmt.ByteSize(uint64(p.size)))
+}
@@ -0,0 +1,427 @@
+// Package scp implements the scp protocol used to copy files over an SSH
+// session. The remote end of the session runs "scp -t" to receive files or
+// "scp -f" to send them; Send and Receive implement the local end.
+package scp
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type Options struct {
+	// Recursive copies directories and their contents.
+	Recursive bool
+	// PreserveTimes keeps the modification and access times of the copied
+	// files. File modes are always kept.
+	PreserveTimes bool
+	// Progress receives a progress line for each file copied when set.
+	Progress io.Writer
+}
+
+// ProtocolError is returned when the other end of the session reports an
+// error or sends a message that is not valid.
+type ProtocolError struct {
+	Message string
+}
+
+func (e ProtocolError) Error() string {
+	return e.Message
+}
+
+// IsDirectoryError is returned when copying a directory without the
+// Recursive option.
+type IsDirectoryError struct {
+	Path string
+}
+
+func (e IsDirectoryError) Error() string {
+	return fmt.Sprintf("%s: is a directory", e.Path)
+}
+
+// SinkCommand returns the command to run on the remote end to receive files
+// at path.
+func SinkCommand(path string, opts Options) string {
+	return remoteCommand("-t", path, opts)
+}
+
+// SourceCommand returns the command to run on the remote end to send the
+// files at path.
+func SourceCommand(path string, opts Options) string {
+	return remoteCommand("-f", path, opts)
+}
+
+func remoteCommand(mode string, path string, opts Options) string {
+	args := []string{"scp", mode}
+	if opts.Recursive {
+		args = append(args, "-r")
+	}
+	if opts.PreserveTimes {
+		args = append(args, "-p")
+	}
+	args = append(args, "'"+strings.Replace(path, "'", `'\''`, -1)+"'")
+	return strings.Join(args, " ")
+}
+
+type session struct {
+	out  io.Writer
+	in   *bufio.Reader
+	opts Options
+}
+
+// Send copies the files and directories at paths to a sink. Messages are
+// written to out and the sink's responses are read from in.
+func Send(out io.Writer, in io.Reader, paths []string, opts Options) error {
+	s := session{out: out, in: bufio.NewReader(in), opts: opts}
+
+	err := s.readStatus()
+	if err != nil {
+		return err
+	}
+
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			s.sendError(err.Error())
+			return err
+		}
+
+		if info.IsDir() {
+			if !opts.Recursive {
+				err = IsDirectoryError{Path: path}
+				s.sendError(err.Error())
+				return err
+			}
+			err = s.sendDirectory(path, info)
+		} else {
+			err = s.sendFile(path, info)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s session) sendTimes(info os.FileInfo) error {
+	if !s.opts.PreserveTimes {
+		return nil
+	}
+
+	modTime := info.ModTime().Unix()
+	return s.sendMessage(fmt.Sprintf("T%d 0 %d 0\n", modTime, modTime))
+}
+
+func (s session) sendFile(path string, info os.FileInfo) error {
+	file, err := os.Open(path)
+	if err != nil {
+		s.sendError(err.Error())
+		return err
+	}
+	defer file.Close()
+
+	err = s.sendTimes(info)
+	if err != nil {
+		return err
+	}
+
+	err = s.sendMessage(fmt.Sprintf("C%04o %d %s\n", info.Mode().Perm(), info.Size(), info.Name()))
+	if err != nil {
+		return err
+	}
+
+	progress := newProgressWriter(s.opts.Progress, info.Name(), info.Size())
+	_, err = io.CopyN(io.MultiWriter(s.out, progress), file, info.Size())
+	if err != nil {
+		return err
+	}
+	progress.Done()
+
+	return s.sendMessage("\x00")
+}
+
+func (s session) sendDirectory(path string, info os.FileInfo) error {
+	err := s.sendTimes(info)
+	if err != nil {
+		return err
+	}
+
+	err = s.sendMessage(fmt.Sprintf("D%04o 0 %s\n", info.Mode().Perm(), info.Name()))
+	if err != nil {
+		return err
+	}
+
+	entries, err := ioutil.ReadDir(path)
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range entries {
+		entryPath := filepath.Join(path, entry.Name())
+		entryInfo, err := os.Stat(entryPath)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case entryInfo.IsDir():
+			err = s.sendDirectory(entryPath, entryInfo)
+		case entryInfo.Mode().IsRegular():
+			err = s.sendFile(entryPath, entryInfo)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.sendMessage("E\n")
+}
+
+// sendMessage writes the message and waits for the other end to accept it.
+func (s session) sendMessage(message string) error {
+	_, err := io.WriteString(s.out, message)
+	if err != nil {
+		return err
+	}
+	return s.readStatus()
+}
+
+func (s session) sendError(message string) {
+	fmt.Fprintf(s.out, "\x02%s\n", message)
+}
+
+func (s session) sendOK() error {
+	_, err := s.out.Write([]byte{0})
+	return err
+}
+
+func (s session) readStatus() error {
+	status, err := s.in.ReadByte()
+	if err != nil {
+		return err
+	}
+
+	switch status {
+	case 0:
+		return nil
+	case 1, 2:
+		message, _ := s.in.ReadString('\n')
+		return ProtocolError{Message: strings.TrimSpace(message)}
+	default:
+		return ProtocolError{Message: fmt.Sprintf("unexpected response: %q", status)}
+	}
+}
+
+type fileTimes struct {
+	modTime    time.Time
+	accessTime time.Time
+}
+
+// Receive copies the files sent by a source to target. When target is an
+// existing directory, the files are copied into it. Messages are read from
+// in and responses are written to out.
+func Receive(out io.Writer, in io.Reader, target string, opts Options) error {
+	s := session{out: out, in: bufio.NewReader(in), opts: opts}
+
+	info, err := os.Stat(target)
+	targetIsDir := err == nil && info.IsDir()
+
+	var (
+		dirs      []string
+		dirTimes  []*fileTimes
+		nextTimes *fileTimes
+	)
+
+	destination := func(name string) (string, error) {
+		if name == "" || name == "." || name == ".." || strings.ContainsAny(name, `/\`) {
+			return "", ProtocolError{Message: fmt.Sprintf("invalid file name: %q", name)}
+		}
+
+		switch {
+		case len(dirs) > 0:
+			return filepath.Join(dirs[len(dirs)-1], name), nil
+		case targetIsDir:
+			return filepath.Join(target, name), nil
+		default:
+			return target, nil
+		}
+	}
+
+	err = s.sendOK()
+	if err != nil {
+		return err
+	}
+
+	for {
+		line, err := s.in.ReadString('\n')
+		if err == io.EOF && line == "" {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		line = strings.TrimSuffix(line, "\n")
+		if len(line) == 0 {
+			err = ProtocolError{Message: "unexpected empty message"}
+			s.sendError(err.Error())
+			return err
+		}
+
+		switch line[0] {
+		case 1, 2:
+			return ProtocolError{Message: line[1:]}
+
+		case 'T':
+			nextTimes, err = parseTimes(line[1:])
+			if err != nil {
+				s.sendError(err.Error())
+				return err
+			}
+
+		case 'C':
+			mode, size, name, err := parseHeader(line[1:])
+			if err == nil {
+				var path string
+				path, err = destination(name)
+				if err == nil {
+					err = s.receiveFile(path, name, mode, size, nextTimes)
+				}
+			}
+			if err != nil {
+				s.sendError(err.Error())
+				return err
+			}
+			nextTimes = nil
+
+		case 'D':
+			mode, _, name, err := parseHeader(line[1:])
+			var path string
+			if err == nil {
+				if !opts.Recursive {
+					err = IsDirectoryError{Path: name}
+				} else {
+					path, err = destination(name)
+				}
+			}
+			if err == nil {
+				err = os.MkdirAll(path, mode)
+			}
+			if err == nil {
+				err = os.Chmod(path, mode)
+			}
+			if err != nil {
+				s.sendError(err.Error())
+				return err
+			}
+			dirs = append(dirs, path)
+			dirTimes = append(dirTimes, nextTimes)
+			nextTimes = nil
+
+		case 'E':
+			if len(dirs) == 0 {
+				err = ProtocolError{Message: "unexpected end of directory"}
+				s.sendError(err.Error())
+				return err
+			}
+
+			path, times := dirs[len(dirs)-1], dirTimes[len(dirTimes)-1]
+			dirs, dirTimes = dirs[:len(dirs)-1], dirTimes[:len(dirTimes)-1]
+			if times != nil && opts.PreserveTimes {
+				err = os.Chtimes(path, times.accessTime, times.modTime)
+				if err != nil {
+					s.sendError(err.Error())
+					return err
+				}
+			}
+
+		default:
+			err = ProtocolError{Message: fmt.Sprintf("unexpected message: %q", line)}
+			s.sendError(err.Error())
+			return err
+		}
+
+		err = s.sendOK()
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (s session) receiveFile(path string, name string, mode os.FileMode, size int64, times *fileTimes) error {
+	file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	err = s.sendOK()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	progress := newProgressWriter(s.opts.Progress, name, size)
+	_, err = io.CopyN(io.MultiWriter(file, progress), s.in, size)
+	closeErr := file.Close()
+	if err != nil {
+		return err
+	}
+	if closeErr != nil {
+		return closeErr
+	}
+	progress.Done()
+
+	err = os.Chmod(path, mode)
+	if err != nil {
+		return err
+	}
+
+	if times != nil && s.opts.PreserveTimes {
+		err = os.Chtimes(path, times.accessTime, times.modTime)
+		if err != nil {
+			return err
+		}
+	}
+
+	return s.readStatus()
+}
+
+// parseHeader parses the mode, size and name of a "C" or "D" message.
+func parseHeader(header string) (os.FileMode, int64, string, error) {
+	parts := strings.SplitN(header, " ", 3)
+	if len(parts) != 3 {
+		return 0, 0, "", ProtocolError{Message: fmt.Sprintf("invalid header: %q", header)}
+	}
+
+	mode, err := strconv.ParseUint(parts[0], 8, 32)
+	if err != nil {
+		return 0, 0, "", ProtocolError{Message: fmt.Sprintf("invalid mode: %q", parts[0])}
+	}
+
+	size, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil || size < 0 {
+		return 0, 0, "", ProtocolError{Message: fmt.Sprintf("invalid size: %q", parts[1])}
+	}
+
+	return os.FileMode(mode).Perm(), size, parts[2], nil
+}
+
+// parseTimes parses the times of a "T" message.
+func parseTimes(message string) (*fileTimes, error) {
+	var modTime, modTimeMicro, accessTime, accessTimeMicro int64
+	_, err := fmt.Sscanf(message, "%d %d %d %d", &modTime, &modTimeMicro, &accessTime, &accessTimeMicro)
+	if err != nil {
+		return nil, ProtocolError{Message: fmt.Sprintf("invalid times: %q", message)}
+	}
+
+	return &fileTimes{
+		modTime:    time.Unix(modTime, modTimeMicro*1000),
+		accessTime: time.Unix(accessTime, accessTimeMicro*1000),
+	}, nil
+}
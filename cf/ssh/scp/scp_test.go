@@ -0,0 +1,200 @@
+//go:build !windows
+// +build !windows
+
+package scp_test
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/ssh/scp"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("SCP", func() {
+	var (
+		srcDir  string
+		destDir string
+		opts    scp.Options
+	)
+
+	// copyFiles connects Send to Receive the way an SSH session connects the
+	// local and remote ends.
+	copyFiles := func(paths []string, target string) (sendErr error, receiveErr error) {
+		sendReader, sendWriter := io.Pipe()
+		receiveReader, receiveWriter := io.Pipe()
+
+		done := make(chan error)
+		go func() {
+			err := scp.Receive(receiveWriter, sendReader, target, opts)
+			receiveWriter.Close()
+			sendReader.Close()
+			done <- err
+		}()
+
+		sendErr = scp.Send(sendWriter, receiveReader, paths, opts)
+		sendWriter.Close()
+		receiveReader.Close()
+		receiveErr = <-done
+		return sendErr, receiveErr
+	}
+
+	BeforeEach(func() {
+		var err error
+		srcDir, err = ioutil.TempDir("", "scp-src")
+		Expect(err).NotTo(HaveOccurred())
+		destDir, err = ioutil.TempDir("", "scp-dest")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(os.MkdirAll(filepath.Join(srcDir, "dir", "subdir"), 0750)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "file1"), []byte("some-contents"), 0600)).To(Succeed())
+		Expect(os.Chmod(filepath.Join(srcDir, "file1"), 0741)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "dir", "file2"), []byte("other-contents"), 0644)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(srcDir, "dir", "subdir", "empty"), nil, 0644)).To(Succeed())
+
+		opts = scp.Options{}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(srcDir)).To(Succeed())
+		Expect(os.RemoveAll(destDir)).To(Succeed())
+	})
+
+	Describe("SinkCommand and SourceCommand", func() {
+		It("returns the remote scp command with the quoted path", func() {
+			Expect(scp.SinkCommand("/some/path", scp.Options{})).To(Equal("scp -t '/some/path'"))
+			Expect(scp.SourceCommand("it's", scp.Options{Recursive: true, PreserveTimes: true})).To(Equal(`scp -f -r -p 'it'\''s'`))
+		})
+	})
+
+	Context("when copying a file into a directory", func() {
+		It("copies the contents and mode of the file", func() {
+			sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "file1")}, destDir)
+			Expect(sendErr).NotTo(HaveOccurred())
+			Expect(receiveErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(filepath.Join(destDir, "file1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-contents"))
+
+			info, err := os.Stat(filepath.Join(destDir, "file1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0741)))
+		})
+	})
+
+	Context("when copying a file to a new path", func() {
+		It("creates the file at the path", func() {
+			target := filepath.Join(destDir, "renamed")
+			sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "file1")}, target)
+			Expect(sendErr).NotTo(HaveOccurred())
+			Expect(receiveErr).NotTo(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(target)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-contents"))
+		})
+	})
+
+	Context("when copying a directory", func() {
+		Context("when recursive is set", func() {
+			BeforeEach(func() {
+				opts.Recursive = true
+			})
+
+			It("copies the directory and its contents", func() {
+				sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "dir")}, destDir)
+				Expect(sendErr).NotTo(HaveOccurred())
+				Expect(receiveErr).NotTo(HaveOccurred())
+
+				contents, err := ioutil.ReadFile(filepath.Join(destDir, "dir", "file2"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(contents)).To(Equal("other-contents"))
+
+				info, err := os.Stat(filepath.Join(destDir, "dir", "subdir", "empty"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Size()).To(BeZero())
+
+				info, err = os.Stat(filepath.Join(destDir, "dir"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(info.Mode().Perm()).To(Equal(os.FileMode(0750)))
+			})
+		})
+
+		Context("when recursive is not set", func() {
+			It("returns an IsDirectoryError", func() {
+				sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "dir")}, destDir)
+				Expect(sendErr).To(MatchError(scp.IsDirectoryError{Path: filepath.Join(srcDir, "dir")}))
+				Expect(receiveErr).To(HaveOccurred())
+			})
+		})
+	})
+
+	Context("when preserving times", func() {
+		var modTime time.Time
+
+		BeforeEach(func() {
+			opts.PreserveTimes = true
+			modTime = time.Unix(1500000000, 0)
+			Expect(os.Chtimes(filepath.Join(srcDir, "file1"), modTime, modTime)).To(Succeed())
+		})
+
+		It("sets the modification time of the copied file", func() {
+			sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "file1")}, destDir)
+			Expect(sendErr).NotTo(HaveOccurred())
+			Expect(receiveErr).NotTo(HaveOccurred())
+
+			info, err := os.Stat(filepath.Join(destDir, "file1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.ModTime()).To(BeTemporally("==", modTime))
+		})
+	})
+
+	Context("when a progress writer is set", func() {
+		var progress *bytes.Buffer
+
+		BeforeEach(func() {
+			progress = new(bytes.Buffer)
+			opts.Progress = progress
+		})
+
+		It("displays the progress of each file", func() {
+			sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "file1")}, destDir)
+			Expect(sendErr).NotTo(HaveOccurred())
+			Expect(receiveErr).NotTo(HaveOccurred())
+
+			Expect(progress.String()).To(ContainSubstring("\rfile1  13B / 13B\n"))
+		})
+	})
+
+	Context("when the source file does not exist", func() {
+		It("returns the error on both ends", func() {
+			sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "banana")}, destDir)
+			Expect(os.IsNotExist(sendErr)).To(BeTrue())
+			Expect(receiveErr).To(BeAssignableToTypeOf(scp.ProtocolError{}))
+		})
+	})
+
+	Context("when the source sends an empty message", func() {
+		It("returns a ProtocolError", func() {
+			out := new(bytes.Buffer)
+			err := scp.Receive(out, bytes.NewBufferString("\n"), destDir, opts)
+			Expect(err).To(MatchError(scp.ProtocolError{Message: "unexpected empty message"}))
+		})
+	})
+
+	Context("when the target cannot be written", func() {
+		It("returns the error on both ends", func() {
+			target := filepath.Join(destDir, "missing", "file1")
+			sendErr, receiveErr := copyFiles([]string{filepath.Join(srcDir, "file1")}, target)
+			Expect(sendErr).To(BeAssignableToTypeOf(scp.ProtocolError{}))
+			Expect(os.IsNotExist(receiveErr)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,150 @@
+// Package socks implements the server side of the SOCKS5 protocol (RFC 1928)
+// needed by a dynamic port forward: clients connect without authentication
+// and ask for a single outgoing TCP connection.
+package socks
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+)
+
+const (
+	version5 = 0x05
+
+	methodNoAuth       = 0x00
+	methodNoAcceptable = 0xff
+
+	commandConnect = 0x01
+
+	addressTypeIPv4   = 0x01
+	addressTypeDomain = 0x03
+	addressTypeIPv6   = 0x04
+)
+
+// ReplyCode is the status sent to the client in reply to its request.
+type ReplyCode byte
+
+const (
+	Succeeded               ReplyCode = 0x00
+	GeneralFailure          ReplyCode = 0x01
+	HostUnreachable         ReplyCode = 0x04
+	CommandNotSupported     ReplyCode = 0x07
+	AddressTypeNotSupported ReplyCode = 0x08
+)
+
+// ProtocolError is returned when the client sends a request that is not
+// valid or not supported.
+type ProtocolError struct {
+	Message string
+}
+
+func (e ProtocolError) Error() string {
+	return e.Message
+}
+
+// Handshake negotiates the authentication method with the client on conn and
+// reads its request. It returns the host:port address the client asked to
+// connect to; the caller must then send a Reply.
+func Handshake(conn io.ReadWriter) (string, error) {
+	header := make([]byte, 2)
+	_, err := io.ReadFull(conn, header)
+	if err != nil {
+		return "", err
+	}
+	if header[0] != version5 {
+		return "", ProtocolError{Message: fmt.Sprintf("unsupported SOCKS version %d", header[0])}
+	}
+
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(conn, methods)
+	if err != nil {
+		return "", err
+	}
+
+	method := byte(methodNoAcceptable)
+	for _, m := range methods {
+		if m == methodNoAuth {
+			method = methodNoAuth
+		}
+	}
+	_, err = conn.Write([]byte{version5, method})
+	if err != nil {
+		return "", err
+	}
+	if method == methodNoAcceptable {
+		return "", ProtocolError{Message: "client does not support unauthenticated connections"}
+	}
+
+	request := make([]byte, 4)
+	_, err = io.ReadFull(conn, request)
+	if err != nil {
+		return "", err
+	}
+	if request[0] != version5 {
+		return "", ProtocolError{Message: fmt.Sprintf("unsupported SOCKS version %d", request[0])}
+	}
+	if request[1] != commandConnect {
+		_ = Reply(conn, CommandNotSupported)
+		return "", ProtocolError{Message: fmt.Sprintf("unsupported SOCKS command %d", request[1])}
+	}
+
+	host, err := readHost(conn, request[3])
+	if err != nil {
+		if _, ok := err.(ProtocolError); ok {
+			_ = Reply(conn, AddressTypeNotSupported)
+		}
+		return "", err
+	}
+
+	port := make([]byte, 2)
+	_, err = io.ReadFull(conn, port)
+	if err != nil {
+		return "", err
+	}
+
+	return net.JoinHostPort(host, strconv.Itoa(int(binary.BigEndian.Uint16(port)))), nil
+}
+
+func readHost(conn io.Reader, addressType byte) (string, error) {
+	switch addressType {
+	case addressTypeIPv4, addressTypeIPv6:
+		size := net.IPv4len
+		if addressType == addressTypeIPv6 {
+			size = net.IPv6len
+		}
+
+		ip := make(net.IP, size)
+		_, err := io.ReadFull(conn, ip)
+		if err != nil {
+			return "", err
+		}
+		return ip.String(), nil
+
+	case addressTypeDomain:
+		length := make([]byte, 1)
+		_, err := io.ReadFull(conn, length)
+		if err != nil {
+			return "", err
+		}
+
+		domain := make([]byte, length[0])
+		_, err = io.ReadFull(conn, domain)
+		if err != nil {
+			return "", err
+		}
+		return string(domain), nil
+
+	default:
+		return "", ProtocolError{Message: fmt.Sprintf("unsupported SOCKS address type %d", addressType)}
+	}
+}
+
+// Reply sends the reply to the client's request. The bound address is
+// always reported as 0.0.0.0:0, which clients ignore for CONNECT requests.
+func Reply(conn io.Writer, code ReplyCode) error {
+	_, err := conn.Write([]byte{version5, byte(code), 0x00, addressTypeIPv4, 0, 0, 0, 0, 0, 0})
+	return err
+}
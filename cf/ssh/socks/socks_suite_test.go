@@ -0,0 +1,13 @@
+package socks_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestSocks(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Socks Suite")
+}
@@ -0,0 +1,110 @@
+package socks_test
+
+import (
+	"bytes"
+	"io"
+
+	"code.cloudfoundry.org/cli/cf/ssh/socks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+// fakeConn reads the client's messages from input and records the
+// server's responses in output.
+type fakeConn struct {
+	input  io.Reader
+	output bytes.Buffer
+}
+
+func (conn *fakeConn) Read(p []byte) (int, error) {
+	return conn.input.Read(p)
+}
+
+func (conn *fakeConn) Write(p []byte) (int, error) {
+	return conn.output.Write(p)
+}
+
+func (conn *fakeConn) Bytes() []byte {
+	return conn.output.Bytes()
+}
+
+var _ = Describe("Handshake", func() {
+	var conn *fakeConn
+
+	newConn := func(input ...byte) *fakeConn {
+		return &fakeConn{input: bytes.NewReader(input)}
+	}
+
+	DescribeTable("returns the requested address",
+		func(request []byte, expectedAddress string) {
+			conn = newConn(append([]byte{0x05, 0x02, 0x02, 0x00}, request...)...)
+
+			address, err := socks.Handshake(conn)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address).To(Equal(expectedAddress))
+			Expect(conn.Bytes()).To(Equal([]byte{0x05, 0x00}))
+		},
+
+		Entry("IPv4", []byte{0x05, 0x01, 0x00, 0x01, 10, 0, 0, 1, 0x1f, 0x90}, "10.0.0.1:8080"),
+		Entry("domain", []byte{0x05, 0x01, 0x00, 0x03, 7, 'd', 'b', '.', 'i', 'n', 't', 'r', 0x15, 0x38}, "db.intr:5432"),
+		Entry("IPv6", []byte{0x05, 0x01, 0x00, 0x04, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0x00, 0x50}, "[::1]:80"),
+	)
+
+	Context("when the client requires authentication", func() {
+		It("rejects the client", func() {
+			conn = newConn(0x05, 0x01, 0x02)
+
+			_, err := socks.Handshake(conn)
+			Expect(err).To(MatchError(socks.ProtocolError{Message: "client does not support unauthenticated connections"}))
+			Expect(conn.Bytes()).To(Equal([]byte{0x05, 0xff}))
+		})
+	})
+
+	Context("when the client speaks SOCKS4", func() {
+		It("returns an error", func() {
+			conn = newConn(0x04, 0x01, 0x00, 0x50)
+
+			_, err := socks.Handshake(conn)
+			Expect(err).To(MatchError(socks.ProtocolError{Message: "unsupported SOCKS version 4"}))
+		})
+	})
+
+	Context("when the client asks for a BIND", func() {
+		It("replies that the command is not supported", func() {
+			conn = newConn(0x05, 0x01, 0x00, 0x05, 0x02, 0x00, 0x01, 10, 0, 0, 1, 0x1f, 0x90)
+
+			_, err := socks.Handshake(conn)
+			Expect(err).To(MatchError(socks.ProtocolError{Message: "unsupported SOCKS command 2"}))
+			Expect(conn.Bytes()).To(Equal([]byte{0x05, 0x00, 0x05, 0x07, 0x00, 0x01, 0, 0, 0, 0, 0, 0}))
+		})
+	})
+
+	Context("when the address type is unknown", func() {
+		It("replies that the address type is not supported", func() {
+			conn = newConn(0x05, 0x01, 0x00, 0x05, 0x01, 0x00, 0x09)
+
+			_, err := socks.Handshake(conn)
+			Expect(err).To(MatchError(socks.ProtocolError{Message: "unsupported SOCKS address type 9"}))
+			Expect(conn.Bytes()[2:4]).To(Equal([]byte{0x05, 0x08}))
+		})
+	})
+
+	Context("when the request is cut short", func() {
+		It("returns the read error", func() {
+			conn = newConn(0x05, 0x01, 0x00, 0x05, 0x01, 0x00, 0x01, 10, 0)
+
+			_, err := socks.Handshake(conn)
+			Expect(err).To(Equal(io.ErrUnexpectedEOF))
+		})
+	})
+})
+
+var _ = Describe("Reply", func() {
+	It("writes the reply code with an empty bound address", func() {
+		buffer := new(bytes.Buffer)
+		Expect(socks.Reply(buffer, socks.HostUnreachable)).To(Succeed())
+		Expect(buffer.Bytes()).To(Equal([]byte{0x05, 0x04, 0x00, 0x01, 0, 0, 0, 0, 0, 0}))
+	})
+})
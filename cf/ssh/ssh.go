@@ -21,7 +21,9 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/models"
 	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/scp"
 	"code.cloudfoundry.org/cli/cf/ssh/sigwinch"
+	"code.cloudfoundry.org/cli/cf/ssh/socks"
 	"code.cloudfoundry.org/cli/cf/ssh/terminal"
 	"github.com/docker/docker/pkg/term"
 )
@@ -38,6 +40,10 @@ type SecureShell interface {
 	Connect(opts *options.SSHOptions) error
 	InteractiveSession() error
 	LocalPortForward() error
+	RemotePortForward() error
+	DynamicPortForward() error
+	Upload(localPaths []string, remotePath string, opts scp.Options) error
+	Download(remotePath string, localPath string, opts scp.Options) error
 	Wait() error
 	Close() error
 }
@@ -54,6 +60,7 @@ type SecureClient interface {
 	NewSession() (SecureSession, error)
 	Conn() ssh.Conn
 	Dial(network, address string) (net.Conn, error)
+	Listen(network, address string) (net.Listener, error)
 	Wait() error
 	Close() error
 }
@@ -90,7 +97,7 @@ type secureShell struct {
 	secureClient           SecureClient
 	opts                   *options.SSHOptions
 
-	localListeners []net.Listener
+	listeners []net.Listener
 }
 
 func NewSecureShell(
@@ -104,15 +111,15 @@ func NewSecureShell(
 	token string,
 ) SecureShell {
 	return &secureShell{
-		secureDialer:      secureDialer,
-		terminalHelper:    terminalHelper,
-		listenerFactory:   listenerFactory,
-		keepAliveInterval: keepAliveInterval,
-		app:               app,
+		secureDialer:           secureDialer,
+		terminalHelper:         terminalHelper,
+		listenerFactory:        listenerFactory,
+		keepAliveInterval:      keepAliveInterval,
+		app:                    app,
 		sshEndpointFingerprint: sshEndpointFingerprint,
 		sshEndpoint:            sshEndpoint,
 		token:                  token,
-		localListeners:         []net.Listener{},
+		listeners:              []net.Listener{},
 	}
 }
 
@@ -141,7 +148,7 @@ func (c *secureShell) Connect(opts *options.SSHOptions) error {
 }
 
 func (c *secureShell) Close() error {
-	for _, listener := range c.localListeners {
+	for _, listener := range c.listeners {
 		_ = listener.Close()
 	}
 	return c.secureClient.Close()
@@ -153,15 +160,55 @@ func (c *secureShell) LocalPortForward() error {
 		if err != nil {
 			return err
 		}
-		c.localListeners = append(c.localListeners, listener)
+		c.listeners = append(c.listeners, listener)
+
+		connectAddress := forwardSpec.ConnectAddress
+		go c.acceptLoop(listener, func(conn net.Conn) {
+			c.handleForwardConnection(conn, connectAddress)
+		})
+	}
+
+	return nil
+}
+
+// RemotePortForward listens on the remote forward addresses in the
+// application instance and connects each accepted connection to its connect
+// address from the local machine.
+func (c *secureShell) RemotePortForward() error {
+	for _, forwardSpec := range c.opts.RemoteForwardSpecs {
+		listener, err := c.secureClient.Listen("tcp", forwardSpec.ListenAddress)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
 
-		go c.localForwardAcceptLoop(listener, forwardSpec.ConnectAddress)
+		connectAddress := forwardSpec.ConnectAddress
+		go c.acceptLoop(listener, func(conn net.Conn) {
+			c.handleRemoteForwardConnection(conn, connectAddress)
+		})
 	}
 
 	return nil
 }
 
-func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string) {
+// DynamicPortForward runs a SOCKS proxy on each dynamic forward address.
+// Connections requested by the proxy clients are made from the application
+// instance.
+func (c *secureShell) DynamicPortForward() error {
+	for _, address := range c.opts.DynamicForwardAddresses {
+		listener, err := c.listenerFactory.Listen("tcp", address)
+		if err != nil {
+			return err
+		}
+		c.listeners = append(c.listeners, listener)
+
+		go c.acceptLoop(listener, c.handleDynamicForwardConnection)
+	}
+
+	return nil
+}
+
+func (c *secureShell) acceptLoop(listener net.Listener, handleConnection func(net.Conn)) {
 	defer listener.Close()
 
 	for {
@@ -174,7 +221,7 @@ func (c *secureShell) localForwardAcceptLoop(listener net.Listener, addr string)
 			return
 		}
 
-		go c.handleForwardConnection(conn, addr)
+		go handleConnection(conn)
 	}
 }
 
@@ -188,6 +235,48 @@ func (c *secureShell) handleForwardConnection(conn net.Conn, targetAddr string)
 	}
 	defer target.Close()
 
+	proxyConnections(conn, target)
+}
+
+func (c *secureShell) handleRemoteForwardConnection(conn net.Conn, targetAddr string) {
+	defer conn.Close()
+
+	target, err := net.Dial("tcp", targetAddr)
+	if err != nil {
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	proxyConnections(conn, target)
+}
+
+func (c *secureShell) handleDynamicForwardConnection(conn net.Conn) {
+	defer conn.Close()
+
+	targetAddr, err := socks.Handshake(conn)
+	if err != nil {
+		fmt.Printf("SOCKS request failed: %s\n", err.Error())
+		return
+	}
+
+	target, err := c.secureClient.Dial("tcp", targetAddr)
+	if err != nil {
+		_ = socks.Reply(conn, socks.HostUnreachable)
+		fmt.Printf("connect to %s failed: %s\n", targetAddr, err.Error())
+		return
+	}
+	defer target.Close()
+
+	err = socks.Reply(conn, socks.Succeeded)
+	if err != nil {
+		return
+	}
+
+	proxyConnections(conn, target)
+}
+
+func proxyConnections(conn net.Conn, target net.Conn) {
 	wg := &sync.WaitGroup{}
 	wg.Add(2)
 
@@ -209,6 +298,67 @@ func copyAndDone(wg *sync.WaitGroup, dest io.Writer, src io.Reader) {
 	wg.Done()
 }
 
+// Upload copies the local files and directories to remotePath in the
+// application instance with scp.
+func (c *secureShell) Upload(localPaths []string, remotePath string, opts scp.Options) error {
+	return c.copyFiles(scp.SinkCommand(remotePath, opts), func(in io.Writer, out io.Reader) error {
+		return scp.Send(in, out, localPaths, opts)
+	})
+}
+
+// Download copies remotePath in the application instance to localPath with
+// scp.
+func (c *secureShell) Download(remotePath string, localPath string, opts scp.Options) error {
+	return c.copyFiles(scp.SourceCommand(remotePath, opts), func(in io.Writer, out io.Reader) error {
+		return scp.Receive(in, out, localPath, opts)
+	})
+}
+
+// copyFiles runs the remote scp command in a new session and transfers the
+// files over the session's stdin and stdout.
+func (c *secureShell) copyFiles(command string, transfer func(in io.Writer, out io.Reader) error) error {
+	session, err := c.secureClient.NewSession()
+	if err != nil {
+		return fmt.Errorf("SSH session allocation failed: %s", err.Error())
+	}
+	defer session.Close()
+
+	inPipe, err := session.StdinPipe()
+	if err != nil {
+		return err
+	}
+
+	outPipe, err := session.StdoutPipe()
+	if err != nil {
+		return err
+	}
+
+	errPipe, err := session.StderrPipe()
+	if err != nil {
+		return err
+	}
+
+	err = session.Start(command)
+	if err != nil {
+		return err
+	}
+
+	_, _, stderr := c.terminalHelper.StdStreams()
+	wg := &sync.WaitGroup{}
+	wg.Add(1)
+	go copyAndDone(wg, stderr, errPipe)
+
+	err = transfer(inPipe, outPipe)
+	_ = inPipe.Close()
+	if err != nil {
+		return err
+	}
+
+	err = session.Wait()
+	wg.Wait()
+	return err
+}
+
 func (c *secureShell) InteractiveSession() error {
 	var err error
 
@@ -479,6 +629,9 @@ func (sc *secureClient) Wait() error    { return sc.client.Wait() }
 func (sc *secureClient) Dial(n, addr string) (net.Conn, error) {
 	return sc.client.Dial(n, addr)
 }
+func (sc *secureClient) Listen(n, addr string) (net.Listener, error) {
+	return sc.client.Listen(n, addr)
+}
 func (sc *secureClient) NewSession() (SecureSession, error) {
 	return sc.client.NewSession()
 }
@@ -0,0 +1,237 @@
+//go:build !windows && !386
+// +build !windows,!386
+
+package sshCmd_test
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/cli/cf/models"
+	"code.cloudfoundry.org/cli/cf/ssh"
+	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/scp"
+	"code.cloudfoundry.org/cli/cf/ssh/terminal/terminalfakes"
+	"code.cloudfoundry.org/diego-ssh/test_helpers/fake_io"
+	"golang.org/x/crypto/ssh"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// scpServer is an in-process SSH server that runs scp.Send and scp.Receive
+// for "scp -f" and "scp -t" exec requests.
+type scpServer struct {
+	listener net.Listener
+	config   *ssh.ServerConfig
+	commands chan string
+}
+
+func newSCPServer() *scpServer {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	Expect(err).NotTo(HaveOccurred())
+
+	config := &ssh.ServerConfig{
+		PasswordCallback: func(ssh.ConnMetadata, []byte) (*ssh.Permissions, error) {
+			return nil, nil
+		},
+	}
+	config.AddHostKey(TestHostKey)
+
+	server := &scpServer{
+		listener: listener,
+		config:   config,
+		commands: make(chan string, 10),
+	}
+	go server.serve()
+	return server
+}
+
+func (server *scpServer) Address() string {
+	return server.listener.Addr().String()
+}
+
+func (server *scpServer) Close() {
+	server.listener.Close()
+}
+
+func (server *scpServer) serve() {
+	defer GinkgoRecover()
+
+	for {
+		conn, err := server.listener.Accept()
+		if err != nil {
+			return
+		}
+
+		_, channels, requests, err := ssh.NewServerConn(conn, server.config)
+		if err != nil {
+			continue
+		}
+		go ssh.DiscardRequests(requests)
+
+		go func() {
+			for newChannel := range channels {
+				channel, channelRequests, err := newChannel.Accept()
+				if err != nil {
+					continue
+				}
+				go server.handleSession(channel, channelRequests)
+			}
+		}()
+	}
+}
+
+func (server *scpServer) handleSession(channel ssh.Channel, requests <-chan *ssh.Request) {
+	for request := range requests {
+		if request.Type != "exec" {
+			request.Reply(false, nil)
+			continue
+		}
+
+		var payload struct{ Command string }
+		ssh.Unmarshal(request.Payload, &payload)
+		request.Reply(true, nil)
+		server.commands <- payload.Command
+
+		go func() {
+			args := strings.Fields(payload.Command)
+			path := strings.Trim(args[len(args)-1], "'")
+			opts := scp.Options{}
+			for _, arg := range args[2 : len(args)-1] {
+				switch arg {
+				case "-r":
+					opts.Recursive = true
+				case "-p":
+					opts.PreserveTimes = true
+				}
+			}
+
+			var err error
+			if args[1] == "-t" {
+				err = scp.Receive(channel, channel, path, opts)
+			} else {
+				err = scp.Send(channel, channel, []string{path}, opts)
+			}
+
+			status := struct{ Status uint32 }{0}
+			if err != nil {
+				channel.Stderr().Write([]byte(err.Error()))
+				status.Status = 1
+			}
+			channel.CloseWrite()
+			channel.SendRequest("exit-status", false, ssh.Marshal(status))
+			channel.Close()
+		}()
+	}
+}
+
+var _ = Describe("SCP", func() {
+	var (
+		server      *scpServer
+		secureShell sshCmd.SecureShell
+		stderr      *fake_io.FakeWriter
+
+		localDir  string
+		remoteDir string
+		opts      scp.Options
+	)
+
+	BeforeEach(func() {
+		server = newSCPServer()
+
+		var err error
+		localDir, err = ioutil.TempDir("", "scp-local")
+		Expect(err).NotTo(HaveOccurred())
+		remoteDir, err = ioutil.TempDir("", "scp-remote")
+		Expect(err).NotTo(HaveOccurred())
+
+		stderr = new(fake_io.FakeWriter)
+		stderr.WriteStub = func(p []byte) (int, error) {
+			return len(p), nil
+		}
+		fakeTerminalHelper := new(terminalfakes.FakeTerminalHelper)
+		fakeTerminalHelper.StdStreamsReturns(nil, nil, stderr)
+
+		secureShell = sshCmd.NewSecureShell(
+			sshCmd.DefaultSecureDialer(),
+			fakeTerminalHelper,
+			sshCmd.DefaultListenerFactory(),
+			30*time.Second,
+			models.Application{ApplicationFields: models.ApplicationFields{GUID: "app-guid", State: "STARTED", Diego: true}},
+			"",
+			server.Address(),
+			"some-token",
+		)
+		err = secureShell.Connect(&options.SSHOptions{AppName: "app-1", Index: 2, SkipHostValidation: true})
+		Expect(err).NotTo(HaveOccurred())
+
+		opts = scp.Options{Recursive: true}
+	})
+
+	AfterEach(func() {
+		secureShell.Close()
+		server.Close()
+		Expect(os.RemoveAll(localDir)).To(Succeed())
+		Expect(os.RemoveAll(remoteDir)).To(Succeed())
+	})
+
+	Describe("Upload", func() {
+		BeforeEach(func() {
+			Expect(os.MkdirAll(filepath.Join(localDir, "dir"), 0755)).To(Succeed())
+			Expect(ioutil.WriteFile(filepath.Join(localDir, "dir", "file"), []byte("some-contents"), 0640)).To(Succeed())
+		})
+
+		It("runs scp -t on the instance and sends the files", func() {
+			err := secureShell.Upload([]string{filepath.Join(localDir, "dir")}, remoteDir, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(server.commands).To(Receive(Equal("scp -t -r '" + remoteDir + "'")))
+
+			contents, err := ioutil.ReadFile(filepath.Join(remoteDir, "dir", "file"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-contents"))
+
+			info, err := os.Stat(filepath.Join(remoteDir, "dir", "file"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0640)))
+		})
+
+		Context("when the instance cannot write the files", func() {
+			It("returns the error", func() {
+				Expect(ioutil.WriteFile(filepath.Join(remoteDir, "not-a-dir"), nil, 0600)).To(Succeed())
+				err := secureShell.Upload([]string{filepath.Join(localDir, "dir")}, filepath.Join(remoteDir, "not-a-dir", "dir"), opts)
+				Expect(err).To(BeAssignableToTypeOf(scp.ProtocolError{}))
+			})
+		})
+	})
+
+	Describe("Download", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(filepath.Join(remoteDir, "file"), []byte("remote-contents"), 0600)).To(Succeed())
+		})
+
+		It("runs scp -f on the instance and receives the files", func() {
+			err := secureShell.Download(filepath.Join(remoteDir, "file"), localDir, opts)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(server.commands).To(Receive(Equal("scp -f -r '" + filepath.Join(remoteDir, "file") + "'")))
+
+			contents, err := ioutil.ReadFile(filepath.Join(localDir, "file"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(contents)).To(Equal("remote-contents"))
+		})
+
+		Context("when the remote file does not exist", func() {
+			It("returns the error", func() {
+				err := secureShell.Download(filepath.Join(remoteDir, "banana"), localDir, opts)
+				Expect(err).To(BeAssignableToTypeOf(scp.ProtocolError{}))
+				Expect(err.Error()).To(ContainSubstring("no such file or directory"))
+			})
+		})
+	})
+})
@@ -1,3 +1,4 @@
+//go:build !windows && !386
 // +build !windows,!386
 
 // skipping 386 because lager uses UInt64 in Session()
@@ -935,26 +936,6 @@ var _ = Describe("SSH", func() {
 			realLocalListener.Close()
 		})
 
-		validateConnectivity := func(addr string) {
-			conn, err := net.Dial("tcp", addr)
-			Expect(err).NotTo(HaveOccurred())
-
-			msg := fmt.Sprintf("Hello from %s\n", addr)
-			n, err := conn.Write([]byte(msg))
-			Expect(err).NotTo(HaveOccurred())
-			Expect(n).To(Equal(len(msg)))
-
-			response := make([]byte, len(msg))
-			n, err = conn.Read(response)
-			Expect(err).NotTo(HaveOccurred())
-			Expect(n).To(Equal(len(msg)))
-
-			err = conn.Close()
-			Expect(err).NotTo(HaveOccurred())
-
-			Expect(response).To(Equal([]byte(msg)))
-		}
-
 		It("dials the connect address when a local connection is made", func() {
 			Expect(localForwardError).NotTo(HaveOccurred())
 
@@ -1165,6 +1146,222 @@ var _ = Describe("SSH", func() {
 		})
 	})
 
+	Describe("RemotePortForward", func() {
+		var (
+			opts               *options.SSHOptions
+			remoteForwardError error
+
+			echoListener   net.Listener
+			remoteListener net.Listener
+		)
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			l := echoListener
+			go func() {
+				for {
+					conn, err := l.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			remoteListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeSecureClient.ListenReturns(remoteListener, nil)
+
+			opts = &options.SSHOptions{
+				AppName: "app-1",
+				RemoteForwardSpecs: []options.ForwardSpec{{
+					ListenAddress:  "localhost:9999",
+					ConnectAddress: echoListener.Addr().String(),
+				}},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			remoteForwardError = secureShell.RemotePortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the listen address in the application instance", func() {
+			Expect(remoteForwardError).NotTo(HaveOccurred())
+			Expect(fakeSecureClient.ListenCallCount()).To(Equal(1))
+
+			network, addr := fakeSecureClient.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:9999"))
+		})
+
+		It("copies data between the remote connections and the local connect address", func() {
+			validateConnectivity(remoteListener.Addr().String())
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(0))
+		})
+
+		It("closes the remote listeners when the secure shell is closed", func() {
+			Expect(secureShell.Close()).To(Succeed())
+
+			_, err := net.Dial("tcp", remoteListener.Addr().String())
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when listening in the application instance fails", func() {
+			BeforeEach(func() {
+				remoteListener.Close()
+				fakeSecureClient.ListenReturns(nil, errors.New("tcpip-forward request denied by peer"))
+			})
+
+			It("returns the error", func() {
+				Expect(remoteForwardError).To(MatchError("tcpip-forward request denied by peer"))
+			})
+		})
+	})
+
+	Describe("DynamicPortForward", func() {
+		var (
+			opts                *options.SSHOptions
+			dynamicForwardError error
+
+			echoListener  net.Listener
+			localListener net.Listener
+		)
+
+		// socksConnect asks the proxy for a connection to addr and returns
+		// the proxy's reply code.
+		socksConnect := func(conn net.Conn, addr *net.TCPAddr) byte {
+			request := []byte{0x05, 0x01, 0x00, 0x05, 0x01, 0x00, 0x01}
+			request = append(request, addr.IP.To4()...)
+			request = append(request, byte(addr.Port>>8), byte(addr.Port))
+			_, err := conn.Write(request)
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, 12)
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response[:2]).To(Equal([]byte{0x05, 0x00}))
+			return response[3]
+		}
+
+		BeforeEach(func() {
+			var err error
+			echoListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			l := echoListener
+			go func() {
+				for {
+					conn, err := l.Accept()
+					if err != nil {
+						return
+					}
+					go func() {
+						io.Copy(conn, conn)
+						conn.Close()
+					}()
+				}
+			}()
+
+			localListener, err = net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).NotTo(HaveOccurred())
+			fakeListenerFactory.ListenReturns(localListener, nil)
+
+			fakeSecureClient.DialStub = net.Dial
+
+			opts = &options.SSHOptions{
+				AppName:                 "app-1",
+				DynamicForwardAddresses: []string{"localhost:1080"},
+			}
+
+			currentApp.State = "STARTED"
+			currentApp.Diego = true
+		})
+
+		JustBeforeEach(func() {
+			connectErr := secureShell.Connect(opts)
+			Expect(connectErr).NotTo(HaveOccurred())
+
+			dynamicForwardError = secureShell.DynamicPortForward()
+		})
+
+		AfterEach(func() {
+			Expect(secureShell.Close()).To(Succeed())
+			echoListener.Close()
+		})
+
+		It("listens on the dynamic forward address", func() {
+			Expect(dynamicForwardError).NotTo(HaveOccurred())
+			Expect(fakeListenerFactory.ListenCallCount()).To(Equal(1))
+
+			network, addr := fakeListenerFactory.ListenArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal("localhost:1080"))
+		})
+
+		It("connects to the requested address through the secure client", func() {
+			conn, err := net.Dial("tcp", localListener.Addr().String())
+			Expect(err).NotTo(HaveOccurred())
+			defer conn.Close()
+
+			Expect(socksConnect(conn, echoListener.Addr().(*net.TCPAddr))).To(Equal(byte(0x00)))
+
+			Expect(fakeSecureClient.DialCallCount()).To(Equal(1))
+			network, addr := fakeSecureClient.DialArgsForCall(0)
+			Expect(network).To(Equal("tcp"))
+			Expect(addr).To(Equal(echoListener.Addr().String()))
+
+			msg := "Hello through SOCKS\n"
+			_, err = conn.Write([]byte(msg))
+			Expect(err).NotTo(HaveOccurred())
+
+			response := make([]byte, len(msg))
+			_, err = io.ReadFull(conn, response)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(response)).To(Equal(msg))
+		})
+
+		Context("when the secure client cannot connect to the requested address", func() {
+			BeforeEach(func() {
+				fakeSecureClient.DialReturns(nil, errors.New("connection refused"))
+				fakeSecureClient.DialStub = nil
+			})
+
+			It("replies that the host is unreachable", func() {
+				conn, err := net.Dial("tcp", localListener.Addr().String())
+				Expect(err).NotTo(HaveOccurred())
+				defer conn.Close()
+
+				Expect(socksConnect(conn, echoListener.Addr().(*net.TCPAddr))).To(Equal(byte(0x04)))
+			})
+		})
+
+		Context("when listen fails", func() {
+			BeforeEach(func() {
+				localListener.Close()
+				fakeListenerFactory.ListenReturns(nil, errors.New("address already in use"))
+			})
+
+			It("returns the error", func() {
+				Expect(dynamicForwardError).To(MatchError("address already in use"))
+			})
+		})
+	})
+
 	Describe("Wait", func() {
 		var opts *options.SSHOptions
 		var waitErr error
@@ -1264,3 +1461,23 @@ var _ = Describe("SSH", func() {
 		})
 	})
 })
+
+func validateConnectivity(addr string) {
+	conn, err := net.Dial("tcp", addr)
+	Expect(err).NotTo(HaveOccurred())
+
+	msg := fmt.Sprintf("Hello from %s\n", addr)
+	n, err := conn.Write([]byte(msg))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(n).To(Equal(len(msg)))
+
+	response := make([]byte, len(msg))
+	n, err = io.ReadFull(conn, response)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(n).To(Equal(len(msg)))
+
+	err = conn.Close()
+	Expect(err).NotTo(HaveOccurred())
+
+	Expect(response).To(Equal([]byte(msg)))
+}
@@ -17,13 +17,20 @@ type FakeSecureClient struct {
 		result1 sshCmd.SecureSession
 		result2 error
 	}
+	newSessionReturnsOnCall map[int]struct {
+		result1 sshCmd.SecureSession
+		result2 error
+	}
 	ConnStub        func() ssh.Conn
 	connMutex       sync.RWMutex
 	connArgsForCall []struct{}
 	connReturns     struct {
 		result1 ssh.Conn
 	}
-	DialStub        func(network, address string) (net.Conn, error)
+	connReturnsOnCall map[int]struct {
+		result1 ssh.Conn
+	}
+	DialStub        func(network string, address string) (net.Conn, error)
 	dialMutex       sync.RWMutex
 	dialArgsForCall []struct {
 		network string
@@ -33,32 +40,59 @@ type FakeSecureClient struct {
 		result1 net.Conn
 		result2 error
 	}
+	dialReturnsOnCall map[int]struct {
+		result1 net.Conn
+		result2 error
+	}
+	ListenStub        func(network string, address string) (net.Listener, error)
+	listenMutex       sync.RWMutex
+	listenArgsForCall []struct {
+		network string
+		address string
+	}
+	listenReturns struct {
+		result1 net.Listener
+		result2 error
+	}
+	listenReturnsOnCall map[int]struct {
+		result1 net.Listener
+		result2 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
 	waitReturns     struct {
 		result1 error
 	}
+	waitReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloseStub        func() error
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct{}
 	closeReturns     struct {
 		result1 error
 	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeSecureClient) NewSession() (sshCmd.SecureSession, error) {
 	fake.newSessionMutex.Lock()
+	ret, specificReturn := fake.newSessionReturnsOnCall[len(fake.newSessionArgsForCall)]
 	fake.newSessionArgsForCall = append(fake.newSessionArgsForCall, struct{}{})
 	fake.recordInvocation("NewSession", []interface{}{})
 	fake.newSessionMutex.Unlock()
 	if fake.NewSessionStub != nil {
 		return fake.NewSessionStub()
-	} else {
-		return fake.newSessionReturns.result1, fake.newSessionReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.newSessionReturns.result1, fake.newSessionReturns.result2
 }
 
 func (fake *FakeSecureClient) NewSessionCallCount() int {
@@ -75,16 +109,33 @@ func (fake *FakeSecureClient) NewSessionReturns(result1 sshCmd.SecureSession, re
 	}{result1, result2}
 }
 
+func (fake *FakeSecureClient) NewSessionReturnsOnCall(i int, result1 sshCmd.SecureSession, result2 error) {
+	fake.NewSessionStub = nil
+	if fake.newSessionReturnsOnCall == nil {
+		fake.newSessionReturnsOnCall = make(map[int]struct {
+			result1 sshCmd.SecureSession
+			result2 error
+		})
+	}
+	fake.newSessionReturnsOnCall[i] = struct {
+		result1 sshCmd.SecureSession
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureClient) Conn() ssh.Conn {
 	fake.connMutex.Lock()
+	ret, specificReturn := fake.connReturnsOnCall[len(fake.connArgsForCall)]
 	fake.connArgsForCall = append(fake.connArgsForCall, struct{}{})
 	fake.recordInvocation("Conn", []interface{}{})
 	fake.connMutex.Unlock()
 	if fake.ConnStub != nil {
 		return fake.ConnStub()
-	} else {
-		return fake.connReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.connReturns.result1
 }
 
 func (fake *FakeSecureClient) ConnCallCount() int {
@@ -100,8 +151,21 @@ func (fake *FakeSecureClient) ConnReturns(result1 ssh.Conn) {
 	}{result1}
 }
 
+func (fake *FakeSecureClient) ConnReturnsOnCall(i int, result1 ssh.Conn) {
+	fake.ConnStub = nil
+	if fake.connReturnsOnCall == nil {
+		fake.connReturnsOnCall = make(map[int]struct {
+			result1 ssh.Conn
+		})
+	}
+	fake.connReturnsOnCall[i] = struct {
+		result1 ssh.Conn
+	}{result1}
+}
+
 func (fake *FakeSecureClient) Dial(network string, address string) (net.Conn, error) {
 	fake.dialMutex.Lock()
+	ret, specificReturn := fake.dialReturnsOnCall[len(fake.dialArgsForCall)]
 	fake.dialArgsForCall = append(fake.dialArgsForCall, struct {
 		network string
 		address string
@@ -110,9 +174,11 @@ func (fake *FakeSecureClient) Dial(network string, address string) (net.Conn, er
 	fake.dialMutex.Unlock()
 	if fake.DialStub != nil {
 		return fake.DialStub(network, address)
-	} else {
-		return fake.dialReturns.result1, fake.dialReturns.result2
 	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.dialReturns.result1, fake.dialReturns.result2
 }
 
 func (fake *FakeSecureClient) DialCallCount() int {
@@ -135,16 +201,85 @@ func (fake *FakeSecureClient) DialReturns(result1 net.Conn, result2 error) {
 	}{result1, result2}
 }
 
+func (fake *FakeSecureClient) DialReturnsOnCall(i int, result1 net.Conn, result2 error) {
+	fake.DialStub = nil
+	if fake.dialReturnsOnCall == nil {
+		fake.dialReturnsOnCall = make(map[int]struct {
+			result1 net.Conn
+			result2 error
+		})
+	}
+	fake.dialReturnsOnCall[i] = struct {
+		result1 net.Conn
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureClient) Listen(network string, address string) (net.Listener, error) {
+	fake.listenMutex.Lock()
+	ret, specificReturn := fake.listenReturnsOnCall[len(fake.listenArgsForCall)]
+	fake.listenArgsForCall = append(fake.listenArgsForCall, struct {
+		network string
+		address string
+	}{network, address})
+	fake.recordInvocation("Listen", []interface{}{network, address})
+	fake.listenMutex.Unlock()
+	if fake.ListenStub != nil {
+		return fake.ListenStub(network, address)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.listenReturns.result1, fake.listenReturns.result2
+}
+
+func (fake *FakeSecureClient) ListenCallCount() int {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return len(fake.listenArgsForCall)
+}
+
+func (fake *FakeSecureClient) ListenArgsForCall(i int) (string, string) {
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
+	return fake.listenArgsForCall[i].network, fake.listenArgsForCall[i].address
+}
+
+func (fake *FakeSecureClient) ListenReturns(result1 net.Listener, result2 error) {
+	fake.ListenStub = nil
+	fake.listenReturns = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeSecureClient) ListenReturnsOnCall(i int, result1 net.Listener, result2 error) {
+	fake.ListenStub = nil
+	if fake.listenReturnsOnCall == nil {
+		fake.listenReturnsOnCall = make(map[int]struct {
+			result1 net.Listener
+			result2 error
+		})
+	}
+	fake.listenReturnsOnCall[i] = struct {
+		result1 net.Listener
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeSecureClient) Wait() error {
 	fake.waitMutex.Lock()
+	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct{}{})
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
 	if fake.WaitStub != nil {
 		return fake.WaitStub()
-	} else {
-		return fake.waitReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitReturns.result1
 }
 
 func (fake *FakeSecureClient) WaitCallCount() int {
@@ -160,16 +295,31 @@ func (fake *FakeSecureClient) WaitReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureClient) WaitReturnsOnCall(i int, result1 error) {
+	fake.WaitStub = nil
+	if fake.waitReturnsOnCall == nil {
+		fake.waitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureClient) Close() error {
 	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct{}{})
 	fake.recordInvocation("Close", []interface{}{})
 	fake.closeMutex.Unlock()
 	if fake.CloseStub != nil {
 		return fake.CloseStub()
-	} else {
-		return fake.closeReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeReturns.result1
 }
 
 func (fake *FakeSecureClient) CloseCallCount() int {
@@ -185,6 +335,18 @@ func (fake *FakeSecureClient) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureClient) CloseReturnsOnCall(i int, result1 error) {
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -194,6 +356,8 @@ func (fake *FakeSecureClient) Invocations() map[string][][]interface{} {
 	defer fake.connMutex.RUnlock()
 	fake.dialMutex.RLock()
 	defer fake.dialMutex.RUnlock()
+	fake.listenMutex.RLock()
+	defer fake.listenMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/ssh"
 	"code.cloudfoundry.org/cli/cf/ssh/options"
+	"code.cloudfoundry.org/cli/cf/ssh/scp"
 )
 
 type FakeSecureShell struct {
@@ -17,36 +18,96 @@ type FakeSecureShell struct {
 	connectReturns struct {
 		result1 error
 	}
+	connectReturnsOnCall map[int]struct {
+		result1 error
+	}
 	InteractiveSessionStub        func() error
 	interactiveSessionMutex       sync.RWMutex
 	interactiveSessionArgsForCall []struct{}
 	interactiveSessionReturns     struct {
 		result1 error
 	}
+	interactiveSessionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	LocalPortForwardStub        func() error
 	localPortForwardMutex       sync.RWMutex
 	localPortForwardArgsForCall []struct{}
 	localPortForwardReturns     struct {
 		result1 error
 	}
+	localPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	RemotePortForwardStub        func() error
+	remotePortForwardMutex       sync.RWMutex
+	remotePortForwardArgsForCall []struct{}
+	remotePortForwardReturns     struct {
+		result1 error
+	}
+	remotePortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DynamicPortForwardStub        func() error
+	dynamicPortForwardMutex       sync.RWMutex
+	dynamicPortForwardArgsForCall []struct{}
+	dynamicPortForwardReturns     struct {
+		result1 error
+	}
+	dynamicPortForwardReturnsOnCall map[int]struct {
+		result1 error
+	}
+	UploadStub        func(localPaths []string, remotePath string, opts scp.Options) error
+	uploadMutex       sync.RWMutex
+	uploadArgsForCall []struct {
+		localPaths []string
+		remotePath string
+		opts       scp.Options
+	}
+	uploadReturns struct {
+		result1 error
+	}
+	uploadReturnsOnCall map[int]struct {
+		result1 error
+	}
+	DownloadStub        func(remotePath string, localPath string, opts scp.Options) error
+	downloadMutex       sync.RWMutex
+	downloadArgsForCall []struct {
+		remotePath string
+		localPath  string
+		opts       scp.Options
+	}
+	downloadReturns struct {
+		result1 error
+	}
+	downloadReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WaitStub        func() error
 	waitMutex       sync.RWMutex
 	waitArgsForCall []struct{}
 	waitReturns     struct {
 		result1 error
 	}
+	waitReturnsOnCall map[int]struct {
+		result1 error
+	}
 	CloseStub        func() error
 	closeMutex       sync.RWMutex
 	closeArgsForCall []struct{}
 	closeReturns     struct {
 		result1 error
 	}
+	closeReturnsOnCall map[int]struct {
+		result1 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
 func (fake *FakeSecureShell) Connect(opts *options.SSHOptions) error {
 	fake.connectMutex.Lock()
+	ret, specificReturn := fake.connectReturnsOnCall[len(fake.connectArgsForCall)]
 	fake.connectArgsForCall = append(fake.connectArgsForCall, struct {
 		opts *options.SSHOptions
 	}{opts})
@@ -54,9 +115,11 @@ func (fake *FakeSecureShell) Connect(opts *options.SSHOptions) error {
 	fake.connectMutex.Unlock()
 	if fake.ConnectStub != nil {
 		return fake.ConnectStub(opts)
-	} else {
-		return fake.connectReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.connectReturns.result1
 }
 
 func (fake *FakeSecureShell) ConnectCallCount() int {
@@ -78,16 +141,31 @@ func (fake *FakeSecureShell) ConnectReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) ConnectReturnsOnCall(i int, result1 error) {
+	fake.ConnectStub = nil
+	if fake.connectReturnsOnCall == nil {
+		fake.connectReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.connectReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) InteractiveSession() error {
 	fake.interactiveSessionMutex.Lock()
+	ret, specificReturn := fake.interactiveSessionReturnsOnCall[len(fake.interactiveSessionArgsForCall)]
 	fake.interactiveSessionArgsForCall = append(fake.interactiveSessionArgsForCall, struct{}{})
 	fake.recordInvocation("InteractiveSession", []interface{}{})
 	fake.interactiveSessionMutex.Unlock()
 	if fake.InteractiveSessionStub != nil {
 		return fake.InteractiveSessionStub()
-	} else {
-		return fake.interactiveSessionReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.interactiveSessionReturns.result1
 }
 
 func (fake *FakeSecureShell) InteractiveSessionCallCount() int {
@@ -103,16 +181,31 @@ func (fake *FakeSecureShell) InteractiveSessionReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) InteractiveSessionReturnsOnCall(i int, result1 error) {
+	fake.InteractiveSessionStub = nil
+	if fake.interactiveSessionReturnsOnCall == nil {
+		fake.interactiveSessionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.interactiveSessionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) LocalPortForward() error {
 	fake.localPortForwardMutex.Lock()
+	ret, specificReturn := fake.localPortForwardReturnsOnCall[len(fake.localPortForwardArgsForCall)]
 	fake.localPortForwardArgsForCall = append(fake.localPortForwardArgsForCall, struct{}{})
 	fake.recordInvocation("LocalPortForward", []interface{}{})
 	fake.localPortForwardMutex.Unlock()
 	if fake.LocalPortForwardStub != nil {
 		return fake.LocalPortForwardStub()
-	} else {
-		return fake.localPortForwardReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.localPortForwardReturns.result1
 }
 
 func (fake *FakeSecureShell) LocalPortForwardCallCount() int {
@@ -128,16 +221,216 @@ func (fake *FakeSecureShell) LocalPortForwardReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) LocalPortForwardReturnsOnCall(i int, result1 error) {
+	fake.LocalPortForwardStub = nil
+	if fake.localPortForwardReturnsOnCall == nil {
+		fake.localPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.localPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForward() error {
+	fake.remotePortForwardMutex.Lock()
+	ret, specificReturn := fake.remotePortForwardReturnsOnCall[len(fake.remotePortForwardArgsForCall)]
+	fake.remotePortForwardArgsForCall = append(fake.remotePortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("RemotePortForward", []interface{}{})
+	fake.remotePortForwardMutex.Unlock()
+	if fake.RemotePortForwardStub != nil {
+		return fake.RemotePortForwardStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.remotePortForwardReturns.result1
+}
+
+func (fake *FakeSecureShell) RemotePortForwardCallCount() int {
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	return len(fake.remotePortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturns(result1 error) {
+	fake.RemotePortForwardStub = nil
+	fake.remotePortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) RemotePortForwardReturnsOnCall(i int, result1 error) {
+	fake.RemotePortForwardStub = nil
+	if fake.remotePortForwardReturnsOnCall == nil {
+		fake.remotePortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.remotePortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForward() error {
+	fake.dynamicPortForwardMutex.Lock()
+	ret, specificReturn := fake.dynamicPortForwardReturnsOnCall[len(fake.dynamicPortForwardArgsForCall)]
+	fake.dynamicPortForwardArgsForCall = append(fake.dynamicPortForwardArgsForCall, struct{}{})
+	fake.recordInvocation("DynamicPortForward", []interface{}{})
+	fake.dynamicPortForwardMutex.Unlock()
+	if fake.DynamicPortForwardStub != nil {
+		return fake.DynamicPortForwardStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.dynamicPortForwardReturns.result1
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardCallCount() int {
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	return len(fake.dynamicPortForwardArgsForCall)
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturns(result1 error) {
+	fake.DynamicPortForwardStub = nil
+	fake.dynamicPortForwardReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DynamicPortForwardReturnsOnCall(i int, result1 error) {
+	fake.DynamicPortForwardStub = nil
+	if fake.dynamicPortForwardReturnsOnCall == nil {
+		fake.dynamicPortForwardReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.dynamicPortForwardReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) Upload(localPaths []string, remotePath string, opts scp.Options) error {
+	var localPathsCopy []string
+	if localPaths != nil {
+		localPathsCopy = make([]string, len(localPaths))
+		copy(localPathsCopy, localPaths)
+	}
+	fake.uploadMutex.Lock()
+	ret, specificReturn := fake.uploadReturnsOnCall[len(fake.uploadArgsForCall)]
+	fake.uploadArgsForCall = append(fake.uploadArgsForCall, struct {
+		localPaths []string
+		remotePath string
+		opts       scp.Options
+	}{localPathsCopy, remotePath, opts})
+	fake.recordInvocation("Upload", []interface{}{localPathsCopy, remotePath, opts})
+	fake.uploadMutex.Unlock()
+	if fake.UploadStub != nil {
+		return fake.UploadStub(localPaths, remotePath, opts)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uploadReturns.result1
+}
+
+func (fake *FakeSecureShell) UploadCallCount() int {
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	return len(fake.uploadArgsForCall)
+}
+
+func (fake *FakeSecureShell) UploadArgsForCall(i int) ([]string, string, scp.Options) {
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	return fake.uploadArgsForCall[i].localPaths, fake.uploadArgsForCall[i].remotePath, fake.uploadArgsForCall[i].opts
+}
+
+func (fake *FakeSecureShell) UploadReturns(result1 error) {
+	fake.UploadStub = nil
+	fake.uploadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) UploadReturnsOnCall(i int, result1 error) {
+	fake.UploadStub = nil
+	if fake.uploadReturnsOnCall == nil {
+		fake.uploadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.uploadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) Download(remotePath string, localPath string, opts scp.Options) error {
+	fake.downloadMutex.Lock()
+	ret, specificReturn := fake.downloadReturnsOnCall[len(fake.downloadArgsForCall)]
+	fake.downloadArgsForCall = append(fake.downloadArgsForCall, struct {
+		remotePath string
+		localPath  string
+		opts       scp.Options
+	}{remotePath, localPath, opts})
+	fake.recordInvocation("Download", []interface{}{remotePath, localPath, opts})
+	fake.downloadMutex.Unlock()
+	if fake.DownloadStub != nil {
+		return fake.DownloadStub(remotePath, localPath, opts)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.downloadReturns.result1
+}
+
+func (fake *FakeSecureShell) DownloadCallCount() int {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	return len(fake.downloadArgsForCall)
+}
+
+func (fake *FakeSecureShell) DownloadArgsForCall(i int) (string, string, scp.Options) {
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
+	return fake.downloadArgsForCall[i].remotePath, fake.downloadArgsForCall[i].localPath, fake.downloadArgsForCall[i].opts
+}
+
+func (fake *FakeSecureShell) DownloadReturns(result1 error) {
+	fake.DownloadStub = nil
+	fake.downloadReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeSecureShell) DownloadReturnsOnCall(i int, result1 error) {
+	fake.DownloadStub = nil
+	if fake.downloadReturnsOnCall == nil {
+		fake.downloadReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.downloadReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Wait() error {
 	fake.waitMutex.Lock()
+	ret, specificReturn := fake.waitReturnsOnCall[len(fake.waitArgsForCall)]
 	fake.waitArgsForCall = append(fake.waitArgsForCall, struct{}{})
 	fake.recordInvocation("Wait", []interface{}{})
 	fake.waitMutex.Unlock()
 	if fake.WaitStub != nil {
 		return fake.WaitStub()
-	} else {
-		return fake.waitReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.waitReturns.result1
 }
 
 func (fake *FakeSecureShell) WaitCallCount() int {
@@ -153,16 +446,31 @@ func (fake *FakeSecureShell) WaitReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) WaitReturnsOnCall(i int, result1 error) {
+	fake.WaitStub = nil
+	if fake.waitReturnsOnCall == nil {
+		fake.waitReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.waitReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Close() error {
 	fake.closeMutex.Lock()
+	ret, specificReturn := fake.closeReturnsOnCall[len(fake.closeArgsForCall)]
 	fake.closeArgsForCall = append(fake.closeArgsForCall, struct{}{})
 	fake.recordInvocation("Close", []interface{}{})
 	fake.closeMutex.Unlock()
 	if fake.CloseStub != nil {
 		return fake.CloseStub()
-	} else {
-		return fake.closeReturns.result1
 	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.closeReturns.result1
 }
 
 func (fake *FakeSecureShell) CloseCallCount() int {
@@ -178,6 +486,18 @@ func (fake *FakeSecureShell) CloseReturns(result1 error) {
 	}{result1}
 }
 
+func (fake *FakeSecureShell) CloseReturnsOnCall(i int, result1 error) {
+	fake.CloseStub = nil
+	if fake.closeReturnsOnCall == nil {
+		fake.closeReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.closeReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -187,6 +507,14 @@ func (fake *FakeSecureShell) Invocations() map[string][][]interface{} {
 	defer fake.interactiveSessionMutex.RUnlock()
 	fake.localPortForwardMutex.RLock()
 	defer fake.localPortForwardMutex.RUnlock()
+	fake.remotePortForwardMutex.RLock()
+	defer fake.remotePortForwardMutex.RUnlock()
+	fake.dynamicPortForwardMutex.RLock()
+	defer fake.dynamicPortForwardMutex.RUnlock()
+	fake.uploadMutex.RLock()
+	defer fake.uploadMutex.RUnlock()
+	fake.downloadMutex.RLock()
+	defer fake.downloadMutex.RUnlock()
 	fake.waitMutex.RLock()
 	defer fake.waitMutex.RUnlock()
 	fake.closeMutex.RLock()
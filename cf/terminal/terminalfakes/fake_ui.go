@@ -109,6 +109,26 @@ type FakeUI struct {
 	notifyUpdateIfNeededArgsForCall []struct {
 		arg1 coreconfig.Reader
 	}
+	SetOutputFormatStub        func(format string)
+	setOutputFormatMutex       sync.RWMutex
+	setOutputFormatArgsForCall []struct {
+		format string
+	}
+	StructuredOutputEnabledStub        func() bool
+	structuredOutputEnabledMutex       sync.RWMutex
+	structuredOutputEnabledArgsForCall []struct{}
+	structuredOutputEnabledReturns     struct {
+		result1 bool
+	}
+	DisplayStructuredDataStub        func(kind string, data interface{}) error
+	displayStructuredDataMutex       sync.RWMutex
+	displayStructuredDataArgsForCall []struct {
+		kind string
+		data interface{}
+	}
+	displayStructuredDataReturns struct {
+		result1 error
+	}
 	WriterStub        func() io.Writer
 	writerMutex       sync.RWMutex
 	writerArgsForCall []struct{}
@@ -543,6 +563,89 @@ func (fake *FakeUI) NotifyUpdateIfNeededArgsForCall(i int) coreconfig.Reader {
 	return fake.notifyUpdateIfNeededArgsForCall[i].arg1
 }
 
+func (fake *FakeUI) SetOutputFormat(format string) {
+	fake.setOutputFormatMutex.Lock()
+	fake.setOutputFormatArgsForCall = append(fake.setOutputFormatArgsForCall, struct {
+		format string
+	}{format})
+	fake.recordInvocation("SetOutputFormat", []interface{}{format})
+	fake.setOutputFormatMutex.Unlock()
+	if fake.SetOutputFormatStub != nil {
+		fake.SetOutputFormatStub(format)
+	}
+}
+
+func (fake *FakeUI) SetOutputFormatCallCount() int {
+	fake.setOutputFormatMutex.RLock()
+	defer fake.setOutputFormatMutex.RUnlock()
+	return len(fake.setOutputFormatArgsForCall)
+}
+
+func (fake *FakeUI) SetOutputFormatArgsForCall(i int) string {
+	fake.setOutputFormatMutex.RLock()
+	defer fake.setOutputFormatMutex.RUnlock()
+	return fake.setOutputFormatArgsForCall[i].format
+}
+
+func (fake *FakeUI) StructuredOutputEnabled() bool {
+	fake.structuredOutputEnabledMutex.Lock()
+	fake.structuredOutputEnabledArgsForCall = append(fake.structuredOutputEnabledArgsForCall, struct{}{})
+	fake.recordInvocation("StructuredOutputEnabled", []interface{}{})
+	fake.structuredOutputEnabledMutex.Unlock()
+	if fake.StructuredOutputEnabledStub != nil {
+		return fake.StructuredOutputEnabledStub()
+	} else {
+		return fake.structuredOutputEnabledReturns.result1
+	}
+}
+
+func (fake *FakeUI) StructuredOutputEnabledCallCount() int {
+	fake.structuredOutputEnabledMutex.RLock()
+	defer fake.structuredOutputEnabledMutex.RUnlock()
+	return len(fake.structuredOutputEnabledArgsForCall)
+}
+
+func (fake *FakeUI) StructuredOutputEnabledReturns(result1 bool) {
+	fake.StructuredOutputEnabledStub = nil
+	fake.structuredOutputEnabledReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeUI) DisplayStructuredData(kind string, data interface{}) error {
+	fake.displayStructuredDataMutex.Lock()
+	fake.displayStructuredDataArgsForCall = append(fake.displayStructuredDataArgsForCall, struct {
+		kind string
+		data interface{}
+	}{kind, data})
+	fake.recordInvocation("DisplayStructuredData", []interface{}{kind, data})
+	fake.displayStructuredDataMutex.Unlock()
+	if fake.DisplayStructuredDataStub != nil {
+		return fake.DisplayStructuredDataStub(kind, data)
+	} else {
+		return fake.displayStructuredDataReturns.result1
+	}
+}
+
+func (fake *FakeUI) DisplayStructuredDataCallCount() int {
+	fake.displayStructuredDataMutex.RLock()
+	defer fake.displayStructuredDataMutex.RUnlock()
+	return len(fake.displayStructuredDataArgsForCall)
+}
+
+func (fake *FakeUI) DisplayStructuredDataArgsForCall(i int) (string, interface{}) {
+	fake.displayStructuredDataMutex.RLock()
+	defer fake.displayStructuredDataMutex.RUnlock()
+	return fake.displayStructuredDataArgsForCall[i].kind, fake.displayStructuredDataArgsForCall[i].data
+}
+
+func (fake *FakeUI) DisplayStructuredDataReturns(result1 error) {
+	fake.DisplayStructuredDataStub = nil
+	fake.displayStructuredDataReturns = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeUI) Writer() io.Writer {
 	fake.writerMutex.Lock()
 	fake.writerArgsForCall = append(fake.writerArgsForCall, struct{}{})
@@ -601,6 +704,12 @@ func (fake *FakeUI) Invocations() map[string][][]interface{} {
 	defer fake.tableMutex.RUnlock()
 	fake.notifyUpdateIfNeededMutex.RLock()
 	defer fake.notifyUpdateIfNeededMutex.RUnlock()
+	fake.setOutputFormatMutex.RLock()
+	defer fake.setOutputFormatMutex.RUnlock()
+	fake.structuredOutputEnabledMutex.RLock()
+	defer fake.structuredOutputEnabledMutex.RUnlock()
+	fake.displayStructuredDataMutex.RLock()
+	defer fake.displayStructuredDataMutex.RUnlock()
 	fake.writerMutex.RLock()
 	defer fake.writerMutex.RUnlock()
 	return fake.invocations
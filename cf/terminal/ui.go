@@ -11,9 +11,12 @@ import (
 
 	"bufio"
 
+	"os"
+
 	"code.cloudfoundry.org/cli/cf"
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	"code.cloudfoundry.org/cli/cf/trace"
+	utilui "code.cloudfoundry.org/cli/util/ui"
 )
 
 type ColoringFunction func(value string, row int, col int) string
@@ -42,6 +45,10 @@ type UI interface {
 	Table(headers []string) *UITable
 	NotifyUpdateIfNeeded(coreconfig.Reader)
 
+	SetOutputFormat(format string)
+	StructuredOutputEnabled() bool
+	DisplayStructuredData(kind string, data interface{}) error
+
 	Writer() io.Writer
 }
 
@@ -52,18 +59,21 @@ type Printer interface {
 }
 
 type terminalUI struct {
-	stdin   io.Reader
-	stdout  io.Writer
-	printer Printer
-	logger  trace.Printer
+	stdin        io.Reader
+	stdout       io.Writer
+	printer      Printer
+	errPrinter   Printer
+	logger       trace.Printer
+	outputFormat utilui.OutputFormat
 }
 
 func NewUI(r io.Reader, w io.Writer, printer Printer, logger trace.Printer) UI {
 	return &terminalUI{
-		stdin:   r,
-		stdout:  w,
-		printer: printer,
-		logger:  logger,
+		stdin:      r,
+		stdout:     w,
+		printer:    printer,
+		errPrinter: NewTeePrinter(os.Stderr),
+		logger:     logger,
 	}
 }
 
@@ -92,10 +102,44 @@ func (ui *terminalUI) PrintCapturingNoOutput(message string, args ...interface{}
 
 func (ui *terminalUI) Say(message string, args ...interface{}) {
 	if len(args) == 0 {
-		_, _ = ui.printer.Printf("%s\n", message)
+		_, _ = ui.textPrinter().Printf("%s\n", message)
 	} else {
-		_, _ = ui.printer.Printf(message+"\n", args...)
+		_, _ = ui.textPrinter().Printf(message+"\n", args...)
+	}
+}
+
+// textPrinter returns the printer for human readable output, which writes to
+// stderr when structured output is enabled so that stdout only contains the
+// structured data.
+func (ui *terminalUI) textPrinter() Printer {
+	if ui.StructuredOutputEnabled() {
+		return ui.errPrinter
 	}
+	return ui.printer
+}
+
+// SetOutputFormat sets the format that DisplayStructuredData displays command
+// data in; empty for tables.
+func (ui *terminalUI) SetOutputFormat(format string) {
+	ui.outputFormat = utilui.OutputFormat(format)
+}
+
+// StructuredOutputEnabled returns true when command data should be displayed
+// with DisplayStructuredData instead of tables.
+func (ui *terminalUI) StructuredOutputEnabled() bool {
+	return ui.outputFormat != utilui.OutputTable
+}
+
+// DisplayStructuredData outputs data to stdout in the configured output
+// format, wrapped in the same versioned document as the rewritten commands.
+func (ui *terminalUI) DisplayStructuredData(kind string, data interface{}) error {
+	output, err := utilui.MarshalStructuredData(ui.outputFormat, kind, data)
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(ui.stdout, "%s\n", output)
+	return err
 }
 
 func (ui *terminalUI) Warn(message string, args ...interface{}) {
@@ -247,7 +291,7 @@ func (ui *terminalUI) ShowConfiguration(config coreconfig.Reader) error {
 }
 
 func (ui *terminalUI) LoadingIndication() {
-	_, _ = ui.printer.Print(".")
+	_, _ = ui.textPrinter().Print(".")
 }
 
 func (ui *terminalUI) Table(headers []string) *UITable {
@@ -2,6 +2,7 @@ package terminal_test
 
 import (
 	"io"
+	"io/ioutil"
 	"os"
 	"strings"
 
@@ -380,6 +381,71 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("structured output", func() {
+		var (
+			out            *gbytes.Buffer
+			stderr         *os.File
+			originalStderr *os.File
+			ui             UI
+		)
+
+		BeforeEach(func() {
+			var err error
+			stderr, err = ioutil.TempFile("", "stderr")
+			Expect(err).NotTo(HaveOccurred())
+
+			originalStderr = os.Stderr
+			os.Stderr = stderr
+
+			out = gbytes.NewBuffer()
+			ui = NewUI(os.Stdin, out, NewTeePrinter(out), fakeLogger)
+		})
+
+		AfterEach(func() {
+			os.Stderr = originalStderr
+			stderr.Close()
+			os.Remove(stderr.Name())
+		})
+
+		It("is disabled by default", func() {
+			Expect(ui.StructuredOutputEnabled()).To(BeFalse())
+
+			ui.Say("Hello")
+			Expect(out).To(gbytes.Say("Hello"))
+		})
+
+		Context("when the output format is json", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat("json")
+			})
+
+			It("displays the data as a versioned JSON document and all other output to stderr", func() {
+				Expect(ui.StructuredOutputEnabled()).To(BeTrue())
+
+				ui.Say("Hello")
+				ui.Ok()
+				Expect(ui.DisplayStructuredData("some-kind", []string{"some-data"})).To(Succeed())
+
+				Expect(out.Contents()).To(MatchJSON(`{"schema_version": 1, "kind": "some-kind", "data": ["some-data"]}`))
+
+				errOutput, err := ioutil.ReadFile(stderr.Name())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(Decolorize(string(errOutput))).To(Equal("Hello\nOK\n"))
+			})
+		})
+
+		Context("when the output format is yaml", func() {
+			BeforeEach(func() {
+				ui.SetOutputFormat("yaml")
+			})
+
+			It("displays the data as a versioned YAML document", func() {
+				Expect(ui.DisplayStructuredData("some-kind", []string{"some-data"})).To(Succeed())
+				Expect(string(out.Contents())).To(Equal("schema_version: 1\nkind: some-kind\ndata:\n- some-data\n"))
+			})
+		})
+	})
+
 	Describe("NotifyUpdateIfNeeded", func() {
 		var (
 			output []string
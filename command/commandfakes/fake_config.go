@@ -34,6 +34,15 @@ type FakeConfig struct {
 	aPIVersionReturnsOnCall map[int]struct {
 		result1 string
 	}
+	AuthorizationEndpointStub        func() string
+	authorizationEndpointMutex       sync.RWMutex
+	authorizationEndpointArgsForCall []struct{}
+	authorizationEndpointReturns     struct {
+		result1 string
+	}
+	authorizationEndpointReturnsOnCall map[int]struct {
+		result1 string
+	}
 	BinaryNameStub        func() string
 	binaryNameMutex       sync.RWMutex
 	binaryNameArgsForCall []struct{}
@@ -61,6 +70,24 @@ type FakeConfig struct {
 	colorEnabledReturnsOnCall map[int]struct {
 		result1 configv3.ColorSetting
 	}
+	ContextsStub        func() []configv3.NamedContext
+	contextsMutex       sync.RWMutex
+	contextsArgsForCall []struct{}
+	contextsReturns     struct {
+		result1 []configv3.NamedContext
+	}
+	contextsReturnsOnCall map[int]struct {
+		result1 []configv3.NamedContext
+	}
+	CurrentContextStub        func() string
+	currentContextMutex       sync.RWMutex
+	currentContextArgsForCall []struct{}
+	currentContextReturns     struct {
+		result1 string
+	}
+	currentContextReturnsOnCall map[int]struct {
+		result1 string
+	}
 	CurrentUserStub        func() (configv3.User, error)
 	currentUserMutex       sync.RWMutex
 	currentUserArgsForCall []struct{}
@@ -198,6 +225,29 @@ type FakeConfig struct {
 	removePluginArgsForCall []struct {
 		arg1 string
 	}
+	ResponseCacheDirectoryStub        func() string
+	responseCacheDirectoryMutex       sync.RWMutex
+	responseCacheDirectoryArgsForCall []struct{}
+	responseCacheDirectoryReturns     struct {
+		result1 string
+	}
+	responseCacheDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	ResponseCacheTTLStub        func() time.Duration
+	responseCacheTTLMutex       sync.RWMutex
+	responseCacheTTLArgsForCall []struct{}
+	responseCacheTTLReturns     struct {
+		result1 time.Duration
+	}
+	responseCacheTTLReturnsOnCall map[int]struct {
+		result1 time.Duration
+	}
+	SaveContextStub        func(name string)
+	saveContextMutex       sync.RWMutex
+	saveContextArgsForCall []struct {
+		name string
+	}
 	SetAccessTokenStub        func(token string)
 	setAccessTokenMutex       sync.RWMutex
 	setAccessTokenArgsForCall []struct {
@@ -240,6 +290,17 @@ type FakeConfig struct {
 		refreshToken   string
 		sshOAuthClient string
 	}
+	SetUAAClientCredentialsStub        func(client string, clientSecret string)
+	setUAAClientCredentialsMutex       sync.RWMutex
+	setUAAClientCredentialsArgsForCall []struct {
+		client       string
+		clientSecret string
+	}
+	SetUAAGrantTypeStub        func(uaaGrantType string)
+	setUAAGrantTypeMutex       sync.RWMutex
+	setUAAGrantTypeArgsForCall []struct {
+		uaaGrantType string
+	}
 	SkipSSLValidationStub        func() bool
 	skipSSLValidationMutex       sync.RWMutex
 	skipSSLValidationArgsForCall []struct{}
@@ -294,6 +355,15 @@ type FakeConfig struct {
 	targetReturnsOnCall map[int]struct {
 		result1 string
 	}
+	UAAGrantTypeStub        func() string
+	uAAGrantTypeMutex       sync.RWMutex
+	uAAGrantTypeArgsForCall []struct{}
+	uAAGrantTypeReturns     struct {
+		result1 string
+	}
+	uAAGrantTypeReturnsOnCall map[int]struct {
+		result1 string
+	}
 	UAAOAuthClientSecretStub        func() string
 	uAAOAuthClientSecretMutex       sync.RWMutex
 	uAAOAuthClientSecretArgsForCall []struct{}
@@ -318,10 +388,30 @@ type FakeConfig struct {
 	UnsetSpaceInformationStub               func()
 	unsetSpaceInformationMutex              sync.RWMutex
 	unsetSpaceInformationArgsForCall        []struct{}
-	VerboseStub                             func() (bool, []string)
-	verboseMutex                            sync.RWMutex
-	verboseArgsForCall                      []struct{}
-	verboseReturns                          struct {
+	UploadStateDirectoryStub                func() string
+	uploadStateDirectoryMutex               sync.RWMutex
+	uploadStateDirectoryArgsForCall         []struct{}
+	uploadStateDirectoryReturns             struct {
+		result1 string
+	}
+	uploadStateDirectoryReturnsOnCall map[int]struct {
+		result1 string
+	}
+	UseContextStub        func(name string) error
+	useContextMutex       sync.RWMutex
+	useContextArgsForCall []struct {
+		name string
+	}
+	useContextReturns struct {
+		result1 error
+	}
+	useContextReturnsOnCall map[int]struct {
+		result1 error
+	}
+	VerboseStub        func() (bool, []string)
+	verboseMutex       sync.RWMutex
+	verboseArgsForCall []struct{}
+	verboseReturns     struct {
 		result1 bool
 		result2 []string
 	}
@@ -447,6 +537,46 @@ func (fake *FakeConfig) APIVersionReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) AuthorizationEndpoint() string {
+	fake.authorizationEndpointMutex.Lock()
+	ret, specificReturn := fake.authorizationEndpointReturnsOnCall[len(fake.authorizationEndpointArgsForCall)]
+	fake.authorizationEndpointArgsForCall = append(fake.authorizationEndpointArgsForCall, struct{}{})
+	fake.recordInvocation("AuthorizationEndpoint", []interface{}{})
+	fake.authorizationEndpointMutex.Unlock()
+	if fake.AuthorizationEndpointStub != nil {
+		return fake.AuthorizationEndpointStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.authorizationEndpointReturns.result1
+}
+
+func (fake *FakeConfig) AuthorizationEndpointCallCount() int {
+	fake.authorizationEndpointMutex.RLock()
+	defer fake.authorizationEndpointMutex.RUnlock()
+	return len(fake.authorizationEndpointArgsForCall)
+}
+
+func (fake *FakeConfig) AuthorizationEndpointReturns(result1 string) {
+	fake.AuthorizationEndpointStub = nil
+	fake.authorizationEndpointReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) AuthorizationEndpointReturnsOnCall(i int, result1 string) {
+	fake.AuthorizationEndpointStub = nil
+	if fake.authorizationEndpointReturnsOnCall == nil {
+		fake.authorizationEndpointReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.authorizationEndpointReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) BinaryName() string {
 	fake.binaryNameMutex.Lock()
 	ret, specificReturn := fake.binaryNameReturnsOnCall[len(fake.binaryNameArgsForCall)]
@@ -567,6 +697,86 @@ func (fake *FakeConfig) ColorEnabledReturnsOnCall(i int, result1 configv3.ColorS
 	}{result1}
 }
 
+func (fake *FakeConfig) Contexts() []configv3.NamedContext {
+	fake.contextsMutex.Lock()
+	ret, specificReturn := fake.contextsReturnsOnCall[len(fake.contextsArgsForCall)]
+	fake.contextsArgsForCall = append(fake.contextsArgsForCall, struct{}{})
+	fake.recordInvocation("Contexts", []interface{}{})
+	fake.contextsMutex.Unlock()
+	if fake.ContextsStub != nil {
+		return fake.ContextsStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.contextsReturns.result1
+}
+
+func (fake *FakeConfig) ContextsCallCount() int {
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	return len(fake.contextsArgsForCall)
+}
+
+func (fake *FakeConfig) ContextsReturns(result1 []configv3.NamedContext) {
+	fake.ContextsStub = nil
+	fake.contextsReturns = struct {
+		result1 []configv3.NamedContext
+	}{result1}
+}
+
+func (fake *FakeConfig) ContextsReturnsOnCall(i int, result1 []configv3.NamedContext) {
+	fake.ContextsStub = nil
+	if fake.contextsReturnsOnCall == nil {
+		fake.contextsReturnsOnCall = make(map[int]struct {
+			result1 []configv3.NamedContext
+		})
+	}
+	fake.contextsReturnsOnCall[i] = struct {
+		result1 []configv3.NamedContext
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContext() string {
+	fake.currentContextMutex.Lock()
+	ret, specificReturn := fake.currentContextReturnsOnCall[len(fake.currentContextArgsForCall)]
+	fake.currentContextArgsForCall = append(fake.currentContextArgsForCall, struct{}{})
+	fake.recordInvocation("CurrentContext", []interface{}{})
+	fake.currentContextMutex.Unlock()
+	if fake.CurrentContextStub != nil {
+		return fake.CurrentContextStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.currentContextReturns.result1
+}
+
+func (fake *FakeConfig) CurrentContextCallCount() int {
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
+	return len(fake.currentContextArgsForCall)
+}
+
+func (fake *FakeConfig) CurrentContextReturns(result1 string) {
+	fake.CurrentContextStub = nil
+	fake.currentContextReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) CurrentContextReturnsOnCall(i int, result1 string) {
+	fake.CurrentContextStub = nil
+	if fake.currentContextReturnsOnCall == nil {
+		fake.currentContextReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.currentContextReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) CurrentUser() (configv3.User, error) {
 	fake.currentUserMutex.Lock()
 	ret, specificReturn := fake.currentUserReturnsOnCall[len(fake.currentUserArgsForCall)]
@@ -1165,6 +1375,110 @@ func (fake *FakeConfig) RemovePluginArgsForCall(i int) string {
 	return fake.removePluginArgsForCall[i].arg1
 }
 
+func (fake *FakeConfig) ResponseCacheDirectory() string {
+	fake.responseCacheDirectoryMutex.Lock()
+	ret, specificReturn := fake.responseCacheDirectoryReturnsOnCall[len(fake.responseCacheDirectoryArgsForCall)]
+	fake.responseCacheDirectoryArgsForCall = append(fake.responseCacheDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheDirectory", []interface{}{})
+	fake.responseCacheDirectoryMutex.Unlock()
+	if fake.ResponseCacheDirectoryStub != nil {
+		return fake.ResponseCacheDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheDirectoryCallCount() int {
+	fake.responseCacheDirectoryMutex.RLock()
+	defer fake.responseCacheDirectoryMutex.RUnlock()
+	return len(fake.responseCacheDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheDirectoryReturns(result1 string) {
+	fake.ResponseCacheDirectoryStub = nil
+	fake.responseCacheDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheDirectoryReturnsOnCall(i int, result1 string) {
+	fake.ResponseCacheDirectoryStub = nil
+	if fake.responseCacheDirectoryReturnsOnCall == nil {
+		fake.responseCacheDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.responseCacheDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheTTL() time.Duration {
+	fake.responseCacheTTLMutex.Lock()
+	ret, specificReturn := fake.responseCacheTTLReturnsOnCall[len(fake.responseCacheTTLArgsForCall)]
+	fake.responseCacheTTLArgsForCall = append(fake.responseCacheTTLArgsForCall, struct{}{})
+	fake.recordInvocation("ResponseCacheTTL", []interface{}{})
+	fake.responseCacheTTLMutex.Unlock()
+	if fake.ResponseCacheTTLStub != nil {
+		return fake.ResponseCacheTTLStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.responseCacheTTLReturns.result1
+}
+
+func (fake *FakeConfig) ResponseCacheTTLCallCount() int {
+	fake.responseCacheTTLMutex.RLock()
+	defer fake.responseCacheTTLMutex.RUnlock()
+	return len(fake.responseCacheTTLArgsForCall)
+}
+
+func (fake *FakeConfig) ResponseCacheTTLReturns(result1 time.Duration) {
+	fake.ResponseCacheTTLStub = nil
+	fake.responseCacheTTLReturns = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) ResponseCacheTTLReturnsOnCall(i int, result1 time.Duration) {
+	fake.ResponseCacheTTLStub = nil
+	if fake.responseCacheTTLReturnsOnCall == nil {
+		fake.responseCacheTTLReturnsOnCall = make(map[int]struct {
+			result1 time.Duration
+		})
+	}
+	fake.responseCacheTTLReturnsOnCall[i] = struct {
+		result1 time.Duration
+	}{result1}
+}
+
+func (fake *FakeConfig) SaveContext(name string) {
+	fake.saveContextMutex.Lock()
+	fake.saveContextArgsForCall = append(fake.saveContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("SaveContext", []interface{}{name})
+	fake.saveContextMutex.Unlock()
+	if fake.SaveContextStub != nil {
+		fake.SaveContextStub(name)
+	}
+}
+
+func (fake *FakeConfig) SaveContextCallCount() int {
+	fake.saveContextMutex.RLock()
+	defer fake.saveContextMutex.RUnlock()
+	return len(fake.saveContextArgsForCall)
+}
+
+func (fake *FakeConfig) SaveContextArgsForCall(i int) string {
+	fake.saveContextMutex.RLock()
+	defer fake.saveContextMutex.RUnlock()
+	return fake.saveContextArgsForCall[i].name
+}
+
 func (fake *FakeConfig) SetAccessToken(token string) {
 	fake.setAccessTokenMutex.Lock()
 	fake.setAccessTokenArgsForCall = append(fake.setAccessTokenArgsForCall, struct {
@@ -1321,6 +1635,55 @@ func (fake *FakeConfig) SetTokenInformationArgsForCall(i int) (string, string, s
 	return fake.setTokenInformationArgsForCall[i].accessToken, fake.setTokenInformationArgsForCall[i].refreshToken, fake.setTokenInformationArgsForCall[i].sshOAuthClient
 }
 
+func (fake *FakeConfig) SetUAAClientCredentials(client string, clientSecret string) {
+	fake.setUAAClientCredentialsMutex.Lock()
+	fake.setUAAClientCredentialsArgsForCall = append(fake.setUAAClientCredentialsArgsForCall, struct {
+		client       string
+		clientSecret string
+	}{client, clientSecret})
+	fake.recordInvocation("SetUAAClientCredentials", []interface{}{client, clientSecret})
+	fake.setUAAClientCredentialsMutex.Unlock()
+	if fake.SetUAAClientCredentialsStub != nil {
+		fake.SetUAAClientCredentialsStub(client, clientSecret)
+	}
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsCallCount() int {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return len(fake.setUAAClientCredentialsArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAClientCredentialsArgsForCall(i int) (string, string) {
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	return fake.setUAAClientCredentialsArgsForCall[i].client, fake.setUAAClientCredentialsArgsForCall[i].clientSecret
+}
+
+func (fake *FakeConfig) SetUAAGrantType(uaaGrantType string) {
+	fake.setUAAGrantTypeMutex.Lock()
+	fake.setUAAGrantTypeArgsForCall = append(fake.setUAAGrantTypeArgsForCall, struct {
+		uaaGrantType string
+	}{uaaGrantType})
+	fake.recordInvocation("SetUAAGrantType", []interface{}{uaaGrantType})
+	fake.setUAAGrantTypeMutex.Unlock()
+	if fake.SetUAAGrantTypeStub != nil {
+		fake.SetUAAGrantTypeStub(uaaGrantType)
+	}
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeCallCount() int {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return len(fake.setUAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) SetUAAGrantTypeArgsForCall(i int) string {
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
+	return fake.setUAAGrantTypeArgsForCall[i].uaaGrantType
+}
+
 func (fake *FakeConfig) SkipSSLValidation() bool {
 	fake.skipSSLValidationMutex.Lock()
 	ret, specificReturn := fake.skipSSLValidationReturnsOnCall[len(fake.skipSSLValidationArgsForCall)]
@@ -1561,6 +1924,46 @@ func (fake *FakeConfig) TargetReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeConfig) UAAGrantType() string {
+	fake.uAAGrantTypeMutex.Lock()
+	ret, specificReturn := fake.uAAGrantTypeReturnsOnCall[len(fake.uAAGrantTypeArgsForCall)]
+	fake.uAAGrantTypeArgsForCall = append(fake.uAAGrantTypeArgsForCall, struct{}{})
+	fake.recordInvocation("UAAGrantType", []interface{}{})
+	fake.uAAGrantTypeMutex.Unlock()
+	if fake.UAAGrantTypeStub != nil {
+		return fake.UAAGrantTypeStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uAAGrantTypeReturns.result1
+}
+
+func (fake *FakeConfig) UAAGrantTypeCallCount() int {
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
+	return len(fake.uAAGrantTypeArgsForCall)
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturns(result1 string) {
+	fake.UAAGrantTypeStub = nil
+	fake.uAAGrantTypeReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UAAGrantTypeReturnsOnCall(i int, result1 string) {
+	fake.UAAGrantTypeStub = nil
+	if fake.uAAGrantTypeReturnsOnCall == nil {
+		fake.uAAGrantTypeReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uAAGrantTypeReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
 func (fake *FakeConfig) UAAOAuthClientSecret() string {
 	fake.uAAOAuthClientSecretMutex.Lock()
 	ret, specificReturn := fake.uAAOAuthClientSecretReturnsOnCall[len(fake.uAAOAuthClientSecretArgsForCall)]
@@ -1673,6 +2076,94 @@ func (fake *FakeConfig) UnsetSpaceInformationCallCount() int {
 	return len(fake.unsetSpaceInformationArgsForCall)
 }
 
+func (fake *FakeConfig) UploadStateDirectory() string {
+	fake.uploadStateDirectoryMutex.Lock()
+	ret, specificReturn := fake.uploadStateDirectoryReturnsOnCall[len(fake.uploadStateDirectoryArgsForCall)]
+	fake.uploadStateDirectoryArgsForCall = append(fake.uploadStateDirectoryArgsForCall, struct{}{})
+	fake.recordInvocation("UploadStateDirectory", []interface{}{})
+	fake.uploadStateDirectoryMutex.Unlock()
+	if fake.UploadStateDirectoryStub != nil {
+		return fake.UploadStateDirectoryStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.uploadStateDirectoryReturns.result1
+}
+
+func (fake *FakeConfig) UploadStateDirectoryCallCount() int {
+	fake.uploadStateDirectoryMutex.RLock()
+	defer fake.uploadStateDirectoryMutex.RUnlock()
+	return len(fake.uploadStateDirectoryArgsForCall)
+}
+
+func (fake *FakeConfig) UploadStateDirectoryReturns(result1 string) {
+	fake.UploadStateDirectoryStub = nil
+	fake.uploadStateDirectoryReturns = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UploadStateDirectoryReturnsOnCall(i int, result1 string) {
+	fake.UploadStateDirectoryStub = nil
+	if fake.uploadStateDirectoryReturnsOnCall == nil {
+		fake.uploadStateDirectoryReturnsOnCall = make(map[int]struct {
+			result1 string
+		})
+	}
+	fake.uploadStateDirectoryReturnsOnCall[i] = struct {
+		result1 string
+	}{result1}
+}
+
+func (fake *FakeConfig) UseContext(name string) error {
+	fake.useContextMutex.Lock()
+	ret, specificReturn := fake.useContextReturnsOnCall[len(fake.useContextArgsForCall)]
+	fake.useContextArgsForCall = append(fake.useContextArgsForCall, struct {
+		name string
+	}{name})
+	fake.recordInvocation("UseContext", []interface{}{name})
+	fake.useContextMutex.Unlock()
+	if fake.UseContextStub != nil {
+		return fake.UseContextStub(name)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.useContextReturns.result1
+}
+
+func (fake *FakeConfig) UseContextCallCount() int {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return len(fake.useContextArgsForCall)
+}
+
+func (fake *FakeConfig) UseContextArgsForCall(i int) string {
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
+	return fake.useContextArgsForCall[i].name
+}
+
+func (fake *FakeConfig) UseContextReturns(result1 error) {
+	fake.UseContextStub = nil
+	fake.useContextReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeConfig) UseContextReturnsOnCall(i int, result1 error) {
+	fake.UseContextStub = nil
+	if fake.useContextReturnsOnCall == nil {
+		fake.useContextReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.useContextReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeConfig) Verbose() (bool, []string) {
 	fake.verboseMutex.Lock()
 	ret, specificReturn := fake.verboseReturnsOnCall[len(fake.verboseArgsForCall)]
@@ -1765,12 +2256,18 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.addPluginRepositoryMutex.RUnlock()
 	fake.aPIVersionMutex.RLock()
 	defer fake.aPIVersionMutex.RUnlock()
+	fake.authorizationEndpointMutex.RLock()
+	defer fake.authorizationEndpointMutex.RUnlock()
 	fake.binaryNameMutex.RLock()
 	defer fake.binaryNameMutex.RUnlock()
 	fake.binaryVersionMutex.RLock()
 	defer fake.binaryVersionMutex.RUnlock()
 	fake.colorEnabledMutex.RLock()
 	defer fake.colorEnabledMutex.RUnlock()
+	fake.contextsMutex.RLock()
+	defer fake.contextsMutex.RUnlock()
+	fake.currentContextMutex.RLock()
+	defer fake.currentContextMutex.RUnlock()
 	fake.currentUserMutex.RLock()
 	defer fake.currentUserMutex.RUnlock()
 	fake.dialTimeoutMutex.RLock()
@@ -1801,6 +2298,12 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.refreshTokenMutex.RUnlock()
 	fake.removePluginMutex.RLock()
 	defer fake.removePluginMutex.RUnlock()
+	fake.responseCacheDirectoryMutex.RLock()
+	defer fake.responseCacheDirectoryMutex.RUnlock()
+	fake.responseCacheTTLMutex.RLock()
+	defer fake.responseCacheTTLMutex.RUnlock()
+	fake.saveContextMutex.RLock()
+	defer fake.saveContextMutex.RUnlock()
 	fake.setAccessTokenMutex.RLock()
 	defer fake.setAccessTokenMutex.RUnlock()
 	fake.setOrganizationInformationMutex.RLock()
@@ -1813,6 +2316,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.setTargetInformationMutex.RUnlock()
 	fake.setTokenInformationMutex.RLock()
 	defer fake.setTokenInformationMutex.RUnlock()
+	fake.setUAAClientCredentialsMutex.RLock()
+	defer fake.setUAAClientCredentialsMutex.RUnlock()
+	fake.setUAAGrantTypeMutex.RLock()
+	defer fake.setUAAGrantTypeMutex.RUnlock()
 	fake.skipSSLValidationMutex.RLock()
 	defer fake.skipSSLValidationMutex.RUnlock()
 	fake.stagingTimeoutMutex.RLock()
@@ -1825,6 +2332,8 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.targetedSpaceMutex.RUnlock()
 	fake.targetMutex.RLock()
 	defer fake.targetMutex.RUnlock()
+	fake.uAAGrantTypeMutex.RLock()
+	defer fake.uAAGrantTypeMutex.RUnlock()
 	fake.uAAOAuthClientSecretMutex.RLock()
 	defer fake.uAAOAuthClientSecretMutex.RUnlock()
 	fake.uAAOAuthClientMutex.RLock()
@@ -1833,6 +2342,10 @@ func (fake *FakeConfig) Invocations() map[string][][]interface{} {
 	defer fake.unsetOrganizationInformationMutex.RUnlock()
 	fake.unsetSpaceInformationMutex.RLock()
 	defer fake.unsetSpaceInformationMutex.RUnlock()
+	fake.uploadStateDirectoryMutex.RLock()
+	defer fake.uploadStateDirectoryMutex.RUnlock()
+	fake.useContextMutex.RLock()
+	defer fake.useContextMutex.RUnlock()
 	fake.verboseMutex.RLock()
 	defer fake.verboseMutex.RUnlock()
 	fake.writePluginConfigMutex.RLock()
@@ -131,7 +131,7 @@ func (cmd HelpCommand) displayHelpFooter() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("GLOBAL OPTIONS:")
-	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(allCommandsIndent, cmd.globalOptionsTableData(), 17)
 }
 
 func (cmd HelpCommand) displayCommonCommands() {
@@ -199,7 +199,7 @@ func (cmd HelpCommand) displayCommonCommands() {
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayHeader("Global options:")
-	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 25)
+	cmd.UI.DisplayNonWrappingTable(commonCommandsIndent, cmd.globalOptionsTableData(), 17)
 	cmd.UI.DisplayNewline()
 
 	cmd.UI.DisplayText("These are commonly used commands. Use 'cf help -a' to see all, with descriptions.")
@@ -311,6 +311,8 @@ func (cmd HelpCommand) globalOptionsTableData() [][]string {
 	return [][]string{
 		{"--help, -h", cmd.UI.TranslateText("Show help")},
 		{"-v", cmd.UI.TranslateText("Print API request diagnostics to stdout")},
+		{"--output json|yaml", cmd.UI.TranslateText("Display command data as json or yaml, for commands that support it")},
+		{"--context NAME", cmd.UI.TranslateText("Run the command against a saved context without switching to it")},
 	}
 }
 
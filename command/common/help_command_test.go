@@ -413,6 +413,8 @@ var _ = Describe("help Command", func() {
 			Expect(testUI.Out).To(Say("Global options:"))
 			Expect(testUI.Out).To(Say("  --help, -h                         Show help"))
 			Expect(testUI.Out).To(Say("  -v                                 Print API request diagnostics to stdout"))
+			Expect(testUI.Out).To(Say("  --output json\\|yaml                 Display command data as json or yaml, for commands that support it"))
+			Expect(testUI.Out).To(Say("  --context NAME                     Run the command against a saved context without switching to it"))
 
 			Expect(testUI.Out).To(Say("These are commonly used commands. Use 'cf help -a' to see all, with descriptions."))
 			Expect(testUI.Out).To(Say("See 'cf help <command>' to read about a specific command."))
@@ -597,6 +599,8 @@ var _ = Describe("help Command", func() {
 				Expect(testUI.Out).To(Say("GLOBAL OPTIONS:"))
 				Expect(testUI.Out).To(Say("   --help, -h                         Show help"))
 				Expect(testUI.Out).To(Say("   -v                                 Print API request diagnostics to stdout"))
+				Expect(testUI.Out).To(Say("   --output json\\|yaml                 Display command data as json or yaml, for commands that support it"))
+				Expect(testUI.Out).To(Say("   --context NAME                     Run the command against a saved context without switching to it"))
 			})
 
 			Context("when there are multiple installed plugins", func() {
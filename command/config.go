@@ -13,9 +13,12 @@ type Config interface {
 	AccessToken() string
 	AddPluginRepository(name string, url string)
 	APIVersion() string
+	AuthorizationEndpoint() string
 	BinaryName() string
 	BinaryVersion() string
 	ColorEnabled() configv3.ColorSetting
+	Contexts() []configv3.NamedContext
+	CurrentContext() string
 	CurrentUser() (configv3.User, error)
 	DialTimeout() time.Duration
 	Experimental() bool
@@ -31,22 +34,30 @@ type Config interface {
 	PollingInterval() time.Duration
 	RefreshToken() string
 	RemovePlugin(string)
+	ResponseCacheDirectory() string
+	ResponseCacheTTL() time.Duration
+	SaveContext(name string)
 	SetAccessToken(token string)
 	SetOrganizationInformation(guid string, name string)
 	SetRefreshToken(token string)
 	SetSpaceInformation(guid string, name string, allowSSH bool)
 	SetTargetInformation(api string, apiVersion string, auth string, minCLIVersion string, doppler string, uaa string, routing string, skipSSLValidation bool)
 	SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string)
+	SetUAAClientCredentials(client string, clientSecret string)
+	SetUAAGrantType(uaaGrantType string)
 	SkipSSLValidation() bool
 	StagingTimeout() time.Duration
 	StartupTimeout() time.Duration
 	TargetedOrganization() configv3.Organization
 	TargetedSpace() configv3.Space
 	Target() string
+	UAAGrantType() string
 	UAAOAuthClientSecret() string
 	UAAOAuthClient() string
 	UnsetOrganizationInformation()
 	UnsetSpaceInformation()
+	UploadStateDirectory() string
+	UseContext(name string) error
 	Verbose() (bool, []string)
 	WritePluginConfig() error
 }
@@ -173,6 +173,52 @@ func (e RequiredArgumentError) Translate(translate func(string, ...interface{})
 	})
 }
 
+type RequiredFlagsError struct {
+	Arg1 string
+	Arg2 string
+}
+
+func (e RequiredFlagsError) Error() string {
+	return "Incorrect Usage: '{{.Arg1}}' and '{{.Arg2}}' must be used together."
+}
+
+func (e RequiredFlagsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Arg1": e.Arg1,
+		"Arg2": e.Arg2,
+	})
+}
+
+type ArgumentCombinationError struct {
+	Arg1 string
+	Arg2 string
+}
+
+func (e ArgumentCombinationError) Error() string {
+	return "Incorrect Usage: '{{.Arg1}}' and '{{.Arg2}}' cannot be used together."
+}
+
+func (e ArgumentCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Arg1": e.Arg1,
+		"Arg2": e.Arg2,
+	})
+}
+
+type UnsupportedFlagError struct {
+	Flag string
+}
+
+func (e UnsupportedFlagError) Error() string {
+	return "Incorrect Usage: '{{.Flag}}' is not supported by this command."
+}
+
+func (e UnsupportedFlagError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Flag": e.Flag,
+	})
+}
+
 type ThreeRequiredArgumentsError struct {
 	ArgumentName1 string
 	ArgumentName2 string
@@ -51,7 +51,9 @@ var _ = Describe("Translatable Errors", func() {
 		// Parse errors.
 		Entry("ParseArgumentError", ParseArgumentError{}),
 		Entry("RequiredArgumentError", RequiredArgumentError{}),
+		Entry("RequiredFlagsError", RequiredFlagsError{}),
 		Entry("ThreeRequiredArgumentsError", ThreeRequiredArgumentsError{}),
+		Entry("UnsupportedFlagError", UnsupportedFlagError{}),
 
 		// Version errors.
 		Entry("MinimumAPIVersionNotMetError", MinimumAPIVersionNotMetError{}),
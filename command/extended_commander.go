@@ -9,3 +9,9 @@ type ExtendedCommander interface {
 	flags.Commander
 	Setup(Config, UI) error
 }
+
+// StructuredOutputCommander is implemented by commands that can display their
+// data in the format given by the --output global flag.
+type StructuredOutputCommander interface {
+	StructuredOutputSupported() bool
+}
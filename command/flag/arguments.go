@@ -4,6 +4,14 @@ type AppName struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 }
 
+type OptionalAppName struct {
+	AppName string `positional-arg-name:"APP_NAME" description:"The application name"`
+}
+
+type AppNames struct {
+	AppNames []string `positional-arg-name:"APP_NAME" description:"The application names or globs"`
+}
+
 type Buildpack struct {
 	Buildpack string `positional-arg-name:"BUILDPACK" required:"true" description:"The buildpack"`
 }
@@ -81,8 +89,8 @@ type APITarget struct {
 }
 
 type Authentication struct {
-	Username string `positional-arg-name:"USERNAME" required:"true" description:"The username"`
-	Password string `positional-arg-name:"PASSWORD" required:"true" description:"The password"`
+	Username string `positional-arg-name:"USERNAME" description:"The username"`
+	Password string `positional-arg-name:"PASSWORD" description:"The password"`
 }
 
 type CreateUser struct {
@@ -137,6 +145,11 @@ type UnbindSecurityGroupArgs struct {
 	SpaceName         string `positional-arg-name:"SPACE" description:"The space name"`
 }
 
+type EnvDiffArgs struct {
+	AppName      string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
+	OtherAppName string `positional-arg-name:"OTHER_APP_NAME" description:"The name of the application to compare with"`
+}
+
 type FilesArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
 	Path    string `positional-arg-name:"PATH" description:"The file path"`
@@ -144,8 +157,8 @@ type FilesArgs struct {
 
 type SetEnvironmentArgs struct {
 	AppName                  string              `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
-	EnvironmentVariableName  string              `positional-arg-name:"ENV_VAR_NAME" required:"true" description:"The environment variable name"`
-	EnvironmentVariableValue EnvironmentVariable `positional-arg-name:"ENV_VAR_VALUE" required:"true" description:"The environment variable value"`
+	EnvironmentVariableName  string              `positional-arg-name:"ENV_VAR_NAME" description:"The environment variable name"`
+	EnvironmentVariableValue EnvironmentVariable `positional-arg-name:"ENV_VAR_VALUE" description:"The environment variable value"`
 }
 
 type UnsetEnvironmentArgs struct {
@@ -281,7 +294,7 @@ type InstallPluginArgs struct {
 
 type RunTaskArgs struct {
 	AppName string `positional-arg-name:"APP_NAME" required:"true" description:"The application name"`
-	Command string `positional-arg-name:"COMMAND" required:"true" description:"The command to execute"`
+	Command string `positional-arg-name:"COMMAND" description:"The command to execute"`
 }
 
 type TerminateTaskArgs struct {
@@ -306,3 +319,11 @@ type SpaceIsolationArgs struct {
 type ResetSpaceIsolationArgs struct {
 	SpaceName string `positional-arg-name:"SPACE_NAME" required:"true" description:"The space name"`
 }
+
+type ContextName struct {
+	Name string `positional-arg-name:"NAME" required:"true" description:"The context name"`
+}
+
+type CacheAction struct {
+	Action string `positional-arg-name:"clear" description:"Remove all cached responses"`
+}
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type DeploymentStrategy struct {
+	Type string
+}
+
+func (_ DeploymentStrategy) Complete(prefix string) []flags.Completion {
+	return completions([]string{"blue-green", "rolling"}, prefix, false)
+}
+
+func (s *DeploymentStrategy) UnmarshalFlag(val string) error {
+	valLower := strings.ToLower(val)
+	switch valLower {
+	case "blue-green", "rolling":
+		s.Type = valLower
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STRATEGY must be "rolling" or "blue-green"`,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DeploymentStrategy", func() {
+	var strategy DeploymentStrategy
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := strategy.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'blue-green' when passed 'b'", "b",
+				[]flags.Completion{{Item: "blue-green"}}),
+			Entry("returns 'rolling' when passed 'RO'", "RO",
+				[]flags.Completion{{Item: "rolling"}}),
+			Entry("completes to 'blue-green' and 'rolling' when passed nothing", "",
+				[]flags.Completion{{Item: "blue-green"}, {Item: "rolling"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			strategy = DeploymentStrategy{}
+		})
+
+		DescribeTable("downcases and sets type",
+			func(settingType string, expectedType string) {
+				err := strategy.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(strategy.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'blue-green' when passed 'blue-green'", "blue-green", "blue-green"),
+			Entry("sets 'blue-green' when passed 'Blue-Green'", "Blue-Green", "blue-green"),
+			Entry("sets 'rolling' when passed 'rolling'", "rolling", "rolling"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := strategy.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STRATEGY must be "rolling" or "blue-green"`,
+				}))
+				Expect(strategy.Type).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,26 @@
+package flag
+
+import (
+	"strconv"
+
+	"code.cloudfoundry.org/cli/types"
+	flags "github.com/jessevdk/go-flags"
+)
+
+// InstanceIndex is the index of an application instance. Use IsSet to check
+// if the flag was provided.
+type InstanceIndex types.NullInt
+
+func (i *InstanceIndex) UnmarshalFlag(val string) error {
+	index, err := strconv.Atoi(val)
+	if err != nil || index < 0 {
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: "INSTANCE must be a non-negative integer",
+		}
+	}
+
+	i.Value = index
+	i.IsSet = true
+	return nil
+}
@@ -0,0 +1,44 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("InstanceIndex", func() {
+	var index InstanceIndex
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			index = InstanceIndex{}
+		})
+
+		It("sets the index", func() {
+			err := index.UnmarshalFlag("0")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(index.IsSet).To(BeTrue())
+			Expect(index.Value).To(Equal(0))
+		})
+
+		Context("when passed a negative integer", func() {
+			It("returns an error", func() {
+				err := index.UnmarshalFlag("-1")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: "INSTANCE must be a non-negative integer",
+				}))
+				Expect(index.IsSet).To(BeFalse())
+			})
+		})
+
+		Context("when passed a non-integer", func() {
+			It("returns an error", func() {
+				err := index.UnmarshalFlag("banana")
+				Expect(err).To(HaveOccurred())
+				Expect(index.IsSet).To(BeFalse())
+			})
+		})
+	})
+})
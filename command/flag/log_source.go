@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogSource struct {
+	Type string
+}
+
+func (_ LogSource) Complete(prefix string) []flags.Completion {
+	return completions([]string{"API", "APP", "CELL", "LGR", "RTR", "SSH", "STG"}, prefix, false)
+}
+
+func (s *LogSource) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "API", "APP", "CELL", "LGR", "RTR", "SSH", "STG":
+		s.Type = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `SOURCE must be "API", "APP", "CELL", "LGR", "RTR", "SSH" or "STG"`,
+		}
+	}
+	return nil
+}
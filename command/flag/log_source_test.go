@@ -0,0 +1,57 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogSource", func() {
+	var source LogSource
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := source.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'API' and 'APP' when passed 'a'", "a",
+				[]flags.Completion{{Item: "API"}, {Item: "APP"}}),
+			Entry("returns 'RTR' when passed 'R'", "R",
+				[]flags.Completion{{Item: "RTR"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			source = LogSource{}
+		})
+
+		DescribeTable("upcases and sets type",
+			func(settingType string, expectedType string) {
+				err := source.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(source.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'APP' when passed 'app'", "app", "APP"),
+			Entry("sets 'RTR' when passed 'Rtr'", "Rtr", "RTR"),
+			Entry("sets 'STG' when passed 'STG'", "STG", "STG"),
+			Entry("sets 'CELL' when passed 'cell'", "cell", "CELL"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := source.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `SOURCE must be "API", "APP", "CELL", "LGR", "RTR", "SSH" or "STG"`,
+				}))
+				Expect(source.Type).To(BeEmpty())
+			})
+		})
+	})
+})
@@ -0,0 +1,29 @@
+package flag
+
+import (
+	"strings"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+type LogStream struct {
+	Type string
+}
+
+func (_ LogStream) Complete(prefix string) []flags.Completion {
+	return completions([]string{"ERR", "OUT"}, prefix, false)
+}
+
+func (s *LogStream) UnmarshalFlag(val string) error {
+	valUpper := strings.ToUpper(val)
+	switch valUpper {
+	case "ERR", "OUT":
+		s.Type = valUpper
+	default:
+		return &flags.Error{
+			Type:    flags.ErrRequired,
+			Message: `STREAM must be "OUT" or "ERR"`,
+		}
+	}
+	return nil
+}
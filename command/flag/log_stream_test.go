@@ -0,0 +1,53 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("LogStream", func() {
+	var stream LogStream
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := stream.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'OUT' when passed 'o'", "o",
+				[]flags.Completion{{Item: "OUT"}}),
+			Entry("returns 'ERR' and 'OUT' when passed nothing", "",
+				[]flags.Completion{{Item: "ERR"}, {Item: "OUT"}}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			stream = LogStream{}
+		})
+
+		DescribeTable("upcases and sets type",
+			func(settingType string, expectedType string) {
+				err := stream.UnmarshalFlag(settingType)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(stream.Type).To(Equal(expectedType))
+			},
+			Entry("sets 'OUT' when passed 'out'", "out", "OUT"),
+			Entry("sets 'ERR' when passed 'Err'", "Err", "ERR"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := stream.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `STREAM must be "OUT" or "ERR"`,
+				}))
+				Expect(stream.Type).To(BeEmpty())
+			})
+		})
+	})
+})
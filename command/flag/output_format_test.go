@@ -0,0 +1,58 @@
+package flag_test
+
+import (
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	var output OutputFormat
+
+	Describe("Complete", func() {
+		DescribeTable("returns list of completions",
+			func(prefix string, matches []flags.Completion) {
+				completions := output.Complete(prefix)
+				Expect(completions).To(Equal(matches))
+			},
+			Entry("returns 'json' when passed 'j'", "j",
+				[]flags.Completion{{Item: "json"}}),
+			Entry("returns 'yaml' when passed 'Y'", "Y",
+				[]flags.Completion{{Item: "yaml"}}),
+			Entry("completes to 'json' and 'yaml' when passed nothing", "",
+				[]flags.Completion{{Item: "json"}, {Item: "yaml"}}),
+			Entry("completes to nothing when passed 'wut'", "wut",
+				[]flags.Completion{}),
+		)
+	})
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			output = OutputFormat{}
+		})
+
+		DescribeTable("downcases and sets format",
+			func(format string, expectedFormat string) {
+				err := output.UnmarshalFlag(format)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(output.Format).To(Equal(expectedFormat))
+			},
+			Entry("sets 'json' when passed 'json'", "json", "json"),
+			Entry("sets 'json' when passed 'JSON'", "JSON", "json"),
+			Entry("sets 'yaml' when passed 'yaml'", "yaml", "yaml"),
+		)
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := output.UnmarshalFlag("xml")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `OUTPUT must be "json" or "yaml"`,
+				}))
+				Expect(output.Format).To(BeEmpty())
+			})
+		})
+	})
+})
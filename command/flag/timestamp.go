@@ -0,0 +1,30 @@
+package flag
+
+import (
+	"time"
+
+	flags "github.com/jessevdk/go-flags"
+)
+
+// Timestamp is a point in time given either as an RFC3339 timestamp or as a
+// duration before now, such as "90s" or "1h30m".
+type Timestamp struct {
+	time.Time
+}
+
+func (t *Timestamp) UnmarshalFlag(val string) error {
+	if timestamp, err := time.Parse(time.RFC3339, val); err == nil {
+		t.Time = timestamp
+		return nil
+	}
+
+	if duration, err := time.ParseDuration(val); err == nil && duration >= 0 {
+		t.Time = time.Now().Add(-duration)
+		return nil
+	}
+
+	return &flags.Error{
+		Type:    flags.ErrRequired,
+		Message: `TIME must be an RFC3339 timestamp (e.g. "2017-04-01T15:04:05Z") or a duration (e.g. "30m")`,
+	}
+}
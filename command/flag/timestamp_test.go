@@ -0,0 +1,47 @@
+package flag_test
+
+import (
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/flag"
+	flags "github.com/jessevdk/go-flags"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Timestamp", func() {
+	var timestamp Timestamp
+
+	Describe("UnmarshalFlag", func() {
+		BeforeEach(func() {
+			timestamp = Timestamp{}
+		})
+
+		Context("when passed an RFC3339 timestamp", func() {
+			It("sets the time", func() {
+				err := timestamp.UnmarshalFlag("2017-04-01T15:04:05Z")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Time).To(Equal(time.Date(2017, 4, 1, 15, 4, 5, 0, time.UTC)))
+			})
+		})
+
+		Context("when passed a duration", func() {
+			It("sets the time to the duration before now", func() {
+				err := timestamp.UnmarshalFlag("1h")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(timestamp.Time).To(BeTemporally("~", time.Now().Add(-time.Hour), time.Minute))
+			})
+		})
+
+		Context("when passed anything else", func() {
+			It("returns an error", func() {
+				err := timestamp.UnmarshalFlag("banana")
+				Expect(err).To(MatchError(&flags.Error{
+					Type:    flags.ErrRequired,
+					Message: `TIME must be an RFC3339 timestamp (e.g. "2017-04-01T15:04:05Z") or a duration (e.g. "30m")`,
+				}))
+				Expect(timestamp.Time.IsZero()).To(BeTrue())
+			})
+		})
+	})
+})
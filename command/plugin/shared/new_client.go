@@ -3,6 +3,7 @@ package shared
 import (
 	"code.cloudfoundry.org/cli/api/plugin"
 	"code.cloudfoundry.org/cli/api/plugin/wrapper"
+	"code.cloudfoundry.org/cli/api/retrypolicy"
 	"code.cloudfoundry.org/cli/command"
 )
 
@@ -26,7 +27,7 @@ func NewClient(config command.Config, ui command.UI) *plugin.Client {
 		pluginClient.WrapConnection(wrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
-	pluginClient.WrapConnection(wrapper.NewRetryRequest(2))
+	pluginClient.WrapConnection(wrapper.NewRetryRequestWithPolicy(retrypolicy.Default()))
 
 	return pluginClient
 }
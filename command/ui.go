@@ -15,10 +15,14 @@ type UI interface {
 	DisplayInstancesTableForApp(table [][]string)
 	DisplayKeyValueTable(prefix string, table [][]string, padding int)
 	DisplayKeyValueTableForApp(table [][]string)
+	DisplayAppLogMessage(appName string, message ui.LogMessage)
 	DisplayLogMessage(message ui.LogMessage, displayHeader bool)
+	DisplayLogMessageAsJSON(appName string, message ui.LogMessage) error
 	DisplayNewline()
 	DisplayNonWrappingTable(prefix string, table [][]string, padding int)
 	DisplayOK()
+	DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error)
+	DisplayStructuredData(kind string, data interface{}) error
 	DisplayTableWithHeader(prefix string, table [][]string, padding int)
 	DisplayText(template string, data ...map[string]interface{})
 	DisplayTextWithFlavor(text string, keys ...map[string]interface{})
@@ -26,6 +30,7 @@ type UI interface {
 	DisplayWarnings(warnings []string)
 	RequestLoggerFileWriter(filePaths []string) *ui.RequestLoggerFileWriter
 	RequestLoggerTerminalDisplay() *ui.RequestLoggerTerminalDisplay
+	StructuredOutputEnabled() bool
 	TranslateText(template string, data ...map[string]interface{}) string
 	UserFriendlyDate(input time.Time) string
 	Writer() io.Writer
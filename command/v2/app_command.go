@@ -41,6 +41,10 @@ func (cmd *AppCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd AppCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd AppCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
@@ -87,7 +91,5 @@ func (cmd AppCommand) displayAppSummary() error {
 		return shared.HandleError(err)
 	}
 
-	shared.DisplayAppSummary(cmd.UI, appSummary, false)
-
-	return nil
+	return shared.DisplayAppSummary(cmd.UI, appSummary, false)
 }
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/types"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
 	"github.com/cloudfoundry/bytefmt"
@@ -172,7 +173,7 @@ var _ = Describe("App Command", func() {
 						Application: v2action.Application{
 							Name:              "some-app",
 							GUID:              "some-app-guid",
-							Instances:         3,
+							Instances:         types.NullInt{Value: 3, IsSet: true},
 							Memory:            128,
 							PackageUpdatedAt:  time.Unix(0, 0),
 							DetectedBuildpack: "some-buildpack",
@@ -292,6 +293,52 @@ var _ = Describe("App Command", func() {
 						})
 					})
 
+					Context("when structured output is enabled", func() {
+						BeforeEach(func() {
+							testUI.OutputFormat = ui.OutputJSON
+							fakeActor.GetApplicationSummaryByNameAndSpaceReturns(applicationSummary, warnings, nil)
+						})
+
+						It("displays the app summary and instance stats as a versioned document", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(testUI.Out).To(Say(`{
+  "schema_version": 1,
+  "kind": "application",
+  "data": {
+    "name": "some-app",
+    "guid": "some-app-guid",
+    "requested_state": "started",
+    "instances": 3,
+    "running_instances": 1,
+    "memory_in_mb": 128,
+    "disk_quota_in_mb": 0,
+    "isolation_segment": "some-isolation-segment",
+    "routes": \[
+      "banana.fruit.com/hi",
+      "foobar.com:13"
+    \],
+    "last_uploaded": "1970-01-01T00:00:00Z",
+    "stack": "potatos",
+    "buildpack": "some-buildpack",
+    "instance_stats": \[
+      {
+        "index": 0,
+        "state": "running",
+        "since": "2014-06-19T01:18:37Z",
+        "cpu": 0.73,
+        "memory_in_bytes": 104857600,
+        "memory_quota_in_bytes": 134217728,
+        "disk_in_bytes": 52428800,
+        "disk_quota_in_bytes": 2147483648,
+        "details": "info from the backend"
+      },`))
+							Expect(testUI.Out).To(Say(`"state": "crashed"`))
+							Expect(testUI.Out).NotTo(Say("Showing health and status"))
+							Expect(testUI.Err).To(Say("Showing health and status for app some-app"))
+						})
+					})
+
 					Context("when the isolation segment is empty", func() {
 						BeforeEach(func() {
 							applicationSummary.IsolationSegment = ""
@@ -0,0 +1,194 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	log "github.com/Sirupsen/logrus"
+)
+
+//go:generate counterfeiter . ApplyActor
+
+type ApplyActor interface {
+	Apply(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	ApplySpace(config pushaction.SpaceConfig) (pushaction.Warnings, error)
+	ConvertToApplicationConfig(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	ConvertToSpaceConfig(orgGUID string, spaceName string, space manifest.Space, prune bool) (pushaction.SpaceConfig, pushaction.Warnings, error)
+	PlanSpace(config pushaction.SpaceConfig) pushaction.SpacePlan
+	PruneSpace(config pushaction.SpaceConfig) (pushaction.Warnings, error)
+}
+
+type ApplyCommand struct {
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to the space manifest" required:"true"`
+	Prune           bool                        `long:"prune" description:"Delete apps, orphaned routes and service instances, and unbind security groups, that are not in the space manifest"`
+	Force           bool                        `long:"force" description:"Delete and unbind without confirmation"`
+	DryRun          bool                        `long:"dry-run" description:"Display the changes that would be made to the space without making them"`
+	usage           interface{}                 `usage:"CF_NAME apply -f SPACE_MANIFEST_PATH [--prune [--force]] [--dry-run]"`
+	relatedCommands interface{}                 `related_commands:"create-space-manifest, push"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ApplyActor
+}
+
+func (cmd *ApplyCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = pushaction.NewActor(v2action.NewActor(ccClient, uaaClient), config)
+	return nil
+}
+
+func (cmd ApplyCommand) Execute(args []string) error {
+	cmd.UI.DisplayWarning(command.ExperimentalWarning)
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	log.WithField("file", cmd.PathToManifest).Info("reading space manifest")
+	space, err := manifest.ReadSpaceManifest(string(cmd.PathToManifest))
+	if err != nil {
+		log.Errorln("reading space manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Applying space manifest to org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	spaceConfig, warnings, err := cmd.Actor.ConvertToSpaceConfig(
+		cmd.Config.TargetedOrganization().GUID,
+		cmd.Config.TargetedSpace().Name,
+		space,
+		cmd.Prune,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("converting space manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	plan := cmd.Actor.PlanSpace(spaceConfig)
+	cmd.displayPlan(plan)
+
+	if cmd.DryRun {
+		return nil
+	}
+
+	if !cmd.Force && hasDestructiveChanges(plan) {
+		applyChanges, promptErr := cmd.UI.DisplayBoolPrompt(false, "Really delete and unbind the resources above?")
+		if promptErr != nil {
+			return promptErr
+		}
+
+		if !applyChanges {
+			cmd.UI.DisplayText("Space manifest has not been applied")
+			return nil
+		}
+	}
+
+	warnings, err = cmd.Actor.ApplySpace(spaceConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("applying space:", err)
+		return shared.HandleError(err)
+	}
+
+	err = cmd.applyApplications(spaceConfig)
+	if err != nil {
+		return err
+	}
+
+	warnings, err = cmd.Actor.PruneSpace(spaceConfig)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("pruning space:", err)
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayOK()
+	return nil
+}
+
+// applyApplications pushes each application in the space manifest in turn,
+// the same way v2-push does.
+func (cmd ApplyCommand) applyApplications(spaceConfig pushaction.SpaceConfig) error {
+	if len(spaceConfig.DesiredApplications) == 0 {
+		return nil
+	}
+
+	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfig(
+		spaceConfig.OrgGUID,
+		spaceConfig.SpaceGUID,
+		false,
+		spaceConfig.DesiredApplications,
+	)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		log.Errorln("converting manifest:", err)
+		return shared.HandleError(err)
+	}
+
+	pushCommand := V2PushCommand{UI: cmd.UI, Config: cmd.Config}
+	errs := pushaction.ScheduleApplications(appConfigs, 1, func(appConfig pushaction.ApplicationConfig) error {
+		log.Infoln("starting create/update:", appConfig.DesiredApplication.Name)
+		eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig)
+		return pushCommand.processApplyStreams(appConfig, eventStream, warningsStream, errorStream, nil)
+	})
+
+	return pushCommand.pushFailures(appConfigs, errs)
+}
+
+// displayPlan displays a table of the changes that will be made to the
+// space.
+func (cmd ApplyCommand) displayPlan(plan pushaction.SpacePlan) {
+	cmd.UI.DisplayNewline()
+	if len(plan.Changes) == 0 {
+		cmd.UI.DisplayText("No changes")
+		return
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("resource"),
+			cmd.UI.TranslateText("name"),
+			cmd.UI.TranslateText("action"),
+		},
+	}
+	for _, change := range plan.Changes {
+		table = append(table, []string{string(change.Resource), change.Name, string(change.Action)})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	cmd.UI.DisplayNewline()
+}
+
+// hasDestructiveChanges returns true when the plan deletes or unbinds any
+// resource.
+func hasDestructiveChanges(plan pushaction.SpacePlan) bool {
+	for _, change := range plan.Changes {
+		if change.Action == pushaction.PlanDelete || change.Action == pushaction.PlanUnbind {
+			return true
+		}
+	}
+	return false
+}
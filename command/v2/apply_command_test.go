@@ -0,0 +1,310 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("apply Command", func() {
+	var (
+		cmd             ApplyCommand
+		testUI          *ui.UI
+		input           *Buffer
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeApplyActor
+		binaryName      string
+
+		tmpDir       string
+		manifestPath string
+		executeErr   error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeApplyActor)
+
+		cmd = ApplyCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+
+		var err error
+		tmpDir, err = ioutil.TempDir("", "apply-command-test")
+		Expect(err).ToNot(HaveOccurred())
+		manifestPath = filepath.Join(tmpDir, "space.yml")
+		err = ioutil.WriteFile(manifestPath, []byte(`---
+space-quota: large
+service-instances:
+- name: db
+  service: p-mysql
+  plan: 100mb
+`), 0666)
+		Expect(err).ToNot(HaveOccurred())
+		cmd.PathToManifest = flag.PathWithExistenceCheck(manifestPath)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in, and org and space are targeted", func() {
+		var spaceConfig pushaction.SpaceConfig
+
+		BeforeEach(func() {
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+			fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+			spaceConfig = pushaction.SpaceConfig{OrgGUID: "some-org-guid", SpaceGUID: "some-space-guid"}
+			fakeActor.ConvertToSpaceConfigReturns(spaceConfig, pushaction.Warnings{"convert-warning"}, nil)
+			fakeActor.PlanSpaceReturns(pushaction.SpacePlan{Changes: []pushaction.SpaceChange{
+				{Resource: pushaction.SpaceQuotaResource, Name: "large", Action: pushaction.PlanUpdate},
+				{Resource: pushaction.ServiceInstanceResource, Name: "db", Action: pushaction.PlanCreate},
+			}})
+			fakeActor.ApplySpaceReturns(pushaction.Warnings{"apply-warning"}, nil)
+			fakeActor.PruneSpaceReturns(pushaction.Warnings{"prune-warning"}, nil)
+		})
+
+		It("displays the plan, converges the space and displays OK", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Applying space manifest to org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`resource\s+name\s+action`))
+			Expect(testUI.Out).To(Say(`space quota\s+large\s+update`))
+			Expect(testUI.Out).To(Say(`service instance\s+db\s+create`))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Err).To(Say("convert-warning"))
+			Expect(testUI.Err).To(Say("apply-warning"))
+			Expect(testUI.Err).To(Say("prune-warning"))
+
+			Expect(fakeActor.ConvertToSpaceConfigCallCount()).To(Equal(1))
+			orgGUID, spaceName, space, prune := fakeActor.ConvertToSpaceConfigArgsForCall(0)
+			Expect(orgGUID).To(Equal("some-org-guid"))
+			Expect(spaceName).To(Equal("some-space"))
+			Expect(space.SpaceQuota).To(Equal("large"))
+			Expect(space.ServiceInstances).To(Equal([]manifest.ServiceInstance{{Name: "db", Service: "p-mysql", Plan: "100mb"}}))
+			Expect(prune).To(BeFalse())
+
+			Expect(fakeActor.ApplySpaceArgsForCall(0)).To(Equal(spaceConfig))
+			Expect(fakeActor.PruneSpaceArgsForCall(0)).To(Equal(spaceConfig))
+			Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(0))
+		})
+
+		Context("when --prune is provided", func() {
+			BeforeEach(func() {
+				cmd.Prune = true
+			})
+
+			It("asks the actor to prune", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				_, _, _, prune := fakeActor.ConvertToSpaceConfigArgsForCall(0)
+				Expect(prune).To(BeTrue())
+			})
+		})
+
+		Context("when --dry-run is provided", func() {
+			BeforeEach(func() {
+				cmd.DryRun = true
+			})
+
+			It("displays the plan without changing the space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say(`space quota\s+large\s+update`))
+				Expect(testUI.Out).ToNot(Say("OK"))
+
+				Expect(fakeActor.ApplySpaceCallCount()).To(Equal(0))
+				Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(0))
+				Expect(fakeActor.PruneSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the plan deletes or unbinds resources", func() {
+			BeforeEach(func() {
+				cmd.Prune = true
+				fakeActor.PlanSpaceReturns(pushaction.SpacePlan{Changes: []pushaction.SpaceChange{
+					{Resource: pushaction.ApplicationResource, Name: "old-app", Action: pushaction.PlanDelete},
+					{Resource: pushaction.SecurityGroupResource, Name: "old-group", Action: pushaction.PlanUnbind},
+				}})
+			})
+
+			Context("when the user confirms", func() {
+				BeforeEach(func() {
+					input.Write([]byte("y\n"))
+				})
+
+				It("converges and prunes the space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say(`Really delete and unbind the resources above\? \[yN\]`))
+					Expect(testUI.Out).To(Say("OK"))
+
+					Expect(fakeActor.ApplySpaceCallCount()).To(Equal(1))
+					Expect(fakeActor.PruneSpaceCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the user declines", func() {
+				BeforeEach(func() {
+					input.Write([]byte("n\n"))
+				})
+
+				It("does not change the space", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Space manifest has not been applied"))
+
+					Expect(fakeActor.ApplySpaceCallCount()).To(Equal(0))
+					Expect(fakeActor.PruneSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --force is provided", func() {
+				BeforeEach(func() {
+					cmd.Force = true
+				})
+
+				It("does not prompt", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).ToNot(Say("Really delete"))
+
+					Expect(fakeActor.ApplySpaceCallCount()).To(Equal(1))
+					Expect(fakeActor.PruneSpaceCallCount()).To(Equal(1))
+				})
+			})
+		})
+
+		Context("when the space manifest lists applications", func() {
+			BeforeEach(func() {
+				spaceConfig.DesiredApplications = []manifest.Application{{Name: "some-app"}}
+				fakeActor.ConvertToSpaceConfigReturns(spaceConfig, nil, nil)
+				fakeActor.ConvertToApplicationConfigReturns(
+					[]pushaction.ApplicationConfig{{DesiredApplication: v2action.Application{Name: "some-app"}}},
+					pushaction.Warnings{"app-config-warning"},
+					nil,
+				)
+				fakeActor.ApplyStub = func(_ pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+					eventStream := make(chan pushaction.Event)
+					warningsStream := make(chan pushaction.Warnings)
+					errorStream := make(chan error)
+
+					go func() {
+						defer GinkgoRecover()
+						Eventually(warningsStream).Should(BeSent(pushaction.Warnings{"push-warning"}))
+						Eventually(eventStream).Should(BeSent(pushaction.Complete))
+						close(eventStream)
+						close(warningsStream)
+						close(errorStream)
+					}()
+
+					return eventStream, warningsStream, errorStream
+				}
+			})
+
+			It("pushes the applications before pruning", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Err).To(Say("app-config-warning"))
+				Expect(testUI.Err).To(Say("push-warning"))
+
+				orgGUID, spaceGUID, dryRun, apps := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(dryRun).To(BeFalse())
+				Expect(apps).To(Equal(spaceConfig.DesiredApplications))
+				Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+				Expect(fakeActor.PruneSpaceCallCount()).To(Equal(1))
+			})
+		})
+
+		Context("when the space manifest is invalid", func() {
+			BeforeEach(func() {
+				err := ioutil.WriteFile(manifestPath, []byte("service-instances:\n- service: p-mysql\n  plan: 100mb\n"), 0666)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns an InvalidManifestError", func() {
+				Expect(executeErr).To(BeAssignableToTypeOf(shared.InvalidManifestError{}))
+				Expect(fakeActor.ConvertToSpaceConfigCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when converting the space manifest fails", func() {
+			BeforeEach(func() {
+				fakeActor.ConvertToSpaceConfigReturns(pushaction.SpaceConfig{}, pushaction.Warnings{"convert-warning"}, v2action.SpaceQuotaNotFoundError{Name: "large"})
+			})
+
+			It("returns the translated error and displays warnings", func() {
+				Expect(executeErr).To(MatchError(shared.SpaceQuotaNotFoundError{Name: "large"}))
+				Expect(testUI.Err).To(Say("convert-warning"))
+				Expect(fakeActor.ApplySpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when applying the space fails", func() {
+			BeforeEach(func() {
+				fakeActor.ApplySpaceReturns(pushaction.Warnings{"apply-warning"}, errors.New("apply error"))
+			})
+
+			It("returns the error and does not prune", func() {
+				Expect(executeErr).To(MatchError("apply error"))
+				Expect(testUI.Err).To(Say("apply-warning"))
+				Expect(fakeActor.PruneSpaceCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when pruning fails", func() {
+			BeforeEach(func() {
+				fakeActor.PruneSpaceReturns(pushaction.Warnings{"prune-warning"}, errors.New("prune error"))
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError("prune error"))
+				Expect(testUI.Err).To(Say("prune-warning"))
+				Expect(testUI.Out).ToNot(Say("OK"))
+			})
+		})
+	})
+})
@@ -16,6 +16,10 @@ func (_ AppsCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (_ AppsCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (_ AppsCommand) Execute(args []string) error {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
@@ -1,24 +1,144 @@
 package v2
 
 import (
-	"os"
-
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . AuthActor
+
+type AuthActor interface {
+	Authenticate(config v2action.Config, credentials map[string]string, grantType uaa.GrantType) error
+}
+
 type AuthCommand struct {
-	RequiredArgs    flag.Authentication `positional-args:"yes"`
-	usage           interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)"`
-	relatedCommands interface{}         `related_commands:"api, login, target"`
+	RequiredArgs      flag.Authentication `positional-args:"yes"`
+	ClientCredentials bool                `long:"client-credentials" description:"Use (non-user) service account (also called client credentials)"`
+	SSO               bool                `long:"sso" description:"Prompt for a one-time passcode to authenticate"`
+	SSOPasscode       string              `long:"sso-passcode" description:"One-time passcode"`
+	Assertion         string              `long:"assertion" description:"JWT issued by a trusted identity provider to exchange for a token"`
+	usage             interface{}         `usage:"CF_NAME auth USERNAME PASSWORD\n   CF_NAME auth CLIENT_ID CLIENT_SECRET --client-credentials\n   CF_NAME auth (--sso | --sso-passcode PASSCODE)\n   CF_NAME auth --assertion JWT\n\nWARNING:\n   Providing your password as a command line option is highly discouraged\n   Your password may be visible to others and may be recorded in your shell history\n\nEXAMPLES:\n   CF_NAME auth name@example.com \"my password\" (use quotes for passwords with a space)\n   CF_NAME auth name@example.com \"\\\"password\\\"\" (escape quotes if used in password)\n   CF_NAME auth my-client my-client-secret --client-credentials\n   CF_NAME auth --sso (CF_NAME will provide a url to obtain a one-time passcode)"`
+	relatedCommands   interface{}         `related_commands:"api, login, target"`
+
+	UI     command.UI
+	Config command.Config
+	Actor  AuthActor
 }
 
-func (_ AuthCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *AuthCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+
 	return nil
 }
 
-func (_ AuthCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd AuthCommand) Execute(args []string) error {
+	grantType, err := cmd.grantType()
+	if err != nil {
+		return err
+	}
+
+	if grantType != uaa.GrantTypeClientCredentials && cmd.Config.UAAGrantType() == string(uaa.GrantTypeClientCredentials) {
+		return shared.PasswordGrantTypeLogoutRequiredError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("API endpoint: {{.Endpoint}}", map[string]interface{}{
+		"Endpoint": cmd.Config.Target(),
+	})
+
+	credentials, err := cmd.credentials(grantType)
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayText("Authenticating...")
+
+	err = cmd.Actor.Authenticate(cmd.Config, credentials, grantType)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Use '{{.BinaryName}} target' to view or set your target org and space.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+	})
+
 	return nil
 }
+
+// grantType returns the grant selected by the flags, checking that the
+// arguments it needs, and only those, were provided.
+func (cmd AuthCommand) grantType() (uaa.GrantType, error) {
+	var flags []string
+	if cmd.ClientCredentials {
+		flags = append(flags, "--client-credentials")
+	}
+	if cmd.SSO {
+		flags = append(flags, "--sso")
+	}
+	if cmd.SSOPasscode != "" {
+		flags = append(flags, "--sso-passcode")
+	}
+	if cmd.Assertion != "" {
+		flags = append(flags, "--assertion")
+	}
+	if len(flags) > 1 {
+		return "", command.ArgumentCombinationError{Arg1: flags[0], Arg2: flags[1]}
+	}
+
+	switch {
+	case cmd.SSO || cmd.SSOPasscode != "" || cmd.Assertion != "":
+		if cmd.RequiredArgs.Username != "" {
+			return "", command.ArgumentCombinationError{Arg1: "USERNAME", Arg2: flags[0]}
+		}
+		if cmd.Assertion != "" {
+			return uaa.GrantTypeJWTBearer, nil
+		}
+		return uaa.GrantTypePassword, nil
+	case cmd.RequiredArgs.Username == "":
+		return "", command.RequiredArgumentError{ArgumentName: "USERNAME"}
+	case cmd.RequiredArgs.Password == "":
+		return "", command.RequiredArgumentError{ArgumentName: "PASSWORD"}
+	case cmd.ClientCredentials:
+		return uaa.GrantTypeClientCredentials, nil
+	default:
+		return uaa.GrantTypePassword, nil
+	}
+}
+
+func (cmd AuthCommand) credentials(grantType uaa.GrantType) (map[string]string, error) {
+	switch {
+	case grantType == uaa.GrantTypeClientCredentials:
+		return map[string]string{
+			"client_id":     cmd.RequiredArgs.Username,
+			"client_secret": cmd.RequiredArgs.Password,
+		}, nil
+	case grantType == uaa.GrantTypeJWTBearer:
+		return map[string]string{"assertion": cmd.Assertion}, nil
+	case cmd.SSOPasscode != "":
+		return map[string]string{"passcode": cmd.SSOPasscode}, nil
+	case cmd.SSO:
+		passcode, err := cmd.UI.DisplayPasswordPrompt("Temporary Authentication Code ( Get one at {{.PasscodeURL}} )", map[string]interface{}{
+			"PasscodeURL": cmd.Config.AuthorizationEndpoint() + "/passcode",
+		})
+		if err != nil {
+			return nil, err
+		}
+		return map[string]string{"passcode": passcode}, nil
+	default:
+		return map[string]string{
+			"username": cmd.RequiredArgs.Username,
+			"password": cmd.RequiredArgs.Password,
+		}, nil
+	}
+}
@@ -0,0 +1,208 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/api/uaa"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("auth Command", func() {
+	var (
+		cmd        AuthCommand
+		testUI     *ui.UI
+		input      *Buffer
+		fakeActor  *v2fakes.FakeAuthActor
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		input = NewBuffer()
+		testUI = ui.NewTestUI(input, NewBuffer(), NewBuffer())
+		fakeActor = new(v2fakes.FakeAuthActor)
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+		fakeConfig.TargetReturns("https://api.some-cf.com")
+		fakeConfig.AuthorizationEndpointReturns("https://login.some-cf.com")
+
+		cmd = AuthCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Actor:  fakeActor,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when a username and password are provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "some-user"
+			cmd.RequiredArgs.Password = "some-password"
+		})
+
+		It("authenticates with the password grant", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			config, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(config).To(Equal(fakeConfig))
+			Expect(credentials).To(Equal(map[string]string{
+				"username": "some-user",
+				"password": "some-password",
+			}))
+			Expect(grantType).To(Equal(uaa.GrantTypePassword))
+
+			Expect(testUI.Out).To(Say("API endpoint: https://api.some-cf.com"))
+			Expect(testUI.Out).To(Say("Authenticating..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use 'faceman target' to view or set your target org and space."))
+		})
+
+		Context("when a service account is logged in", func() {
+			BeforeEach(func() {
+				fakeConfig.UAAGrantTypeReturns("client_credentials")
+			})
+
+			It("returns a PasswordGrantTypeLogoutRequiredError", func() {
+				Expect(executeErr).To(MatchError(shared.PasswordGrantTypeLogoutRequiredError{BinaryName: "faceman"}))
+				Expect(fakeActor.AuthenticateCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when the credentials are rejected", func() {
+			BeforeEach(func() {
+				fakeActor.AuthenticateReturns(uaa.BadCredentialsError{Message: "Bad credentials"})
+			})
+
+			It("returns a BadCredentialsError", func() {
+				Expect(executeErr).To(MatchError(shared.BadCredentialsError{}))
+			})
+		})
+
+		Context("when authenticating fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some error")
+				fakeActor.AuthenticateReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Context("when the password is not provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Username = "some-user"
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "PASSWORD"}))
+		})
+	})
+
+	Context("when no arguments or flags are provided", func() {
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "USERNAME"}))
+		})
+	})
+
+	Context("when --client-credentials is provided", func() {
+		BeforeEach(func() {
+			cmd.ClientCredentials = true
+			cmd.RequiredArgs.Username = "some-client"
+			cmd.RequiredArgs.Password = "some-secret"
+			fakeConfig.UAAGrantTypeReturns("client_credentials")
+		})
+
+		It("authenticates with the client credentials grant", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeActor.AuthenticateCallCount()).To(Equal(1))
+			_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{
+				"client_id":     "some-client",
+				"client_secret": "some-secret",
+			}))
+			Expect(grantType).To(Equal(uaa.GrantTypeClientCredentials))
+		})
+	})
+
+	Context("when --sso-passcode is provided", func() {
+		BeforeEach(func() {
+			cmd.SSOPasscode = "some-passcode"
+		})
+
+		It("authenticates with the passcode", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+			Expect(grantType).To(Equal(uaa.GrantTypePassword))
+		})
+
+		Context("when a username is also provided", func() {
+			BeforeEach(func() {
+				cmd.RequiredArgs.Username = "some-user"
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "USERNAME", Arg2: "--sso-passcode"}))
+			})
+		})
+
+		Context("when --sso is also provided", func() {
+			BeforeEach(func() {
+				cmd.SSO = true
+			})
+
+			It("returns an ArgumentCombinationError", func() {
+				Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--sso", Arg2: "--sso-passcode"}))
+			})
+		})
+	})
+
+	Context("when --sso is provided", func() {
+		BeforeEach(func() {
+			cmd.SSO = true
+			input.Write([]byte("some-passcode\n"))
+		})
+
+		It("prompts for a passcode and authenticates with it", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say(`Temporary Authentication Code \( Get one at https://login.some-cf.com/passcode \)`))
+
+			_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{"passcode": "some-passcode"}))
+			Expect(grantType).To(Equal(uaa.GrantTypePassword))
+		})
+	})
+
+	Context("when --assertion is provided", func() {
+		BeforeEach(func() {
+			cmd.Assertion = "some-jwt"
+		})
+
+		It("authenticates with the JWT bearer grant", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			_, credentials, grantType := fakeActor.AuthenticateArgsForCall(0)
+			Expect(credentials).To(Equal(map[string]string{"assertion": "some-jwt"}))
+			Expect(grantType).To(Equal(uaa.GrantTypeJWTBearer))
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package v2
+
+import (
+	"fmt"
+	"time"
+
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+//go:generate counterfeiter . ResponseCache
+
+type ResponseCache interface {
+	Entries() ([]ccWrapper.CachedResponse, error)
+	Clear() error
+}
+
+type CacheCommand struct {
+	OptionalArgs    flag.CacheAction `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME cache [clear]\n\nTIP:\n   Set CF_CACHE_TTL to a number of seconds to cache Cloud Controller responses for that long."`
+	relatedCommands interface{}      `related_commands:"apps, services"`
+
+	UI     command.UI
+	Config command.Config
+	Cache  ResponseCache
+}
+
+func (cmd *CacheCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.Cache = ccWrapper.NewResponseCache(config.ResponseCacheDirectory(), config.ResponseCacheTTL())
+	return nil
+}
+
+func (cmd CacheCommand) Execute(args []string) error {
+	switch cmd.OptionalArgs.Action {
+	case "":
+		return cmd.displayCache()
+	case "clear":
+		return cmd.clearCache()
+	default:
+		return command.ParseArgumentError{
+			ArgumentName: cmd.OptionalArgs.Action,
+			ExpectedType: "clear",
+		}
+	}
+}
+
+func (cmd CacheCommand) displayCache() error {
+	entries, err := cmd.Cache.Entries()
+	if err != nil {
+		return err
+	}
+
+	ttl := cmd.UI.TranslateText("disabled")
+	if cacheTTL := cmd.Config.ResponseCacheTTL(); cacheTTL > 0 {
+		ttl = cacheTTL.String()
+	}
+
+	var size int
+	for _, entry := range entries {
+		size += len(entry.Body)
+	}
+
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("location:"), cmd.Config.ResponseCacheDirectory()},
+		{cmd.UI.TranslateText("ttl:"), ttl},
+		{cmd.UI.TranslateText("responses:"), fmt.Sprint(len(entries))},
+		{cmd.UI.TranslateText("size:"), fmt.Sprintf("%dK", (size+1023)/1024)},
+	}, 3)
+
+	if len(entries) == 0 {
+		return nil
+	}
+
+	table := [][]string{{
+		cmd.UI.TranslateText("url"),
+		cmd.UI.TranslateText("age"),
+	}}
+	for _, entry := range entries {
+		table = append(table, []string{
+			entry.URL,
+			time.Since(entry.StoredAt).Truncate(time.Second).String(),
+		})
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
+
+func (cmd CacheCommand) clearCache() error {
+	cmd.UI.DisplayText("Clearing cached Cloud Controller responses...")
+
+	err := cmd.Cache.Clear()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayOK()
+	return nil
+}
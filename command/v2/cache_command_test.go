@@ -0,0 +1,133 @@
+package v2_test
+
+import (
+	"errors"
+	"time"
+
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("cache Command", func() {
+	var (
+		cmd        CacheCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		fakeCache  *v2fakes.FakeResponseCache
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeCache = new(v2fakes.FakeResponseCache)
+
+		fakeConfig.ResponseCacheDirectoryReturns("/home/faceman/.cf/cache")
+
+		cmd = CacheCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+			Cache:  fakeCache,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no action is given", func() {
+		Context("when the cache is disabled and empty", func() {
+			It("displays the cache settings", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`location:\s+/home/faceman/.cf/cache`))
+				Expect(testUI.Out).To(Say(`ttl:\s+disabled`))
+				Expect(testUI.Out).To(Say(`responses:\s+0`))
+				Expect(testUI.Out).To(Say(`size:\s+0K`))
+				Expect(testUI.Out).ToNot(Say("url"))
+			})
+		})
+
+		Context("when responses are cached", func() {
+			BeforeEach(func() {
+				fakeConfig.ResponseCacheTTLReturns(30 * time.Second)
+				fakeCache.EntriesReturns([]ccWrapper.CachedResponse{
+					{URL: "https://api.foo.com/v2/apps", StoredAt: time.Now().Add(-5 * time.Second), Body: make([]byte, 1500)},
+					{URL: "https://api.foo.com/v2/services", StoredAt: time.Now(), Body: make([]byte, 100)},
+				}, nil)
+			})
+
+			It("displays the settings and the cached responses", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say(`ttl:\s+30s`))
+				Expect(testUI.Out).To(Say(`responses:\s+2`))
+				Expect(testUI.Out).To(Say(`size:\s+2K`))
+				Expect(testUI.Out).To(Say(`url\s+age`))
+				Expect(testUI.Out).To(Say(`https://api.foo.com/v2/apps\s+5s`))
+				Expect(testUI.Out).To(Say(`https://api.foo.com/v2/services\s+0s`))
+			})
+		})
+
+		Context("when reading the cache fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCache.EntriesReturns(nil, expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Context("when clear is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "clear"
+		})
+
+		It("clears the cache", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeCache.ClearCallCount()).To(Equal(1))
+			Expect(testUI.Out).To(Say("Clearing cached Cloud Controller responses..."))
+			Expect(testUI.Out).To(Say("OK"))
+		})
+
+		Context("when clearing the cache fails", func() {
+			var expectedErr error
+
+			BeforeEach(func() {
+				expectedErr = errors.New("some-error")
+				fakeCache.ClearReturns(expectedErr)
+			})
+
+			It("returns the error", func() {
+				Expect(executeErr).To(MatchError(expectedErr))
+			})
+		})
+	})
+
+	Context("when an unknown action is given", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.Action = "banana"
+		})
+
+		It("returns a ParseArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.ParseArgumentError{
+				ArgumentName: "banana",
+				ExpectedType: "clear",
+			}))
+			Expect(fakeCache.ClearCallCount()).To(Equal(0))
+		})
+	})
+})
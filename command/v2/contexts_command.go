@@ -0,0 +1,55 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+)
+
+type ContextsCommand struct {
+	usage           interface{} `usage:"CF_NAME contexts"`
+	relatedCommands interface{} `related_commands:"save-context, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *ContextsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd ContextsCommand) Execute(args []string) error {
+	cmd.UI.DisplayText("Getting saved contexts...")
+	cmd.UI.DisplayNewline()
+
+	contexts := cmd.Config.Contexts()
+	if len(contexts) == 0 {
+		cmd.UI.DisplayText("No contexts found.")
+		return nil
+	}
+
+	currentContext := cmd.Config.CurrentContext()
+	table := [][]string{{
+		cmd.UI.TranslateText("current"),
+		cmd.UI.TranslateText("name"),
+		cmd.UI.TranslateText("api endpoint"),
+		cmd.UI.TranslateText("org"),
+		cmd.UI.TranslateText("space"),
+	}}
+	for _, context := range contexts {
+		var current string
+		if context.Name == currentContext {
+			current = "*"
+		}
+		table = append(table, []string{
+			current,
+			context.Name,
+			context.Target,
+			context.TargetedOrganization.Name,
+			context.TargetedSpace.Name,
+		})
+	}
+
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
@@ -0,0 +1,70 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("contexts Command", func() {
+	var (
+		cmd        ContextsCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = ContextsCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when there are no saved contexts", func() {
+		It("displays that no contexts were found", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting saved contexts..."))
+			Expect(testUI.Out).To(Say("No contexts found."))
+		})
+	})
+
+	Context("when there are saved contexts", func() {
+		BeforeEach(func() {
+			fakeConfig.ContextsReturns([]configv3.NamedContext{
+				{
+					Name:                 "dev",
+					Target:               "https://api.dev.com",
+					TargetedOrganization: configv3.Organization{Name: "dev-org"},
+					TargetedSpace:        configv3.Space{Name: "dev-space"},
+				},
+				{
+					Name:   "prod",
+					Target: "https://api.prod.com",
+				},
+			})
+			fakeConfig.CurrentContextReturns("prod")
+		})
+
+		It("displays the contexts and marks the current one", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Getting saved contexts..."))
+			Expect(testUI.Out).To(Say(`current\s+name\s+api endpoint\s+org\s+space`))
+			Expect(testUI.Out).To(Say(`\s+dev\s+https://api.dev.com\s+dev-org\s+dev-space`))
+			Expect(testUI.Out).To(Say(`\*\s+prod\s+https://api.prod.com`))
+		})
+	})
+})
@@ -0,0 +1,25 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type CreateSpaceManifestCommand struct {
+	FilePath         flag.Path   `short:"p" description:"Specify a path for the manifest file. If path not specified, the manifest file is created in the current working directory."`
+	ServicesFilePath flag.Path   `short:"s" description:"Specify a path for the user-provided services file. If path not specified, the services file is created in the current working directory."`
+	usage            interface{} `usage:"CF_NAME create-space-manifest [-p /path/to/<space-name>_manifest.yml] [-s /path/to/<space-name>_user_provided_services.yml]"`
+	relatedCommands  interface{} `related_commands:"create-app-manifest, create-user-provided-service, push"`
+}
+
+func (_ CreateSpaceManifestCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ CreateSpaceManifestCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
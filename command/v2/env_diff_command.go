@@ -0,0 +1,218 @@
+package v2
+
+import (
+	"encoding/json"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+)
+
+//go:generate counterfeiter . EnvDiffActor
+
+type EnvDiffActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationEnvironment(appGUID string) (v2action.ApplicationEnvironment, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+type EnvDiffCommand struct {
+	RequiredArgs    flag.EnvDiffArgs `positional-args:"yes"`
+	Organization    string           `long:"org" short:"o" description:"Org of the space given with --space (Default: targeted org)"`
+	Space           string           `long:"space" short:"s" description:"Compare with the app in this space instead of the targeted space"`
+	usage           interface{}      `usage:"CF_NAME env-diff APP_NAME OTHER_APP_NAME\n   CF_NAME env-diff APP_NAME [OTHER_APP_NAME] -s SPACE [-o ORG]\n\nEXAMPLES:\n   CF_NAME env-diff my-app my-app-venerable\n   CF_NAME env-diff my-app -s production -o my-org"`
+	relatedCommands interface{}      `related_commands:"env, running-environment-variable-group, set-env, staging-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EnvDiffActor
+}
+
+// envDiffApp is an application that is compared, and where it is.
+type envDiffApp struct {
+	name      string
+	orgName   string
+	spaceName string
+	spaceGUID string
+}
+
+func (cmd *EnvDiffCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+	return nil
+}
+
+func (cmd EnvDiffCommand) Execute(args []string) error {
+	if cmd.Organization != "" && cmd.Space == "" {
+		return command.RequiredFlagsError{Arg1: "--org", Arg2: "--space"}
+	}
+	if cmd.RequiredArgs.OtherAppName == "" && cmd.Space == "" {
+		return command.RequiredArgumentError{ArgumentName: "OTHER_APP_NAME"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	first := envDiffApp{
+		name:      cmd.RequiredArgs.AppName,
+		orgName:   cmd.Config.TargetedOrganization().Name,
+		spaceName: cmd.Config.TargetedSpace().Name,
+		spaceGUID: cmd.Config.TargetedSpace().GUID,
+	}
+	second, err := cmd.otherApp(first)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Comparing env variables of app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} with app {{.OtherAppName}} in org {{.OtherOrgName}} / space {{.OtherSpaceName}} as {{.Username}}...", map[string]interface{}{
+		"AppName":        first.name,
+		"OrgName":        first.orgName,
+		"SpaceName":      first.spaceName,
+		"OtherAppName":   second.name,
+		"OtherOrgName":   second.orgName,
+		"OtherSpaceName": second.spaceName,
+		"Username":       user.Name,
+	})
+	cmd.UI.DisplayNewline()
+
+	firstEnvironment, err := cmd.environment(first)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+	secondEnvironment, err := cmd.environment(second)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	differences := v2action.DiffApplicationEnvironments(firstEnvironment, secondEnvironment)
+	if len(differences) == 0 {
+		cmd.UI.DisplayText("No differences found")
+		return nil
+	}
+
+	firstLabel, secondLabel := envDiffLabels(first, second)
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("group"),
+			cmd.UI.TranslateText("name"),
+			firstLabel,
+			secondLabel,
+		},
+	}
+	for _, difference := range differences {
+		firstValue, err := cmd.displayValue(difference.Group, difference.Name, difference.FirstValue)
+		if err != nil {
+			return err
+		}
+		secondValue, err := cmd.displayValue(difference.Group, difference.Name, difference.SecondValue)
+		if err != nil {
+			return err
+		}
+		table = append(table, []string{string(difference.Group), difference.Name, firstValue, secondValue})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
+	return nil
+}
+
+// otherApp returns the application to compare with, which is in the space
+// given with --space, or in the targeted space.
+func (cmd EnvDiffCommand) otherApp(first envDiffApp) (envDiffApp, error) {
+	second := first
+	if cmd.RequiredArgs.OtherAppName != "" {
+		second.name = cmd.RequiredArgs.OtherAppName
+	}
+	if cmd.Space == "" {
+		return second, nil
+	}
+
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return envDiffApp{}, err
+		}
+		orgGUID = org.GUID
+		second.orgName = org.Name
+	}
+
+	space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(orgGUID, cmd.Space)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return envDiffApp{}, err
+	}
+	second.spaceName = space.Name
+	second.spaceGUID = space.GUID
+	return second, nil
+}
+
+func (cmd EnvDiffCommand) environment(app envDiffApp) (v2action.ApplicationEnvironment, error) {
+	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(app.name, app.spaceGUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return v2action.ApplicationEnvironment{}, err
+	}
+
+	environment, warnings, err := cmd.Actor.GetApplicationEnvironment(application.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	return environment, err
+}
+
+// displayValue formats the value of a variable for display, hiding it when
+// the name of the variable, or of a key nested in the value, looks like a
+// secret.
+func (cmd EnvDiffCommand) displayValue(group v2action.EnvironmentGroup, name string, value interface{}) (string, error) {
+	if value == nil {
+		if group == v2action.ServicesEnvironmentGroup {
+			return cmd.UI.TranslateText("(not bound)"), nil
+		}
+		return cmd.UI.TranslateText("(not set)"), nil
+	}
+
+	raw, err := json.Marshal(map[string]interface{}{name: value})
+	if err != nil {
+		return "", err
+	}
+	sanitized, err := ui.SanitizeJSON(raw)
+	if err != nil {
+		return "", err
+	}
+
+	if str, ok := sanitized[name].(string); ok {
+		return str, nil
+	}
+	raw, err = json.Marshal(sanitized[name])
+	return string(raw), err
+}
+
+// envDiffLabels returns the column headings for the two applications, which
+// include the space, and then the org, when that is needed to tell them
+// apart.
+func envDiffLabels(first envDiffApp, second envDiffApp) (string, string) {
+	if first.name != second.name {
+		return first.name, second.name
+	}
+	if first.spaceName != second.spaceName {
+		return first.spaceName + "/" + first.name, second.spaceName + "/" + second.name
+	}
+	return first.orgName + "/" + first.spaceName + "/" + first.name, second.orgName + "/" + second.spaceName + "/" + second.name
+}
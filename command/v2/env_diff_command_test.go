@@ -0,0 +1,213 @@
+package v2_test
+
+import (
+	"errors"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("env-diff Command", func() {
+	var (
+		cmd             EnvDiffCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeEnvDiffActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeEnvDiffActor)
+
+		cmd = EnvDiffCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.RequiredArgs.OtherAppName = "other-app"
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceStub = func(name string, _ string) (v2action.Application, v2action.Warnings, error) {
+			return v2action.Application{GUID: name + "-guid", Name: name}, v2action.Warnings{name + "-warning"}, nil
+		}
+		fakeActor.GetApplicationEnvironmentStub = func(appGUID string) (v2action.ApplicationEnvironment, v2action.Warnings, error) {
+			if appGUID == "some-app-guid" {
+				return v2action.ApplicationEnvironment{
+					UserProvided:    map[string]interface{}{"DB_PASSWORD": "secret", "LOG_LEVEL": "debug", "SAME": "value"},
+					ServiceBindings: map[string]interface{}{"some-db": "p-mysql"},
+				}, v2action.Warnings{"env-warning"}, nil
+			}
+			return v2action.ApplicationEnvironment{
+				UserProvided: map[string]interface{}{
+					"DB_PASSWORD": "other-secret",
+					"SAME":        "value",
+					"CONFIG":      map[string]interface{}{"api_token": "abc", "url": "https://example.com"},
+				},
+			}, v2action.Warnings{"other-env-warning"}, nil
+		}
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --org is provided without --space", func() {
+		BeforeEach(func() {
+			cmd.Organization = "other-org"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredFlagsError{Arg1: "--org", Arg2: "--space"}))
+		})
+	})
+
+	Context("when neither the other app nor --space is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.OtherAppName = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "OTHER_APP_NAME"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when comparing two apps in the targeted space", func() {
+		It("displays the differences with secrets redacted", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Comparing env variables of app some-app in org some-org / space some-space with app other-app in org some-org / space some-space as some-user..."))
+			Expect(testUI.Out).To(Say(`group\s+name\s+some-app\s+other-app`))
+			Expect(testUI.Out).To(Say(`user-provided\s+CONFIG\s+\(not set\)\s+{"api_token":"\[PRIVATE DATA HIDDEN\]","url":"https://example.com"}`))
+			Expect(testUI.Out).To(Say(`user-provided\s+DB_PASSWORD\s+\[PRIVATE DATA HIDDEN\]\s+\[PRIVATE DATA HIDDEN\]`))
+			Expect(testUI.Out).To(Say(`user-provided\s+LOG_LEVEL\s+debug\s+\(not set\)`))
+			Expect(testUI.Out).To(Say(`services\s+some-db\s+p-mysql\s+\(not bound\)`))
+			Expect(testUI.Out).ToNot(Say("SAME"))
+			Expect(testUI.Out).ToNot(Say("secret"))
+
+			Expect(testUI.Err).To(Say("some-app-warning"))
+			Expect(testUI.Err).To(Say("env-warning"))
+			Expect(testUI.Err).To(Say("other-app-warning"))
+			Expect(testUI.Err).To(Say("other-env-warning"))
+
+			Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(2))
+			_, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(1)
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+		})
+	})
+
+	Context("when the environments are the same", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationEnvironmentReturns(v2action.ApplicationEnvironment{}, nil, nil)
+			fakeActor.GetApplicationEnvironmentStub = nil
+		})
+
+		It("says so", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+			Expect(testUI.Out).To(Say("No differences found"))
+		})
+	})
+
+	Context("when comparing with the same app in another space", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.OtherAppName = ""
+			cmd.Space = "production"
+			cmd.Organization = "other-org"
+			fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "other-org-guid", Name: "other-org"}, v2action.Warnings{"org-warning"}, nil)
+			fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "production-guid", Name: "production"}, v2action.Warnings{"space-warning"}, nil)
+			fakeActor.GetApplicationByNameAndSpaceStub = func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+				if spaceGUID == "production-guid" {
+					return v2action.Application{GUID: "production-app-guid", Name: name}, nil, nil
+				}
+				return v2action.Application{GUID: name + "-guid", Name: name}, nil, nil
+			}
+		})
+
+		It("looks the app up in that space and labels the columns with the space", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Comparing env variables of app some-app in org some-org / space some-space with app some-app in org other-org / space production as some-user..."))
+			Expect(testUI.Out).To(Say(`group\s+name\s+some-space/some-app\s+production/some-app`))
+			Expect(testUI.Err).To(Say("org-warning"))
+			Expect(testUI.Err).To(Say("space-warning"))
+
+			orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+			Expect(orgGUID).To(Equal("other-org-guid"))
+			Expect(spaceName).To(Equal("production"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(1)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("production-guid"))
+		})
+
+		Context("when the space does not exist", func() {
+			BeforeEach(func() {
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{}, nil, v2action.SpaceNotFoundError{Name: "production"})
+			})
+
+			It("returns a SpaceNotFoundError", func() {
+				Expect(executeErr).To(MatchError(shared.SpaceNotFoundError{Name: "production"}))
+				Expect(fakeActor.GetApplicationByNameAndSpaceCallCount()).To(Equal(0))
+			})
+		})
+	})
+
+	Context("when an app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceStub = nil
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+		})
+	})
+
+	Context("when getting an environment fails", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationEnvironmentStub = nil
+			fakeActor.GetApplicationEnvironmentReturns(v2action.ApplicationEnvironment{}, v2action.Warnings{"env-warning"}, errors.New("env error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("env error"))
+			Expect(testUI.Err).To(Say("env-warning"))
+		})
+	})
+})
@@ -1,23 +1,254 @@
 package v2
 
 import (
-	"os"
+	"encoding/json"
+	"fmt"
+	"time"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 )
 
+//go:generate counterfeiter . EventsActor
+
+type EventsActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetEvents(filter v2action.EventFilter) ([]v2action.Event, v2action.Warnings, error)
+	GetOrganizationByName(orgName string) (v2action.Organization, v2action.Warnings, error)
+	GetSpaceByOrganizationAndName(orgGUID string, spaceName string) (v2action.Space, v2action.Warnings, error)
+}
+
+// eventsLimit is the number of most recent events that are displayed.
+const eventsLimit = 50
+
 type EventsCommand struct {
-	RequiredArgs flag.AppName `positional-args:"yes"`
-	usage        interface{}  `usage:"CF_NAME events APP_NAME"`
+	OptionalArgs    flag.OptionalAppName `positional-args:"yes"`
+	ActorName       string               `long:"actor" description:"Only show events caused by this user or client, by name or GUID"`
+	JSON            bool                 `long:"json" description:"Display the events as JSON"`
+	Organization    string               `long:"org" short:"o" description:"Show events in this org instead of the targeted space"`
+	Since           flag.Timestamp       `long:"since" description:"Only show events after this time, as an RFC3339 timestamp or a duration ago (e.g. 168h)"`
+	Space           string               `long:"space" short:"s" description:"Show events in this space of the targeted org, or of the org given with --org"`
+	TargetName      string               `long:"target" description:"Only show events that happened to this resource, by name or GUID"`
+	Type            string               `long:"type" description:"Only show events of this type (e.g. audit.route.delete-request)"`
+	Until           flag.Timestamp       `long:"until" description:"Only show events before this time, as an RFC3339 timestamp or a duration ago (e.g. 24h)"`
+	usage           interface{}          `usage:"CF_NAME events [APP_NAME] [-o ORG] [-s SPACE] [--actor ACTOR] [--type TYPE] [--target TARGET] [--since TIME] [--until TIME] [--json]\n\nEXAMPLES:\n   CF_NAME events my-app\n   CF_NAME events --type audit.route.delete-request --since 168h\n   CF_NAME events -o my-org --actor admin --json"`
+	relatedCommands interface{}          `related_commands:"app, apps, logs"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       EventsActor
 }
 
-func (_ EventsCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *EventsCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
 	return nil
 }
 
-func (_ EventsCommand) Execute(args []string) error {
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd EventsCommand) Execute(args []string) error {
+	appName := cmd.OptionalArgs.AppName
+	if appName != "" && cmd.Organization != "" {
+		return command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--org"}
+	}
+	if appName != "" && cmd.Space != "" {
+		return command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--space"}
+	}
+	if appName != "" && cmd.TargetName != "" {
+		return command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--target"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, cmd.Organization == "", cmd.Organization == "" && cmd.Space == "")
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	filter := v2action.EventFilter{
+		Actor:  cmd.ActorName,
+		Type:   cmd.Type,
+		Target: cmd.TargetName,
+		Since:  cmd.Since.Time,
+		Until:  cmd.Until.Time,
+		Limit:  eventsLimit,
+	}
+
+	orgName, spaceName, err := cmd.scopeFilter(&filter)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if !cmd.JSON {
+		cmd.displayHeader(orgName, spaceName, user.Name)
+	}
+
+	events, warnings, err := cmd.Actor.GetEvents(filter)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if cmd.JSON {
+		return cmd.displayEventsAsJSON(events)
+	}
+
+	if len(events) == 0 {
+		cmd.UI.DisplayText("No events found")
+		return nil
+	}
+
+	table := [][]string{
+		{
+			cmd.UI.TranslateText("time"),
+			cmd.UI.TranslateText("event"),
+			cmd.UI.TranslateText("actor"),
+			cmd.UI.TranslateText("target"),
+			cmd.UI.TranslateText("description"),
+		},
+	}
+	for _, event := range events {
+		actor := event.ActorName
+		if actor == "" {
+			actor = event.ActorGUID
+		}
+		target := event.ActeeName
+		if target == "" {
+			target = event.ActeeGUID
+		}
+
+		table = append(table, []string{
+			event.Timestamp.Local().Format("2006-01-02T15:04:05.00-0700"),
+			event.Type,
+			actor,
+			target,
+			event.Description(),
+		})
+	}
+	cmd.UI.DisplayTableWithHeader("", table, 3)
 	return nil
 }
+
+// scopeFilter limits the filter to the app, space or org that the events are
+// requested for, and returns the names of the org and space in scope. An
+// empty space name means the whole org is in scope.
+func (cmd EventsCommand) scopeFilter(filter *v2action.EventFilter) (string, string, error) {
+	orgName := cmd.Config.TargetedOrganization().Name
+	orgGUID := cmd.Config.TargetedOrganization().GUID
+	if cmd.Organization != "" {
+		org, warnings, err := cmd.Actor.GetOrganizationByName(cmd.Organization)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", "", err
+		}
+		orgName = org.Name
+		orgGUID = org.GUID
+	}
+
+	switch {
+	case cmd.Space != "":
+		space, warnings, err := cmd.Actor.GetSpaceByOrganizationAndName(orgGUID, cmd.Space)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", "", err
+		}
+		filter.SpaceGUID = space.GUID
+		return orgName, space.Name, nil
+	case cmd.Organization != "":
+		filter.OrganizationGUID = orgGUID
+		return orgName, "", nil
+	}
+
+	spaceName := cmd.Config.TargetedSpace().Name
+	if cmd.OptionalArgs.AppName != "" {
+		app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.OptionalArgs.AppName, cmd.Config.TargetedSpace().GUID)
+		cmd.UI.DisplayWarnings(warnings)
+		if err != nil {
+			return "", "", err
+		}
+		filter.TargetGUID = app.GUID
+		return orgName, spaceName, nil
+	}
+
+	filter.SpaceGUID = cmd.Config.TargetedSpace().GUID
+	return orgName, spaceName, nil
+}
+
+func (cmd EventsCommand) displayHeader(orgName string, spaceName string, username string) {
+	switch {
+	case cmd.OptionalArgs.AppName != "":
+		cmd.UI.DisplayTextWithFlavor("Getting events for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"AppName":   cmd.OptionalArgs.AppName,
+			"OrgName":   orgName,
+			"SpaceName": spaceName,
+			"Username":  username,
+		})
+	case spaceName == "":
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":  orgName,
+			"Username": username,
+		})
+	default:
+		cmd.UI.DisplayTextWithFlavor("Getting events in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+			"OrgName":   orgName,
+			"SpaceName": spaceName,
+			"Username":  username,
+		})
+	}
+	cmd.UI.DisplayNewline()
+}
+
+type eventResource struct {
+	GUID string `json:"guid"`
+	Type string `json:"type"`
+	Name string `json:"name"`
+}
+
+type eventJSON struct {
+	GUID             string                 `json:"guid"`
+	Type             string                 `json:"type"`
+	Actor            eventResource          `json:"actor"`
+	Target           eventResource          `json:"target"`
+	Timestamp        time.Time              `json:"timestamp"`
+	SpaceGUID        string                 `json:"space_guid,omitempty"`
+	OrganizationGUID string                 `json:"organization_guid,omitempty"`
+	Metadata         map[string]interface{} `json:"metadata"`
+}
+
+// displayEventsAsJSON displays the events as a JSON array, so that they can
+// be piped to other tools.
+func (cmd EventsCommand) displayEventsAsJSON(events []v2action.Event) error {
+	eventsJSON := []eventJSON{}
+	for _, event := range events {
+		eventsJSON = append(eventsJSON, eventJSON{
+			GUID:             event.GUID,
+			Type:             event.Type,
+			Actor:            eventResource{GUID: event.ActorGUID, Type: event.ActorType, Name: event.ActorName},
+			Target:           eventResource{GUID: event.ActeeGUID, Type: event.ActeeType, Name: event.ActeeName},
+			Timestamp:        event.Timestamp,
+			SpaceGUID:        event.SpaceGUID,
+			OrganizationGUID: event.OrganizationGUID,
+			Metadata:         event.Metadata,
+		})
+	}
+
+	output, err := json.MarshalIndent(eventsJSON, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(cmd.UI.Writer(), string(output))
+	return err
+}
@@ -0,0 +1,280 @@
+package v2_test
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("events Command", func() {
+	var (
+		cmd             EventsCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeEventsActor
+		binaryName      string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeEventsActor)
+
+		cmd = EventsCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when an app name is combined with --org", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppName = "some-app"
+			cmd.Organization = "other-org"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--org"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(1))
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the user is logged in and targeted", func() {
+		BeforeEach(func() {
+			fakeActor.GetEventsReturns(
+				[]v2action.Event{
+					{
+						GUID:      "event-guid",
+						Type:      "audit.route.delete-request",
+						ActorGUID: "admin-guid",
+						ActorType: "user",
+						ActorName: "admin",
+						ActeeGUID: "route-guid",
+						ActeeType: "route",
+						ActeeName: "some-host",
+						Timestamp: time.Date(2017, 4, 2, 10, 0, 0, 0, time.UTC),
+						Metadata:  map[string]interface{}{"request": map[string]interface{}{"recursive": true}},
+						SpaceGUID: "some-space-guid",
+					},
+				},
+				v2action.Warnings{"events-warning"},
+				nil,
+			)
+		})
+
+		Context("when no app, org or space is provided", func() {
+			BeforeEach(func() {
+				cmd.ActorName = "admin"
+				cmd.Type = "audit.route.delete-request"
+				cmd.TargetName = "some-host"
+				cmd.Since = flag.Timestamp{Time: time.Unix(1, 0)}
+				cmd.Until = flag.Timestamp{Time: time.Unix(2, 0)}
+			})
+
+			It("displays the filtered events in the targeted space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				Expect(testUI.Out).To(Say("Getting events in org some-org / space some-space as some-user..."))
+				Expect(testUI.Out).To(Say(`time\s+event\s+actor\s+target\s+description`))
+				Expect(testUI.Out).To(Say(`2017-04-0\dT\d\d:00:00.00[-+]\d{4}\s+audit.route.delete-request\s+admin\s+some-host\s+recursive: true`))
+				Expect(testUI.Err).To(Say("events-warning"))
+
+				Expect(fakeActor.GetEventsCallCount()).To(Equal(1))
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{
+					Actor:     "admin",
+					Type:      "audit.route.delete-request",
+					Target:    "some-host",
+					SpaceGUID: "some-space-guid",
+					Since:     time.Unix(1, 0),
+					Until:     time.Unix(2, 0),
+					Limit:     50,
+				}))
+			})
+		})
+
+		Context("when an app name is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppName = "some-app"
+				fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: "some-app"}, v2action.Warnings{"app-warning"}, nil)
+			})
+
+			It("displays the events of the app", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Getting events for app some-app in org some-org / space some-space as some-user..."))
+				Expect(testUI.Err).To(Say("app-warning"))
+
+				appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+				Expect(appName).To(Equal("some-app"))
+				Expect(spaceGUID).To(Equal("some-space-guid"))
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{TargetGUID: "some-app-guid", Limit: 50}))
+			})
+
+			Context("when the app does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, nil, v2action.ApplicationNotFoundError{Name: "some-app"})
+				})
+
+				It("returns an ApplicationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+					Expect(fakeActor.GetEventsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
+		Context("when --org is provided", func() {
+			BeforeEach(func() {
+				cmd.Organization = "other-org"
+				fakeActor.GetOrganizationByNameReturns(v2action.Organization{GUID: "other-org-guid", Name: "other-org"}, v2action.Warnings{"org-warning"}, nil)
+			})
+
+			It("displays the events of the org without requiring a targeted org or space", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("Getting events in org other-org as some-user..."))
+				Expect(testUI.Err).To(Say("org-warning"))
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeFalse())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				Expect(fakeActor.GetOrganizationByNameArgsForCall(0)).To(Equal("other-org"))
+				Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{OrganizationGUID: "other-org-guid", Limit: 50}))
+			})
+
+			Context("when --space is provided too", func() {
+				BeforeEach(func() {
+					cmd.Space = "other-space"
+					fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "other-space-guid", Name: "other-space"}, v2action.Warnings{"space-warning"}, nil)
+				})
+
+				It("displays the events of the space in that org", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+					Expect(testUI.Out).To(Say("Getting events in org other-org / space other-space as some-user..."))
+					Expect(testUI.Err).To(Say("space-warning"))
+
+					orgGUID, spaceName := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+					Expect(orgGUID).To(Equal("other-org-guid"))
+					Expect(spaceName).To(Equal("other-space"))
+					Expect(fakeActor.GetEventsArgsForCall(0)).To(Equal(v2action.EventFilter{SpaceGUID: "other-space-guid", Limit: 50}))
+				})
+			})
+
+			Context("when the org does not exist", func() {
+				BeforeEach(func() {
+					fakeActor.GetOrganizationByNameReturns(v2action.Organization{}, nil, v2action.OrganizationNotFoundError{Name: "other-org"})
+				})
+
+				It("returns an OrganizationNotFoundError", func() {
+					Expect(executeErr).To(MatchError(shared.OrganizationNotFoundError{Name: "other-org"}))
+				})
+			})
+		})
+
+		Context("when --space is provided without --org", func() {
+			BeforeEach(func() {
+				cmd.Space = "other-space"
+				fakeActor.GetSpaceByOrganizationAndNameReturns(v2action.Space{GUID: "other-space-guid", Name: "other-space"}, nil, nil)
+			})
+
+			It("looks the space up in the targeted org", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+				Expect(checkTargetedOrg).To(BeTrue())
+				Expect(checkTargetedSpace).To(BeFalse())
+
+				orgGUID, _ := fakeActor.GetSpaceByOrganizationAndNameArgsForCall(0)
+				Expect(orgGUID).To(Equal("some-org-guid"))
+			})
+		})
+
+		Context("when --json is provided", func() {
+			BeforeEach(func() {
+				cmd.JSON = true
+			})
+
+			It("displays the events as JSON", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).ToNot(Say("Getting events"))
+
+				var events []map[string]interface{}
+				Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &events)).To(Succeed())
+				Expect(events).To(Equal([]map[string]interface{}{
+					{
+						"guid":       "event-guid",
+						"type":       "audit.route.delete-request",
+						"actor":      map[string]interface{}{"guid": "admin-guid", "type": "user", "name": "admin"},
+						"target":     map[string]interface{}{"guid": "route-guid", "type": "route", "name": "some-host"},
+						"timestamp":  "2017-04-02T10:00:00Z",
+						"space_guid": "some-space-guid",
+						"metadata":   map[string]interface{}{"request": map[string]interface{}{"recursive": true}},
+					},
+				}))
+			})
+		})
+
+		Context("when there are no events", func() {
+			BeforeEach(func() {
+				fakeActor.GetEventsReturns(nil, nil, nil)
+			})
+
+			It("says so", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+				Expect(testUI.Out).To(Say("No events found"))
+			})
+		})
+
+		Context("when getting the events fails", func() {
+			BeforeEach(func() {
+				fakeActor.GetEventsReturns(nil, v2action.Warnings{"events-warning"}, errors.New("events error"))
+			})
+
+			It("returns the error and displays warnings", func() {
+				Expect(executeErr).To(MatchError("events error"))
+				Expect(testUI.Err).To(Say("events-warning"))
+			})
+		})
+	})
+})
@@ -0,0 +1,65 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/dotenv"
+)
+
+//go:generate counterfeiter . ExportEnvActor
+
+type ExportEnvActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	GetApplicationEnvironment(appGUID string) (v2action.ApplicationEnvironment, v2action.Warnings, error)
+}
+
+type ExportEnvCommand struct {
+	RequiredArgs    flag.AppName `positional-args:"yes"`
+	usage           interface{}  `usage:"CF_NAME export-env APP_NAME\n\nEXAMPLES:\n   CF_NAME export-env my-app > .env\n   CF_NAME set-env my-other-app --from-file .env"`
+	relatedCommands interface{}  `related_commands:"env, env-diff, set-env, unset-env"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       ExportEnvActor
+}
+
+func (cmd *ExportEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
+	return nil
+}
+
+// Execute writes the user provided env variables of the app as a dotenv file,
+// without a header, so that the output can be redirected to a file and read
+// with set-env --from-file. Warnings are written to stderr.
+func (cmd ExportEnvCommand) Execute(args []string) error {
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	environment, warnings, err := cmd.Actor.GetApplicationEnvironment(app.GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	return dotenv.Write(cmd.UI.Writer(), environment.UserProvided)
+}
@@ -13,6 +13,12 @@ type GetHealthCheckActor interface {
 	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
 }
 
+// healthCheckData is the structured output of the get-health-check command.
+type healthCheckData struct {
+	Type     string `json:"type"`
+	Endpoint string `json:"endpoint,omitempty"`
+}
+
 type GetHealthCheckCommand struct {
 	RequiredArgs flag.AppName `positional-args:"yes"`
 	usage        interface{}  `usage:"CF_NAME get-health-check APP_NAME"`
@@ -37,6 +43,10 @@ func (cmd *GetHealthCheckCommand) Setup(config command.Config, ui command.UI) er
 	return nil
 }
 
+func (cmd GetHealthCheckCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd GetHealthCheckCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
@@ -69,6 +79,13 @@ func (cmd GetHealthCheckCommand) Execute(args []string) error {
 
 	cmd.UI.DisplayNewline()
 
+	if cmd.UI.StructuredOutputEnabled() {
+		return cmd.UI.DisplayStructuredData("health_check", healthCheckData{
+			Type:     app.HealthCheckType,
+			Endpoint: app.CalculatedHealthCheckEndpoint(),
+		})
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("health check type:"), app.HealthCheckType},
 		{cmd.UI.TranslateText("endpoint (for http type):"), app.CalculatedHealthCheckEndpoint()},
@@ -154,6 +154,24 @@ var _ = Describe("get-health-check Command", func() {
 				Expect(testUI.Out).To(Say("health check type:          http"))
 				Expect(testUI.Out).To(Say("endpoint \\(for http type\\):   /some-endpoint"))
 			})
+
+			Context("when structured output is enabled", func() {
+				BeforeEach(func() {
+					testUI.OutputFormat = ui.OutputYAML
+				})
+
+				It("shows the health check as a versioned document", func() {
+					Expect(executeErr).ToNot(HaveOccurred())
+
+					Expect(testUI.Out).To(Say(`schema_version: 1
+kind: health_check
+data:
+  type: http
+  endpoint: /some-endpoint
+`))
+					Expect(testUI.Err).To(Say("Getting health check type for app some-app"))
+				})
+			})
 		})
 	})
 })
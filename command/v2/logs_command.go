@@ -1,6 +1,8 @@
 package v2
 
 import (
+	"strings"
+
 	"github.com/cloudfoundry/noaa/consumer"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
@@ -8,20 +10,32 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/types"
 )
 
 //go:generate counterfeiter . LogsActor
 
 type LogsActor interface {
+	GetApplicationsByNamePatternsAndSpace(patterns []string, spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	GetRecentLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, error)
 	GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
 	GetStreamingLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error, v2action.Warnings, error)
+	GetStreamingLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error)
 }
 
 type LogsCommand struct {
-	RequiredArgs    flag.AppName `positional-args:"yes"`
-	Recent          bool         `long:"recent" description:"Dump recent logs instead of tailing"`
-	usage           interface{}  `usage:"CF_NAME logs APP_NAME"`
-	relatedCommands interface{}  `related_commands:"app, apps, ssh"`
+	OptionalArgs    flag.AppNames      `positional-args:"yes"`
+	Instance        flag.InstanceIndex `long:"instance" short:"i" description:"Only show logs from the instance with this index"`
+	JSON            bool               `long:"json" description:"Display each log message as a JSON object on its own line"`
+	Recent          bool               `long:"recent" description:"Dump recent logs instead of tailing"`
+	Since           flag.Timestamp     `long:"since" description:"Only show recent logs after this time, as an RFC3339 timestamp or a duration ago (e.g. 30m)"`
+	Sources         []flag.LogSource   `long:"source" description:"Only show logs from this source (API, APP, CELL, LGR, RTR, SSH or STG), can be repeated"`
+	SpaceWide       bool               `long:"space-wide" description:"Show logs for all apps in the targeted space"`
+	Stream          flag.LogStream     `long:"stream" description:"Only show logs written to this stream (OUT or ERR)"`
+	Until           flag.Timestamp     `long:"until" description:"Only show recent logs before this time, as an RFC3339 timestamp or a duration ago (e.g. 30m)"`
+	usage           interface{}        `usage:"CF_NAME logs (APP_NAME... | --space-wide) [--recent [--since TIME] [--until TIME]] [--source SOURCE]... [-i INSTANCE] [--stream OUT|ERR] [--json]\n\nEXAMPLES:\n   CF_NAME logs my-app\n   CF_NAME logs 'web-*' worker --recent --json\n   CF_NAME logs --space-wide --source APP"`
+	relatedCommands interface{}        `related_commands:"app, apps, ssh"`
 
 	UI          command.UI
 	Config      command.Config
@@ -47,6 +61,22 @@ func (cmd *LogsCommand) Setup(config command.Config, ui command.UI) error {
 }
 
 func (cmd LogsCommand) Execute(args []string) error {
+	if len(cmd.OptionalArgs.AppNames) == 0 && !cmd.SpaceWide {
+		return command.RequiredArgumentError{ArgumentName: "APP_NAME"}
+	}
+	if len(cmd.OptionalArgs.AppNames) > 0 && cmd.SpaceWide {
+		return command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--space-wide"}
+	}
+
+	if !cmd.Recent {
+		if !cmd.Since.IsZero() {
+			return command.RequiredFlagsError{Arg1: "--since", Arg2: "--recent"}
+		}
+		if !cmd.Until.IsZero() {
+			return command.RequiredFlagsError{Arg1: "--until", Arg2: "--recent"}
+		}
+	}
+
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
 		return shared.HandleError(err)
@@ -57,14 +87,22 @@ func (cmd LogsCommand) Execute(args []string) error {
 		return err
 	}
 
-	cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
-		map[string]interface{}{
-			"AppName":   cmd.RequiredArgs.AppName,
-			"OrgName":   cmd.Config.TargetedOrganization().Name,
-			"SpaceName": cmd.Config.TargetedSpace().Name,
-			"Username":  user.Name,
-		})
-	cmd.UI.DisplayNewline()
+	if cmd.SpaceWide || len(cmd.OptionalArgs.AppNames) > 1 || strings.ContainsAny(cmd.OptionalArgs.AppNames[0], "*?") {
+		return cmd.displayApplicationsLogs(user.Name)
+	}
+
+	// The JSON lines are meant to be piped, so they are not preceded by any
+	// other text.
+	if !cmd.JSON {
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":   cmd.OptionalArgs.AppNames[0],
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  user.Name,
+			})
+		cmd.UI.DisplayNewline()
+	}
 
 	if cmd.Recent {
 		return cmd.displayRecentLogs()
@@ -75,14 +113,22 @@ func (cmd LogsCommand) Execute(args []string) error {
 
 func (cmd LogsCommand) displayRecentLogs() error {
 	messages, warnings, err := cmd.Actor.GetRecentLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		cmd.OptionalArgs.AppNames[0],
 		cmd.Config.TargetedSpace().GUID,
 		cmd.NOAAClient,
 		cmd.Config,
 	)
 
+	filter := cmd.logFilter()
 	for _, message := range messages {
-		cmd.UI.DisplayLogMessage(message, true)
+		if !filter.Matches(message) {
+			continue
+		}
+
+		displayErr := cmd.displayLogMessage(message)
+		if displayErr != nil {
+			return displayErr
+		}
 	}
 
 	cmd.UI.DisplayWarnings(warnings)
@@ -91,7 +137,7 @@ func (cmd LogsCommand) displayRecentLogs() error {
 
 func (cmd LogsCommand) streamLogs() error {
 	messages, logErrs, warnings, err := cmd.Actor.GetStreamingLogsForApplicationByNameAndSpace(
-		cmd.RequiredArgs.AppName,
+		cmd.OptionalArgs.AppNames[0],
 		cmd.Config.TargetedSpace().GUID,
 		cmd.NOAAClient,
 		cmd.Config,
@@ -102,6 +148,7 @@ func (cmd LogsCommand) streamLogs() error {
 		return err
 	}
 
+	filter := cmd.logFilter()
 	var messagesClosed, errLogsClosed bool
 	for {
 		select {
@@ -111,7 +158,15 @@ func (cmd LogsCommand) streamLogs() error {
 				break
 			}
 
-			cmd.UI.DisplayLogMessage(message, true)
+			if !filter.Matches(*message) {
+				break
+			}
+
+			err = cmd.displayLogMessage(*message)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
 		case logErr, ok := <-logErrs:
 			if !ok {
 				errLogsClosed = true
@@ -129,3 +184,148 @@ func (cmd LogsCommand) streamLogs() error {
 
 	return nil
 }
+
+func (cmd LogsCommand) logFilter() v2action.LogFilter {
+	filter := v2action.LogFilter{
+		SourceInstance: types.NullInt(cmd.Instance),
+		Type:           cmd.Stream.Type,
+		Since:          cmd.Since.Time,
+		Until:          cmd.Until.Time,
+	}
+	for _, source := range cmd.Sources {
+		filter.SourceTypes = append(filter.SourceTypes, source.Type)
+	}
+	return filter
+}
+
+func (cmd LogsCommand) displayLogMessage(message v2action.LogMessage) error {
+	if cmd.JSON {
+		return cmd.UI.DisplayLogMessageAsJSON(cmd.OptionalArgs.AppNames[0], message)
+	}
+
+	cmd.UI.DisplayLogMessage(message, true)
+	return nil
+}
+
+// displayApplicationsLogs displays the merged logs of all the applications
+// matching the app names, or of all the applications in the space.
+func (cmd LogsCommand) displayApplicationsLogs(username string) error {
+	var (
+		apps     []v2action.Application
+		warnings v2action.Warnings
+		err      error
+	)
+	if cmd.SpaceWide {
+		apps, warnings, err = cmd.Actor.GetApplicationsBySpace(cmd.Config.TargetedSpace().GUID)
+	} else {
+		apps, warnings, err = cmd.Actor.GetApplicationsByNamePatternsAndSpace(cmd.OptionalArgs.AppNames, cmd.Config.TargetedSpace().GUID)
+	}
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	if len(apps) == 0 {
+		cmd.UI.DisplayText("No apps found")
+		return nil
+	}
+
+	if !cmd.JSON {
+		var appNames []string
+		for _, app := range apps {
+			appNames = append(appNames, app.Name)
+		}
+
+		cmd.UI.DisplayTextWithFlavor("Retrieving logs for apps {{.AppNames}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppNames":  strings.Join(appNames, ", "),
+				"OrgName":   cmd.Config.TargetedOrganization().Name,
+				"SpaceName": cmd.Config.TargetedSpace().Name,
+				"Username":  username,
+			})
+		cmd.UI.DisplayNewline()
+	}
+
+	filter := cmd.logFilter()
+
+	if cmd.Recent {
+		messages, err := cmd.Actor.GetRecentLogsForApplications(apps, cmd.NOAAClient, cmd.Config)
+		if err != nil {
+			return err
+		}
+
+		for _, message := range messages {
+			if !filter.Matches(message) {
+				continue
+			}
+
+			err = cmd.displayAppLogMessage(message)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	messages, logErrs := cmd.Actor.GetStreamingLogsForApplications(apps, cmd.NOAAClient, cmd.Config)
+	for messages != nil || logErrs != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				break
+			}
+
+			if !filter.Matches(*message) {
+				break
+			}
+
+			err = cmd.displayAppLogMessage(*message)
+			if err != nil {
+				cmd.NOAAClient.Close()
+				return err
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+
+			// An error in one app's stream does not stop the other streams.
+			if streamErr, isStreamErr := logErr.(v2action.ApplicationLogStreamError); isStreamErr {
+				cmd.displayLogStreamError(streamErr)
+				break
+			}
+
+			cmd.NOAAClient.Close()
+			return logErr
+		}
+	}
+
+	return nil
+}
+
+func (cmd LogsCommand) displayAppLogMessage(message v2action.LogMessage) error {
+	if cmd.JSON {
+		return cmd.UI.DisplayLogMessageAsJSON(message.AppName(), message)
+	}
+
+	cmd.UI.DisplayAppLogMessage(message.AppName(), message)
+	return nil
+}
+
+func (cmd LogsCommand) displayLogStreamError(err v2action.ApplicationLogStreamError) {
+	switch err.Err.(type) {
+	case v2action.NOAATimeoutError:
+		cmd.UI.DisplayWarning("timeout connecting to log server for app {{.AppName}}, its logs will not be shown",
+			map[string]interface{}{
+				"AppName": err.AppName,
+			})
+	default:
+		cmd.UI.DisplayWarning("Error streaming logs for app {{.AppName}}: {{.Error}}",
+			map[string]interface{}{
+				"AppName": err.AppName,
+				"Error":   err.Err.Error(),
+			})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v2"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
@@ -47,7 +48,7 @@ var _ = Describe("logs command", func() {
 
 		binaryName = "faceman"
 		fakeConfig.BinaryNameReturns(binaryName)
-		cmd.RequiredArgs.AppName = "some-app"
+		cmd.OptionalArgs.AppNames = []string{"some-app"}
 		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
 	})
 
@@ -55,6 +56,26 @@ var _ = Describe("logs command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when no app names are provided", func() {
+		BeforeEach(func() {
+			cmd.OptionalArgs.AppNames = nil
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "APP_NAME"}))
+		})
+	})
+
+	Context("when app names and --space-wide are provided", func() {
+		BeforeEach(func() {
+			cmd.SpaceWide = true
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "APP_NAME", Arg2: "--space-wide"}))
+		})
+	})
+
 	Context("when the checkTarget fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(
@@ -146,6 +167,34 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
+
+				Context("when filters are provided", func() {
+					BeforeEach(func() {
+						cmd.Instance = flag.InstanceIndex{IsSet: true, Value: 2}
+						cmd.Stream = flag.LogStream{Type: "OUT"}
+						cmd.Since = flag.Timestamp{Time: time.Unix(1, 0)}
+						cmd.Until = flag.Timestamp{Time: time.Unix(2, 0)}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("i am message 1"))
+						Expect(testUI.Out).To(Say("i am message 2"))
+					})
+				})
+
+				Context("when the --json flag is provided", func() {
+					BeforeEach(func() {
+						cmd.JSON = true
+					})
+
+					It("displays each log message as a JSON object without flavor text", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("Retrieving logs"))
+						Expect(testUI.Out).To(Say(`{"app_name":"some-app","timestamp":"1970-01-01T00:00:00Z","source_type":"app","source_instance":"1","type":"OUT","message":"i am message 1"}\n`))
+						Expect(testUI.Out).To(Say(`{"app_name":"some-app","timestamp":"1970-01-01T00:00:01Z","source_type":"another-app","source_instance":"2","type":"OUT","message":"i am message 2"}\n`))
+					})
+				})
 			})
 		})
 
@@ -154,6 +203,27 @@ var _ = Describe("logs command", func() {
 				cmd.Recent = false
 			})
 
+			Context("when --since is provided", func() {
+				BeforeEach(func() {
+					cmd.Since = flag.Timestamp{Time: time.Unix(1, 0)}
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(executeErr).To(MatchError(command.RequiredFlagsError{Arg1: "--since", Arg2: "--recent"}))
+					Expect(fakeActor.GetStreamingLogsForApplicationByNameAndSpaceCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when --until is provided", func() {
+				BeforeEach(func() {
+					cmd.Until = flag.Timestamp{Time: time.Unix(1, 0)}
+				})
+
+				It("returns a RequiredFlagsError", func() {
+					Expect(executeErr).To(MatchError(command.RequiredFlagsError{Arg1: "--until", Arg2: "--recent"}))
+				})
+			})
+
 			Context("when the logs setup returns an error", func() {
 				var expectedErr error
 
@@ -247,6 +317,147 @@ var _ = Describe("logs command", func() {
 					Expect(client).To(Equal(noaaClient))
 					Expect(config).To(Equal(fakeConfig))
 				})
+
+				Context("when a source filter is provided", func() {
+					BeforeEach(func() {
+						cmd.Sources = []flag.LogSource{{Type: "APP"}}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).To(Say("i am message 1"))
+						Expect(testUI.Out).ToNot(Say("i am message 2"))
+					})
+				})
+			})
+		})
+
+		Context("when several apps are provided", func() {
+			var apps []v2action.Application
+
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = []string{"web-*", "worker"}
+				apps = []v2action.Application{
+					{Name: "web-1", GUID: "web-1-guid"},
+					{Name: "worker", GUID: "worker-guid"},
+				}
+				fakeActor.GetApplicationsByNamePatternsAndSpaceReturns(apps, v2action.Warnings{"app-warning"}, nil)
+			})
+
+			Context("when the apps cannot be found", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsByNamePatternsAndSpaceReturns(nil, v2action.Warnings{"app-warning"}, v2action.ApplicationNotFoundError{Name: "web-*"})
+				})
+
+				It("returns an ApplicationNotFoundError and displays the warnings", func() {
+					Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "web-*"}))
+					Expect(testUI.Err).To(Say("app-warning"))
+				})
+			})
+
+			Context("when the --recent flag is provided", func() {
+				BeforeEach(func() {
+					cmd.Recent = true
+					fakeActor.GetRecentLogsForApplicationsReturns(
+						[]v2action.LogMessage{
+							*v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0"),
+							*v2action.NewLogMessage("i am message 2", 1, time.Unix(1, 0), "RTR", "0"),
+						},
+						nil)
+				})
+
+				It("displays flavor text and the logs of all the apps", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("app-warning"))
+					Expect(testUI.Out).To(Say("Retrieving logs for apps web-1, worker in org some-org-name / space some-space-name as some-user..."))
+					Expect(testUI.Out).To(Say("i am message 1"))
+					Expect(testUI.Out).To(Say("i am message 2"))
+
+					patterns, spaceGUID := fakeActor.GetApplicationsByNamePatternsAndSpaceArgsForCall(0)
+					Expect(patterns).To(Equal([]string{"web-*", "worker"}))
+					Expect(spaceGUID).To(Equal("some-space-guid"))
+
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+					actualApps, client, config := fakeActor.GetRecentLogsForApplicationsArgsForCall(0)
+					Expect(actualApps).To(Equal(apps))
+					Expect(client).To(Equal(noaaClient))
+					Expect(config).To(Equal(fakeConfig))
+				})
+
+				Context("when a filter is provided", func() {
+					BeforeEach(func() {
+						cmd.Sources = []flag.LogSource{{Type: "RTR"}}
+					})
+
+					It("only displays the matching log messages", func() {
+						Expect(executeErr).NotTo(HaveOccurred())
+						Expect(testUI.Out).ToNot(Say("i am message 1"))
+						Expect(testUI.Out).To(Say("i am message 2"))
+					})
+				})
+			})
+
+			Context("when the --recent flag is not provided", func() {
+				BeforeEach(func() {
+					fakeActor.GetStreamingLogsForApplicationsStub = func(_ []v2action.Application, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error) {
+						messages := make(chan *v2action.LogMessage)
+						logErrs := make(chan error)
+
+						go func() {
+							messages <- v2action.NewLogMessage("i am message 1", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+							logErrs <- v2action.ApplicationLogStreamError{AppName: "worker", Err: v2action.NOAATimeoutError{}}
+							logErrs <- v2action.ApplicationLogStreamError{AppName: "web-1", Err: errors.New("some-error")}
+							messages <- v2action.NewLogMessage("i am message 2", 1, time.Unix(1, 0), "APP/PROC/WEB", "0")
+							close(messages)
+							close(logErrs)
+						}()
+
+						return messages, logErrs
+					}
+				})
+
+				It("displays the logs of all the apps, and stream errors as warnings", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("i am message 1"))
+					Expect(testUI.Out).To(Say("i am message 2"))
+					Expect(testUI.Err).To(Say("timeout connecting to log server for app worker, its logs will not be shown"))
+					Expect(testUI.Err).To(Say("Error streaming logs for app web-1: some-error"))
+
+					Expect(fakeActor.GetStreamingLogsForApplicationsCallCount()).To(Equal(1))
+					actualApps, _, _ := fakeActor.GetStreamingLogsForApplicationsArgsForCall(0)
+					Expect(actualApps).To(Equal(apps))
+				})
+			})
+		})
+
+		Context("when --space-wide is provided", func() {
+			BeforeEach(func() {
+				cmd.OptionalArgs.AppNames = nil
+				cmd.SpaceWide = true
+				cmd.Recent = true
+			})
+
+			Context("when there are apps in the space", func() {
+				BeforeEach(func() {
+					fakeActor.GetApplicationsBySpaceReturns([]v2action.Application{{Name: "some-app"}}, v2action.Warnings{"space-warning"}, nil)
+				})
+
+				It("displays the logs of all the apps in the space", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Err).To(Say("space-warning"))
+					Expect(testUI.Out).To(Say("Retrieving logs for apps some-app"))
+
+					Expect(fakeActor.GetApplicationsBySpaceArgsForCall(0)).To(Equal("some-space-guid"))
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when there are no apps in the space", func() {
+				It("displays that no apps were found", func() {
+					Expect(executeErr).NotTo(HaveOccurred())
+					Expect(testUI.Out).To(Say("No apps found"))
+					Expect(fakeActor.GetRecentLogsForApplicationsCallCount()).To(Equal(0))
+				})
 			})
 		})
 	})
@@ -27,6 +27,16 @@ type OrgActorV3 interface {
 	CloudControllerAPIVersion() string
 }
 
+// organizationData is the structured output of the org command.
+type organizationData struct {
+	Name              string   `json:"name"`
+	GUID              string   `json:"guid"`
+	Domains           []string `json:"domains"`
+	Quota             string   `json:"quota"`
+	Spaces            []string `json:"spaces"`
+	IsolationSegments []string `json:"isolation_segments,omitempty"`
+}
+
 type OrgCommand struct {
 	RequiredArgs    flag.Organization `positional-args:"yes"`
 	GUID            bool              `long:"guid" description:"Retrieve and display the given org's guid.  All other output for the org is suppressed."`
@@ -63,6 +73,10 @@ func (cmd *OrgCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd OrgCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd OrgCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, false, false)
 	if err != nil {
@@ -108,6 +122,14 @@ func (cmd OrgCommand) displayOrgSummary() error {
 		return shared.HandleError(err)
 	}
 
+	data := organizationData{
+		Name:    orgSummary.Name,
+		GUID:    orgSummary.GUID,
+		Domains: orgSummary.DomainNames,
+		Quota:   orgSummary.QuotaName,
+		Spaces:  orgSummary.SpaceNames,
+	}
+
 	table := [][]string{
 		{cmd.UI.TranslateText("name:"), orgSummary.Name},
 		{cmd.UI.TranslateText("domains:"), strings.Join(orgSummary.DomainNames, ", ")},
@@ -130,10 +152,15 @@ func (cmd OrgCommand) displayOrgSummary() error {
 			}
 
 			sort.Strings(isolationSegmentNames)
+			data.IsolationSegments = isolationSegmentNames
 			table = append(table, []string{cmd.UI.TranslateText("isolation segments:"), strings.Join(isolationSegmentNames, ", ")})
 		}
 	}
 
+	if cmd.UI.StructuredOutputEnabled() {
+		return cmd.UI.DisplayStructuredData("organization", data)
+	}
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	return nil
@@ -225,6 +225,40 @@ var _ = Describe("org Command", func() {
 					orgGuid := fakeActorV3.GetIsolationSegmentsByOrganizationArgsForCall(0)
 					Expect(orgGuid).To(Equal("some-org-guid"))
 				})
+				Context("when structured output is enabled", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = ui.OutputJSON
+					})
+
+					It("displays the org summary as a versioned document", func() {
+						Expect(executeErr).To(BeNil())
+
+						Expect(testUI.Out).To(Say(`{
+  "schema_version": 1,
+  "kind": "organization",
+  "data": {
+    "name": "some-org",
+    "guid": "some-org-guid",
+    "domains": \[
+      "a-shared.com",
+      "b-private.com",
+      "c-shared.com",
+      "d-private.com"
+    \],
+    "quota": "some-quota",
+    "spaces": \[
+      "space1",
+      "space2"
+    \],
+    "isolation_segments": \[
+      "isolation-segment-1",
+      "isolation-segment-2"
+    \]
+  }
+}`))
+						Expect(testUI.Err).To(Say("Getting info for org some-org as some-user"))
+					})
+				})
 			})
 
 			Context("when api version is below 3.11.0", func() {
@@ -15,6 +15,10 @@ func (_ OrgsCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (_ OrgsCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (_ OrgsCommand) Execute(args []string) error {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
@@ -17,6 +17,7 @@ type PushCommand struct {
 	PathToManifest       flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
 	HealthCheckType      flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
 	Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	IgnoreFile           flag.PathWithExistenceCheck `long:"ignore-file" description:"Path to a file of .cfignore patterns to use instead of the .cfignore in the app directory"`
 	NumInstances         int                         `short:"i" description:"Number of instances"`
 	DiskLimit            string                      `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	MemoryLimit          string                      `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
@@ -17,6 +17,10 @@ func (_ RoutesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (_ RoutesCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (_ RoutesCommand) Execute(args []string) error {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
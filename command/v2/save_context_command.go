@@ -0,0 +1,41 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+)
+
+type SaveContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME save-context NAME\n\nEXAMPLES:\n   CF_NAME save-context prod"`
+	relatedCommands interface{}      `related_commands:"contexts, use-context"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *SaveContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd SaveContextCommand) Execute(args []string) error {
+	if cmd.Config.Target() == "" {
+		return command.NoAPISetError{BinaryName: cmd.Config.BinaryName()}
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Saving the current target as context {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	cmd.Config.SaveContext(cmd.RequiredArgs.Name)
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Use '{{.BinaryName}} use-context {{.Name}}' to switch back to this target.", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"Name":       cmd.RequiredArgs.Name,
+	})
+	return nil
+}
@@ -0,0 +1,60 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("save-context Command", func() {
+	var (
+		cmd        SaveContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeConfig.BinaryNameReturns("faceman")
+
+		cmd = SaveContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "prod"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when no API is set", func() {
+		It("returns a NoAPISetError", func() {
+			Expect(executeErr).To(MatchError(command.NoAPISetError{BinaryName: "faceman"}))
+			Expect(fakeConfig.SaveContextCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when an API is set", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.prod.com")
+		})
+
+		It("saves the context", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.SaveContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.SaveContextArgsForCall(0)).To(Equal("prod"))
+
+			Expect(testUI.Out).To(Say("Saving the current target as context prod..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Use 'faceman use-context prod' to switch back to this target."))
+		})
+	})
+})
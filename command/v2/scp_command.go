@@ -0,0 +1,26 @@
+package v2
+
+import (
+	"os"
+
+	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/command"
+)
+
+type SCPCommand struct {
+	AppInstanceIndex   int         `long:"app-instance-index" short:"i" description:"Application instance index"`
+	PreserveTimes      bool        `long:"preserve-times" short:"p" description:"Preserve modification and access times of the copied files"`
+	Recursive          bool        `long:"recursive" short:"r" description:"Recursively copy directories"`
+	SkipHostValidation bool        `long:"skip-host-validation" short:"k" description:"Skip host key validation"`
+	usage              interface{} `usage:"CF_NAME scp APP_NAME [-i app-instance-index] [-r] [-p] [--skip-host-validation] SOURCE... TARGET\n\n   Paths in the application container instance start with ':'. Either all of the SOURCE paths or the TARGET path must be in the instance.\n\nEXAMPLES:\n   CF_NAME scp my-app ./config.yml :/home/vcap/app/\n   CF_NAME scp my-app -r :/home/vcap/app/logs ./logs"`
+	relatedCommands    interface{} `related_commands:"ssh, ssh-enabled"`
+}
+
+func (_ SCPCommand) Setup(config command.Config, ui command.UI) error {
+	return nil
+}
+
+func (_ SCPCommand) Execute(args []string) error {
+	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+	return nil
+}
@@ -16,6 +16,10 @@ func (_ SecurityGroupsCommand) Setup(config command.Config, ui command.UI) error
 	return nil
 }
 
+func (_ SecurityGroupsCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (_ SecurityGroupsCommand) Execute(args []string) error {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
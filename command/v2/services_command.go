@@ -16,6 +16,10 @@ func (_ ServicesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (_ ServicesCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (_ ServicesCommand) Execute(args []string) error {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
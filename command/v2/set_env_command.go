@@ -2,27 +2,128 @@ package v2
 
 import (
 	"os"
+	"strings"
 
-	"code.cloudfoundry.org/cli/cf/cmd"
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	oldCmd "code.cloudfoundry.org/cli/cf/cmd"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/dotenv"
 )
 
 // WorkAroundPrefix is the flag in hole emoji
 const WorkAroundPrefix = "\U000026f3"
 
+//go:generate counterfeiter . SetEnvActor
+
+type SetEnvActor interface {
+	GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	SetApplicationEnvironmentVariables(appGUID string, variables map[string]string, prune bool) (v2action.EnvironmentVariableChanges, v2action.Warnings, error)
+}
+
 type SetEnvCommand struct {
-	RequiredArgs    flag.SetEnvironmentArgs `positional-args:"yes"`
-	usage           interface{}             `usage:"CF_NAME set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE"`
-	relatedCommands interface{}             `related_commands:"apps, env, restart, set-staging-environment-variable-group, set-running-environment-variable-group"`
+	RequiredArgs    flag.SetEnvironmentArgs     `positional-args:"yes"`
+	FromFile        flag.PathWithExistenceCheck `long:"from-file" description:"Set every env variable in a dotenv file of NAME=value lines"`
+	Prune           bool                        `long:"prune" description:"Remove env variables that are not in the file given with --from-file"`
+	usage           interface{}                 `usage:"CF_NAME set-env APP_NAME ENV_VAR_NAME ENV_VAR_VALUE\n   CF_NAME set-env APP_NAME --from-file PATH [--prune]\n\nEXAMPLES:\n   CF_NAME set-env my-app LOG_LEVEL debug\n   CF_NAME set-env my-app --from-file .env --prune"`
+	relatedCommands interface{}                 `related_commands:"apps, env, export-env, restart, restage, set-staging-environment-variable-group, set-running-environment-variable-group"`
+
+	UI          command.UI
+	Config      command.Config
+	SharedActor command.SharedActor
+	Actor       SetEnvActor
 }
 
-func (_ SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+func (cmd *SetEnvCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	cmd.SharedActor = sharedaction.NewActor()
+
+	// Setting a single variable is still done by the legacy command, which
+	// creates its own clients.
+	if cmd.FromFile == "" {
+		return nil
+	}
+
+	ccClient, uaaClient, err := shared.NewClients(config, ui, true)
+	if err != nil {
+		return err
+	}
+	cmd.Actor = v2action.NewActor(ccClient, uaaClient)
 	return nil
 }
 
-func (_ SetEnvCommand) Execute(args []string) error {
-	//TODO: Be sure to sanitize the WorkAroundPrefix
-	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
+func (cmd SetEnvCommand) Execute(args []string) error {
+	if cmd.FromFile == "" {
+		if cmd.Prune {
+			return command.RequiredFlagsError{Arg1: "--prune", Arg2: "--from-file"}
+		}
+
+		//TODO: Be sure to sanitize the WorkAroundPrefix
+		oldCmd.Main(os.Getenv("CF_TRACE"), os.Args)
+		return nil
+	}
+
+	if cmd.RequiredArgs.EnvironmentVariableName != "" {
+		return command.ArgumentCombinationError{Arg1: "ENV_VAR_NAME", Arg2: "--from-file"}
+	}
+
+	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	variables, err := dotenv.ReadFile(string(cmd.FromFile))
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	user, err := cmd.Config.CurrentUser()
+	if err != nil {
+		return err
+	}
+
+	cmd.UI.DisplayTextWithFlavor("Setting env variables from {{.Path}} for app {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...", map[string]interface{}{
+		"Path":      string(cmd.FromFile),
+		"AppName":   cmd.RequiredArgs.AppName,
+		"OrgName":   cmd.Config.TargetedOrganization().Name,
+		"SpaceName": cmd.Config.TargetedSpace().Name,
+		"Username":  user.Name,
+	})
+
+	app, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, cmd.Config.TargetedSpace().GUID)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	changes, warnings, err := cmd.Actor.SetApplicationEnvironmentVariables(app.GUID, variables, cmd.Prune)
+	cmd.UI.DisplayWarnings(warnings)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	if len(changes.Set) == 0 && len(changes.Removed) == 0 {
+		cmd.UI.DisplayText("No env variables changed")
+		return nil
+	}
+
+	if len(changes.Set) > 0 {
+		cmd.UI.DisplayText("Set: {{.Names}}", map[string]interface{}{
+			"Names": strings.Join(changes.Set, ", "),
+		})
+	}
+	if len(changes.Removed) > 0 {
+		cmd.UI.DisplayText("Removed: {{.Names}}", map[string]interface{}{
+			"Names": strings.Join(changes.Removed, ", "),
+		})
+	}
+	cmd.UI.DisplayText("TIP: Use '{{.BinaryName}} restage {{.AppName}}' to ensure your env variable changes take effect", map[string]interface{}{
+		"BinaryName": cmd.Config.BinaryName(),
+		"AppName":    cmd.RequiredArgs.AppName,
+	})
 	return nil
 }
@@ -0,0 +1,200 @@
+package v2_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/command/v2/v2fakes"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("set-env Command", func() {
+	var (
+		cmd             SetEnvCommand
+		testUI          *ui.UI
+		fakeConfig      *commandfakes.FakeConfig
+		fakeSharedActor *commandfakes.FakeSharedActor
+		fakeActor       *v2fakes.FakeSetEnvActor
+		binaryName      string
+		envFilePath     string
+		executeErr      error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+		fakeSharedActor = new(commandfakes.FakeSharedActor)
+		fakeActor = new(v2fakes.FakeSetEnvActor)
+
+		file, err := ioutil.TempFile("", "set-env")
+		Expect(err).ToNot(HaveOccurred())
+		_, err = file.WriteString("# some comment\nLOG_LEVEL=debug\nGREETING=\"hello world\"\n")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.Close()).To(Succeed())
+		envFilePath = file.Name()
+
+		cmd = SetEnvCommand{
+			UI:          testUI,
+			Config:      fakeConfig,
+			SharedActor: fakeSharedActor,
+			Actor:       fakeActor,
+		}
+		cmd.RequiredArgs.AppName = "some-app"
+		cmd.FromFile = flag.PathWithExistenceCheck(envFilePath)
+
+		binaryName = "faceman"
+		fakeConfig.BinaryNameReturns(binaryName)
+		fakeConfig.TargetedOrganizationReturns(configv3.Organization{GUID: "some-org-guid", Name: "some-org"})
+		fakeConfig.TargetedSpaceReturns(configv3.Space{GUID: "some-space-guid", Name: "some-space"})
+		fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+		fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{GUID: "some-app-guid", Name: "some-app"}, v2action.Warnings{"app-warning"}, nil)
+		fakeActor.SetApplicationEnvironmentVariablesReturns(
+			v2action.EnvironmentVariableChanges{Set: []string{"GREETING", "LOG_LEVEL"}, Removed: []string{"OLD_VAR"}},
+			v2action.Warnings{"set-warning"},
+			nil,
+		)
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(envFilePath)).To(Succeed())
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when --prune is provided without --from-file", func() {
+		BeforeEach(func() {
+			cmd.FromFile = ""
+			cmd.Prune = true
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredFlagsError{Arg1: "--prune", Arg2: "--from-file"}))
+		})
+	})
+
+	Context("when an env variable name is provided with --from-file", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.EnvironmentVariableName = "SOME_VAR"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "ENV_VAR_NAME", Arg2: "--from-file"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when checking target fails", func() {
+		BeforeEach(func() {
+			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
+		})
+
+		It("returns an error", func() {
+			Expect(executeErr).To(MatchError(command.NotLoggedInError{BinaryName: binaryName}))
+
+			_, checkTargetedOrg, checkTargetedSpace := fakeSharedActor.CheckTargetArgsForCall(0)
+			Expect(checkTargetedOrg).To(BeTrue())
+			Expect(checkTargetedSpace).To(BeTrue())
+		})
+	})
+
+	Context("when the file is not a valid dotenv file", func() {
+		BeforeEach(func() {
+			Expect(ioutil.WriteFile(envFilePath, []byte("LOG_LEVEL=debug\nnot a variable\n"), 0600)).To(Succeed())
+		})
+
+		It("returns an InvalidEnvFileError", func() {
+			Expect(executeErr).To(MatchError(shared.InvalidEnvFileError{Path: envFilePath, Line: 2, Message: "expected NAME=value"}))
+			Expect(fakeActor.SetApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when the env variables are set", func() {
+		It("sets every variable in the file in one update and displays a single restage tip", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("Setting env variables from %s for app some-app in org some-org / space some-space as some-user...", envFilePath))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("Set: GREETING, LOG_LEVEL"))
+			Expect(testUI.Out).To(Say("Removed: OLD_VAR"))
+			Expect(testUI.Out).To(Say("TIP: Use 'faceman restage some-app' to ensure your env variable changes take effect"))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(testUI.Err).To(Say("set-warning"))
+
+			appName, spaceGUID := fakeActor.GetApplicationByNameAndSpaceArgsForCall(0)
+			Expect(appName).To(Equal("some-app"))
+			Expect(spaceGUID).To(Equal("some-space-guid"))
+
+			Expect(fakeActor.SetApplicationEnvironmentVariablesCallCount()).To(Equal(1))
+			appGUID, variables, prune := fakeActor.SetApplicationEnvironmentVariablesArgsForCall(0)
+			Expect(appGUID).To(Equal("some-app-guid"))
+			Expect(variables).To(Equal(map[string]string{"LOG_LEVEL": "debug", "GREETING": "hello world"}))
+			Expect(prune).To(BeFalse())
+		})
+
+		Context("when --prune is provided", func() {
+			BeforeEach(func() {
+				cmd.Prune = true
+			})
+
+			It("prunes the variables that are not in the file", func() {
+				Expect(executeErr).ToNot(HaveOccurred())
+
+				_, _, prune := fakeActor.SetApplicationEnvironmentVariablesArgsForCall(0)
+				Expect(prune).To(BeTrue())
+			})
+		})
+	})
+
+	Context("when no env variables change", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationEnvironmentVariablesReturns(v2action.EnvironmentVariableChanges{}, nil, nil)
+		})
+
+		It("says so and does not display the restage tip", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say("No env variables changed"))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+
+	Context("when the app does not exist", func() {
+		BeforeEach(func() {
+			fakeActor.GetApplicationByNameAndSpaceReturns(v2action.Application{}, v2action.Warnings{"app-warning"}, v2action.ApplicationNotFoundError{Name: "some-app"})
+		})
+
+		It("returns an ApplicationNotFoundError and displays warnings", func() {
+			Expect(executeErr).To(MatchError(command.ApplicationNotFoundError{Name: "some-app"}))
+			Expect(testUI.Err).To(Say("app-warning"))
+			Expect(fakeActor.SetApplicationEnvironmentVariablesCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when setting the env variables fails", func() {
+		BeforeEach(func() {
+			fakeActor.SetApplicationEnvironmentVariablesReturns(v2action.EnvironmentVariableChanges{}, v2action.Warnings{"set-warning"}, errors.New("set error"))
+		})
+
+		It("returns the error and displays warnings", func() {
+			Expect(executeErr).To(MatchError("set error"))
+			Expect(testUI.Err).To(Say("set-warning"))
+			Expect(testUI.Out).ToNot(Say("TIP"))
+		})
+	})
+})
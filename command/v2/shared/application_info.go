@@ -10,16 +10,51 @@ import (
 	"github.com/cloudfoundry/bytefmt"
 )
 
+// applicationData is the structured output of the application summary.
+type applicationData struct {
+	Name             string                    `json:"name"`
+	GUID             string                    `json:"guid"`
+	RequestedState   string                    `json:"requested_state"`
+	Instances        int                       `json:"instances"`
+	RunningInstances int                       `json:"running_instances"`
+	Memory           int                       `json:"memory_in_mb"`
+	DiskQuota        int                       `json:"disk_quota_in_mb"`
+	IsolationSegment string                    `json:"isolation_segment,omitempty"`
+	Routes           []string                  `json:"routes"`
+	LastUploaded     string                    `json:"last_uploaded,omitempty"`
+	Stack            string                    `json:"stack"`
+	Buildpack        string                    `json:"buildpack"`
+	StartCommand     string                    `json:"start_command,omitempty"`
+	InstanceStats    []applicationInstanceData `json:"instance_stats"`
+}
+
+type applicationInstanceData struct {
+	Index       int     `json:"index"`
+	State       string  `json:"state"`
+	Since       string  `json:"since"`
+	CPU         float64 `json:"cpu"`
+	Memory      int     `json:"memory_in_bytes"`
+	MemoryQuota int     `json:"memory_quota_in_bytes"`
+	Disk        int     `json:"disk_in_bytes"`
+	DiskQuota   int     `json:"disk_quota_in_bytes"`
+	Details     string  `json:"details,omitempty"`
+}
+
 // DisplayAppSummary displays the application summary to the UI, and optionally
-// the command to start the app.
-func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, displayStartCommand bool) {
-	instances := fmt.Sprintf("%d/%d", appSummary.StartingOrRunningInstanceCount(), appSummary.Instances)
+// the command to start the app. When structured output is enabled the summary
+// is displayed as a versioned document instead of tables.
+func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, displayStartCommand bool) error {
+	if ui.StructuredOutputEnabled() {
+		return displayAppSummaryData(ui, appSummary, displayStartCommand)
+	}
+
+	instances := fmt.Sprintf("%d/%d", appSummary.StartingOrRunningInstanceCount(), appSummary.Instances.Value)
 
 	usage := ui.TranslateText(
 		"{{.MemorySize}} x {{.NumInstances}} instances",
 		map[string]interface{}{
 			"MemorySize":   bytefmt.ByteSize(uint64(appSummary.Memory) * bytefmt.MEGABYTE),
-			"NumInstances": appSummary.Instances,
+			"NumInstances": appSummary.Instances.Value,
 		})
 
 	formattedRoutes := []string{}
@@ -57,6 +92,50 @@ func DisplayAppSummary(ui command.UI, appSummary v2action.ApplicationSummary, di
 	} else {
 		displayAppInstances(ui, appSummary.RunningInstances)
 	}
+
+	return nil
+}
+
+func displayAppSummaryData(ui command.UI, appSummary v2action.ApplicationSummary, displayStartCommand bool) error {
+	data := applicationData{
+		Name:             appSummary.Name,
+		GUID:             appSummary.GUID,
+		RequestedState:   strings.ToLower(string(appSummary.State)),
+		Instances:        appSummary.Instances.Value,
+		RunningInstances: appSummary.StartingOrRunningInstanceCount(),
+		Memory:           appSummary.Memory,
+		DiskQuota:        appSummary.DiskQuota,
+		IsolationSegment: appSummary.IsolationSegment,
+		Routes:           []string{},
+		Stack:            appSummary.Stack.Name,
+		Buildpack:        appSummary.Application.CalculatedBuildpack(),
+		InstanceStats:    []applicationInstanceData{},
+	}
+
+	if !appSummary.PackageUpdatedAt.IsZero() {
+		data.LastUploaded = zuluDate(appSummary.PackageUpdatedAt)
+	}
+	if displayStartCommand {
+		data.StartCommand = appSummary.Application.DetectedStartCommand
+	}
+	for _, route := range appSummary.Routes {
+		data.Routes = append(data.Routes, route.String())
+	}
+	for _, instance := range appSummary.RunningInstances {
+		data.InstanceStats = append(data.InstanceStats, applicationInstanceData{
+			Index:       instance.ID,
+			State:       strings.ToLower(string(instance.State)),
+			Since:       zuluDate(instance.TimeSinceCreation()),
+			CPU:         instance.CPU,
+			Memory:      instance.Memory,
+			MemoryQuota: instance.MemoryQuota,
+			Disk:        instance.Disk,
+			DiskQuota:   instance.DiskQuota,
+			Details:     instance.Details,
+		})
+	}
+
+	return ui.DisplayStructuredData("application", data)
 }
 
 func displayAppInstances(ui command.UI, instances []v2action.ApplicationInstanceWithStats) {
@@ -2,6 +2,7 @@ package shared
 
 import (
 	"fmt"
+	"strings"
 	"time"
 )
 
@@ -60,6 +61,20 @@ func (e OrganizationNotFoundError) Translate(translate func(string, ...interface
 	})
 }
 
+type IgnoreFileNotFoundError struct {
+	Path string
+}
+
+func (e IgnoreFileNotFoundError) Error() string {
+	return "Ignore file '{{.Path}}' not found."
+}
+
+func (e IgnoreFileNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
+
 type SecurityGroupNotFoundError struct {
 	Name string
 }
@@ -74,6 +89,53 @@ func (e SecurityGroupNotFoundError) Translate(translate func(string, ...interfac
 	})
 }
 
+type ServiceNotFoundError struct {
+	Name string
+}
+
+func (e ServiceNotFoundError) Error() string {
+	return "Service offering '{{.Name}}' not found."
+}
+
+func (e ServiceNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ServicePlanNotFoundError struct {
+	PlanName    string
+	ServiceName string
+}
+
+func (e ServicePlanNotFoundError) Error() string {
+	return "Service plan '{{.PlanName}}' not found for service offering '{{.ServiceName}}'."
+}
+
+func (e ServicePlanNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"PlanName":    e.PlanName,
+		"ServiceName": e.ServiceName,
+	})
+}
+
+// ServiceInstanceTypeChangedError is returned when a space manifest lists a
+// service instance as managed when it is user provided, or the other way
+// around.
+type ServiceInstanceTypeChangedError struct {
+	Name string
+}
+
+func (e ServiceInstanceTypeChangedError) Error() string {
+	return "Service instance '{{.Name}}' cannot be changed between a managed and a user-provided service instance. Delete it first."
+}
+
+func (e ServiceInstanceTypeChangedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
 type SpaceNotFoundError struct {
 	Name string
 }
@@ -187,3 +249,284 @@ func (e StartupTimeoutError) Translate(translate func(string, ...interface{}) st
 		"BinaryName": e.BinaryName,
 	})
 }
+
+type InvalidManifestError struct {
+	Path   string
+	Errors []string
+}
+
+func (e InvalidManifestError) Error() string {
+	return "Manifest file '{{.Path}}' is invalid:\n{{.Errors}}"
+}
+
+func (e InvalidManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":   e.Path,
+		"Errors": strings.Join(e.Errors, "\n"),
+	})
+}
+
+type InvalidEnvFileError struct {
+	Path    string
+	Line    int
+	Message string
+}
+
+func (e InvalidEnvFileError) Error() string {
+	return "Env file '{{.Path}}' is invalid:\nline {{.Line}}: {{.Message}}"
+}
+
+func (e InvalidEnvFileError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path":    e.Path,
+		"Line":    e.Line,
+		"Message": e.Message,
+	})
+}
+
+type ManifestInheritanceCycleError struct {
+	Path string
+}
+
+func (e ManifestInheritanceCycleError) Error() string {
+	return "Manifest '{{.Path}}' inherits from itself"
+}
+
+func (e ManifestInheritanceCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
+
+type PropertyCombinationError struct {
+	AppName    string
+	Properties []string
+}
+
+func (e PropertyCombinationError) Error() string {
+	return "Application {{.AppName}} cannot use the combination of properties: {{.Properties}}"
+}
+
+func (e PropertyCombinationError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Properties": strings.Join(e.Properties, ", "),
+	})
+}
+
+type AppNotFoundInManifestError struct {
+	Name string
+}
+
+func (e AppNotFoundInManifestError) Error() string {
+	return "Could not find app named '{{.AppName}}' in manifest"
+}
+
+func (e AppNotFoundInManifestError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName": e.Name,
+	})
+}
+
+type CommandLineOptionsWithMultipleAppsError struct{}
+
+func (e CommandLineOptionsWithMultipleAppsError) Error() string {
+	return "Incorrect Usage: Command line flags (except -f) cannot be applied when pushing multiple apps from a manifest file."
+}
+
+func (e CommandLineOptionsWithMultipleAppsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type MissingAppNameError struct{}
+
+func (e MissingAppNameError) Error() string {
+	return "Incorrect Usage: The push command requires an app name. The app name can be supplied as an argument or with a manifest.yml file."
+}
+
+func (e MissingAppNameError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+type SpaceQuotaNotFoundError struct {
+	Name string
+}
+
+func (e SpaceQuotaNotFoundError) Error() string {
+	return "Space quota '{{.Name}}' not found."
+}
+
+func (e SpaceQuotaNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type StackNotFoundError struct {
+	Name string
+}
+
+func (e StackNotFoundError) Error() string {
+	return "Stack '{{.Name}}' not found."
+}
+
+func (e StackNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type RollbackFailedError struct {
+	AppName       string
+	DeployError   string
+	RollbackError string
+}
+
+func (e RollbackFailedError) Error() string {
+	return "Deploying app {{.AppName}} failed: {{.DeployError}}\nRolling back to the previous app also failed: {{.RollbackError}}"
+}
+
+func (e RollbackFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":       e.AppName,
+		"DeployError":   e.DeployError,
+		"RollbackError": e.RollbackError,
+	})
+}
+
+type TemporaryAppExistsError struct {
+	Name string
+}
+
+func (e TemporaryAppExistsError) Error() string {
+	return "App {{.Name}} already exists, possibly left over from an earlier deployment. Delete or rename it and try again."
+}
+
+func (e TemporaryAppExistsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
+
+type ManifestDependencyNotFoundError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e ManifestDependencyNotFoundError) Error() string {
+	return "Application {{.AppName}} depends on {{.Dependency}}, which is not in the manifest"
+}
+
+func (e ManifestDependencyNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Dependency": e.Dependency,
+	})
+}
+
+type ManifestDependencyCycleError struct {
+	AppNames []string
+}
+
+func (e ManifestDependencyCycleError) Error() string {
+	return "Application dependencies in the manifest form a cycle: {{.AppNames}}"
+}
+
+func (e ManifestDependencyCycleError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppNames": strings.Join(e.AppNames, " -> "),
+	})
+}
+
+type DependencyFailedError struct {
+	AppName    string
+	Dependency string
+}
+
+func (e DependencyFailedError) Error() string {
+	return "App {{.AppName}} was not pushed because app {{.Dependency}} failed"
+}
+
+func (e DependencyFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":    e.AppName,
+		"Dependency": e.Dependency,
+	})
+}
+
+type translatableError interface {
+	Translate(func(string, ...interface{}) string) string
+}
+
+// AppPushFailure is the error returned while pushing a single app.
+type AppPushFailure struct {
+	AppName string
+	Err     error
+}
+
+// PushFailedError is returned when one or more apps pushed from a manifest
+// fail. Each failure is displayed below the app that it belongs to.
+type PushFailedError struct {
+	Failures []AppPushFailure
+}
+
+func (e PushFailedError) Error() string {
+	return "Failed to push {{.Count}} app(s):{{.Failures}}"
+}
+
+func (e PushFailedError) Translate(translate func(string, ...interface{}) string) string {
+	var failures string
+	for _, failure := range e.Failures {
+		message := failure.Err.Error()
+		if translatableErr, ok := failure.Err.(translatableError); ok {
+			message = translatableErr.Translate(translate)
+		}
+		failures += "\n" + failure.AppName + ": " + message
+	}
+
+	return translate(e.Error(), map[string]interface{}{
+		"Count":    len(e.Failures),
+		"Failures": failures,
+	})
+}
+
+type BadCredentialsError struct{}
+
+func (e BadCredentialsError) Error() string {
+	return "Credentials were rejected, please try again."
+}
+
+func (e BadCredentialsError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error())
+}
+
+// PasswordGrantTypeLogoutRequiredError is returned when a user tries to
+// authenticate while a service account is logged in.
+type PasswordGrantTypeLogoutRequiredError struct {
+	BinaryName string
+}
+
+func (e PasswordGrantTypeLogoutRequiredError) Error() string {
+	return "Service account currently logged in. Use '{{.BinaryName}} logout' to log out service account and try again."
+}
+
+func (e PasswordGrantTypeLogoutRequiredError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"BinaryName": e.BinaryName,
+	})
+}
+
+// ContextNotFoundError is returned when a named context has not been saved.
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (e ContextNotFoundError) Error() string {
+	return "Context '{{.Name}}' not found."
+}
+
+func (e ContextNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Name": e.Name,
+	})
+}
@@ -2,6 +2,7 @@ package shared_test
 
 import (
 	"bytes"
+	"errors"
 	"text/template"
 
 	. "code.cloudfoundry.org/cli/command/v2/shared"
@@ -38,6 +39,9 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Actor errors.
 		Entry("JobFailedError", JobFailedError{}),
+		Entry("BadCredentialsError", BadCredentialsError{}),
+		Entry("PasswordGrantTypeLogoutRequiredError", PasswordGrantTypeLogoutRequiredError{}),
+		Entry("ContextNotFoundError", ContextNotFoundError{}),
 		Entry("JobTimeoutError", JobTimeoutError{}),
 		Entry("HTTPHealthCheckInvalidError", HTTPHealthCheckInvalidError{}),
 		Entry("StagingFailedError", StagingFailedError{}),
@@ -49,7 +53,22 @@ var _ = Describe("Translatable Errors", func() {
 		Entry("NoOrgTargetedError", NoOrganizationTargetedError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
 		Entry("SecurityGroupNotFoundError", SecurityGroupNotFoundError{}),
+		Entry("ServiceNotFoundError", ServiceNotFoundError{}),
+		Entry("ServicePlanNotFoundError", ServicePlanNotFoundError{}),
+		Entry("ServiceInstanceTypeChangedError", ServiceInstanceTypeChangedError{}),
 		Entry("SpaceNotFoundError", SpaceNotFoundError{}),
+		Entry("SpaceQuotaNotFoundError", SpaceQuotaNotFoundError{}),
 		Entry("UnsuccessfulStartError", UnsuccessfulStartError{}),
+		Entry("RollbackFailedError", RollbackFailedError{}),
+		Entry("TemporaryAppExistsError", TemporaryAppExistsError{}),
+		Entry("ManifestDependencyNotFoundError", ManifestDependencyNotFoundError{}),
+		Entry("ManifestDependencyCycleError", ManifestDependencyCycleError{}),
+		Entry("DependencyFailedError", DependencyFailedError{}),
+		Entry("PushFailedError", PushFailedError{
+			Failures: []AppPushFailure{
+				{AppName: "some-app", Err: DependencyFailedError{AppName: "some-app", Dependency: "some-other-app"}},
+				{AppName: "some-other-app", Err: errors.New("some-error")},
+			},
+		}),
 	)
 })
@@ -1,11 +1,15 @@
 package shared
 
 import (
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/dotenv"
 )
 
 func HandleError(err error) error {
@@ -24,9 +28,14 @@ func HandleError(err error) error {
 	case ccerror.JobTimeoutError:
 		return JobTimeoutError{JobGUID: e.JobGUID}
 
+	case uaa.BadCredentialsError:
+		return BadCredentialsError{}
 	case uaa.InvalidAuthTokenError:
 		return InvalidRefreshTokenError{}
 
+	case configv3.ContextNotFoundError:
+		return ContextNotFoundError{Name: e.Name}
+
 	case sharedaction.NotLoggedInError:
 		return command.NotLoggedInError{BinaryName: e.BinaryName}
 	case sharedaction.NoTargetedOrganizationError:
@@ -36,16 +45,61 @@ func HandleError(err error) error {
 
 	case v2action.ApplicationNotFoundError:
 		return command.ApplicationNotFoundError{Name: e.Name}
+	case v2action.IgnoreFileNotFoundError:
+		return IgnoreFileNotFoundError{Path: e.Path}
 	case v2action.OrganizationNotFoundError:
 		return OrganizationNotFoundError{Name: e.Name}
 	case v2action.SecurityGroupNotFoundError:
 		return SecurityGroupNotFoundError{Name: e.Name}
 	case v2action.ServiceInstanceNotFoundError:
 		return command.ServiceInstanceNotFoundError{Name: e.Name}
+	case v2action.ServiceNotFoundError:
+		return ServiceNotFoundError{Name: e.Name}
+	case v2action.ServicePlanNotFoundError:
+		return ServicePlanNotFoundError{PlanName: e.PlanName, ServiceName: e.ServiceName}
 	case v2action.SpaceNotFoundError:
 		return SpaceNotFoundError{Name: e.Name}
+	case v2action.SpaceQuotaNotFoundError:
+		if e.Name != "" {
+			return SpaceQuotaNotFoundError{Name: e.Name}
+		}
 	case v2action.HTTPHealthCheckInvalidError:
 		return HTTPHealthCheckInvalidError{}
+	case v2action.StackNotFoundError:
+		if e.Name != "" {
+			return StackNotFoundError{Name: e.Name}
+		}
+
+	case pushaction.AppNotFoundInManifestError:
+		return AppNotFoundInManifestError{Name: e.Name}
+	case pushaction.DependencyFailedError:
+		return DependencyFailedError{AppName: e.AppName, Dependency: e.Dependency}
+	case pushaction.CommandLineOptionsWithMultipleAppsError:
+		return CommandLineOptionsWithMultipleAppsError{}
+	case pushaction.MissingNameError:
+		return MissingAppNameError{}
+	case pushaction.ServiceInstanceTypeChangedError:
+		return ServiceInstanceTypeChangedError{Name: e.Name}
+	case pushaction.RollbackFailedError:
+		return RollbackFailedError{AppName: e.AppName, DeployError: e.Err.Error(), RollbackError: e.RollbackErr.Error()}
+	case pushaction.TemporaryApplicationExistsError:
+		return TemporaryAppExistsError{Name: e.Name}
+
+	case manifest.DependencyCycleError:
+		return ManifestDependencyCycleError{AppNames: e.AppNames}
+	case manifest.DependencyNotFoundError:
+		return ManifestDependencyNotFoundError{AppName: e.AppName, Dependency: e.Dependency}
+	case manifest.HTTPHealthCheckInvalidError:
+		return HTTPHealthCheckInvalidError{}
+	case manifest.InheritanceCycleError:
+		return ManifestInheritanceCycleError{Path: e.Path}
+	case manifest.InvalidManifestError:
+		return InvalidManifestError{Path: e.Path, Errors: e.Errors}
+	case manifest.PropertyCombinationError:
+		return PropertyCombinationError{AppName: e.AppName, Properties: e.Properties}
+
+	case dotenv.ParseError:
+		return InvalidEnvFileError{Path: e.Path, Line: e.Line, Message: e.Message}
 	}
 
 	return err
@@ -3,12 +3,16 @@ package shared_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/uaa"
 	"code.cloudfoundry.org/cli/command"
 	. "code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/dotenv"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/ginkgo/extensions/table"
 	. "github.com/onsi/gomega"
@@ -51,6 +55,14 @@ var _ = Describe("HandleError", func() {
 			v2action.ServiceInstanceNotFoundError{Name: "some-service-instance"},
 			command.ServiceInstanceNotFoundError{Name: "some-service-instance"}),
 
+		Entry("v2action.ServiceNotFoundError -> ServiceNotFoundError",
+			v2action.ServiceNotFoundError{Name: "some-service"},
+			ServiceNotFoundError{Name: "some-service"}),
+
+		Entry("v2action.ServicePlanNotFoundError -> ServicePlanNotFoundError",
+			v2action.ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"},
+			ServicePlanNotFoundError{PlanName: "some-plan", ServiceName: "some-service"}),
+
 		Entry("ccerror.JobFailedError -> JobFailedError",
 			ccerror.JobFailedError{JobGUID: "some-job-guid"},
 			JobFailedError{JobGUID: "some-job-guid"}),
@@ -67,6 +79,10 @@ var _ = Describe("HandleError", func() {
 			v2action.SpaceNotFoundError{Name: "some-space"},
 			SpaceNotFoundError{Name: "some-space"}),
 
+		Entry("v2action.SpaceQuotaNotFoundError -> SpaceQuotaNotFoundError",
+			v2action.SpaceQuotaNotFoundError{Name: "some-quota"},
+			SpaceQuotaNotFoundError{Name: "some-quota"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			command.NotLoggedInError{BinaryName: "faceman"}),
@@ -84,11 +100,101 @@ var _ = Describe("HandleError", func() {
 			HTTPHealthCheckInvalidError{},
 		),
 
+		Entry("v2action.IgnoreFileNotFoundError -> IgnoreFileNotFoundError",
+			v2action.IgnoreFileNotFoundError{Path: "some-path"},
+			IgnoreFileNotFoundError{Path: "some-path"},
+		),
+
+		Entry("v2action.StackNotFoundError -> StackNotFoundError",
+			v2action.StackNotFoundError{Name: "some-stack"},
+			StackNotFoundError{Name: "some-stack"},
+		),
+
+		Entry("pushaction.AppNotFoundInManifestError -> AppNotFoundInManifestError",
+			pushaction.AppNotFoundInManifestError{Name: "some-app"},
+			AppNotFoundInManifestError{Name: "some-app"},
+		),
+
+		Entry("pushaction.CommandLineOptionsWithMultipleAppsError -> CommandLineOptionsWithMultipleAppsError",
+			pushaction.CommandLineOptionsWithMultipleAppsError{},
+			CommandLineOptionsWithMultipleAppsError{},
+		),
+
+		Entry("pushaction.DependencyFailedError -> DependencyFailedError",
+			pushaction.DependencyFailedError{AppName: "some-app", Dependency: "some-other-app"},
+			DependencyFailedError{AppName: "some-app", Dependency: "some-other-app"},
+		),
+
+		Entry("pushaction.ServiceInstanceTypeChangedError -> ServiceInstanceTypeChangedError",
+			pushaction.ServiceInstanceTypeChangedError{Name: "some-service-instance"},
+			ServiceInstanceTypeChangedError{Name: "some-service-instance"},
+		),
+
+		Entry("pushaction.MissingNameError -> MissingAppNameError",
+			pushaction.MissingNameError{},
+			MissingAppNameError{},
+		),
+
+		Entry("pushaction.RollbackFailedError -> RollbackFailedError",
+			pushaction.RollbackFailedError{AppName: "some-app", Err: errors.New("deploy failed"), RollbackErr: errors.New("rollback failed")},
+			RollbackFailedError{AppName: "some-app", DeployError: "deploy failed", RollbackError: "rollback failed"},
+		),
+
+		Entry("pushaction.TemporaryApplicationExistsError -> TemporaryAppExistsError",
+			pushaction.TemporaryApplicationExistsError{Name: "some-app-new"},
+			TemporaryAppExistsError{Name: "some-app-new"},
+		),
+
+		Entry("manifest.DependencyCycleError -> ManifestDependencyCycleError",
+			manifest.DependencyCycleError{AppNames: []string{"app-1", "app-2", "app-1"}},
+			ManifestDependencyCycleError{AppNames: []string{"app-1", "app-2", "app-1"}},
+		),
+
+		Entry("manifest.DependencyNotFoundError -> ManifestDependencyNotFoundError",
+			manifest.DependencyNotFoundError{AppName: "some-app", Dependency: "some-other-app"},
+			ManifestDependencyNotFoundError{AppName: "some-app", Dependency: "some-other-app"},
+		),
+
+		Entry("manifest.HTTPHealthCheckInvalidError -> HTTPHealthCheckInvalidError",
+			manifest.HTTPHealthCheckInvalidError{AppName: "some-app"},
+			HTTPHealthCheckInvalidError{},
+		),
+
+		Entry("manifest.InheritanceCycleError -> ManifestInheritanceCycleError",
+			manifest.InheritanceCycleError{Path: "some-path"},
+			ManifestInheritanceCycleError{Path: "some-path"},
+		),
+
+		Entry("manifest.InvalidManifestError -> InvalidManifestError",
+			manifest.InvalidManifestError{Path: "some-path", Errors: []string{"line 1: some-error"}},
+			InvalidManifestError{Path: "some-path", Errors: []string{"line 1: some-error"}},
+		),
+
+		Entry("manifest.PropertyCombinationError -> PropertyCombinationError",
+			manifest.PropertyCombinationError{AppName: "some-app", Properties: []string{"docker", "buildpack"}},
+			PropertyCombinationError{AppName: "some-app", Properties: []string{"docker", "buildpack"}},
+		),
+
+		Entry("dotenv.ParseError -> InvalidEnvFileError",
+			dotenv.ParseError{Path: "some-path", Line: 2, Message: "some-error"},
+			InvalidEnvFileError{Path: "some-path", Line: 2, Message: "some-error"},
+		),
+
+		Entry("uaa.BadCredentialsError -> BadCredentialsError",
+			uaa.BadCredentialsError{Message: "Bad credentials"},
+			BadCredentialsError{},
+		),
+
 		Entry("uaa.InvalidAuthTokenError -> InvalidRefreshTokenError",
 			uaa.InvalidAuthTokenError{},
 			InvalidRefreshTokenError{},
 		),
 
+		Entry("configv3.ContextNotFoundError -> ContextNotFoundError",
+			configv3.ContextNotFoundError{Name: "some-context"},
+			ContextNotFoundError{Name: "some-context"},
+		),
+
 		Entry("default case -> original error",
 			err,
 			err),
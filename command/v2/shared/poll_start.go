@@ -51,22 +51,7 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 				break
 			}
 
-			switch err := apiErr.(type) {
-			case v2action.StagingFailedError:
-				return StagingFailedError{Message: err.Error()}
-			case v2action.StagingFailedNoAppDetectedError:
-				return StagingFailedNoAppDetectedError{BinaryName: config.BinaryName(), Message: err.Error()}
-			case v2action.StagingTimeoutError:
-				return StagingTimeoutError{AppName: err.Name, Timeout: err.Timeout}
-			case v2action.ApplicationInstanceCrashedError:
-				return UnsuccessfulStartError{AppName: err.Name, BinaryName: config.BinaryName()}
-			case v2action.ApplicationInstanceFlappingError:
-				return UnsuccessfulStartError{AppName: err.Name, BinaryName: config.BinaryName()}
-			case v2action.StartupTimeoutError:
-				return StartupTimeoutError{AppName: err.Name, BinaryName: config.BinaryName()}
-			default:
-				return HandleError(apiErr)
-			}
+			return HandleStartError(apiErr, config.BinaryName())
 		}
 
 		if breakAppStart && breakWarnings && breakAPIErrs {
@@ -74,3 +59,24 @@ func PollStart(ui command.UI, config command.Config, messages <-chan *v2action.L
 		}
 	}
 }
+
+// HandleStartError converts errors that occur while staging and starting an
+// application into command errors.
+func HandleStartError(err error, binaryName string) error {
+	switch e := err.(type) {
+	case v2action.StagingFailedError:
+		return StagingFailedError{Message: e.Error()}
+	case v2action.StagingFailedNoAppDetectedError:
+		return StagingFailedNoAppDetectedError{BinaryName: binaryName, Message: e.Error()}
+	case v2action.StagingTimeoutError:
+		return StagingTimeoutError{AppName: e.Name, Timeout: e.Timeout}
+	case v2action.ApplicationInstanceCrashedError:
+		return UnsuccessfulStartError{AppName: e.Name, BinaryName: binaryName}
+	case v2action.ApplicationInstanceFlappingError:
+		return UnsuccessfulStartError{AppName: e.Name, BinaryName: binaryName}
+	case v2action.StartupTimeoutError:
+		return StartupTimeoutError{AppName: e.Name, BinaryName: binaryName}
+	default:
+		return HandleError(err)
+	}
+}
@@ -28,6 +28,28 @@ type SpaceActorV3 interface {
 	GetEffectiveIsolationSegmentBySpace(spaceGUID string, orgDefaultIsolationSegmentGUID string) (v3action.IsolationSegment, v3action.Warnings, error)
 }
 
+// spaceData is the structured output of the space command.
+type spaceData struct {
+	Name               string                  `json:"name"`
+	GUID               string                  `json:"guid"`
+	Org                string                  `json:"org"`
+	Apps               []string                `json:"apps"`
+	Services           []string                `json:"services"`
+	IsolationSegment   string                  `json:"isolation_segment,omitempty"`
+	SpaceQuota         string                  `json:"space_quota"`
+	SecurityGroups     []string                `json:"security_groups"`
+	SecurityGroupRules []securityGroupRuleData `json:"security_group_rules,omitempty"`
+}
+
+type securityGroupRuleData struct {
+	SecurityGroup string `json:"security_group"`
+	Destination   string `json:"destination"`
+	Ports         string `json:"ports,omitempty"`
+	Protocol      string `json:"protocol"`
+	Lifecycle     string `json:"lifecycle"`
+	Description   string `json:"description,omitempty"`
+}
+
 type SpaceCommand struct {
 	RequiredArgs       flag.Space  `positional-args:"yes"`
 	GUID               bool        `long:"guid" description:"Retrieve and display the given space's guid.  All other output for the space is suppressed."`
@@ -65,6 +87,10 @@ func (cmd *SpaceCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd SpaceCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd SpaceCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, false)
 
@@ -133,6 +159,34 @@ func (cmd SpaceCommand) displaySpaceSummary(displaySecurityGroupRules bool) erro
 	table = append(table,
 		[]string{cmd.UI.TranslateText("security groups:"), strings.Join(spaceSummary.SecurityGroupNames, ", ")})
 
+	if cmd.UI.StructuredOutputEnabled() {
+		data := spaceData{
+			Name:           spaceSummary.Name,
+			GUID:           spaceSummary.GUID,
+			Org:            spaceSummary.OrgName,
+			Apps:           spaceSummary.AppNames,
+			Services:       spaceSummary.ServiceInstanceNames,
+			SpaceQuota:     spaceSummary.SpaceQuotaName,
+			SecurityGroups: spaceSummary.SecurityGroupNames,
+		}
+		if isolationSegmentRow != nil {
+			data.IsolationSegment = isolationSegmentRow[1]
+		}
+		if displaySecurityGroupRules {
+			for _, rule := range spaceSummary.SecurityGroupRules {
+				data.SecurityGroupRules = append(data.SecurityGroupRules, securityGroupRuleData{
+					SecurityGroup: rule.Name,
+					Destination:   rule.Destination,
+					Ports:         rule.Ports,
+					Protocol:      rule.Protocol,
+					Lifecycle:     rule.Lifecycle,
+					Description:   rule.Description,
+				})
+			}
+		}
+		return cmd.UI.DisplayStructuredData("space", data)
+	}
+
 	cmd.UI.DisplayKeyValueTable("", table, 3)
 
 	if displaySecurityGroupRules {
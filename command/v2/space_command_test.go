@@ -166,7 +166,7 @@ var _ = Describe("space Command", func() {
 							Name: "some-space",
 							GUID: "some-space-guid",
 						},
-						OrgName: "some-org",
+						OrgName:                        "some-org",
 						OrgDefaultIsolationSegmentGUID: "some-org-default-isolation-segment-guid",
 						AppNames:                       []string{"app1", "app2", "app3"},
 						ServiceInstanceNames:           []string{"service1", "service2", "service3"},
@@ -227,6 +227,39 @@ var _ = Describe("space Command", func() {
 					Expect(spaceGUID).To(Equal("some-space-guid"))
 					Expect(orgDefaultIsolationSegmentGUID).To(Equal("some-org-default-isolation-segment-guid"))
 				})
+				Context("when structured output is enabled", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = ui.OutputYAML
+					})
+
+					It("displays the space summary as a versioned document", func() {
+						Expect(executeErr).To(BeNil())
+
+						Expect(testUI.Out).To(Say(`schema_version: 1
+kind: space
+data:
+  name: some-space
+  guid: some-space-guid
+  org: some-org
+  apps:
+  - app1
+  - app2
+  - app3
+  services:
+  - service1
+  - service2
+  - service3
+  isolation_segment: some-isolation-segment
+  space_quota: some-space-quota
+  security_groups:
+  - public_networks
+  - dns
+  - load_balancer
+`))
+						Expect(testUI.Out).ToNot(Say("security_group_rules"))
+						Expect(testUI.Err).To(Say("Getting info for space some-space"))
+					})
+				})
 			})
 
 			Context("when v3 api version is below 3.11.0 and the v2 api version is no less than 2.74.0", func() {
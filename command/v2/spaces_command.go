@@ -16,6 +16,10 @@ func (_ SpacesCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (_ SpacesCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (_ SpacesCommand) Execute(args []string) error {
 	cmd.Main(os.Getenv("CF_TRACE"), os.Args)
 	return nil
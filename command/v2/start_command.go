@@ -47,6 +47,10 @@ func (cmd *StartCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd StartCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd StartCommand) Execute(args []string) error {
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
 	if err != nil {
@@ -95,7 +99,5 @@ func (cmd StartCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	shared.DisplayAppSummary(cmd.UI, appSummary, true)
-
-	return nil
+	return shared.DisplayAppSummary(cmd.UI, appSummary, true)
 }
@@ -0,0 +1,45 @@
+package v2
+
+import (
+	"code.cloudfoundry.org/cli/command"
+	"code.cloudfoundry.org/cli/command/flag"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+)
+
+type UseContextCommand struct {
+	RequiredArgs    flag.ContextName `positional-args:"yes"`
+	usage           interface{}      `usage:"CF_NAME use-context NAME\n\nEXAMPLES:\n   CF_NAME use-context prod\n\nTIP:\n   Use '--context NAME' with any command to run it against a saved context without switching to it."`
+	relatedCommands interface{}      `related_commands:"contexts, save-context, target"`
+
+	UI     command.UI
+	Config command.Config
+}
+
+func (cmd *UseContextCommand) Setup(config command.Config, ui command.UI) error {
+	cmd.UI = ui
+	cmd.Config = config
+	return nil
+}
+
+func (cmd UseContextCommand) Execute(args []string) error {
+	cmd.UI.DisplayTextWithFlavor("Switching to context {{.Name}}...", map[string]interface{}{
+		"Name": cmd.RequiredArgs.Name,
+	})
+
+	err := cmd.Config.UseContext(cmd.RequiredArgs.Name)
+	if err != nil {
+		return shared.HandleError(err)
+	}
+
+	cmd.UI.DisplayOK()
+	cmd.UI.DisplayNewline()
+
+	org := cmd.Config.TargetedOrganization()
+	space := cmd.Config.TargetedSpace()
+	cmd.UI.DisplayKeyValueTable("", [][]string{
+		{cmd.UI.TranslateText("api endpoint:"), cmd.Config.Target()},
+		{cmd.UI.TranslateText("org:"), org.Name},
+		{cmd.UI.TranslateText("space:"), space.Name},
+	}, 3)
+	return nil
+}
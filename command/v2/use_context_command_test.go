@@ -0,0 +1,68 @@
+package v2_test
+
+import (
+	"code.cloudfoundry.org/cli/command/commandfakes"
+	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
+	"code.cloudfoundry.org/cli/util/configv3"
+	"code.cloudfoundry.org/cli/util/ui"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("use-context Command", func() {
+	var (
+		cmd        UseContextCommand
+		testUI     *ui.UI
+		fakeConfig *commandfakes.FakeConfig
+		executeErr error
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		fakeConfig = new(commandfakes.FakeConfig)
+
+		cmd = UseContextCommand{
+			UI:     testUI,
+			Config: fakeConfig,
+		}
+		cmd.RequiredArgs.Name = "prod"
+	})
+
+	JustBeforeEach(func() {
+		executeErr = cmd.Execute(nil)
+	})
+
+	Context("when the context exists", func() {
+		BeforeEach(func() {
+			fakeConfig.TargetReturns("https://api.prod.com")
+			fakeConfig.TargetedOrganizationReturns(configv3.Organization{Name: "prod-org"})
+			fakeConfig.TargetedSpaceReturns(configv3.Space{Name: "prod-space"})
+		})
+
+		It("switches to the context and displays the target", func() {
+			Expect(executeErr).ToNot(HaveOccurred())
+
+			Expect(fakeConfig.UseContextCallCount()).To(Equal(1))
+			Expect(fakeConfig.UseContextArgsForCall(0)).To(Equal("prod"))
+
+			Expect(testUI.Out).To(Say("Switching to context prod..."))
+			Expect(testUI.Out).To(Say("OK"))
+			Expect(testUI.Out).To(Say(`api endpoint:\s+https://api.prod.com`))
+			Expect(testUI.Out).To(Say(`org:\s+prod-org`))
+			Expect(testUI.Out).To(Say(`space:\s+prod-space`))
+		})
+	})
+
+	Context("when the context does not exist", func() {
+		BeforeEach(func() {
+			fakeConfig.UseContextReturns(configv3.ContextNotFoundError{Name: "prod"})
+		})
+
+		It("returns a ContextNotFoundError", func() {
+			Expect(executeErr).To(MatchError(shared.ContextNotFoundError{Name: "prod"}))
+			Expect(testUI.Out).ToNot(Say("OK"))
+		})
+	})
+})
@@ -1,7 +1,11 @@
 package v2
 
 import (
+	"fmt"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
@@ -18,8 +22,9 @@ import (
 
 type V2PushActor interface {
 	Apply(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
-	ConvertToApplicationConfig(orgGUID string, spaceGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	ConvertToApplicationConfig(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	MergeAndValidateSettingsAndManifests(cmdSettings pushaction.CommandLineSettings, apps []manifest.Application) ([]manifest.Application, error)
+	Plan(config pushaction.ApplicationConfig) pushaction.ApplicationPlan
 }
 
 type V2PushCommand struct {
@@ -28,9 +33,12 @@ type V2PushCommand struct {
 	StartupCommand       string                      `short:"c" description:"Startup command, set to null to reset to default start command"`
 	Domain               string                      `short:"d" description:"Domain (e.g. example.com)"`
 	DockerImage          string                      `long:"docker-image" short:"o" description:"Docker-image to be used (e.g. user/docker-image-name)"`
+	DryRun               bool                        `long:"dry-run" description:"Display the changes push would make without making them"`
 	PathToManifest       flag.PathWithExistenceCheck `short:"f" description:"Path to manifest"`
 	HealthCheckType      flag.HealthCheckType        `long:"health-check-type" short:"u" description:"Application health check type (Default: 'port', 'none' accepted for 'process', 'http' implies endpoint '/')"`
 	Hostname             string                      `long:"hostname" short:"n" description:"Hostname (e.g. my-subdomain)"`
+	IgnoreFile           flag.PathWithExistenceCheck `long:"ignore-file" description:"Path to a file of .cfignore patterns to use instead of the .cfignore in the app directory"`
+	MaxInFlight          int                         `long:"max-in-flight" description:"Maximum number of apps from the manifest to push at the same time, apps listed in depends_on are pushed first (Default: 1)"`
 	NumInstances         int                         `short:"i" description:"Number of instances"`
 	DiskLimit            string                      `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
 	MemoryLimit          string                      `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
@@ -42,9 +50,10 @@ type V2PushCommand struct {
 	RandomRoute          bool                        `long:"random-route" description:"Create a random route for this app"`
 	RoutePath            string                      `long:"route-path" description:"Path for the route"`
 	Stack                string                      `short:"s" description:"Stack to use (a stack is a pre-built file system, including an operating system, that can run apps)"`
+	Strategy             flag.DeploymentStrategy     `long:"strategy" description:"Replace an existing app without downtime by staging a new app alongside it ('rolling' replaces one instance at a time, 'blue-green' starts all instances before moving routes)"`
 	ApplicationStartTime int                         `short:"t" description:"Time (in seconds) allowed to elapse between starting up an app and the first healthy response from the app"`
 
-	usage               interface{} `usage:"Push a single app (with or without a manifest):\n   CF_NAME v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n\n   Push multiple apps with a manifest:\n   cf v2-push [-f MANIFEST_PATH]"`
+	usage               interface{} `usage:"Push a single app (with or without a manifest):\n   CF_NAME v2-push APP_NAME [-b BUILDPACK_NAME] [-c COMMAND] [-d DOMAIN] [-f MANIFEST_PATH] [--docker-image DOCKER_IMAGE]\n   [-i NUM_INSTANCES] [-k DISK] [-m MEMORY] [--hostname HOST] [-p PATH] [-s STACK] [-t TIMEOUT] [-u (process | port | http)] [--route-path ROUTE_PATH]\n   [--strategy (rolling | blue-green)] [--no-hostname] [--no-manifest] [--no-route] [--no-start] [--random-route]\n   [--dry-run]\n\n   Push multiple apps with a manifest:\n   cf v2-push [-f MANIFEST_PATH] [--max-in-flight NUM_APPS]"`
 	envCFStagingTimeout interface{} `environmentName:"CF_STAGING_TIMEOUT" environmentDescription:"Max wait time for buildpack staging, in minutes" environmentDefault:"15"`
 	envCFStartupTimeout interface{} `environmentName:"CF_STARTUP_TIMEOUT" environmentDescription:"Max wait time for app instance startup, in minutes" environmentDefault:"5"`
 	relatedCommands     interface{} `related_commands:"apps, create-app-manifest, logs, ssh, start"`
@@ -68,11 +77,27 @@ func (cmd *V2PushCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	v2Actor := v2action.NewActor(ccClient, uaaClient)
 	cmd.StartActor = v2Actor
-	cmd.Actor = pushaction.NewActor(v2Actor)
+	cmd.Actor = pushaction.NewActor(v2Actor, config)
 	return nil
 }
 
+// StructuredOutputSupported returns true when the push plan is displayed
+// instead of pushing.
+func (cmd V2PushCommand) StructuredOutputSupported() bool {
+	return cmd.DryRun
+}
+
 func (cmd V2PushCommand) Execute(args []string) error {
+	if cmd.MaxInFlight < 0 {
+		return command.ParseArgumentError{ArgumentName: "--max-in-flight", ExpectedType: "a positive integer"}
+	}
+
+	// A deployment strategy has to start the new app before it can replace
+	// the existing one.
+	if cmd.NoStart && cmd.Strategy.Type != "" {
+		return command.ArgumentCombinationError{Arg1: "--no-start", Arg2: "--strategy"}
+	}
+
 	cmd.UI.DisplayWarning(command.ExperimentalWarning)
 
 	err := cmd.SharedActor.CheckTarget(cmd.Config, true, true)
@@ -87,20 +112,29 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	//TODO: Read in manifest
+	log.Info("reading manifest if exists")
+	rawApps, err := cmd.readManifest()
+	if err != nil {
+		log.Errorln("reading manifest:", err)
+		return shared.HandleError(err)
+	}
+
 	log.Info("merging manifest and command flags")
-	manifestApplications, err := cmd.Actor.MergeAndValidateSettingsAndManifests(cliSettings, nil)
+	manifestApplications, err := cmd.Actor.MergeAndValidateSettingsAndManifests(cliSettings, rawApps)
 	if err != nil {
 		log.Errorln("merging manifest:", err)
 		return shared.HandleError(err)
 	}
 
-	cmd.UI.DisplayText("Getting app info...")
+	if !cmd.UI.StructuredOutputEnabled() {
+		cmd.UI.DisplayText("Getting app info...")
+	}
 
 	log.Info("converting manifests to ApplicationConfigs")
 	appConfigs, warnings, err := cmd.Actor.ConvertToApplicationConfig(
 		cmd.Config.TargetedOrganization().GUID,
 		cmd.Config.TargetedSpace().GUID,
+		cmd.DryRun,
 		manifestApplications,
 	)
 	cmd.UI.DisplayWarnings(warnings)
@@ -109,16 +143,125 @@ func (cmd V2PushCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	for _, appConfig := range appConfigs {
+	for i := range appConfigs {
+		appConfigs[i].Strategy = pushaction.Strategy(cmd.Strategy.Type)
+	}
+
+	if cmd.DryRun {
+		return cmd.displayPlan(appConfigs)
+	}
+
+	// When several apps are applied at the same time, the output of each app
+	// is recorded and displayed once the app has finished so that it is not
+	// interleaved with the output of the others.
+	var displayLock sync.Mutex
+	grouped := cmd.MaxInFlight > 1 && len(appConfigs) > 1
+	errs := pushaction.ScheduleApplications(appConfigs, cmd.MaxInFlight, func(appConfig pushaction.ApplicationConfig) error {
 		log.Infoln("starting create/update:", appConfig.DesiredApplication.Name)
 		eventStream, warningsStream, errorStream := cmd.Actor.Apply(appConfig)
-		err := cmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream)
+		if !grouped {
+			return cmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream, nil)
+		}
+
+		output := &applyOutput{}
+		err := cmd.processApplyStreams(appConfig, eventStream, warningsStream, errorStream, output)
+
+		displayLock.Lock()
+		defer displayLock.Unlock()
+		displayErr := cmd.displayApplyOutput(appConfig, output)
 		if err != nil {
-			return shared.HandleError(err)
+			return err
+		}
+		return displayErr
+	})
+	//TODO call start / display App
+
+	return cmd.pushFailures(appConfigs, errs)
+}
+
+// pushFailures returns the error for a single app push, or a PushFailedError
+// listing the failures of each app when pushing several apps.
+func (cmd V2PushCommand) pushFailures(appConfigs []pushaction.ApplicationConfig, errs []error) error {
+	if len(appConfigs) == 1 {
+		if errs[0] != nil {
+			return shared.HandleStartError(errs[0], cmd.Config.BinaryName())
+		}
+		return nil
+	}
+
+	var failures []shared.AppPushFailure
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, shared.AppPushFailure{
+				AppName: appConfigs[i].DesiredApplication.Name,
+				Err:     shared.HandleStartError(err, cmd.Config.BinaryName()),
+			})
+		}
+	}
+
+	if len(failures) > 0 {
+		return shared.PushFailedError{Failures: failures}
+	}
+	return nil
+}
+
+// displayPlan displays the changes that would be made for each application
+// configuration, either as text or as a structured document.
+func (cmd V2PushCommand) displayPlan(appConfigs []pushaction.ApplicationConfig) error {
+	plans := []pushaction.ApplicationPlan{}
+	for _, appConfig := range appConfigs {
+		plans = append(plans, cmd.Actor.Plan(appConfig))
+	}
+
+	if cmd.UI.StructuredOutputEnabled() {
+		return cmd.UI.DisplayStructuredData("push_plan", plans)
+	}
+
+	for _, plan := range plans {
+		cmd.UI.DisplayNewline()
+		switch plan.Action {
+		case pushaction.PlanCreate:
+			cmd.UI.DisplayText("App {{.AppName}} will be created", map[string]interface{}{"AppName": plan.Name})
+		case pushaction.PlanUpdate:
+			cmd.UI.DisplayText("App {{.AppName}} will be updated", map[string]interface{}{"AppName": plan.Name})
+		case pushaction.PlanReplace:
+			cmd.UI.DisplayText("App {{.AppName}} will be replaced using the {{.Strategy}} strategy", map[string]interface{}{
+				"AppName":  plan.Name,
+				"Strategy": plan.Strategy,
+			})
+		}
+
+		var table [][]string
+		for _, change := range plan.Changes {
+			value := change.Desired
+			if change.Current != "" {
+				value = fmt.Sprintf("%s -> %s", change.Current, change.Desired)
+			}
+			table = append(table, []string{change.Property + ":", value})
+		}
+		for _, row := range []struct {
+			label  string
+			values []string
+		}{
+			{cmd.UI.TranslateText("routes to create:"), plan.RoutesCreated},
+			{cmd.UI.TranslateText("routes to bind:"), plan.RoutesBound},
+			{cmd.UI.TranslateText("routes to unbind:"), plan.RoutesUnbound},
+			{cmd.UI.TranslateText("services to bind:"), plan.ServicesBound},
+		} {
+			if len(row.values) > 0 {
+				table = append(table, []string{row.label, strings.Join(row.values, ", ")})
+			}
 		}
-		//TODO call start / display App
+
+		if len(table) == 0 {
+			cmd.UI.DisplayText("No changes")
+			continue
+		}
+		cmd.UI.DisplayKeyValueTable("", table, 3)
 	}
 
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Dry run complete, no changes were made")
 	return nil
 }
 
@@ -129,15 +272,77 @@ func (cmd V2PushCommand) GetCommandLineSettings() (pushaction.CommandLineSetting
 	}
 
 	config := pushaction.CommandLineSettings{
-		Name: cmd.OptionalArgs.AppName,
-		Path: pwd,
+		CurrentDirectory: pwd,
+		IgnoreFile:       string(cmd.IgnoreFile),
+		Name:             cmd.OptionalArgs.AppName,
+		Path:             string(cmd.DirectoryPath),
 	}
 
 	log.Debugf("%#v", config)
 	return config, nil
 }
 
-func (cmd V2PushCommand) processApplyStreams(appConfig pushaction.ApplicationConfig, eventStream <-chan pushaction.Event, warningsStream <-chan pushaction.Warnings, errorStream <-chan error) error {
+func (cmd V2PushCommand) readManifest() ([]manifest.Application, error) {
+	if cmd.NoManifest {
+		log.Debug("skipping reading of manifest")
+		return nil, nil
+	}
+
+	pathToManifest, err := cmd.findManifest()
+	if err != nil || pathToManifest == "" {
+		return nil, err
+	}
+
+	log.WithField("file", pathToManifest).Info("reading manifest")
+	return manifest.ReadAndMergeManifests(pathToManifest)
+}
+
+// findManifest returns the path to the manifest provided with -f, which may be
+// a directory containing a manifest, or the manifest in the current
+// directory. An empty path is returned when there is no manifest to read.
+func (cmd V2PushCommand) findManifest() (string, error) {
+	searchDir := string(cmd.PathToManifest)
+	if searchDir != "" {
+		info, err := os.Stat(searchDir)
+		if err != nil {
+			return "", err
+		}
+		if !info.IsDir() {
+			return searchDir, nil
+		}
+	} else {
+		pwd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		searchDir = pwd
+	}
+
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		pathToManifest := filepath.Join(searchDir, name)
+		if _, err := os.Stat(pathToManifest); err == nil {
+			return pathToManifest, nil
+		}
+	}
+
+	log.WithField("dir", searchDir).Debug("no manifest found")
+	return "", nil
+}
+
+// applyOutput records the events and warnings received while applying an
+// application, in the order they were received.
+type applyOutput struct {
+	entries []applyOutputEntry
+}
+
+type applyOutputEntry struct {
+	event    pushaction.Event
+	warnings pushaction.Warnings
+}
+
+// processApplyStreams displays the events and warnings of an apply as they
+// are received, or records them in output when it is not nil.
+func (cmd V2PushCommand) processApplyStreams(appConfig pushaction.ApplicationConfig, eventStream <-chan pushaction.Event, warningsStream <-chan pushaction.Warnings, errorStream <-chan error, output *applyOutput) error {
 	var eventClosed, warningsClosed, complete bool
 
 	for {
@@ -148,6 +353,11 @@ func (cmd V2PushCommand) processApplyStreams(appConfig pushaction.ApplicationCon
 				eventClosed = true
 				break
 			}
+			if output != nil {
+				output.entries = append(output.entries, applyOutputEntry{event: event})
+				complete = event == pushaction.Complete
+				break
+			}
 			var err error
 			complete, err = cmd.processEvent(appConfig, event)
 			if err != nil {
@@ -158,6 +368,12 @@ func (cmd V2PushCommand) processApplyStreams(appConfig pushaction.ApplicationCon
 				log.Debug("received warnings stream closed")
 				warningsClosed = true
 			}
+			if output != nil {
+				if len(warnings) > 0 {
+					output.entries = append(output.entries, applyOutputEntry{warnings: warnings})
+				}
+				break
+			}
 			cmd.UI.DisplayWarnings(warnings)
 		case err, ok := <-errorStream:
 			if !ok {
@@ -176,6 +392,22 @@ func (cmd V2PushCommand) processApplyStreams(appConfig pushaction.ApplicationCon
 	return nil
 }
 
+// displayApplyOutput displays the recorded output of applying an
+// application.
+func (cmd V2PushCommand) displayApplyOutput(appConfig pushaction.ApplicationConfig, output *applyOutput) error {
+	for _, entry := range output.entries {
+		if entry.warnings != nil {
+			cmd.UI.DisplayWarnings(entry.warnings)
+			continue
+		}
+
+		if _, err := cmd.processEvent(appConfig, entry.event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (cmd V2PushCommand) processEvent(appConfig pushaction.ApplicationConfig, event pushaction.Event) (bool, error) {
 	log.Infoln("received apply event:", event)
 
@@ -215,6 +447,50 @@ func (cmd V2PushCommand) processEvent(appConfig pushaction.ApplicationConfig, ev
 		cmd.UI.DisplayText("Creating routes...")
 	case pushaction.RouteBound:
 		cmd.UI.DisplayText("Binding routes...")
+	case pushaction.RouteUnbound:
+		cmd.UI.DisplayText("Unmapping routes...")
+	case pushaction.ServiceBound:
+		cmd.UI.DisplayText("Binding services...")
+	case pushaction.NewApplicationCreated:
+		user, err := cmd.Config.CurrentUser()
+		if err != nil {
+			return false, err
+		}
+
+		cmd.UI.DisplayTextWithFlavor(
+			"Creating app {{.NewAppName}} alongside {{.AppName}} in org {{.OrgName}} / space {{.SpaceName}} as {{.Username}}...",
+			map[string]interface{}{
+				"AppName":    appConfig.DesiredApplication.Name,
+				"NewAppName": appConfig.DesiredApplication.Name + pushaction.NewApplicationSuffix,
+				"OrgName":    cmd.Config.TargetedOrganization().Name,
+				"SpaceName":  cmd.Config.TargetedSpace().Name,
+				"Username":   user.Name,
+			},
+		)
+	case pushaction.StartingNewApplication:
+		cmd.UI.DisplayText("Waiting for new app to start...")
+	case pushaction.NewApplicationHealthy:
+		cmd.UI.DisplayText("New app is healthy")
+	case pushaction.InstanceReplaced:
+		cmd.UI.DisplayText("Replaced an instance of the previous app")
+	case pushaction.RoutesMoved:
+		cmd.UI.DisplayText("Moving routes to new app...")
+	case pushaction.OldApplicationDeleted:
+		cmd.UI.DisplayText("Deleting previous app...")
+	case pushaction.NewApplicationRenamed:
+		cmd.UI.DisplayTextWithFlavor("Renaming new app to {{.AppName}}...", map[string]interface{}{
+			"AppName": appConfig.DesiredApplication.Name,
+		})
+	case pushaction.RollingBack:
+		cmd.UI.DisplayWarning("Deployment failed, rolling back to the previous app...")
+	case pushaction.RolledBack:
+		cmd.UI.DisplayText("Rolled back to the previous app")
+	case pushaction.ResourcesMatched:
+		cached, total := appConfig.CachedFiles()
+		cmd.UI.DisplayText("{{.CachedCount}} of {{.TotalCount}} files cached", map[string]interface{}{
+			"CachedCount": cached,
+			"TotalCount":  total,
+		})
 	case pushaction.UploadingApplication:
 		cmd.UI.DisplayText("Uploading application...")
 	case pushaction.UploadComplete:
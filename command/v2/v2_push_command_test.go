@@ -1,8 +1,11 @@
 package v2_test
 
 import (
+	"encoding/json"
 	"errors"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 
 	"code.cloudfoundry.org/cli/actor/pushaction"
 	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
@@ -10,7 +13,9 @@ import (
 	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
+	"code.cloudfoundry.org/cli/command/flag"
 	. "code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v2/v2fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -62,6 +67,18 @@ var _ = Describe("v2-push Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when --no-start is provided with --strategy", func() {
+		BeforeEach(func() {
+			cmd.NoStart = true
+			cmd.Strategy = flag.DeploymentStrategy{Type: "rolling"}
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "--no-start", Arg2: "--strategy"}))
+			Expect(fakeSharedActor.CheckTargetCallCount()).To(Equal(0))
+		})
+	})
+
 	Context("when checking target fails", func() {
 		BeforeEach(func() {
 			fakeSharedActor.CheckTargetReturns(sharedaction.NotLoggedInError{BinaryName: binaryName})
@@ -108,6 +125,14 @@ var _ = Describe("v2-push Command", func() {
 							DesiredApplication: v2action.Application{Name: appName},
 							TargetedSpaceGUID:  "some-space-guid",
 							Path:               pwd,
+							AllResources: []v2action.Resource{
+								{Filename: "some-dir"},
+								{Filename: "some-dir/file-1", SHA1: "some-sha-1"},
+								{Filename: "file-2", SHA1: "some-sha-2"},
+							},
+							MatchedResources: []v2action.Resource{
+								{Filename: "file-2", SHA1: "some-sha-2"},
+							},
 						},
 					}
 					fakeActor.ConvertToApplicationConfigReturns(appConfigs, pushaction.Warnings{"some-config-warnings"}, nil)
@@ -134,6 +159,9 @@ var _ = Describe("v2-push Command", func() {
 							Eventually(eventStream).Should(BeSent(pushaction.ApplicationUpdated))
 							Eventually(eventStream).Should(BeSent(pushaction.RouteCreated))
 							Eventually(eventStream).Should(BeSent(pushaction.RouteBound))
+							Eventually(eventStream).Should(BeSent(pushaction.RouteUnbound))
+							Eventually(eventStream).Should(BeSent(pushaction.ServiceBound))
+							Eventually(eventStream).Should(BeSent(pushaction.ResourcesMatched))
 							Eventually(eventStream).Should(BeSent(pushaction.UploadingApplication))
 							Eventually(eventStream).Should(BeSent(pushaction.UploadComplete))
 							Eventually(eventStream).Should(BeSent(pushaction.Complete))
@@ -156,20 +184,47 @@ var _ = Describe("v2-push Command", func() {
 						Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
 						cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
 						Expect(cmdSettings).To(Equal(pushaction.CommandLineSettings{
-							Name: appName,
-							Path: pwd,
+							CurrentDirectory: pwd,
+							Name:             appName,
 						}))
 					})
 
+					Context("when the --ignore-file flag is provided", func() {
+						BeforeEach(func() {
+							cmd.IgnoreFile = "some-ignore-file"
+						})
+
+						It("passes the ignore file in the command line settings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+							Expect(cmdSettings.IgnoreFile).To(Equal("some-ignore-file"))
+						})
+					})
+
+					Context("when the -p flag is provided", func() {
+						BeforeEach(func() {
+							cmd.DirectoryPath = "some-directory-path"
+						})
+
+						It("passes the path in the command line settings", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							cmdSettings, _ := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+							Expect(cmdSettings.Path).To(Equal("some-directory-path"))
+						})
+					})
+
 					It("converts the manifests to app configs and outputs config warnings", func() {
 						Expect(executeErr).ToNot(HaveOccurred())
 
 						Expect(testUI.Err).To(Say("some-config-warnings"))
 
 						Expect(fakeActor.ConvertToApplicationConfigCallCount()).To(Equal(1))
-						orgGUID, spaceGUID, manifests := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+						orgGUID, spaceGUID, dryRun, manifests := fakeActor.ConvertToApplicationConfigArgsForCall(0)
 						Expect(orgGUID).To(Equal("some-org-guid"))
 						Expect(spaceGUID).To(Equal("some-space-guid"))
+						Expect(dryRun).To(BeFalse())
 						Expect(manifests).To(Equal(appManifests))
 					})
 
@@ -192,6 +247,9 @@ var _ = Describe("v2-push Command", func() {
 						Expect(testUI.Out).To(Say("Updating app %s in org %s / space %s as %s...", appName, "some-org", "some-space", "some-user"))
 						Expect(testUI.Out).To(Say("Creating routes..."))
 						Expect(testUI.Out).To(Say("Binding routes..."))
+						Expect(testUI.Out).To(Say("Unmapping routes..."))
+						Expect(testUI.Out).To(Say("Binding services..."))
+						Expect(testUI.Out).To(Say("1 of 2 files cached"))
 						Expect(testUI.Out).To(Say("Uploading application..."))
 						Expect(testUI.Out).To(Say("Upload complete"))
 
@@ -210,6 +268,158 @@ var _ = Describe("v2-push Command", func() {
 					})
 				})
 
+				Context("when --dry-run is provided", func() {
+					var plan pushaction.ApplicationPlan
+
+					BeforeEach(func() {
+						cmd.DryRun = true
+						plan = pushaction.ApplicationPlan{
+							Name:   appName,
+							Action: pushaction.PlanUpdate,
+							Changes: []pushaction.PropertyChange{
+								{Property: "buildpack", Current: "java", Desired: "ruby"},
+								{Property: "instances", Desired: "2"},
+							},
+							RoutesCreated: []string{"some-host.example.com"},
+							RoutesBound:   []string{"some-host.example.com"},
+							RoutesUnbound: []string{},
+							ServicesBound: []string{"some-service"},
+						}
+						fakeActor.PlanReturns(plan)
+					})
+
+					It("displays the plan without applying it", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.PlanCallCount()).To(Equal(1))
+						Expect(fakeActor.PlanArgsForCall(0)).To(Equal(appConfigs[0]))
+						Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+
+						_, _, dryRun, _ := fakeActor.ConvertToApplicationConfigArgsForCall(0)
+						Expect(dryRun).To(BeTrue())
+
+						Expect(testUI.Out).To(Say("Getting app info..."))
+						Expect(testUI.Out).To(Say("App %s will be updated", appName))
+						Expect(testUI.Out).To(Say(`buildpack:\s+java -> ruby`))
+						Expect(testUI.Out).To(Say(`instances:\s+2`))
+						Expect(testUI.Out).To(Say(`routes to create:\s+some-host.example.com`))
+						Expect(testUI.Out).To(Say(`routes to bind:\s+some-host.example.com`))
+						Expect(testUI.Out).ToNot(Say("routes to unbind:"))
+						Expect(testUI.Out).To(Say(`services to bind:\s+some-service`))
+						Expect(testUI.Out).To(Say("Dry run complete, no changes were made"))
+					})
+
+					Context("when structured output is enabled", func() {
+						BeforeEach(func() {
+							testUI.OutputFormat = ui.OutputJSON
+						})
+
+						It("displays the plan as a versioned document", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+							Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+
+							var output struct {
+								SchemaVersion int                          `json:"schema_version"`
+								Kind          string                       `json:"kind"`
+								Data          []pushaction.ApplicationPlan `json:"data"`
+							}
+							Expect(json.Unmarshal(testUI.Out.(*Buffer).Contents(), &output)).To(Succeed())
+							Expect(output.SchemaVersion).To(Equal(1))
+							Expect(output.Kind).To(Equal("push_plan"))
+							Expect(output.Data).To(ConsistOf(plan))
+						})
+					})
+				})
+
+				Context("when the push uses a deployment strategy", func() {
+					var (
+						eventStream    chan pushaction.Event
+						warningsStream chan pushaction.Warnings
+						errorStream    chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Strategy = flag.DeploymentStrategy{Type: "blue-green"}
+
+						eventStream = make(chan pushaction.Event)
+						warningsStream = make(chan pushaction.Warnings)
+						errorStream = make(chan error)
+
+						fakeActor.ApplyReturns(eventStream, warningsStream, errorStream)
+
+						go func() {
+							defer GinkgoRecover()
+
+							Eventually(eventStream).Should(BeSent(pushaction.NewApplicationCreated))
+							Eventually(eventStream).Should(BeSent(pushaction.StartingNewApplication))
+							Eventually(eventStream).Should(BeSent(pushaction.NewApplicationHealthy))
+							Eventually(eventStream).Should(BeSent(pushaction.InstanceReplaced))
+							Eventually(eventStream).Should(BeSent(pushaction.RoutesMoved))
+							Eventually(eventStream).Should(BeSent(pushaction.NewApplicationRenamed))
+							Eventually(eventStream).Should(BeSent(pushaction.OldApplicationDeleted))
+							Eventually(eventStream).Should(BeSent(pushaction.Complete))
+							close(eventStream)
+							close(warningsStream)
+							close(errorStream)
+						}()
+					})
+
+					It("applies the configuration with the strategy", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.ApplyCallCount()).To(Equal(1))
+						Expect(fakeActor.ApplyArgsForCall(0).Strategy).To(Equal(pushaction.BlueGreenStrategy))
+					})
+
+					It("displays each phase of the deployment", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say("Creating app %s-new alongside %s in org %s / space %s as %s...", appName, appName, "some-org", "some-space", "some-user"))
+						Expect(testUI.Out).To(Say("Waiting for new app to start..."))
+						Expect(testUI.Out).To(Say("New app is healthy"))
+						Expect(testUI.Out).To(Say("Replaced an instance of the previous app"))
+						Expect(testUI.Out).To(Say("Moving routes to new app..."))
+						Expect(testUI.Out).To(Say("Renaming new app to %s...", appName))
+						Expect(testUI.Out).To(Say("Deleting previous app..."))
+					})
+				})
+
+				Context("when the new app fails to start and is rolled back", func() {
+					var (
+						eventStream    chan pushaction.Event
+						warningsStream chan pushaction.Warnings
+						errorStream    chan error
+					)
+
+					BeforeEach(func() {
+						cmd.Strategy = flag.DeploymentStrategy{Type: "rolling"}
+
+						eventStream = make(chan pushaction.Event)
+						warningsStream = make(chan pushaction.Warnings)
+						errorStream = make(chan error)
+
+						fakeActor.ApplyReturns(eventStream, warningsStream, errorStream)
+
+						go func() {
+							defer GinkgoRecover()
+
+							Eventually(eventStream).Should(BeSent(pushaction.RollingBack))
+							Eventually(eventStream).Should(BeSent(pushaction.RolledBack))
+							Eventually(errorStream).Should(BeSent(v2action.ApplicationInstanceCrashedError{Name: appName + "-new"}))
+							close(eventStream)
+							close(warningsStream)
+							close(errorStream)
+						}()
+					})
+
+					It("displays the rollback and returns an UnsuccessfulStartError", func() {
+						Expect(executeErr).To(MatchError(shared.UnsuccessfulStartError{AppName: appName + "-new", BinaryName: binaryName}))
+
+						Expect(testUI.Err).To(Say("Deployment failed, rolling back to the previous app..."))
+						Expect(testUI.Out).To(Say("Rolled back to the previous app"))
+					})
+				})
+
 				Context("when the push errors", func() {
 					var (
 						expectedErr    error
@@ -253,6 +463,62 @@ var _ = Describe("v2-push Command", func() {
 				})
 			})
 
+			Context("when pushing several apps from the manifest", func() {
+				BeforeEach(func() {
+					cmd.MaxInFlight = 2
+					fakeConfig.CurrentUserReturns(configv3.User{Name: "some-user"}, nil)
+
+					fakeActor.ConvertToApplicationConfigReturns([]pushaction.ApplicationConfig{
+						{DesiredApplication: v2action.Application{Name: "app-1"}},
+						{DesiredApplication: v2action.Application{Name: "app-2"}},
+						{DesiredApplication: v2action.Application{Name: "app-3"}, DependsOn: []string{"app-2"}},
+					}, nil, nil)
+
+					fakeActor.ApplyStub = func(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+						eventStream := make(chan pushaction.Event, 2)
+						warningsStream := make(chan pushaction.Warnings, 1)
+						errorStream := make(chan error, 1)
+
+						if config.DesiredApplication.Name == "app-2" {
+							errorStream <- errors.New("some-error")
+							return eventStream, warningsStream, errorStream
+						}
+
+						eventStream <- pushaction.ApplicationCreated
+						eventStream <- pushaction.Complete
+						warningsStream <- pushaction.Warnings{config.DesiredApplication.Name + "-warning"}
+						close(eventStream)
+						close(warningsStream)
+						return eventStream, warningsStream, errorStream
+					}
+				})
+
+				It("applies the apps whose dependencies succeeded and returns the failures of each app", func() {
+					Expect(executeErr).To(MatchError(shared.PushFailedError{
+						Failures: []shared.AppPushFailure{
+							{AppName: "app-2", Err: errors.New("some-error")},
+							{AppName: "app-3", Err: shared.DependencyFailedError{AppName: "app-3", Dependency: "app-2"}},
+						},
+					}))
+
+					Expect(fakeActor.ApplyCallCount()).To(Equal(2))
+					Expect(testUI.Out).To(Say("Creating app app-1 in org some-org / space some-space as some-user..."))
+					Expect(testUI.Out).ToNot(Say("Creating app app-3"))
+					Expect(testUI.Err).To(Say("app-1-warning"))
+				})
+			})
+
+			Context("when --max-in-flight is negative", func() {
+				BeforeEach(func() {
+					cmd.MaxInFlight = -1
+				})
+
+				It("returns a ParseArgumentError", func() {
+					Expect(executeErr).To(MatchError(command.ParseArgumentError{ArgumentName: "--max-in-flight", ExpectedType: "a positive integer"}))
+					Expect(fakeActor.ApplyCallCount()).To(Equal(0))
+				})
+			})
+
 			Context("when there is an error converting the app setting into a config", func() {
 				var expectedErr error
 
@@ -269,6 +535,88 @@ var _ = Describe("v2-push Command", func() {
 			})
 		})
 
+		Context("when reading the manifest", func() {
+			var tmpDir string
+
+			BeforeEach(func() {
+				var err error
+				tmpDir, err = ioutil.TempDir("", "v2-push-command-test")
+				Expect(err).ToNot(HaveOccurred())
+
+				manifestContents := []byte(`---
+applications:
+- name: manifest-app
+  memory: 128M
+`)
+				Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifest.yml"), manifestContents, 0666)).To(Succeed())
+
+				fakeActor.MergeAndValidateSettingsAndManifestsReturns(nil, errors.New("stop after merging"))
+			})
+
+			AfterEach(func() {
+				Expect(os.RemoveAll(tmpDir)).To(Succeed())
+			})
+
+			Context("when the -f flag points to a manifest file", func() {
+				BeforeEach(func() {
+					cmd.PathToManifest = flag.PathWithExistenceCheck(filepath.Join(tmpDir, "manifest.yml"))
+				})
+
+				It("passes the parsed manifest applications to be merged", func() {
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+					_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+					Expect(apps).To(Equal([]manifest.Application{
+						{
+							Name:   "manifest-app",
+							Memory: 128,
+						},
+					}))
+				})
+
+				Context("when --no-manifest is provided", func() {
+					BeforeEach(func() {
+						cmd.NoManifest = true
+					})
+
+					It("does not read the manifest", func() {
+						Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+						_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+						Expect(apps).To(BeEmpty())
+					})
+				})
+			})
+
+			Context("when the -f flag points to a directory containing a manifest", func() {
+				BeforeEach(func() {
+					cmd.PathToManifest = flag.PathWithExistenceCheck(tmpDir)
+				})
+
+				It("reads the manifest in the directory", func() {
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(1))
+					_, apps := fakeActor.MergeAndValidateSettingsAndManifestsArgsForCall(0)
+					Expect(apps).To(HaveLen(1))
+					Expect(apps[0].Name).To(Equal("manifest-app"))
+				})
+			})
+
+			Context("when the manifest is invalid", func() {
+				BeforeEach(func() {
+					manifestContents := []byte(`---
+applications:
+- name: manifest-app
+  memory: lots
+`)
+					Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifest.yml"), manifestContents, 0666)).To(Succeed())
+					cmd.PathToManifest = flag.PathWithExistenceCheck(filepath.Join(tmpDir, "manifest.yml"))
+				})
+
+				It("returns an InvalidManifestError", func() {
+					Expect(executeErr).To(BeAssignableToTypeOf(shared.InvalidManifestError{}))
+					Expect(fakeActor.MergeAndValidateSettingsAndManifestsCallCount()).To(Equal(0))
+				})
+			})
+		})
+
 		Context("when the push settings are invalid", func() {
 			var expectedErr error
 
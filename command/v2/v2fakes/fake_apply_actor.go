@@ -0,0 +1,458 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/pushaction"
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeApplyActor struct {
+	ApplyStub        func(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error)
+	applyMutex       sync.RWMutex
+	applyArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	applyReturns struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	applyReturnsOnCall map[int]struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}
+	ApplySpaceStub        func(config pushaction.SpaceConfig) (pushaction.Warnings, error)
+	applySpaceMutex       sync.RWMutex
+	applySpaceArgsForCall []struct {
+		config pushaction.SpaceConfig
+	}
+	applySpaceReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	applySpaceReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	ConvertToApplicationConfigStub        func(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	convertToApplicationConfigMutex       sync.RWMutex
+	convertToApplicationConfigArgsForCall []struct {
+		orgGUID   string
+		spaceGUID string
+		dryRun    bool
+		apps      []manifest.Application
+	}
+	convertToApplicationConfigReturns struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToApplicationConfigReturnsOnCall map[int]struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	ConvertToSpaceConfigStub        func(orgGUID string, spaceName string, space manifest.Space, prune bool) (pushaction.SpaceConfig, pushaction.Warnings, error)
+	convertToSpaceConfigMutex       sync.RWMutex
+	convertToSpaceConfigArgsForCall []struct {
+		orgGUID   string
+		spaceName string
+		space     manifest.Space
+		prune     bool
+	}
+	convertToSpaceConfigReturns struct {
+		result1 pushaction.SpaceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	convertToSpaceConfigReturnsOnCall map[int]struct {
+		result1 pushaction.SpaceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}
+	PlanSpaceStub        func(config pushaction.SpaceConfig) pushaction.SpacePlan
+	planSpaceMutex       sync.RWMutex
+	planSpaceArgsForCall []struct {
+		config pushaction.SpaceConfig
+	}
+	planSpaceReturns struct {
+		result1 pushaction.SpacePlan
+	}
+	planSpaceReturnsOnCall map[int]struct {
+		result1 pushaction.SpacePlan
+	}
+	PruneSpaceStub        func(config pushaction.SpaceConfig) (pushaction.Warnings, error)
+	pruneSpaceMutex       sync.RWMutex
+	pruneSpaceArgsForCall []struct {
+		config pushaction.SpaceConfig
+	}
+	pruneSpaceReturns struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	pruneSpaceReturnsOnCall map[int]struct {
+		result1 pushaction.Warnings
+		result2 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeApplyActor) Apply(config pushaction.ApplicationConfig) (<-chan pushaction.Event, <-chan pushaction.Warnings, <-chan error) {
+	fake.applyMutex.Lock()
+	ret, specificReturn := fake.applyReturnsOnCall[len(fake.applyArgsForCall)]
+	fake.applyArgsForCall = append(fake.applyArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("Apply", []interface{}{config})
+	fake.applyMutex.Unlock()
+	if fake.ApplyStub != nil {
+		return fake.ApplyStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.applyReturns.result1, fake.applyReturns.result2, fake.applyReturns.result3
+}
+
+func (fake *FakeApplyActor) ApplyCallCount() int {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return len(fake.applyArgsForCall)
+}
+
+func (fake *FakeApplyActor) ApplyArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	return fake.applyArgsForCall[i].config
+}
+
+func (fake *FakeApplyActor) ApplyReturns(result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.ApplyStub = nil
+	fake.applyReturns = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction.Event, result2 <-chan pushaction.Warnings, result3 <-chan error) {
+	fake.ApplyStub = nil
+	if fake.applyReturnsOnCall == nil {
+		fake.applyReturnsOnCall = make(map[int]struct {
+			result1 <-chan pushaction.Event
+			result2 <-chan pushaction.Warnings
+			result3 <-chan error
+		})
+	}
+	fake.applyReturnsOnCall[i] = struct {
+		result1 <-chan pushaction.Event
+		result2 <-chan pushaction.Warnings
+		result3 <-chan error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyActor) ApplySpace(config pushaction.SpaceConfig) (pushaction.Warnings, error) {
+	fake.applySpaceMutex.Lock()
+	ret, specificReturn := fake.applySpaceReturnsOnCall[len(fake.applySpaceArgsForCall)]
+	fake.applySpaceArgsForCall = append(fake.applySpaceArgsForCall, struct {
+		config pushaction.SpaceConfig
+	}{config})
+	fake.recordInvocation("ApplySpace", []interface{}{config})
+	fake.applySpaceMutex.Unlock()
+	if fake.ApplySpaceStub != nil {
+		return fake.ApplySpaceStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.applySpaceReturns.result1, fake.applySpaceReturns.result2
+}
+
+func (fake *FakeApplyActor) ApplySpaceCallCount() int {
+	fake.applySpaceMutex.RLock()
+	defer fake.applySpaceMutex.RUnlock()
+	return len(fake.applySpaceArgsForCall)
+}
+
+func (fake *FakeApplyActor) ApplySpaceArgsForCall(i int) pushaction.SpaceConfig {
+	fake.applySpaceMutex.RLock()
+	defer fake.applySpaceMutex.RUnlock()
+	return fake.applySpaceArgsForCall[i].config
+}
+
+func (fake *FakeApplyActor) ApplySpaceReturns(result1 pushaction.Warnings, result2 error) {
+	fake.ApplySpaceStub = nil
+	fake.applySpaceReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyActor) ApplySpaceReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.ApplySpaceStub = nil
+	if fake.applySpaceReturnsOnCall == nil {
+		fake.applySpaceReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.applySpaceReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyActor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
+	var appsCopy []manifest.Application
+	if apps != nil {
+		appsCopy = make([]manifest.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.convertToApplicationConfigMutex.Lock()
+	ret, specificReturn := fake.convertToApplicationConfigReturnsOnCall[len(fake.convertToApplicationConfigArgsForCall)]
+	fake.convertToApplicationConfigArgsForCall = append(fake.convertToApplicationConfigArgsForCall, struct {
+		orgGUID   string
+		spaceGUID string
+		dryRun    bool
+		apps      []manifest.Application
+	}{orgGUID, spaceGUID, dryRun, appsCopy})
+	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{orgGUID, spaceGUID, dryRun, appsCopy})
+	fake.convertToApplicationConfigMutex.Unlock()
+	if fake.ConvertToApplicationConfigStub != nil {
+		return fake.ConvertToApplicationConfigStub(orgGUID, spaceGUID, dryRun, apps)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToApplicationConfigReturns.result1, fake.convertToApplicationConfigReturns.result2, fake.convertToApplicationConfigReturns.result3
+}
+
+func (fake *FakeApplyActor) ConvertToApplicationConfigCallCount() int {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return len(fake.convertToApplicationConfigArgsForCall)
+}
+
+func (fake *FakeApplyActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, bool, []manifest.Application) {
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	return fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].dryRun, fake.convertToApplicationConfigArgsForCall[i].apps
+}
+
+func (fake *FakeApplyActor) ConvertToApplicationConfigReturns(result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	fake.convertToApplicationConfigReturns = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyActor) ConvertToApplicationConfigReturnsOnCall(i int, result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToApplicationConfigStub = nil
+	if fake.convertToApplicationConfigReturnsOnCall == nil {
+		fake.convertToApplicationConfigReturnsOnCall = make(map[int]struct {
+			result1 []pushaction.ApplicationConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToApplicationConfigReturnsOnCall[i] = struct {
+		result1 []pushaction.ApplicationConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyActor) ConvertToSpaceConfig(orgGUID string, spaceName string, space manifest.Space, prune bool) (pushaction.SpaceConfig, pushaction.Warnings, error) {
+	fake.convertToSpaceConfigMutex.Lock()
+	ret, specificReturn := fake.convertToSpaceConfigReturnsOnCall[len(fake.convertToSpaceConfigArgsForCall)]
+	fake.convertToSpaceConfigArgsForCall = append(fake.convertToSpaceConfigArgsForCall, struct {
+		orgGUID   string
+		spaceName string
+		space     manifest.Space
+		prune     bool
+	}{orgGUID, spaceName, space, prune})
+	fake.recordInvocation("ConvertToSpaceConfig", []interface{}{orgGUID, spaceName, space, prune})
+	fake.convertToSpaceConfigMutex.Unlock()
+	if fake.ConvertToSpaceConfigStub != nil {
+		return fake.ConvertToSpaceConfigStub(orgGUID, spaceName, space, prune)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.convertToSpaceConfigReturns.result1, fake.convertToSpaceConfigReturns.result2, fake.convertToSpaceConfigReturns.result3
+}
+
+func (fake *FakeApplyActor) ConvertToSpaceConfigCallCount() int {
+	fake.convertToSpaceConfigMutex.RLock()
+	defer fake.convertToSpaceConfigMutex.RUnlock()
+	return len(fake.convertToSpaceConfigArgsForCall)
+}
+
+func (fake *FakeApplyActor) ConvertToSpaceConfigArgsForCall(i int) (string, string, manifest.Space, bool) {
+	fake.convertToSpaceConfigMutex.RLock()
+	defer fake.convertToSpaceConfigMutex.RUnlock()
+	return fake.convertToSpaceConfigArgsForCall[i].orgGUID, fake.convertToSpaceConfigArgsForCall[i].spaceName, fake.convertToSpaceConfigArgsForCall[i].space, fake.convertToSpaceConfigArgsForCall[i].prune
+}
+
+func (fake *FakeApplyActor) ConvertToSpaceConfigReturns(result1 pushaction.SpaceConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToSpaceConfigStub = nil
+	fake.convertToSpaceConfigReturns = struct {
+		result1 pushaction.SpaceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyActor) ConvertToSpaceConfigReturnsOnCall(i int, result1 pushaction.SpaceConfig, result2 pushaction.Warnings, result3 error) {
+	fake.ConvertToSpaceConfigStub = nil
+	if fake.convertToSpaceConfigReturnsOnCall == nil {
+		fake.convertToSpaceConfigReturnsOnCall = make(map[int]struct {
+			result1 pushaction.SpaceConfig
+			result2 pushaction.Warnings
+			result3 error
+		})
+	}
+	fake.convertToSpaceConfigReturnsOnCall[i] = struct {
+		result1 pushaction.SpaceConfig
+		result2 pushaction.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeApplyActor) PlanSpace(config pushaction.SpaceConfig) pushaction.SpacePlan {
+	fake.planSpaceMutex.Lock()
+	ret, specificReturn := fake.planSpaceReturnsOnCall[len(fake.planSpaceArgsForCall)]
+	fake.planSpaceArgsForCall = append(fake.planSpaceArgsForCall, struct {
+		config pushaction.SpaceConfig
+	}{config})
+	fake.recordInvocation("PlanSpace", []interface{}{config})
+	fake.planSpaceMutex.Unlock()
+	if fake.PlanSpaceStub != nil {
+		return fake.PlanSpaceStub(config)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.planSpaceReturns.result1
+}
+
+func (fake *FakeApplyActor) PlanSpaceCallCount() int {
+	fake.planSpaceMutex.RLock()
+	defer fake.planSpaceMutex.RUnlock()
+	return len(fake.planSpaceArgsForCall)
+}
+
+func (fake *FakeApplyActor) PlanSpaceArgsForCall(i int) pushaction.SpaceConfig {
+	fake.planSpaceMutex.RLock()
+	defer fake.planSpaceMutex.RUnlock()
+	return fake.planSpaceArgsForCall[i].config
+}
+
+func (fake *FakeApplyActor) PlanSpaceReturns(result1 pushaction.SpacePlan) {
+	fake.PlanSpaceStub = nil
+	fake.planSpaceReturns = struct {
+		result1 pushaction.SpacePlan
+	}{result1}
+}
+
+func (fake *FakeApplyActor) PlanSpaceReturnsOnCall(i int, result1 pushaction.SpacePlan) {
+	fake.PlanSpaceStub = nil
+	if fake.planSpaceReturnsOnCall == nil {
+		fake.planSpaceReturnsOnCall = make(map[int]struct {
+			result1 pushaction.SpacePlan
+		})
+	}
+	fake.planSpaceReturnsOnCall[i] = struct {
+		result1 pushaction.SpacePlan
+	}{result1}
+}
+
+func (fake *FakeApplyActor) PruneSpace(config pushaction.SpaceConfig) (pushaction.Warnings, error) {
+	fake.pruneSpaceMutex.Lock()
+	ret, specificReturn := fake.pruneSpaceReturnsOnCall[len(fake.pruneSpaceArgsForCall)]
+	fake.pruneSpaceArgsForCall = append(fake.pruneSpaceArgsForCall, struct {
+		config pushaction.SpaceConfig
+	}{config})
+	fake.recordInvocation("PruneSpace", []interface{}{config})
+	fake.pruneSpaceMutex.Unlock()
+	if fake.PruneSpaceStub != nil {
+		return fake.PruneSpaceStub(config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.pruneSpaceReturns.result1, fake.pruneSpaceReturns.result2
+}
+
+func (fake *FakeApplyActor) PruneSpaceCallCount() int {
+	fake.pruneSpaceMutex.RLock()
+	defer fake.pruneSpaceMutex.RUnlock()
+	return len(fake.pruneSpaceArgsForCall)
+}
+
+func (fake *FakeApplyActor) PruneSpaceArgsForCall(i int) pushaction.SpaceConfig {
+	fake.pruneSpaceMutex.RLock()
+	defer fake.pruneSpaceMutex.RUnlock()
+	return fake.pruneSpaceArgsForCall[i].config
+}
+
+func (fake *FakeApplyActor) PruneSpaceReturns(result1 pushaction.Warnings, result2 error) {
+	fake.PruneSpaceStub = nil
+	fake.pruneSpaceReturns = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyActor) PruneSpaceReturnsOnCall(i int, result1 pushaction.Warnings, result2 error) {
+	fake.PruneSpaceStub = nil
+	if fake.pruneSpaceReturnsOnCall == nil {
+		fake.pruneSpaceReturnsOnCall = make(map[int]struct {
+			result1 pushaction.Warnings
+			result2 error
+		})
+	}
+	fake.pruneSpaceReturnsOnCall[i] = struct {
+		result1 pushaction.Warnings
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeApplyActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.applyMutex.RLock()
+	defer fake.applyMutex.RUnlock()
+	fake.applySpaceMutex.RLock()
+	defer fake.applySpaceMutex.RUnlock()
+	fake.convertToApplicationConfigMutex.RLock()
+	defer fake.convertToApplicationConfigMutex.RUnlock()
+	fake.convertToSpaceConfigMutex.RLock()
+	defer fake.convertToSpaceConfigMutex.RUnlock()
+	fake.planSpaceMutex.RLock()
+	defer fake.planSpaceMutex.RUnlock()
+	fake.pruneSpaceMutex.RLock()
+	defer fake.pruneSpaceMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeApplyActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ApplyActor = new(FakeApplyActor)
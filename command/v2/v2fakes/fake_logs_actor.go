@@ -9,6 +9,52 @@ import (
 )
 
 type FakeLogsActor struct {
+	GetApplicationsByNamePatternsAndSpaceStub        func(patterns []string, spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsByNamePatternsAndSpaceMutex       sync.RWMutex
+	getApplicationsByNamePatternsAndSpaceArgsForCall []struct {
+		patterns  []string
+		spaceGUID string
+	}
+	getApplicationsByNamePatternsAndSpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsByNamePatternsAndSpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetApplicationsBySpaceStub        func(spaceGUID string) ([]v2action.Application, v2action.Warnings, error)
+	getApplicationsBySpaceMutex       sync.RWMutex
+	getApplicationsBySpaceArgsForCall []struct {
+		spaceGUID string
+	}
+	getApplicationsBySpaceReturns struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationsBySpaceReturnsOnCall map[int]struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	GetRecentLogsForApplicationsStub        func(apps []v2action.Application, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, error)
+	getRecentLogsForApplicationsMutex       sync.RWMutex
+	getRecentLogsForApplicationsArgsForCall []struct {
+		apps   []v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}
+	getRecentLogsForApplicationsReturns struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}
+	getRecentLogsForApplicationsReturnsOnCall map[int]struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}
 	GetRecentLogsForApplicationByNameAndSpaceStub        func(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error)
 	getRecentLogsForApplicationByNameAndSpaceMutex       sync.RWMutex
 	getRecentLogsForApplicationByNameAndSpaceArgsForCall []struct {
@@ -47,10 +93,197 @@ type FakeLogsActor struct {
 		result3 v2action.Warnings
 		result4 error
 	}
+	GetStreamingLogsForApplicationsStub        func(apps []v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error)
+	getStreamingLogsForApplicationsMutex       sync.RWMutex
+	getStreamingLogsForApplicationsArgsForCall []struct {
+		apps   []v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}
+	getStreamingLogsForApplicationsReturns struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
+	getStreamingLogsForApplicationsReturnsOnCall map[int]struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeLogsActor) GetApplicationsByNamePatternsAndSpace(patterns []string, spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	var patternsCopy []string
+	if patterns != nil {
+		patternsCopy = make([]string, len(patterns))
+		copy(patternsCopy, patterns)
+	}
+	fake.getApplicationsByNamePatternsAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsByNamePatternsAndSpaceReturnsOnCall[len(fake.getApplicationsByNamePatternsAndSpaceArgsForCall)]
+	fake.getApplicationsByNamePatternsAndSpaceArgsForCall = append(fake.getApplicationsByNamePatternsAndSpaceArgsForCall, struct {
+		patterns  []string
+		spaceGUID string
+	}{patternsCopy, spaceGUID})
+	fake.recordInvocation("GetApplicationsByNamePatternsAndSpace", []interface{}{patternsCopy, spaceGUID})
+	fake.getApplicationsByNamePatternsAndSpaceMutex.Unlock()
+	if fake.GetApplicationsByNamePatternsAndSpaceStub != nil {
+		return fake.GetApplicationsByNamePatternsAndSpaceStub(patterns, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsByNamePatternsAndSpaceReturns.result1, fake.getApplicationsByNamePatternsAndSpaceReturns.result2, fake.getApplicationsByNamePatternsAndSpaceReturns.result3
+}
+
+func (fake *FakeLogsActor) GetApplicationsByNamePatternsAndSpaceCallCount() int {
+	fake.getApplicationsByNamePatternsAndSpaceMutex.RLock()
+	defer fake.getApplicationsByNamePatternsAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationsByNamePatternsAndSpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetApplicationsByNamePatternsAndSpaceArgsForCall(i int) ([]string, string) {
+	fake.getApplicationsByNamePatternsAndSpaceMutex.RLock()
+	defer fake.getApplicationsByNamePatternsAndSpaceMutex.RUnlock()
+	return fake.getApplicationsByNamePatternsAndSpaceArgsForCall[i].patterns, fake.getApplicationsByNamePatternsAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeLogsActor) GetApplicationsByNamePatternsAndSpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsByNamePatternsAndSpaceStub = nil
+	fake.getApplicationsByNamePatternsAndSpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetApplicationsByNamePatternsAndSpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsByNamePatternsAndSpaceStub = nil
+	if fake.getApplicationsByNamePatternsAndSpaceReturnsOnCall == nil {
+		fake.getApplicationsByNamePatternsAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsByNamePatternsAndSpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpace(spaceGUID string) ([]v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationsBySpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationsBySpaceReturnsOnCall[len(fake.getApplicationsBySpaceArgsForCall)]
+	fake.getApplicationsBySpaceArgsForCall = append(fake.getApplicationsBySpaceArgsForCall, struct {
+		spaceGUID string
+	}{spaceGUID})
+	fake.recordInvocation("GetApplicationsBySpace", []interface{}{spaceGUID})
+	fake.getApplicationsBySpaceMutex.Unlock()
+	if fake.GetApplicationsBySpaceStub != nil {
+		return fake.GetApplicationsBySpaceStub(spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationsBySpaceReturns.result1, fake.getApplicationsBySpaceReturns.result2, fake.getApplicationsBySpaceReturns.result3
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceCallCount() int {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return len(fake.getApplicationsBySpaceArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceArgsForCall(i int) string {
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	return fake.getApplicationsBySpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceReturns(result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	fake.getApplicationsBySpaceReturns = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetApplicationsBySpaceReturnsOnCall(i int, result1 []v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationsBySpaceStub = nil
+	if fake.getApplicationsBySpaceReturnsOnCall == nil {
+		fake.getApplicationsBySpaceReturnsOnCall = make(map[int]struct {
+			result1 []v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationsBySpaceReturnsOnCall[i] = struct {
+		result1 []v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, error) {
+	var appsCopy []v2action.Application
+	if apps != nil {
+		appsCopy = make([]v2action.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.getRecentLogsForApplicationsMutex.Lock()
+	ret, specificReturn := fake.getRecentLogsForApplicationsReturnsOnCall[len(fake.getRecentLogsForApplicationsArgsForCall)]
+	fake.getRecentLogsForApplicationsArgsForCall = append(fake.getRecentLogsForApplicationsArgsForCall, struct {
+		apps   []v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}{appsCopy, client, config})
+	fake.recordInvocation("GetRecentLogsForApplications", []interface{}{appsCopy, client, config})
+	fake.getRecentLogsForApplicationsMutex.Unlock()
+	if fake.GetRecentLogsForApplicationsStub != nil {
+		return fake.GetRecentLogsForApplicationsStub(apps, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getRecentLogsForApplicationsReturns.result1, fake.getRecentLogsForApplicationsReturns.result2
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsCallCount() int {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	return len(fake.getRecentLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsArgsForCall(i int) ([]v2action.Application, v2action.NOAAClient, v2action.Config) {
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
+	return fake.getRecentLogsForApplicationsArgsForCall[i].apps, fake.getRecentLogsForApplicationsArgsForCall[i].client, fake.getRecentLogsForApplicationsArgsForCall[i].config
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsReturns(result1 []v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsForApplicationsStub = nil
+	fake.getRecentLogsForApplicationsReturns = struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeLogsActor) GetRecentLogsForApplicationsReturnsOnCall(i int, result1 []v2action.LogMessage, result2 error) {
+	fake.GetRecentLogsForApplicationsStub = nil
+	if fake.getRecentLogsForApplicationsReturnsOnCall == nil {
+		fake.getRecentLogsForApplicationsReturnsOnCall = make(map[int]struct {
+			result1 []v2action.LogMessage
+			result2 error
+		})
+	}
+	fake.getRecentLogsForApplicationsReturnsOnCall[i] = struct {
+		result1 []v2action.LogMessage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeLogsActor) GetRecentLogsForApplicationByNameAndSpace(appName string, spaceGUID string, client v2action.NOAAClient, config v2action.Config) ([]v2action.LogMessage, v2action.Warnings, error) {
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.getRecentLogsForApplicationByNameAndSpaceReturnsOnCall[len(fake.getRecentLogsForApplicationByNameAndSpaceArgsForCall)]
@@ -168,13 +401,79 @@ func (fake *FakeLogsActor) GetStreamingLogsForApplicationByNameAndSpaceReturnsOn
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeLogsActor) GetStreamingLogsForApplications(apps []v2action.Application, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error) {
+	var appsCopy []v2action.Application
+	if apps != nil {
+		appsCopy = make([]v2action.Application, len(apps))
+		copy(appsCopy, apps)
+	}
+	fake.getStreamingLogsForApplicationsMutex.Lock()
+	ret, specificReturn := fake.getStreamingLogsForApplicationsReturnsOnCall[len(fake.getStreamingLogsForApplicationsArgsForCall)]
+	fake.getStreamingLogsForApplicationsArgsForCall = append(fake.getStreamingLogsForApplicationsArgsForCall, struct {
+		apps   []v2action.Application
+		client v2action.NOAAClient
+		config v2action.Config
+	}{appsCopy, client, config})
+	fake.recordInvocation("GetStreamingLogsForApplications", []interface{}{appsCopy, client, config})
+	fake.getStreamingLogsForApplicationsMutex.Unlock()
+	if fake.GetStreamingLogsForApplicationsStub != nil {
+		return fake.GetStreamingLogsForApplicationsStub(apps, client, config)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.getStreamingLogsForApplicationsReturns.result1, fake.getStreamingLogsForApplicationsReturns.result2
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsCallCount() int {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return len(fake.getStreamingLogsForApplicationsArgsForCall)
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsArgsForCall(i int) ([]v2action.Application, v2action.NOAAClient, v2action.Config) {
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
+	return fake.getStreamingLogsForApplicationsArgsForCall[i].apps, fake.getStreamingLogsForApplicationsArgsForCall[i].client, fake.getStreamingLogsForApplicationsArgsForCall[i].config
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsReturns(result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsForApplicationsStub = nil
+	fake.getStreamingLogsForApplicationsReturns = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
+func (fake *FakeLogsActor) GetStreamingLogsForApplicationsReturnsOnCall(i int, result1 <-chan *v2action.LogMessage, result2 <-chan error) {
+	fake.GetStreamingLogsForApplicationsStub = nil
+	if fake.getStreamingLogsForApplicationsReturnsOnCall == nil {
+		fake.getStreamingLogsForApplicationsReturnsOnCall = make(map[int]struct {
+			result1 <-chan *v2action.LogMessage
+			result2 <-chan error
+		})
+	}
+	fake.getStreamingLogsForApplicationsReturnsOnCall[i] = struct {
+		result1 <-chan *v2action.LogMessage
+		result2 <-chan error
+	}{result1, result2}
+}
+
 func (fake *FakeLogsActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationsByNamePatternsAndSpaceMutex.RLock()
+	defer fake.getApplicationsByNamePatternsAndSpaceMutex.RUnlock()
+	fake.getApplicationsBySpaceMutex.RLock()
+	defer fake.getApplicationsBySpaceMutex.RUnlock()
+	fake.getRecentLogsForApplicationsMutex.RLock()
+	defer fake.getRecentLogsForApplicationsMutex.RUnlock()
 	fake.getRecentLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getRecentLogsForApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RLock()
 	defer fake.getStreamingLogsForApplicationByNameAndSpaceMutex.RUnlock()
+	fake.getStreamingLogsForApplicationsMutex.RLock()
+	defer fake.getStreamingLogsForApplicationsMutex.RUnlock()
 	return fake.invocations
 }
 
@@ -0,0 +1,141 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeResponseCache struct {
+	EntriesStub        func() ([]ccWrapper.CachedResponse, error)
+	entriesMutex       sync.RWMutex
+	entriesArgsForCall []struct{}
+	entriesReturns     struct {
+		result1 []ccWrapper.CachedResponse
+		result2 error
+	}
+	entriesReturnsOnCall map[int]struct {
+		result1 []ccWrapper.CachedResponse
+		result2 error
+	}
+	ClearStub        func() error
+	clearMutex       sync.RWMutex
+	clearArgsForCall []struct{}
+	clearReturns     struct {
+		result1 error
+	}
+	clearReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeResponseCache) Entries() ([]ccWrapper.CachedResponse, error) {
+	fake.entriesMutex.Lock()
+	ret, specificReturn := fake.entriesReturnsOnCall[len(fake.entriesArgsForCall)]
+	fake.entriesArgsForCall = append(fake.entriesArgsForCall, struct{}{})
+	fake.recordInvocation("Entries", []interface{}{})
+	fake.entriesMutex.Unlock()
+	if fake.EntriesStub != nil {
+		return fake.EntriesStub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fake.entriesReturns.result1, fake.entriesReturns.result2
+}
+
+func (fake *FakeResponseCache) EntriesCallCount() int {
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	return len(fake.entriesArgsForCall)
+}
+
+func (fake *FakeResponseCache) EntriesReturns(result1 []ccWrapper.CachedResponse, result2 error) {
+	fake.EntriesStub = nil
+	fake.entriesReturns = struct {
+		result1 []ccWrapper.CachedResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResponseCache) EntriesReturnsOnCall(i int, result1 []ccWrapper.CachedResponse, result2 error) {
+	fake.EntriesStub = nil
+	if fake.entriesReturnsOnCall == nil {
+		fake.entriesReturnsOnCall = make(map[int]struct {
+			result1 []ccWrapper.CachedResponse
+			result2 error
+		})
+	}
+	fake.entriesReturnsOnCall[i] = struct {
+		result1 []ccWrapper.CachedResponse
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResponseCache) Clear() error {
+	fake.clearMutex.Lock()
+	ret, specificReturn := fake.clearReturnsOnCall[len(fake.clearArgsForCall)]
+	fake.clearArgsForCall = append(fake.clearArgsForCall, struct{}{})
+	fake.recordInvocation("Clear", []interface{}{})
+	fake.clearMutex.Unlock()
+	if fake.ClearStub != nil {
+		return fake.ClearStub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.clearReturns.result1
+}
+
+func (fake *FakeResponseCache) ClearCallCount() int {
+	fake.clearMutex.RLock()
+	defer fake.clearMutex.RUnlock()
+	return len(fake.clearArgsForCall)
+}
+
+func (fake *FakeResponseCache) ClearReturns(result1 error) {
+	fake.ClearStub = nil
+	fake.clearReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResponseCache) ClearReturnsOnCall(i int, result1 error) {
+	fake.ClearStub = nil
+	if fake.clearReturnsOnCall == nil {
+		fake.clearReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.clearReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeResponseCache) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.entriesMutex.RLock()
+	defer fake.entriesMutex.RUnlock()
+	fake.clearMutex.RLock()
+	defer fake.clearMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeResponseCache) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.ResponseCache = new(FakeResponseCache)
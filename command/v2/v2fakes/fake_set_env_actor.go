@@ -0,0 +1,182 @@
+// This file was generated by counterfeiter
+package v2fakes
+
+import (
+	"sync"
+
+	"code.cloudfoundry.org/cli/actor/v2action"
+	"code.cloudfoundry.org/cli/command/v2"
+)
+
+type FakeSetEnvActor struct {
+	GetApplicationByNameAndSpaceStub        func(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error)
+	getApplicationByNameAndSpaceMutex       sync.RWMutex
+	getApplicationByNameAndSpaceArgsForCall []struct {
+		name      string
+		spaceGUID string
+	}
+	getApplicationByNameAndSpaceReturns struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	getApplicationByNameAndSpaceReturnsOnCall map[int]struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}
+	SetApplicationEnvironmentVariablesStub        func(appGUID string, variables map[string]string, prune bool) (v2action.EnvironmentVariableChanges, v2action.Warnings, error)
+	setApplicationEnvironmentVariablesMutex       sync.RWMutex
+	setApplicationEnvironmentVariablesArgsForCall []struct {
+		appGUID   string
+		variables map[string]string
+		prune     bool
+	}
+	setApplicationEnvironmentVariablesReturns struct {
+		result1 v2action.EnvironmentVariableChanges
+		result2 v2action.Warnings
+		result3 error
+	}
+	setApplicationEnvironmentVariablesReturnsOnCall map[int]struct {
+		result1 v2action.EnvironmentVariableChanges
+		result2 v2action.Warnings
+		result3 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeSetEnvActor) GetApplicationByNameAndSpace(name string, spaceGUID string) (v2action.Application, v2action.Warnings, error) {
+	fake.getApplicationByNameAndSpaceMutex.Lock()
+	ret, specificReturn := fake.getApplicationByNameAndSpaceReturnsOnCall[len(fake.getApplicationByNameAndSpaceArgsForCall)]
+	fake.getApplicationByNameAndSpaceArgsForCall = append(fake.getApplicationByNameAndSpaceArgsForCall, struct {
+		name      string
+		spaceGUID string
+	}{name, spaceGUID})
+	fake.recordInvocation("GetApplicationByNameAndSpace", []interface{}{name, spaceGUID})
+	fake.getApplicationByNameAndSpaceMutex.Unlock()
+	if fake.GetApplicationByNameAndSpaceStub != nil {
+		return fake.GetApplicationByNameAndSpaceStub(name, spaceGUID)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationByNameAndSpaceReturns.result1, fake.getApplicationByNameAndSpaceReturns.result2, fake.getApplicationByNameAndSpaceReturns.result3
+}
+
+func (fake *FakeSetEnvActor) GetApplicationByNameAndSpaceCallCount() int {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return len(fake.getApplicationByNameAndSpaceArgsForCall)
+}
+
+func (fake *FakeSetEnvActor) GetApplicationByNameAndSpaceArgsForCall(i int) (string, string) {
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	return fake.getApplicationByNameAndSpaceArgsForCall[i].name, fake.getApplicationByNameAndSpaceArgsForCall[i].spaceGUID
+}
+
+func (fake *FakeSetEnvActor) GetApplicationByNameAndSpaceReturns(result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	fake.getApplicationByNameAndSpaceReturns = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetEnvActor) GetApplicationByNameAndSpaceReturnsOnCall(i int, result1 v2action.Application, result2 v2action.Warnings, result3 error) {
+	fake.GetApplicationByNameAndSpaceStub = nil
+	if fake.getApplicationByNameAndSpaceReturnsOnCall == nil {
+		fake.getApplicationByNameAndSpaceReturnsOnCall = make(map[int]struct {
+			result1 v2action.Application
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationByNameAndSpaceReturnsOnCall[i] = struct {
+		result1 v2action.Application
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetEnvActor) SetApplicationEnvironmentVariables(appGUID string, variables map[string]string, prune bool) (v2action.EnvironmentVariableChanges, v2action.Warnings, error) {
+	fake.setApplicationEnvironmentVariablesMutex.Lock()
+	ret, specificReturn := fake.setApplicationEnvironmentVariablesReturnsOnCall[len(fake.setApplicationEnvironmentVariablesArgsForCall)]
+	fake.setApplicationEnvironmentVariablesArgsForCall = append(fake.setApplicationEnvironmentVariablesArgsForCall, struct {
+		appGUID   string
+		variables map[string]string
+		prune     bool
+	}{appGUID, variables, prune})
+	fake.recordInvocation("SetApplicationEnvironmentVariables", []interface{}{appGUID, variables, prune})
+	fake.setApplicationEnvironmentVariablesMutex.Unlock()
+	if fake.SetApplicationEnvironmentVariablesStub != nil {
+		return fake.SetApplicationEnvironmentVariablesStub(appGUID, variables, prune)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.setApplicationEnvironmentVariablesReturns.result1, fake.setApplicationEnvironmentVariablesReturns.result2, fake.setApplicationEnvironmentVariablesReturns.result3
+}
+
+func (fake *FakeSetEnvActor) SetApplicationEnvironmentVariablesCallCount() int {
+	fake.setApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.setApplicationEnvironmentVariablesMutex.RUnlock()
+	return len(fake.setApplicationEnvironmentVariablesArgsForCall)
+}
+
+func (fake *FakeSetEnvActor) SetApplicationEnvironmentVariablesArgsForCall(i int) (string, map[string]string, bool) {
+	fake.setApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.setApplicationEnvironmentVariablesMutex.RUnlock()
+	return fake.setApplicationEnvironmentVariablesArgsForCall[i].appGUID, fake.setApplicationEnvironmentVariablesArgsForCall[i].variables, fake.setApplicationEnvironmentVariablesArgsForCall[i].prune
+}
+
+func (fake *FakeSetEnvActor) SetApplicationEnvironmentVariablesReturns(result1 v2action.EnvironmentVariableChanges, result2 v2action.Warnings, result3 error) {
+	fake.SetApplicationEnvironmentVariablesStub = nil
+	fake.setApplicationEnvironmentVariablesReturns = struct {
+		result1 v2action.EnvironmentVariableChanges
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetEnvActor) SetApplicationEnvironmentVariablesReturnsOnCall(i int, result1 v2action.EnvironmentVariableChanges, result2 v2action.Warnings, result3 error) {
+	fake.SetApplicationEnvironmentVariablesStub = nil
+	if fake.setApplicationEnvironmentVariablesReturnsOnCall == nil {
+		fake.setApplicationEnvironmentVariablesReturnsOnCall = make(map[int]struct {
+			result1 v2action.EnvironmentVariableChanges
+			result2 v2action.Warnings
+			result3 error
+		})
+	}
+	fake.setApplicationEnvironmentVariablesReturnsOnCall[i] = struct {
+		result1 v2action.EnvironmentVariableChanges
+		result2 v2action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeSetEnvActor) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.getApplicationByNameAndSpaceMutex.RLock()
+	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
+	fake.setApplicationEnvironmentVariablesMutex.RLock()
+	defer fake.setApplicationEnvironmentVariablesMutex.RUnlock()
+	return fake.invocations
+}
+
+func (fake *FakeSetEnvActor) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ v2.SetEnvActor = new(FakeSetEnvActor)
@@ -25,11 +25,12 @@ type FakeV2PushActor struct {
 		result2 <-chan pushaction.Warnings
 		result3 <-chan error
 	}
-	ConvertToApplicationConfigStub        func(spaceGUID string, orgGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
+	ConvertToApplicationConfigStub        func(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error)
 	convertToApplicationConfigMutex       sync.RWMutex
 	convertToApplicationConfigArgsForCall []struct {
-		spaceGUID string
 		orgGUID   string
+		spaceGUID string
+		dryRun    bool
 		apps      []manifest.Application
 	}
 	convertToApplicationConfigReturns struct {
@@ -56,6 +57,17 @@ type FakeV2PushActor struct {
 		result1 []manifest.Application
 		result2 error
 	}
+	PlanStub        func(config pushaction.ApplicationConfig) pushaction.ApplicationPlan
+	planMutex       sync.RWMutex
+	planArgsForCall []struct {
+		config pushaction.ApplicationConfig
+	}
+	planReturns struct {
+		result1 pushaction.ApplicationPlan
+	}
+	planReturnsOnCall map[int]struct {
+		result1 pushaction.ApplicationPlan
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -114,7 +126,7 @@ func (fake *FakeV2PushActor) ApplyReturnsOnCall(i int, result1 <-chan pushaction
 	}{result1, result2, result3}
 }
 
-func (fake *FakeV2PushActor) ConvertToApplicationConfig(spaceGUID string, orgGUID string, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
+func (fake *FakeV2PushActor) ConvertToApplicationConfig(orgGUID string, spaceGUID string, dryRun bool, apps []manifest.Application) ([]pushaction.ApplicationConfig, pushaction.Warnings, error) {
 	var appsCopy []manifest.Application
 	if apps != nil {
 		appsCopy = make([]manifest.Application, len(apps))
@@ -123,14 +135,15 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfig(spaceGUID string, orgGUI
 	fake.convertToApplicationConfigMutex.Lock()
 	ret, specificReturn := fake.convertToApplicationConfigReturnsOnCall[len(fake.convertToApplicationConfigArgsForCall)]
 	fake.convertToApplicationConfigArgsForCall = append(fake.convertToApplicationConfigArgsForCall, struct {
-		spaceGUID string
 		orgGUID   string
+		spaceGUID string
+		dryRun    bool
 		apps      []manifest.Application
-	}{spaceGUID, orgGUID, appsCopy})
-	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{spaceGUID, orgGUID, appsCopy})
+	}{orgGUID, spaceGUID, dryRun, appsCopy})
+	fake.recordInvocation("ConvertToApplicationConfig", []interface{}{orgGUID, spaceGUID, dryRun, appsCopy})
 	fake.convertToApplicationConfigMutex.Unlock()
 	if fake.ConvertToApplicationConfigStub != nil {
-		return fake.ConvertToApplicationConfigStub(spaceGUID, orgGUID, apps)
+		return fake.ConvertToApplicationConfigStub(orgGUID, spaceGUID, dryRun, apps)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -144,10 +157,10 @@ func (fake *FakeV2PushActor) ConvertToApplicationConfigCallCount() int {
 	return len(fake.convertToApplicationConfigArgsForCall)
 }
 
-func (fake *FakeV2PushActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, []manifest.Application) {
+func (fake *FakeV2PushActor) ConvertToApplicationConfigArgsForCall(i int) (string, string, bool, []manifest.Application) {
 	fake.convertToApplicationConfigMutex.RLock()
 	defer fake.convertToApplicationConfigMutex.RUnlock()
-	return fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].apps
+	return fake.convertToApplicationConfigArgsForCall[i].orgGUID, fake.convertToApplicationConfigArgsForCall[i].spaceGUID, fake.convertToApplicationConfigArgsForCall[i].dryRun, fake.convertToApplicationConfigArgsForCall[i].apps
 }
 
 func (fake *FakeV2PushActor) ConvertToApplicationConfigReturns(result1 []pushaction.ApplicationConfig, result2 pushaction.Warnings, result3 error) {
@@ -232,6 +245,54 @@ func (fake *FakeV2PushActor) MergeAndValidateSettingsAndManifestsReturnsOnCall(i
 	}{result1, result2}
 }
 
+func (fake *FakeV2PushActor) Plan(config pushaction.ApplicationConfig) pushaction.ApplicationPlan {
+	fake.planMutex.Lock()
+	ret, specificReturn := fake.planReturnsOnCall[len(fake.planArgsForCall)]
+	fake.planArgsForCall = append(fake.planArgsForCall, struct {
+		config pushaction.ApplicationConfig
+	}{config})
+	fake.recordInvocation("Plan", []interface{}{config})
+	fake.planMutex.Unlock()
+	if fake.PlanStub != nil {
+		return fake.PlanStub(config)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fake.planReturns.result1
+}
+
+func (fake *FakeV2PushActor) PlanCallCount() int {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return len(fake.planArgsForCall)
+}
+
+func (fake *FakeV2PushActor) PlanArgsForCall(i int) pushaction.ApplicationConfig {
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
+	return fake.planArgsForCall[i].config
+}
+
+func (fake *FakeV2PushActor) PlanReturns(result1 pushaction.ApplicationPlan) {
+	fake.PlanStub = nil
+	fake.planReturns = struct {
+		result1 pushaction.ApplicationPlan
+	}{result1}
+}
+
+func (fake *FakeV2PushActor) PlanReturnsOnCall(i int, result1 pushaction.ApplicationPlan) {
+	fake.PlanStub = nil
+	if fake.planReturnsOnCall == nil {
+		fake.planReturnsOnCall = make(map[int]struct {
+			result1 pushaction.ApplicationPlan
+		})
+	}
+	fake.planReturnsOnCall[i] = struct {
+		result1 pushaction.ApplicationPlan
+	}{result1}
+}
+
 func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -241,6 +302,8 @@ func (fake *FakeV2PushActor) Invocations() map[string][][]interface{} {
 	defer fake.convertToApplicationConfigMutex.RUnlock()
 	fake.mergeAndValidateSettingsAndManifestsMutex.RLock()
 	defer fake.mergeAndValidateSettingsAndManifestsMutex.RUnlock()
+	fake.planMutex.RLock()
+	defer fake.planMutex.RUnlock()
 	return fake.invocations
 }
 
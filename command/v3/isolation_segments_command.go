@@ -16,6 +16,13 @@ type IsolationSegmentsActor interface {
 	GetIsolationSegmentSummaries() ([]v3action.IsolationSegmentSummary, v3action.Warnings, error)
 }
 
+// isolationSegmentData is the structured output of the isolation-segments
+// command.
+type isolationSegmentData struct {
+	Name         string   `json:"name"`
+	EntitledOrgs []string `json:"entitled_orgs"`
+}
+
 type IsolationSegmentsCommand struct {
 	usage           interface{} `usage:"CF_NAME isolation-segments"`
 	relatedCommands interface{} `related_commands:"enable-org-isolation, create-isolation-segment"`
@@ -40,6 +47,10 @@ func (cmd *IsolationSegmentsCommand) Setup(config command.Config, ui command.UI)
 	return nil
 }
 
+func (cmd IsolationSegmentsCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.11.0")
 	if err != nil {
@@ -68,6 +79,21 @@ func (cmd IsolationSegmentsCommand) Execute(args []string) error {
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
+	if cmd.UI.StructuredOutputEnabled() {
+		data := []isolationSegmentData{}
+		for _, summary := range summaries {
+			orgs := summary.EntitledOrgs
+			if orgs == nil {
+				orgs = []string{}
+			}
+			data = append(data, isolationSegmentData{
+				Name:         summary.Name,
+				EntitledOrgs: orgs,
+			})
+		}
+		return cmd.UI.DisplayStructuredData("isolation_segments", data)
+	}
+
 	table := [][]string{
 		{
 			cmd.UI.TranslateText("name"),
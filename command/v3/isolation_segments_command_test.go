@@ -120,6 +120,30 @@ var _ = Describe("isolation-segments Command", func() {
 
 					Expect(fakeActor.GetIsolationSegmentSummariesCallCount()).To(Equal(1))
 				})
+				Context("when structured output is enabled", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = ui.OutputYAML
+					})
+
+					It("displays the isolation segment summaries as a versioned document", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`schema_version: 1
+kind: isolation_segments
+data:
+- name: some-iso-1
+  entitled_orgs: \[\]
+- name: some-iso-2
+  entitled_orgs:
+  - some-org-1
+- name: some-iso-3
+  entitled_orgs:
+  - some-org-1
+  - some-org-2
+`))
+						Expect(testUI.Err).To(Say("Getting isolation segments as banana..."))
+					})
+				})
 			})
 
 			Context("when there are no isolation segments", func() {
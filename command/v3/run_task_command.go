@@ -2,34 +2,59 @@ package v3
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
+	"time"
 
+	"github.com/cloudfoundry/noaa/consumer"
+
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/flag"
+	sharedV2 "code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
+// TaskLogDrainTimeout is how long run-task --wait keeps displaying log
+// messages after the task finishes, so that the last lines the task wrote are
+// not lost.
+var TaskLogDrainTimeout = 2 * time.Second
+
 //go:generate counterfeiter . RunTaskActor
 
 type RunTaskActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	RunTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
+	PollTask(appGUID string, task v3action.Task) (v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
+//go:generate counterfeiter . RunTaskLogsActor
+
+type RunTaskLogsActor interface {
+	GetStreamingLogs(appGUID string, client v2action.NOAAClient, config v2action.Config) (<-chan *v2action.LogMessage, <-chan error)
+}
+
 type RunTaskCommand struct {
-	RequiredArgs    flag.RunTaskArgs `positional-args:"yes"`
-	Disk            flag.Megabytes   `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
-	Memory          flag.Megabytes   `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
-	Name            string           `long:"name" description:"Name to give the task (generated if omitted)"`
-	usage           interface{}      `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate"`
-	relatedCommands interface{}      `related_commands:"logs, tasks, terminate-task"`
+	RequiredArgs    flag.RunTaskArgs            `positional-args:"yes"`
+	Disk            flag.Megabytes              `short:"k" description:"Disk limit (e.g. 256M, 1024M, 1G)"`
+	Memory          flag.Megabytes              `short:"m" description:"Memory limit (e.g. 256M, 1024M, 1G)"`
+	Name            string                      `long:"name" description:"Name to give the task (generated if omitted)"`
+	Template        string                      `long:"template" description:"Run the task template with this name from the manifest"`
+	PathToManifest  flag.PathWithExistenceCheck `short:"f" description:"Path to the manifest or task template file (used with --template)"`
+	Wait            bool                        `long:"wait" description:"Display the task's logs and wait for it to finish, exiting with an error if it fails"`
+	usage           interface{}                 `usage:"CF_NAME run-task APP_NAME COMMAND [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait]\n   CF_NAME run-task APP_NAME --template TEMPLATE_NAME [-f MANIFEST_PATH] [-k DISK] [-m MEMORY] [--name TASK_NAME] [--wait]\n\nTIP:\n   Use 'cf logs' to display the logs of the app and all its tasks. If your task name is unique, grep this command's output for the task name to view task-specific logs.\n\n   Task templates are declared in the 'tasks' section of the manifest, with a name, command and optional memory and disk_quota. Tasks run from a template are named after it unless --name is provided; use 'cf tasks APP_NAME --template TEMPLATE_NAME' to view their history.\n\nEXAMPLES:\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate\n   CF_NAME run-task my-app \"bundle exec rake db:migrate\" --name migrate --wait\n   CF_NAME run-task my-app --template migrate -f ./tasks.yml"`
+	relatedCommands interface{}                 `related_commands:"logs, tasks, terminate-task"`
 
 	UI          command.UI
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       RunTaskActor
+	LogsActor   RunTaskLogsActor
+	NOAAClient  *consumer.Consumer
 }
 
 func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
@@ -43,10 +68,28 @@ func (cmd *RunTaskCommand) Setup(config command.Config, ui command.UI) error {
 	}
 	cmd.Actor = v3action.NewActor(client, config)
 
+	if cmd.Wait {
+		ccClientV2, uaaClientV2, err := sharedV2.NewClients(config, ui, true)
+		if err != nil {
+			return err
+		}
+		cmd.LogsActor = v2action.NewActor(ccClientV2, uaaClientV2)
+		cmd.NOAAClient = sharedV2.NewNOAAClient(ccClientV2.DopplerEndpoint(), config, uaaClientV2, ui)
+	}
+
 	return nil
 }
 
 func (cmd RunTaskCommand) Execute(args []string) error {
+	switch {
+	case cmd.Template == "" && cmd.RequiredArgs.Command == "":
+		return command.RequiredArgumentError{ArgumentName: "COMMAND"}
+	case cmd.Template != "" && cmd.RequiredArgs.Command != "":
+		return command.ArgumentCombinationError{Arg1: "COMMAND", Arg2: "--template"}
+	case cmd.Template == "" && cmd.PathToManifest != "":
+		return command.RequiredFlagsError{Arg1: "-f", Arg2: "--template"}
+	}
+
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
 	if err != nil {
 		return err
@@ -64,6 +107,24 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		return err
 	}
 
+	inputTask := v3action.Task{
+		Command: cmd.RequiredArgs.Command,
+	}
+
+	if cmd.Template != "" {
+		template, err := cmd.findTaskTemplate()
+		if err != nil {
+			return err
+		}
+
+		inputTask = v3action.Task{
+			Name:       template.Name,
+			Command:    template.Command,
+			DiskInMB:   template.DiskQuota,
+			MemoryInMB: template.Memory,
+		}
+	}
+
 	application, warnings, err := cmd.Actor.GetApplicationByNameAndSpace(cmd.RequiredArgs.AppName, space.GUID)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -77,10 +138,6 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
-	inputTask := v3action.Task{
-		Command: cmd.RequiredArgs.Command,
-	}
-
 	if cmd.Name != "" {
 		inputTask.Name = cmd.Name
 	}
@@ -91,6 +148,17 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		inputTask.MemoryInMB = cmd.Memory.Size
 	}
 
+	// The logs are streamed before the task is created so that none of its
+	// output is missed.
+	var (
+		messages <-chan *v2action.LogMessage
+		logErrs  <-chan error
+	)
+	if cmd.Wait {
+		messages, logErrs = cmd.LogsActor.GetStreamingLogs(application.GUID, cmd.NOAAClient, cmd.Config)
+		defer cmd.NOAAClient.Close()
+	}
+
 	task, warnings, err := cmd.Actor.RunTask(application.GUID, inputTask)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
@@ -105,5 +173,127 @@ func (cmd RunTaskCommand) Execute(args []string) error {
 		{cmd.UI.TranslateText("task id:"), fmt.Sprint(task.SequenceID)},
 	}, 3)
 
+	if !cmd.Wait {
+		return nil
+	}
+
+	return cmd.waitForTask(application.GUID, task, messages, logErrs)
+}
+
+// findTaskTemplate reads the task template requested with --template from the
+// manifest provided with -f, which may be a directory containing a manifest,
+// or the manifest in the current directory.
+func (cmd RunTaskCommand) findTaskTemplate() (manifest.TaskTemplate, error) {
+	pathToManifest, err := findManifest(string(cmd.PathToManifest))
+	if err != nil {
+		return manifest.TaskTemplate{}, err
+	}
+
+	apps, err := manifest.ReadAndMergeManifests(pathToManifest)
+	if err != nil {
+		return manifest.TaskTemplate{}, sharedV2.HandleError(err)
+	}
+
+	template, err := manifest.FindTaskTemplate(apps, cmd.RequiredArgs.AppName, cmd.Template)
+	if err != nil {
+		return manifest.TaskTemplate{}, shared.HandleError(err)
+	}
+	return template, nil
+}
+
+// findManifest returns the path to the manifest at path, which may be a
+// directory containing a manifest. The current directory is searched when
+// path is empty.
+func findManifest(path string) (string, error) {
+	searchDir := path
+	if searchDir != "" {
+		info, err := os.Stat(searchDir)
+		if err != nil {
+			return "", err
+		}
+		if !info.IsDir() {
+			return searchDir, nil
+		}
+	} else {
+		pwd, err := os.Getwd()
+		if err != nil {
+			return "", err
+		}
+		searchDir = pwd
+	}
+
+	for _, name := range []string{"manifest.yml", "manifest.yaml"} {
+		pathToManifest := filepath.Join(searchDir, name)
+		if _, err := os.Stat(pathToManifest); err == nil {
+			return pathToManifest, nil
+		}
+	}
+
+	return "", shared.ManifestNotFoundError{Path: searchDir}
+}
+
+type pollTaskResult struct {
+	task     v3action.Task
+	warnings v3action.Warnings
+	err      error
+}
+
+// waitForTask displays the task's log messages until the task finishes.
+func (cmd RunTaskCommand) waitForTask(appGUID string, task v3action.Task, messages <-chan *v2action.LogMessage, logErrs <-chan error) error {
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayText("Waiting for task {{.SequenceID}} to finish...", map[string]interface{}{
+		"SequenceID": task.SequenceID,
+	})
+	cmd.UI.DisplayNewline()
+
+	results := make(chan pollTaskResult, 1)
+	go func() {
+		finishedTask, warnings, err := cmd.Actor.PollTask(appGUID, task)
+		results <- pollTaskResult{task: finishedTask, warnings: warnings, err: err}
+	}()
+
+	sourceType := "APP/TASK/" + task.Name
+	var (
+		result pollTaskResult
+		drain  <-chan time.Time
+	)
+	for drain == nil || messages != nil {
+		select {
+		case message, ok := <-messages:
+			if !ok {
+				messages = nil
+				break
+			}
+
+			if message.SourceType() == sourceType {
+				cmd.UI.DisplayLogMessage(*message, true)
+			}
+		case logErr, ok := <-logErrs:
+			if !ok {
+				logErrs = nil
+				break
+			}
+
+			cmd.UI.DisplayWarning("Failed to retrieve logs for task {{.SequenceID}}: {{.Error}}", map[string]interface{}{
+				"SequenceID": task.SequenceID,
+				"Error":      logErr.Error(),
+			})
+		case result = <-results:
+			drain = time.After(TaskLogDrainTimeout)
+		case <-drain:
+			messages = nil
+		}
+	}
+
+	cmd.UI.DisplayNewline()
+	cmd.UI.DisplayWarnings(result.warnings)
+	if result.err != nil {
+		return shared.HandleError(result.err)
+	}
+
+	cmd.UI.DisplayText("Task {{.SequenceID}} ({{.Name}}) succeeded.", map[string]interface{}{
+		"SequenceID": result.task.SequenceID,
+		"Name":       result.task.Name,
+	})
 	return nil
 }
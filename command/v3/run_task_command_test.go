@@ -2,17 +2,24 @@ package v3_test
 
 import (
 	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
+	"code.cloudfoundry.org/cli/actor/v2action"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/flag"
 	"code.cloudfoundry.org/cli/command/v3"
+	"code.cloudfoundry.org/cli/command/v3/shared"
 	"code.cloudfoundry.org/cli/command/v3/v3fakes"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/ui"
+	"github.com/cloudfoundry/noaa/consumer"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gbytes"
@@ -54,6 +61,36 @@ var _ = Describe("run-task Command", func() {
 		executeErr = cmd.Execute(nil)
 	})
 
+	Context("when neither COMMAND nor --template is provided", func() {
+		BeforeEach(func() {
+			cmd.RequiredArgs.Command = ""
+		})
+
+		It("returns a RequiredArgumentError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredArgumentError{ArgumentName: "COMMAND"}))
+		})
+	})
+
+	Context("when both COMMAND and --template are provided", func() {
+		BeforeEach(func() {
+			cmd.Template = "some-template"
+		})
+
+		It("returns an ArgumentCombinationError", func() {
+			Expect(executeErr).To(MatchError(command.ArgumentCombinationError{Arg1: "COMMAND", Arg2: "--template"}))
+		})
+	})
+
+	Context("when -f is provided without --template", func() {
+		BeforeEach(func() {
+			cmd.PathToManifest = "some-manifest.yml"
+		})
+
+		It("returns a RequiredFlagsError", func() {
+			Expect(executeErr).To(MatchError(command.RequiredFlagsError{Arg1: "-f", Arg2: "--template"}))
+		})
+	})
+
 	Context("when the API version is below the minimum", func() {
 		BeforeEach(func() {
 			fakeActor.CloudControllerAPIVersionReturns("0.0.0")
@@ -286,6 +323,190 @@ get-application-warning-2
 get-application-warning-3`))
 					})
 				})
+				Context("when --template is provided", func() {
+					var tmpDir string
+
+					BeforeEach(func() {
+						var err error
+						tmpDir, err = ioutil.TempDir("", "run-task-test")
+						Expect(err).ToNot(HaveOccurred())
+
+						cmd.RequiredArgs.Command = ""
+						cmd.Template = "migrate"
+						cmd.PathToManifest = flag.PathWithExistenceCheck(tmpDir)
+
+						fakeActor.RunTaskReturns(
+							v3action.Task{
+								Name:       "migrate",
+								SequenceID: 3,
+							},
+							nil,
+							nil)
+					})
+
+					AfterEach(func() {
+						Expect(os.RemoveAll(tmpDir)).To(Succeed())
+					})
+
+					Context("when the manifest declares the template", func() {
+						BeforeEach(func() {
+							Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifest.yml"), []byte(`---
+applications:
+- name: some-app-name
+  tasks:
+  - name: migrate
+    command: rake db:migrate
+    memory: 256M
+    disk_quota: 1G
+`), 0666)).To(Succeed())
+						})
+
+						It("runs the task described by the template", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(1))
+							appGUID, task := fakeActor.RunTaskArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(task).To(Equal(v3action.Task{
+								Name:       "migrate",
+								Command:    "rake db:migrate",
+								MemoryInMB: 256,
+								DiskInMB:   1024,
+							}))
+
+							Expect(testUI.Out).To(Say("task name:\\s+migrate"))
+						})
+
+						Context("when flags are also provided", func() {
+							BeforeEach(func() {
+								cmd.Name = "some-task-name"
+								cmd.Memory = flag.Megabytes{Size: 512}
+							})
+
+							It("overrides the template with the flags", func() {
+								Expect(executeErr).ToNot(HaveOccurred())
+
+								_, task := fakeActor.RunTaskArgsForCall(0)
+								Expect(task).To(Equal(v3action.Task{
+									Name:       "some-task-name",
+									Command:    "rake db:migrate",
+									MemoryInMB: 512,
+									DiskInMB:   1024,
+								}))
+							})
+						})
+					})
+
+					Context("when the manifest does not declare the template", func() {
+						BeforeEach(func() {
+							Expect(ioutil.WriteFile(filepath.Join(tmpDir, "manifest.yml"), []byte(`---
+tasks:
+- name: cleanup
+  command: ./cleanup
+`), 0666)).To(Succeed())
+						})
+
+						It("returns a TaskTemplateNotFoundError", func() {
+							Expect(executeErr).To(MatchError(shared.TaskTemplateNotFoundError{
+								AppName:      "some-app-name",
+								TemplateName: "migrate",
+							}))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+
+					Context("when there is no manifest", func() {
+						It("returns a ManifestNotFoundError", func() {
+							Expect(executeErr).To(MatchError(shared.ManifestNotFoundError{Path: tmpDir}))
+							Expect(fakeActor.RunTaskCallCount()).To(Equal(0))
+						})
+					})
+				})
+
+				Context("when --wait is provided", func() {
+					var (
+						fakeLogsActor *v3fakes.FakeRunTaskLogsActor
+						noaaClient    *consumer.Consumer
+					)
+
+					BeforeEach(func() {
+						fakeLogsActor = new(v3fakes.FakeRunTaskLogsActor)
+						noaaClient = new(consumer.Consumer)
+						cmd.Wait = true
+						cmd.LogsActor = fakeLogsActor
+						cmd.NOAAClient = noaaClient
+
+						fakeLogsActor.GetStreamingLogsStub = func(_ string, _ v2action.NOAAClient, _ v2action.Config) (<-chan *v2action.LogMessage, <-chan error) {
+							messages := make(chan *v2action.LogMessage)
+							logErrs := make(chan error)
+
+							go func() {
+								messages <- v2action.NewLogMessage("web request", 1, time.Unix(0, 0), "APP/PROC/WEB", "0")
+								messages <- v2action.NewLogMessage("migrating", 1, time.Unix(0, 0), "APP/TASK/some-task-name", "0")
+								logErrs <- errors.New("websocket closed")
+								messages <- v2action.NewLogMessage("migrated", 1, time.Unix(0, 0), "APP/TASK/some-task-name", "0")
+								close(messages)
+								close(logErrs)
+							}()
+
+							return messages, logErrs
+						}
+
+						fakeActor.RunTaskReturns(
+							v3action.Task{
+								Name:       "some-task-name",
+								SequenceID: 3,
+							},
+							nil,
+							nil)
+					})
+
+					Context("when the task succeeds", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(
+								v3action.Task{Name: "some-task-name", SequenceID: 3, State: "SUCCEEDED"},
+								v3action.Warnings{"poll-warning"},
+								nil)
+						})
+
+						It("streams the task's logs from before the task is created and waits for it to finish", func() {
+							Expect(executeErr).ToNot(HaveOccurred())
+
+							Expect(fakeLogsActor.GetStreamingLogsCallCount()).To(Equal(1))
+							appGUID, client, _ := fakeLogsActor.GetStreamingLogsArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(client).To(Equal(noaaClient))
+
+							Expect(fakeActor.PollTaskCallCount()).To(Equal(1))
+							appGUID, task := fakeActor.PollTaskArgsForCall(0)
+							Expect(appGUID).To(Equal("some-app-guid"))
+							Expect(task).To(Equal(v3action.Task{Name: "some-task-name", SequenceID: 3}))
+
+							Expect(testUI.Out).To(Say("Waiting for task 3 to finish..."))
+							Expect(testUI.Out).To(Say("migrating"))
+							Expect(testUI.Out).To(Say("migrated"))
+							Expect(testUI.Out).To(Say(`Task 3 \(some-task-name\) succeeded\.`))
+							Expect(testUI.Out).ToNot(Say("web request"))
+
+							Expect(testUI.Err).To(Say("Failed to retrieve logs for task 3: websocket closed"))
+							Expect(testUI.Err).To(Say("poll-warning"))
+						})
+					})
+
+					Context("when the task fails", func() {
+						BeforeEach(func() {
+							fakeActor.PollTaskReturns(
+								v3action.Task{Name: "some-task-name", SequenceID: 3, State: "FAILED"},
+								nil,
+								v3action.TaskFailedError{Name: "some-task-name", SequenceID: 3, Reason: "Exited with status 1"})
+						})
+
+						It("returns a TaskFailedError with the failure reason", func() {
+							Expect(executeErr).To(MatchError(shared.TaskFailedError{Name: "some-task-name", SequenceID: 3, Reason: "Exited with status 1"}))
+							Expect(testUI.Out).To(Say("migrated"))
+						})
+					})
+				})
 			})
 
 			Context("when there are errors", func() {
@@ -14,6 +14,54 @@ func (e RunTaskError) Translate(translate func(string, ...interface{}) string) s
 	})
 }
 
+type TaskFailedError struct {
+	Name       string
+	SequenceID int
+	Reason     string
+}
+
+func (e TaskFailedError) Error() string {
+	return "Task {{.SequenceID}} ({{.Name}}) failed: {{.Reason}}"
+}
+
+func (e TaskFailedError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"SequenceID": e.SequenceID,
+		"Name":       e.Name,
+		"Reason":     e.Reason,
+	})
+}
+
+type TaskTemplateNotFoundError struct {
+	AppName      string
+	TemplateName string
+}
+
+func (e TaskTemplateNotFoundError) Error() string {
+	return "Task template {{.TemplateName}} not found for app {{.AppName}}."
+}
+
+func (e TaskTemplateNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"AppName":      e.AppName,
+		"TemplateName": e.TemplateName,
+	})
+}
+
+type ManifestNotFoundError struct {
+	Path string
+}
+
+func (e ManifestNotFoundError) Error() string {
+	return "Could not find a manifest in {{.Path}}."
+}
+
+func (e ManifestNotFoundError) Translate(translate func(string, ...interface{}) string) string {
+	return translate(e.Error(), map[string]interface{}{
+		"Path": e.Path,
+	})
+}
+
 type V3APIDoesNotExistError struct {
 	Message string
 }
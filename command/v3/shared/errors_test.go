@@ -38,6 +38,9 @@ var _ = Describe("Translatable Errors", func() {
 
 		// Actor errors.
 		Entry("RunTaskError", RunTaskError{}),
+		Entry("TaskFailedError", TaskFailedError{}),
+		Entry("TaskTemplateNotFoundError", TaskTemplateNotFoundError{}),
+		Entry("ManifestNotFoundError", ManifestNotFoundError{}),
 		Entry("V3APIDoesNotExistError", V3APIDoesNotExistError{}),
 		Entry("IsolationSegmentNotFoundError", IsolationSegmentNotFoundError{}),
 		Entry("OrgNotFoundError", OrganizationNotFoundError{}),
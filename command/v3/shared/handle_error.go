@@ -3,6 +3,7 @@ package shared
 import (
 	"strings"
 
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -25,6 +26,9 @@ func HandleError(err error) error {
 	case ccerror.UnverifiedServerError:
 		return command.InvalidSSLCertError{API: e.URL}
 
+	case manifest.TaskTemplateNotFoundError:
+		return TaskTemplateNotFoundError{AppName: e.AppName, TemplateName: e.TemplateName}
+
 	case sharedaction.NotLoggedInError:
 		return command.NotLoggedInError{BinaryName: e.BinaryName}
 	case sharedaction.NoTargetedOrganizationError:
@@ -36,6 +40,8 @@ func HandleError(err error) error {
 		return command.ApplicationNotFoundError{Name: e.Name}
 	case v3action.TaskWorkersUnavailableError:
 		return RunTaskError{Message: "Task workers are unavailable."}
+	case v3action.TaskFailedError:
+		return TaskFailedError{Name: e.Name, SequenceID: e.SequenceID, Reason: e.Reason}
 	case v3action.OrganizationNotFoundError:
 		return OrganizationNotFoundError{Name: e.Name}
 	case v3action.IsolationSegmentNotFoundError:
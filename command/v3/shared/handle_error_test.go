@@ -3,6 +3,7 @@ package shared_test
 import (
 	"errors"
 
+	"code.cloudfoundry.org/cli/actor/pushaction/manifest"
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
@@ -60,6 +61,14 @@ var _ = Describe("HandleError", func() {
 			v3action.TaskWorkersUnavailableError{Message: "fooo: Banana Pants"},
 			RunTaskError{Message: "Task workers are unavailable."}),
 
+		Entry("v3action.TaskFailedError -> TaskFailedError",
+			v3action.TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"},
+			TaskFailedError{Name: "some-task", SequenceID: 3, Reason: "Exited with status 1"}),
+
+		Entry("manifest.TaskTemplateNotFoundError -> TaskTemplateNotFoundError",
+			manifest.TaskTemplateNotFoundError{AppName: "some-app", TemplateName: "some-template"},
+			TaskTemplateNotFoundError{AppName: "some-app", TemplateName: "some-template"}),
+
 		Entry("sharedaction.NotLoggedInError -> NotLoggedInError",
 			sharedaction.NotLoggedInError{BinaryName: "faceman"},
 			command.NotLoggedInError{BinaryName: "faceman"}),
@@ -6,6 +6,7 @@ import (
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccerror"
 	"code.cloudfoundry.org/cli/api/cloudcontroller/ccv3"
 	ccWrapper "code.cloudfoundry.org/cli/api/cloudcontroller/wrapper"
+	"code.cloudfoundry.org/cli/api/retrypolicy"
 	"code.cloudfoundry.org/cli/api/uaa"
 	uaaWrapper "code.cloudfoundry.org/cli/api/uaa/wrapper"
 	"code.cloudfoundry.org/cli/command"
@@ -24,10 +25,14 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		ccWrappers = append(ccWrappers, ccWrapper.NewRequestLogger(ui.RequestLoggerFileWriter(location)))
 	}
 
+	if ttl := config.ResponseCacheTTL(); ttl > 0 {
+		ccWrappers = append(ccWrappers, ccWrapper.NewResponseCache(config.ResponseCacheDirectory(), ttl))
+	}
+
 	authWrapper := ccWrapper.NewUAAAuthentication(nil, config)
 
 	ccWrappers = append(ccWrappers, authWrapper)
-	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequest(2))
+	ccWrappers = append(ccWrappers, ccWrapper.NewRetryRequestWithPolicy(retrypolicy.Default()))
 
 	ccClient := ccv3.NewClient(ccv3.Config{
 		AppName:    config.BinaryName(),
@@ -64,6 +69,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 		ClientID:          config.UAAOAuthClient(),
 		ClientSecret:      config.UAAOAuthClientSecret(),
 		DialTimeout:       config.DialTimeout(),
+		GrantType:         uaa.GrantType(config.UAAGrantType()),
 		SkipSSLValidation: config.SkipSSLValidation(),
 		URL:               ccClient.UAA(),
 	})
@@ -76,7 +82,7 @@ func NewClients(config command.Config, ui command.UI, targetCF bool) (*ccv3.Clie
 	}
 
 	uaaClient.WrapConnection(uaaWrapper.NewUAAAuthentication(uaaClient, config))
-	uaaClient.WrapConnection(uaaWrapper.NewRetryRequest(2))
+	uaaClient.WrapConnection(uaaWrapper.NewRetryRequestWithPolicy(retrypolicy.Default()))
 
 	authWrapper.SetClient(uaaClient)
 
@@ -0,0 +1,99 @@
+package shared
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/cli/command"
+	"github.com/cloudfoundry/bytefmt"
+)
+
+// DefaultProgressInterval is how often the amount uploaded is displayed.
+const DefaultProgressInterval = time.Second
+
+// ProgressBar displays the amount of bits uploaded so far. It is used by the
+// actors to report upload progress.
+type ProgressBar struct {
+	UI       command.UI
+	Interval time.Duration
+
+	bytesRead int64
+	start     sync.Once
+	stop      chan struct{}
+	stopped   chan struct{}
+}
+
+// NewProgressBar returns a ProgressBar that displays to the given UI.
+func NewProgressBar(ui command.UI) *ProgressBar {
+	return &ProgressBar{
+		UI:       ui,
+		Interval: DefaultProgressInterval,
+		stop:     make(chan struct{}),
+		stopped:  make(chan struct{}),
+	}
+}
+
+// NewProgressBarWrapper returns a reader that updates the progress bar as it
+// is read. Rewinding the reader, as is done when an upload is retried, resets
+// the amount uploaded.
+func (bar *ProgressBar) NewProgressBarWrapper(reader io.ReadSeeker, size int64) io.ReadSeeker {
+	return &progressReader{bar: bar, reader: reader}
+}
+
+// Complete stops displaying progress and displays that the upload is done.
+func (bar *ProgressBar) Complete() {
+	bar.start.Do(func() { close(bar.stopped) })
+	select {
+	case <-bar.stop:
+	default:
+		close(bar.stop)
+	}
+	<-bar.stopped
+
+	// The spaces overwrite any progress that has been displayed.
+	fmt.Fprint(bar.UI.Writer(), "\r                             \r")
+	bar.UI.DisplayText("Done uploading")
+}
+
+func (bar *ProgressBar) display() {
+	defer close(bar.stopped)
+
+	ticker := time.NewTicker(bar.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-bar.stop:
+			return
+		case <-ticker.C:
+			uploaded := bytefmt.ByteSize(uint64(atomic.LoadInt64(&bar.bytesRead)))
+			fmt.Fprintf(bar.UI.Writer(), "\r%s", bar.UI.TranslateText("{{.Size}} uploaded...", map[string]interface{}{
+				"Size": uploaded,
+			}))
+		}
+	}
+}
+
+type progressReader struct {
+	bar    *ProgressBar
+	reader io.ReadSeeker
+}
+
+func (reader *progressReader) Read(p []byte) (int, error) {
+	reader.bar.start.Do(func() { go reader.bar.display() })
+
+	n, err := reader.reader.Read(p)
+	atomic.AddInt64(&reader.bar.bytesRead, int64(n))
+	return n, err
+}
+
+func (reader *progressReader) Seek(offset int64, whence int) (int64, error) {
+	position, err := reader.reader.Seek(offset, whence)
+	if err == nil {
+		atomic.StoreInt64(&reader.bar.bytesRead, position)
+	}
+	return position, err
+}
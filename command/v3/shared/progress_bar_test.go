@@ -0,0 +1,50 @@
+package shared_test
+
+import (
+	"io/ioutil"
+	"strings"
+	"time"
+
+	. "code.cloudfoundry.org/cli/command/v3/shared"
+	"code.cloudfoundry.org/cli/util/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("ProgressBar", func() {
+	var (
+		testUI *ui.UI
+		bar    *ProgressBar
+	)
+
+	BeforeEach(func() {
+		testUI = ui.NewTestUI(nil, NewBuffer(), NewBuffer())
+		bar = NewProgressBar(testUI)
+		bar.Interval = time.Millisecond
+	})
+
+	It("passes the bits through the wrapper", func() {
+		reader := bar.NewProgressBarWrapper(strings.NewReader("some-bits"), 9)
+		raw, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(raw)).To(Equal("some-bits"))
+		bar.Complete()
+	})
+
+	It("displays the amount uploaded while the wrapper is read", func() {
+		reader := bar.NewProgressBarWrapper(strings.NewReader(strings.Repeat("A", 2048)), 2048)
+		_, err := ioutil.ReadAll(reader)
+		Expect(err).ToNot(HaveOccurred())
+
+		Eventually(testUI.Out).Should(Say(`\r2K uploaded\.\.\.`))
+		bar.Complete()
+		Eventually(testUI.Out).Should(Say("Done uploading"))
+	})
+
+	It("displays done uploading when nothing was read", func() {
+		bar.Complete()
+		Expect(testUI.Out).To(Say("Done uploading"))
+	})
+})
@@ -11,7 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/command/v3/shared"
 )
 
-//These constants are only for filling in translations.
+// These constants are only for filling in translations.
 const (
 	runningState   = "RUNNING"
 	cancelingState = "CANCELING"
@@ -24,12 +24,23 @@ const (
 type TasksActor interface {
 	GetApplicationByNameAndSpace(appName string, spaceGUID string) (v3action.Application, v3action.Warnings, error)
 	GetApplicationTasks(appGUID string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	GetApplicationTasksByName(appGUID string, name string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
 	CloudControllerAPIVersion() string
 }
 
+// taskData is the structured output of the tasks command.
+type taskData struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	State     string `json:"state"`
+	CreatedAt string `json:"created_at"`
+	Command   string `json:"command,omitempty"`
+}
+
 type TasksCommand struct {
 	RequiredArgs    flag.AppName `positional-args:"yes"`
-	usage           interface{}  `usage:"CF_NAME tasks APP_NAME"`
+	Template        string       `long:"template" description:"Only show the tasks run from the task template with this name"`
+	usage           interface{}  `usage:"CF_NAME tasks APP_NAME [--template TEMPLATE_NAME]"`
 	relatedCommands interface{}  `related_commands:"apps, logs, run-task, terminate-task"`
 
 	UI          command.UI
@@ -52,6 +63,10 @@ func (cmd *TasksCommand) Setup(config command.Config, ui command.UI) error {
 	return nil
 }
 
+func (cmd TasksCommand) StructuredOutputSupported() bool {
+	return true
+}
+
 func (cmd TasksCommand) Execute(args []string) error {
 	err := command.MinimumAPIVersionCheck(cmd.Actor.CloudControllerAPIVersion(), "3.0.0")
 	if err != nil {
@@ -83,7 +98,12 @@ func (cmd TasksCommand) Execute(args []string) error {
 		"CurrentUser": user.Name,
 	})
 
-	tasks, warnings, err := cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
+	var tasks []v3action.Task
+	if cmd.Template != "" {
+		tasks, warnings, err = cmd.Actor.GetApplicationTasksByName(application.GUID, cmd.Template, v3action.Descending)
+	} else {
+		tasks, warnings, err = cmd.Actor.GetApplicationTasks(application.GUID, v3action.Descending)
+	}
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
@@ -92,6 +112,20 @@ func (cmd TasksCommand) Execute(args []string) error {
 	cmd.UI.DisplayOK()
 	cmd.UI.DisplayNewline()
 
+	if cmd.UI.StructuredOutputEnabled() {
+		data := []taskData{}
+		for _, task := range tasks {
+			data = append(data, taskData{
+				ID:        task.SequenceID,
+				Name:      task.Name,
+				State:     task.State,
+				CreatedAt: task.CreatedAt,
+				Command:   task.Command,
+			})
+		}
+		return cmd.UI.DisplayStructuredData("tasks", data)
+	}
+
 	table := [][]string{
 		{
 			cmd.UI.TranslateText("id"),
@@ -179,6 +179,31 @@ get-application-warning-2
 get-tasks-warning-1`))
 				})
 
+				Context("when structured output is enabled", func() {
+					BeforeEach(func() {
+						testUI.OutputFormat = ui.OutputJSON
+					})
+
+					It("outputs the tasks as a versioned document and the progress text to stderr", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(testUI.Out).To(Say(`{
+  "schema_version": 1,
+  "kind": "tasks",
+  "data": \[
+    {
+      "id": 3,
+      "name": "task-3",
+      "state": "RUNNING",
+      "created_at": "2016-11-08T22:26:02Z",
+      "command": "some-command"
+    },`))
+						Expect(testUI.Out).To(Say(`"id": 1,`))
+						Expect(testUI.Out).NotTo(Say("Getting tasks"))
+						Expect(testUI.Err).To(Say("Getting tasks for app some-app-name"))
+					})
+				})
+
 				Context("when the tasks' command fields are returned as empty strings", func() {
 					BeforeEach(func() {
 						fakeActor.GetApplicationTasksReturns(
@@ -229,6 +254,38 @@ id   name   state   start time   command
 						Expect(testUI.Out).NotTo(Say("1"))
 					})
 				})
+
+				Context("when --template is provided", func() {
+					BeforeEach(func() {
+						cmd.Template = "migrate"
+						fakeActor.GetApplicationTasksByNameReturns(
+							[]v3action.Task{
+								{
+									SequenceID: 7,
+									Name:       "migrate",
+									State:      "FAILED",
+									CreatedAt:  "2016-11-08T22:26:02Z",
+									Command:    "rake db:migrate",
+								},
+							},
+							v3action.Warnings{"get-tasks-warning"},
+							nil)
+					})
+
+					It("outputs the tasks run from the template", func() {
+						Expect(executeErr).ToNot(HaveOccurred())
+
+						Expect(fakeActor.GetApplicationTasksCallCount()).To(Equal(0))
+						Expect(fakeActor.GetApplicationTasksByNameCallCount()).To(Equal(1))
+						guid, name, order := fakeActor.GetApplicationTasksByNameArgsForCall(0)
+						Expect(guid).To(Equal("some-app-guid"))
+						Expect(name).To(Equal("migrate"))
+						Expect(order).To(Equal(v3action.Descending))
+
+						Expect(testUI.Out).To(Say("7\\s+migrate\\s+FAILED\\s+Tue, 08 Nov 2016 22:26:02 UTC\\s+rake db:migrate"))
+						Expect(testUI.Err).To(Say("get-tasks-warning"))
+					})
+				})
 			})
 
 			Context("when there are errors", func() {
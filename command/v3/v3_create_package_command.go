@@ -12,7 +12,7 @@ import (
 //go:generate counterfeiter . V3CreatePackageActor
 
 type V3CreatePackageActor interface {
-	CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, progressBar v3action.ProgressBar) (v3action.Package, v3action.Warnings, error)
 }
 
 type V3CreatePackageCommand struct {
@@ -23,6 +23,7 @@ type V3CreatePackageCommand struct {
 	Config      command.Config
 	SharedActor command.SharedActor
 	Actor       V3CreatePackageActor
+	ProgressBar v3action.ProgressBar
 }
 
 func (cmd *V3CreatePackageCommand) Setup(config command.Config, ui command.UI) error {
@@ -35,6 +36,7 @@ func (cmd *V3CreatePackageCommand) Setup(config command.Config, ui command.UI) e
 		return err
 	}
 	cmd.Actor = v3action.NewActor(client, config)
+	cmd.ProgressBar = shared.NewProgressBar(ui)
 
 	return nil
 }
@@ -65,7 +67,7 @@ func (cmd V3CreatePackageCommand) Execute(args []string) error {
 		return shared.HandleError(err)
 	}
 
-	_, warnings, err := cmd.Actor.CreateAndUploadPackageByApplicationNameAndSpace(cmd.AppName, cmd.Config.TargetedSpace().GUID, pwd)
+	_, warnings, err := cmd.Actor.CreateAndUploadPackageByApplicationNameAndSpace(cmd.AppName, cmd.Config.TargetedSpace().GUID, pwd, cmd.ProgressBar)
 	cmd.UI.DisplayWarnings(warnings)
 	if err != nil {
 		return shared.HandleError(err)
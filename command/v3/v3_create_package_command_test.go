@@ -6,6 +6,7 @@ import (
 
 	"code.cloudfoundry.org/cli/actor/sharedaction"
 	"code.cloudfoundry.org/cli/actor/v3action"
+	"code.cloudfoundry.org/cli/actor/v3action/v3actionfakes"
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/commandfakes"
 	"code.cloudfoundry.org/cli/command/v3"
@@ -24,6 +25,7 @@ var _ = Describe("v3-create-package Command", func() {
 		fakeConfig      *commandfakes.FakeConfig
 		fakeSharedActor *commandfakes.FakeSharedActor
 		fakeActor       *v3fakes.FakeV3CreatePackageActor
+		fakeProgressBar *v3actionfakes.FakeProgressBar
 		binaryName      string
 		executeErr      error
 		app             string
@@ -35,12 +37,14 @@ var _ = Describe("v3-create-package Command", func() {
 		fakeConfig = new(commandfakes.FakeConfig)
 		fakeSharedActor = new(commandfakes.FakeSharedActor)
 		fakeActor = new(v3fakes.FakeV3CreatePackageActor)
+		fakeProgressBar = new(v3actionfakes.FakeProgressBar)
 
 		cmd = v3.V3CreatePackageCommand{
 			UI:          testUI,
 			Config:      fakeConfig,
 			SharedActor: fakeSharedActor,
 			Actor:       fakeActor,
+			ProgressBar: fakeProgressBar,
 		}
 
 		binaryName = "faceman"
@@ -96,10 +100,11 @@ var _ = Describe("v3-create-package Command", func() {
 
 				Expect(fakeActor.CreateAndUploadPackageByApplicationNameAndSpaceCallCount()).To(Equal(1))
 
-				appName, spaceGUID, bitsPath := fakeActor.CreateAndUploadPackageByApplicationNameAndSpaceArgsForCall(0)
+				appName, spaceGUID, bitsPath, progressBar := fakeActor.CreateAndUploadPackageByApplicationNameAndSpaceArgsForCall(0)
 				Expect(appName).To(Equal(app))
 				Expect(spaceGUID).To(Equal("some-space-guid"))
 				Expect(bitsPath).To(Equal(path))
+				Expect(progressBar).To(Equal(fakeProgressBar))
 			})
 		})
 
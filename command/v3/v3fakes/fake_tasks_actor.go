@@ -41,6 +41,23 @@ type FakeTasksActor struct {
 		result2 v3action.Warnings
 		result3 error
 	}
+	GetApplicationTasksByNameStub        func(appGUID string, name string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error)
+	getApplicationTasksByNameMutex       sync.RWMutex
+	getApplicationTasksByNameArgsForCall []struct {
+		appGUID   string
+		name      string
+		sortOrder v3action.SortOrder
+	}
+	getApplicationTasksByNameReturns struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
+	getApplicationTasksByNameReturnsOnCall map[int]struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}
 	CloudControllerAPIVersionStub        func() string
 	cloudControllerAPIVersionMutex       sync.RWMutex
 	cloudControllerAPIVersionArgsForCall []struct{}
@@ -164,6 +181,62 @@ func (fake *FakeTasksActor) GetApplicationTasksReturnsOnCall(i int, result1 []v3
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTasksActor) GetApplicationTasksByName(appGUID string, name string, sortOrder v3action.SortOrder) ([]v3action.Task, v3action.Warnings, error) {
+	fake.getApplicationTasksByNameMutex.Lock()
+	ret, specificReturn := fake.getApplicationTasksByNameReturnsOnCall[len(fake.getApplicationTasksByNameArgsForCall)]
+	fake.getApplicationTasksByNameArgsForCall = append(fake.getApplicationTasksByNameArgsForCall, struct {
+		appGUID   string
+		name      string
+		sortOrder v3action.SortOrder
+	}{appGUID, name, sortOrder})
+	fake.recordInvocation("GetApplicationTasksByName", []interface{}{appGUID, name, sortOrder})
+	fake.getApplicationTasksByNameMutex.Unlock()
+	if fake.GetApplicationTasksByNameStub != nil {
+		return fake.GetApplicationTasksByNameStub(appGUID, name, sortOrder)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fake.getApplicationTasksByNameReturns.result1, fake.getApplicationTasksByNameReturns.result2, fake.getApplicationTasksByNameReturns.result3
+}
+
+func (fake *FakeTasksActor) GetApplicationTasksByNameCallCount() int {
+	fake.getApplicationTasksByNameMutex.RLock()
+	defer fake.getApplicationTasksByNameMutex.RUnlock()
+	return len(fake.getApplicationTasksByNameArgsForCall)
+}
+
+func (fake *FakeTasksActor) GetApplicationTasksByNameArgsForCall(i int) (string, string, v3action.SortOrder) {
+	fake.getApplicationTasksByNameMutex.RLock()
+	defer fake.getApplicationTasksByNameMutex.RUnlock()
+	return fake.getApplicationTasksByNameArgsForCall[i].appGUID, fake.getApplicationTasksByNameArgsForCall[i].name, fake.getApplicationTasksByNameArgsForCall[i].sortOrder
+}
+
+func (fake *FakeTasksActor) GetApplicationTasksByNameReturns(result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksByNameStub = nil
+	fake.getApplicationTasksByNameReturns = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTasksActor) GetApplicationTasksByNameReturnsOnCall(i int, result1 []v3action.Task, result2 v3action.Warnings, result3 error) {
+	fake.GetApplicationTasksByNameStub = nil
+	if fake.getApplicationTasksByNameReturnsOnCall == nil {
+		fake.getApplicationTasksByNameReturnsOnCall = make(map[int]struct {
+			result1 []v3action.Task
+			result2 v3action.Warnings
+			result3 error
+		})
+	}
+	fake.getApplicationTasksByNameReturnsOnCall[i] = struct {
+		result1 []v3action.Task
+		result2 v3action.Warnings
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTasksActor) CloudControllerAPIVersion() string {
 	fake.cloudControllerAPIVersionMutex.Lock()
 	ret, specificReturn := fake.cloudControllerAPIVersionReturnsOnCall[len(fake.cloudControllerAPIVersionArgsForCall)]
@@ -211,6 +284,8 @@ func (fake *FakeTasksActor) Invocations() map[string][][]interface{} {
 	defer fake.getApplicationByNameAndSpaceMutex.RUnlock()
 	fake.getApplicationTasksMutex.RLock()
 	defer fake.getApplicationTasksMutex.RUnlock()
+	fake.getApplicationTasksByNameMutex.RLock()
+	defer fake.getApplicationTasksByNameMutex.RUnlock()
 	fake.cloudControllerAPIVersionMutex.RLock()
 	defer fake.cloudControllerAPIVersionMutex.RUnlock()
 	return fake.invocations
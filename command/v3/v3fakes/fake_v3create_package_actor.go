@@ -9,12 +9,13 @@ import (
 )
 
 type FakeV3CreatePackageActor struct {
-	CreateAndUploadPackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error)
+	CreateAndUploadPackageByApplicationNameAndSpaceStub        func(appName string, spaceGUID string, bitsPath string, progressBar v3action.ProgressBar) (v3action.Package, v3action.Warnings, error)
 	createAndUploadPackageByApplicationNameAndSpaceMutex       sync.RWMutex
 	createAndUploadPackageByApplicationNameAndSpaceArgsForCall []struct {
-		appName   string
-		spaceGUID string
-		bitsPath  string
+		appName     string
+		spaceGUID   string
+		bitsPath    string
+		progressBar v3action.ProgressBar
 	}
 	createAndUploadPackageByApplicationNameAndSpaceReturns struct {
 		result1 v3action.Package
@@ -30,18 +31,19 @@ type FakeV3CreatePackageActor struct {
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeV3CreatePackageActor) CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string) (v3action.Package, v3action.Warnings, error) {
+func (fake *FakeV3CreatePackageActor) CreateAndUploadPackageByApplicationNameAndSpace(appName string, spaceGUID string, bitsPath string, progressBar v3action.ProgressBar) (v3action.Package, v3action.Warnings, error) {
 	fake.createAndUploadPackageByApplicationNameAndSpaceMutex.Lock()
 	ret, specificReturn := fake.createAndUploadPackageByApplicationNameAndSpaceReturnsOnCall[len(fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall)]
 	fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall = append(fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall, struct {
-		appName   string
-		spaceGUID string
-		bitsPath  string
-	}{appName, spaceGUID, bitsPath})
-	fake.recordInvocation("CreateAndUploadPackageByApplicationNameAndSpace", []interface{}{appName, spaceGUID, bitsPath})
+		appName     string
+		spaceGUID   string
+		bitsPath    string
+		progressBar v3action.ProgressBar
+	}{appName, spaceGUID, bitsPath, progressBar})
+	fake.recordInvocation("CreateAndUploadPackageByApplicationNameAndSpace", []interface{}{appName, spaceGUID, bitsPath, progressBar})
 	fake.createAndUploadPackageByApplicationNameAndSpaceMutex.Unlock()
 	if fake.CreateAndUploadPackageByApplicationNameAndSpaceStub != nil {
-		return fake.CreateAndUploadPackageByApplicationNameAndSpaceStub(appName, spaceGUID, bitsPath)
+		return fake.CreateAndUploadPackageByApplicationNameAndSpaceStub(appName, spaceGUID, bitsPath, progressBar)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -55,10 +57,10 @@ func (fake *FakeV3CreatePackageActor) CreateAndUploadPackageByApplicationNameAnd
 	return len(fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall)
 }
 
-func (fake *FakeV3CreatePackageActor) CreateAndUploadPackageByApplicationNameAndSpaceArgsForCall(i int) (string, string, string) {
+func (fake *FakeV3CreatePackageActor) CreateAndUploadPackageByApplicationNameAndSpaceArgsForCall(i int) (string, string, string, v3action.ProgressBar) {
 	fake.createAndUploadPackageByApplicationNameAndSpaceMutex.RLock()
 	defer fake.createAndUploadPackageByApplicationNameAndSpaceMutex.RUnlock()
-	return fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].appName, fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].bitsPath
+	return fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].appName, fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].spaceGUID, fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].bitsPath, fake.createAndUploadPackageByApplicationNameAndSpaceArgsForCall[i].progressBar
 }
 
 func (fake *FakeV3CreatePackageActor) CreateAndUploadPackageByApplicationNameAndSpaceReturns(result1 v3action.Package, result2 v3action.Warnings, result3 error) {
@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/cli/command"
 	"code.cloudfoundry.org/cli/command/common"
 	"code.cloudfoundry.org/cli/command/v2"
+	"code.cloudfoundry.org/cli/command/v2/shared"
 	"code.cloudfoundry.org/cli/util/configv3"
 	"code.cloudfoundry.org/cli/util/panichandler"
 	"code.cloudfoundry.org/cli/util/ui"
@@ -136,7 +137,9 @@ func isOption(s string) bool {
 
 func executionWrapper(cmd flags.Commander, args []string) error {
 	cfConfig, err := configv3.LoadConfig(configv3.FlagOverride{
-		Verbose: common.Commands.VerboseOrVersion,
+		Context:      common.Commands.Context,
+		OutputFormat: common.Commands.Output.Format,
+		Verbose:      common.Commands.VerboseOrVersion,
 	})
 	if err != nil {
 		return err
@@ -158,6 +161,15 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 		log.SetOutput(os.Stderr)
 		log.SetLevel(log.Level(cfConfig.LogLevel()))
 
+		err = cfConfig.UseContextOverride()
+		if err != nil {
+			return handleError(shared.HandleError(err), commandUI)
+		}
+
+		if cfConfig.OutputFormat() != "" && !structuredOutputSupported(cmd) {
+			return handleError(command.UnsupportedFlagError{Flag: "--output"}, commandUI)
+		}
+
 		err = extendedCmd.Setup(cfConfig, commandUI)
 		if err != nil {
 			return handleError(err, commandUI)
@@ -168,6 +180,13 @@ func executionWrapper(cmd flags.Commander, args []string) error {
 	return fmt.Errorf("command does not conform to ExtendedCommander")
 }
 
+// structuredOutputSupported returns true when the command can display its
+// data in the format given by the --output flag.
+func structuredOutputSupported(cmd flags.Commander) bool {
+	structuredCmd, ok := cmd.(command.StructuredOutputCommander)
+	return ok && structuredCmd.StructuredOutputSupported()
+}
+
 func handleError(err error, commandUI UI) error {
 	if err == nil {
 		return nil
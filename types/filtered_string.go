@@ -0,0 +1,68 @@
+package types
+
+import "encoding/json"
+
+// FilteredString is a wrapper around string properties that have special
+// values: "default" and "null" both reset the property to its default value,
+// which is represented by an empty string.
+type FilteredString struct {
+	IsSet bool
+	Value string
+}
+
+// ParseValue is used to parse a user provided flag argument or manifest
+// value.
+func (n *FilteredString) ParseValue(val string) {
+	if val == "" {
+		return
+	}
+
+	n.IsSet = true
+
+	switch val {
+	case "null", "default":
+		n.Value = ""
+	default:
+		n.Value = val
+	}
+}
+
+// String returns the value of the FilteredString.
+func (n FilteredString) String() string {
+	return n.Value
+}
+
+// IsDefault returns true if the value is set and refers to the default
+// value.
+func (n FilteredString) IsDefault() bool {
+	return n.IsSet && n.Value == ""
+}
+
+// MarshalJSON marshals the value to a JSON string. An unset or default value
+// is marshalled to null.
+func (n FilteredString) MarshalJSON() ([]byte, error) {
+	if n.Value != "" {
+		return json.Marshal(n.Value)
+	}
+
+	return json.Marshal(new(json.RawMessage))
+}
+
+// UnmarshalJSON unmarshals a JSON string or null.
+func (n *FilteredString) UnmarshalJSON(rawJSON []byte) error {
+	var value *string
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		n.IsSet = false
+		n.Value = ""
+		return nil
+	}
+
+	n.IsSet = true
+	n.Value = *value
+	return nil
+}
@@ -0,0 +1,92 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("FilteredString", func() {
+	var filteredString FilteredString
+
+	BeforeEach(func() {
+		filteredString = FilteredString{}
+	})
+
+	Describe("ParseValue", func() {
+		Context("when the value is empty", func() {
+			It("does not set the value", func() {
+				filteredString.ParseValue("")
+				Expect(filteredString).To(Equal(FilteredString{}))
+			})
+		})
+
+		Context("when the value is 'default'", func() {
+			It("sets the value to the default", func() {
+				filteredString.ParseValue("default")
+				Expect(filteredString).To(Equal(FilteredString{IsSet: true}))
+				Expect(filteredString.IsDefault()).To(BeTrue())
+			})
+		})
+
+		Context("when the value is 'null'", func() {
+			It("sets the value to the default", func() {
+				filteredString.ParseValue("null")
+				Expect(filteredString).To(Equal(FilteredString{IsSet: true}))
+				Expect(filteredString.IsDefault()).To(BeTrue())
+			})
+		})
+
+		Context("when the value is anything else", func() {
+			It("sets the value", func() {
+				filteredString.ParseValue("some-value")
+				Expect(filteredString).To(Equal(FilteredString{IsSet: true, Value: "some-value"}))
+				Expect(filteredString.IsDefault()).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("MarshalJSON", func() {
+		Context("when the value is unset", func() {
+			It("marshals to null", func() {
+				raw, err := filteredString.MarshalJSON()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("null"))
+			})
+		})
+
+		Context("when the value is the default", func() {
+			It("marshals to null", func() {
+				filteredString.ParseValue("default")
+				raw, err := filteredString.MarshalJSON()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("null"))
+			})
+		})
+
+		Context("when the value is set", func() {
+			It("marshals to the string", func() {
+				filteredString.ParseValue("some-value")
+				raw, err := filteredString.MarshalJSON()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal(`"some-value"`))
+			})
+		})
+	})
+
+	Describe("UnmarshalJSON", func() {
+		Context("when the value is null", func() {
+			It("is unset", func() {
+				Expect(filteredString.UnmarshalJSON([]byte("null"))).To(Succeed())
+				Expect(filteredString).To(Equal(FilteredString{}))
+			})
+		})
+
+		Context("when the value is a string", func() {
+			It("is set to the string", func() {
+				Expect(filteredString.UnmarshalJSON([]byte(`"some-value"`))).To(Succeed())
+				Expect(filteredString).To(Equal(FilteredString{IsSet: true, Value: "some-value"}))
+			})
+		})
+	})
+})
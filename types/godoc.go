@@ -0,0 +1,3 @@
+// Package types contains value types that distinguish between a value that
+// was not provided and its zero value.
+package types
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"encoding/json"
+	"strconv"
+)
+
+// NullInt is a wrapper around integer values that can be null or an integer.
+// Use IsSet to check if the value is provided, instead of checking against 0.
+type NullInt struct {
+	IsSet bool
+	Value int
+}
+
+// ParseStringValue is used to parse a user provided flag argument.
+func (n *NullInt) ParseStringValue(val string) error {
+	if val == "" {
+		return nil
+	}
+
+	intVal, err := strconv.Atoi(val)
+	if err != nil {
+		return err
+	}
+
+	n.Value = intVal
+	n.IsSet = true
+
+	return nil
+}
+
+// MarshalJSON marshals the value to a JSON integer, or null when unset.
+func (n NullInt) MarshalJSON() ([]byte, error) {
+	if n.IsSet {
+		return json.Marshal(n.Value)
+	}
+
+	return json.Marshal(new(json.RawMessage))
+}
+
+// UnmarshalJSON unmarshals a JSON integer or null.
+func (n *NullInt) UnmarshalJSON(rawJSON []byte) error {
+	var value *int
+	err := json.Unmarshal(rawJSON, &value)
+	if err != nil {
+		return err
+	}
+
+	if value == nil {
+		n.Value = 0
+		n.IsSet = false
+		return nil
+	}
+
+	n.Value = *value
+	n.IsSet = true
+	return nil
+}
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	. "code.cloudfoundry.org/cli/types"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NullInt", func() {
+	var nullInt NullInt
+
+	BeforeEach(func() {
+		nullInt = NullInt{}
+	})
+
+	Describe("ParseStringValue", func() {
+		Context("when the value is empty", func() {
+			It("does not set the value", func() {
+				Expect(nullInt.ParseStringValue("")).To(Succeed())
+				Expect(nullInt).To(Equal(NullInt{}))
+			})
+		})
+
+		Context("when the value is an integer", func() {
+			It("sets the value, including zero", func() {
+				Expect(nullInt.ParseStringValue("0")).To(Succeed())
+				Expect(nullInt).To(Equal(NullInt{IsSet: true, Value: 0}))
+			})
+		})
+
+		Context("when the value is not an integer", func() {
+			It("returns an error", func() {
+				Expect(nullInt.ParseStringValue("abc")).ToNot(Succeed())
+				Expect(nullInt).To(Equal(NullInt{}))
+			})
+		})
+	})
+
+	Describe("MarshalJSON", func() {
+		Context("when the value is unset", func() {
+			It("marshals to null", func() {
+				raw, err := nullInt.MarshalJSON()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("null"))
+			})
+		})
+
+		Context("when the value is set to zero", func() {
+			It("marshals to 0", func() {
+				raw, err := NullInt{IsSet: true}.MarshalJSON()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(raw)).To(Equal("0"))
+			})
+		})
+	})
+
+	Describe("UnmarshalJSON", func() {
+		Context("when the value is null", func() {
+			It("is unset", func() {
+				Expect(nullInt.UnmarshalJSON([]byte("null"))).To(Succeed())
+				Expect(nullInt).To(Equal(NullInt{}))
+			})
+		})
+
+		Context("when the value is an integer", func() {
+			It("is set", func() {
+				Expect(nullInt.UnmarshalJSON([]byte("5"))).To(Succeed())
+				Expect(nullInt).To(Equal(NullInt{IsSet: true, Value: 5}))
+			})
+		})
+	})
+})
@@ -90,6 +90,11 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 			return nil, err
 		}
 
+		config.loadedTokens = tokens{
+			accessToken:  config.ConfigFile.AccessToken,
+			refreshToken: config.ConfigFile.RefreshToken,
+		}
+
 		if config.ConfigFile.UAAOAuthClient == "" {
 			config.ConfigFile.UAAOAuthClient = DefaultUAAOAuthClient
 			config.ConfigFile.UAAOAuthClientSecret = DefaultUAAOAuthClientSecret
@@ -98,6 +103,7 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 
 	config.ENV = EnvOverride{
 		BinaryName:       filepath.Base(os.Args[0]),
+		CFCacheTTL:       os.Getenv("CF_CACHE_TTL"),
 		CFColor:          os.Getenv("CF_COLOR"),
 		CFPluginHome:     os.Getenv("CF_PLUGIN_HOME"),
 		CFStagingTimeout: os.Getenv("CF_STAGING_TIMEOUT"),
@@ -159,18 +165,148 @@ func LoadConfig(flags ...FlagOverride) (*Config, error) {
 // WriteConfig creates the .cf directory and then writes the config.json. The
 // location of .cf directory is written in the same way LoadConfig reads .cf
 // directory.
+//
+// The config.json is written atomically while holding a lock shared with
+// other CLI processes. If another process refreshed the tokens since this
+// config was loaded, and this config's tokens have not changed, the newer
+// tokens are kept.
 func WriteConfig(c *Config) error {
-	rawConfig, err := json.MarshalIndent(c.ConfigFile, "", "  ")
+	err := os.MkdirAll(filepath.Join(homeDirectory(), ".cf"), 0700)
+	if err != nil {
+		return err
+	}
+
+	filePath := ConfigFilePath()
+	return withFileLock(filePath, func() error {
+		c.keepNewerTokens(filePath)
+
+		rawConfig, err := json.MarshalIndent(c.persistedConfig(), "", "  ")
+		if err != nil {
+			return err
+		}
+
+		return writeFileAtomically(filePath, rawConfig, 0600)
+	})
+}
+
+// LockTokens runs refresh while holding the lock on the .cf/config.json that
+// is shared with other CLI processes. Tokens that another process refreshed
+// are loaded before refresh runs, so that refresh can reuse them, and the
+// tokens are stored in the config.json afterwards, so that other processes
+// can reuse them in turn. When a context is applied with --context, the
+// tokens saved in that context are used instead.
+func (config *Config) LockTokens(refresh func() error) error {
+	filePath := ConfigFilePath()
+	if _, err := os.Stat(filePath); err != nil {
+		return refresh()
+	}
+
+	return withFileLock(filePath, func() error {
+		config.keepNewerTokens(filePath)
+
+		err := refresh()
+		if err != nil {
+			return err
+		}
+
+		return config.storeTokens(filePath)
+	})
+}
+
+// keepNewerTokens replaces the tokens in the config with the tokens in the
+// config file at filePath when this config's tokens are the ones it was
+// loaded with.
+func (config *Config) keepNewerTokens(filePath string) {
+	if config.ConfigFile.AccessToken != config.loadedTokens.accessToken ||
+		config.ConfigFile.RefreshToken != config.loadedTokens.refreshToken {
+		return
+	}
+
+	file, err := ioutil.ReadFile(filePath)
+	if err != nil {
+		return
+	}
+
+	var current CFConfig
+	if err := json.Unmarshal(file, &current); err != nil {
+		return
+	}
+
+	target, currentTokens, ok := config.fileTokens(current)
+	if ok && target == config.ConfigFile.Target {
+		config.ConfigFile.AccessToken = currentTokens.accessToken
+		config.ConfigFile.RefreshToken = currentTokens.refreshToken
+		config.loadedTokens = currentTokens
+	}
+}
+
+// storeTokens writes this config's tokens to the config file at filePath,
+// leaving the rest of the file as it is, when they have changed since they
+// were loaded.
+func (config *Config) storeTokens(filePath string) error {
+	if config.ConfigFile.AccessToken == config.loadedTokens.accessToken &&
+		config.ConfigFile.RefreshToken == config.loadedTokens.refreshToken {
+		return nil
+	}
+
+	file, err := ioutil.ReadFile(filePath)
 	if err != nil {
 		return err
 	}
 
-	err = os.MkdirAll(filepath.Join(homeDirectory(), ".cf"), 0700)
+	var current CFConfig
+	err = json.Unmarshal(file, &current)
 	if err != nil {
 		return err
 	}
 
-	return ioutil.WriteFile(ConfigFilePath(), rawConfig, 0600)
+	target, _, ok := config.fileTokens(current)
+	if !ok || target != config.ConfigFile.Target {
+		return nil
+	}
+
+	if config.contextOverride != "" {
+		context := current.Contexts[config.contextOverride]
+		context.AccessToken = config.ConfigFile.AccessToken
+		context.RefreshToken = config.ConfigFile.RefreshToken
+		current.Contexts[config.contextOverride] = context
+	} else {
+		current.AccessToken = config.ConfigFile.AccessToken
+		current.RefreshToken = config.ConfigFile.RefreshToken
+	}
+	rawConfig, err := json.MarshalIndent(current, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	err = writeFileAtomically(filePath, rawConfig, 0600)
+	if err != nil {
+		return err
+	}
+
+	config.loadedTokens = tokens{
+		accessToken:  config.ConfigFile.AccessToken,
+		refreshToken: config.ConfigFile.RefreshToken,
+	}
+	return nil
+}
+
+// fileTokens returns the target and tokens in file that this config uses:
+// those of the context applied with --context, or the current ones. It
+// returns false when that context is no longer in file.
+func (config *Config) fileTokens(file CFConfig) (string, tokens, bool) {
+	if config.contextOverride != "" {
+		context, ok := file.Contexts[config.contextOverride]
+		return context.Target, tokens{
+			accessToken:  context.AccessToken,
+			refreshToken: context.RefreshToken,
+		}, ok
+	}
+
+	return file.Target, tokens{
+		accessToken:  file.AccessToken,
+		refreshToken: file.RefreshToken,
+	}, true
 }
 
 // Config combines the settings taken from the .cf/config.json, os.ENV, and the
@@ -188,33 +324,44 @@ type Config struct {
 	// detectedSettings are settings detected when the config is loaded.
 	detectedSettings detectedSettings
 
+	// loadedTokens are the tokens read from the .cf/config.json.
+	loadedTokens tokens
+
+	// contextOverride is the name of the context applied with the --context
+	// global flag, and overriddenContext holds the settings it replaced.
+	contextOverride   string
+	overriddenContext NamedContext
+
 	pluginsConfig PluginsConfig
 }
 
 // CFConfig represents .cf/config.json
 type CFConfig struct {
-	ConfigVersion            int                `json:"ConfigVersion"`
-	Target                   string             `json:"Target"`
-	APIVersion               string             `json:"APIVersion"`
-	AuthorizationEndpoint    string             `json:"AuthorizationEndpoint"`
-	DopplerEndpoint          string             `json:"DopplerEndPoint"`
-	UAAEndpoint              string             `json:"UaaEndpoint"`
-	RoutingEndpoint          string             `json:"RoutingAPIEndpoint"`
-	AccessToken              string             `json:"AccessToken"`
-	SSHOAuthClient           string             `json:"SSHOAuthClient"`
-	UAAOAuthClient           string             `json:"UAAOAuthClient"`
-	UAAOAuthClientSecret     string             `json:"UAAOAuthClientSecret"`
-	RefreshToken             string             `json:"RefreshToken"`
-	TargetedOrganization     Organization       `json:"OrganizationFields"`
-	TargetedSpace            Space              `json:"SpaceFields"`
-	SkipSSLValidation        bool               `json:"SSLDisabled"`
-	AsyncTimeout             int                `json:"AsyncTimeout"`
-	Trace                    string             `json:"Trace"`
-	ColorEnabled             string             `json:"ColorEnabled"`
-	Locale                   string             `json:"Locale"`
-	PluginRepositories       []PluginRepository `json:"PluginRepos"`
-	MinCLIVersion            string             `json:"MinCLIVersion"`
-	MinRecommendedCLIVersion string             `json:"MinRecommendedCLIVersion"`
+	ConfigVersion            int                     `json:"ConfigVersion"`
+	Target                   string                  `json:"Target"`
+	APIVersion               string                  `json:"APIVersion"`
+	AuthorizationEndpoint    string                  `json:"AuthorizationEndpoint"`
+	DopplerEndpoint          string                  `json:"DopplerEndPoint"`
+	UAAEndpoint              string                  `json:"UaaEndpoint"`
+	RoutingEndpoint          string                  `json:"RoutingAPIEndpoint"`
+	AccessToken              string                  `json:"AccessToken"`
+	SSHOAuthClient           string                  `json:"SSHOAuthClient"`
+	UAAOAuthClient           string                  `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret     string                  `json:"UAAOAuthClientSecret"`
+	UAAGrantType             string                  `json:"UAAGrantType"`
+	RefreshToken             string                  `json:"RefreshToken"`
+	TargetedOrganization     Organization            `json:"OrganizationFields"`
+	TargetedSpace            Space                   `json:"SpaceFields"`
+	SkipSSLValidation        bool                    `json:"SSLDisabled"`
+	AsyncTimeout             int                     `json:"AsyncTimeout"`
+	Trace                    string                  `json:"Trace"`
+	ColorEnabled             string                  `json:"ColorEnabled"`
+	Locale                   string                  `json:"Locale"`
+	PluginRepositories       []PluginRepository      `json:"PluginRepos"`
+	MinCLIVersion            string                  `json:"MinCLIVersion"`
+	MinRecommendedCLIVersion string                  `json:"MinRecommendedCLIVersion"`
+	CurrentContext           string                  `json:"CurrentContext,omitempty"`
+	Contexts                 map[string]NamedContext `json:"Contexts,omitempty"`
 }
 
 // Organization contains basic information about the targeted organization
@@ -247,6 +394,7 @@ type Space struct {
 // EnvOverride represents all the environment variables read by the CF CLI
 type EnvOverride struct {
 	BinaryName       string
+	CFCacheTTL       string
 	CFColor          string
 	CFHome           string
 	CFPluginHome     string
@@ -264,7 +412,9 @@ type EnvOverride struct {
 
 // FlagOverride represents all the global flags passed to the CF CLI
 type FlagOverride struct {
-	Verbose bool
+	Context      string
+	OutputFormat string
+	Verbose      bool
 }
 
 // detectedSettings are automatically detected settings determined by the CLI.
@@ -273,6 +423,11 @@ type detectedSettings struct {
 	terminalWidth int
 }
 
+type tokens struct {
+	accessToken  string
+	refreshToken string
+}
+
 // Target returns the CC API URL
 func (config *Config) Target() string {
 	return config.ConfigFile.Target
@@ -320,6 +475,18 @@ func (config *Config) UAAOAuthClientSecret() string {
 	return config.ConfigFile.UAAOAuthClientSecret
 }
 
+// AuthorizationEndpoint returns the URL of the login server.
+func (config *Config) AuthorizationEndpoint() string {
+	return config.ConfigFile.AuthorizationEndpoint
+}
+
+// UAAGrantType returns the grant the current tokens were obtained with. It is
+// "client_credentials" when a service account is logged in, and empty
+// otherwise.
+func (config *Config) UAAGrantType() string {
+	return config.ConfigFile.UAAGrantType
+}
+
 // APIVersion returns the CC API Version
 func (config *Config) APIVersion() string {
 	return config.ConfigFile.APIVersion
@@ -486,6 +653,13 @@ func (config *Config) TerminalWidth() int {
 	return config.detectedSettings.terminalWidth
 }
 
+// OutputFormat returns the format, provided by the --output flag, that
+// command data should be displayed in. It is empty when data should be
+// displayed as tables.
+func (config *Config) OutputFormat() string {
+	return config.Flags.OutputFormat
+}
+
 // DialTimeout returns the timeout to use when dialing. This is based off of:
 //   1. The $CF_DIAL_TIMEOUT environment variable if set
 //   2. Defaults to 5 seconds
@@ -500,6 +674,33 @@ func (config *Config) DialTimeout() time.Duration {
 	return DefaultDialTimeout
 }
 
+// ResponseCacheTTL returns how long Cloud Controller responses are cached for.
+// This is based off of:
+//   1. The $CF_CACHE_TTL environment variable, in seconds, if set
+//   2. Defaults to 0, which disables the cache
+func (config *Config) ResponseCacheTTL() time.Duration {
+	if config.ENV.CFCacheTTL != "" {
+		envVal, err := strconv.ParseInt(config.ENV.CFCacheTTL, 10, 64)
+		if err == nil && envVal > 0 {
+			return time.Duration(envVal) * time.Second
+		}
+	}
+
+	return 0
+}
+
+// ResponseCacheDirectory returns the directory Cloud Controller responses are
+// cached in.
+func (config *Config) ResponseCacheDirectory() string {
+	return filepath.Join(homeDirectory(), ".cf", "cache")
+}
+
+// UploadStateDirectory returns the directory used to record the state of
+// package uploads, so that an interrupted upload can be resumed.
+func (config *Config) UploadStateDirectory() string {
+	return filepath.Join(homeDirectory(), ".cf", "uploads")
+}
+
 func (config *Config) BinaryVersion() string {
 	return version.VersionString()
 }
@@ -544,6 +745,18 @@ func (config *Config) SetTargetInformation(api string, apiVersion string, auth s
 	config.UnsetSpaceInformation()
 }
 
+// SetUAAClientCredentials sets the UAA client ID and secret used to request
+// tokens.
+func (config *Config) SetUAAClientCredentials(client string, clientSecret string) {
+	config.ConfigFile.UAAOAuthClient = client
+	config.ConfigFile.UAAOAuthClientSecret = clientSecret
+}
+
+// SetUAAGrantType sets the grant the current tokens were obtained with.
+func (config *Config) SetUAAGrantType(uaaGrantType string) {
+	config.ConfigFile.UAAGrantType = uaaGrantType
+}
+
 // SetTokenInformation sets the current token/user information
 func (config *Config) SetTokenInformation(accessToken string, refreshToken string, sshOAuthClient string) {
 	config.ConfigFile.AccessToken = accessToken
@@ -0,0 +1,60 @@
+package configv3
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// withFileLock runs fn while holding an exclusive lock on path. The lock is
+// taken on a separate path.lock file so that path itself can be replaced
+// while the lock is held. This prevents CLI processes that share a CF_HOME
+// from interleaving their writes.
+func withFileLock(path string, fn func() error) error {
+	lock, err := os.OpenFile(path+".lock", os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return err
+	}
+	defer lock.Close()
+
+	err = lockFile(lock)
+	if err != nil {
+		return err
+	}
+	defer unlockFile(lock)
+
+	return fn()
+}
+
+// writeFileAtomically writes data to a temporary file in the same directory
+// as path and renames it over path, so that readers never see a partially
+// written file.
+func writeFileAtomically(path string, data []byte, perm os.FileMode) error {
+	tmpFile, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path))
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmpFile.Name())
+
+	_, err = tmpFile.Write(data)
+	if err == nil {
+		err = tmpFile.Sync()
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	err = os.Chmod(tmpFile.Name(), perm)
+	if err != nil {
+		return err
+	}
+
+	err = os.Rename(tmpFile.Name(), path)
+	if linkErr, ok := err.(*os.LinkError); ok {
+		return &os.PathError{Op: "rename", Path: path, Err: linkErr.Err}
+	}
+	return err
+}
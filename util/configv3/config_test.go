@@ -2,10 +2,12 @@ package configv3_test
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	. "code.cloudfoundry.org/cli/util/configv3"
@@ -61,6 +63,7 @@ var _ = Describe("Config", func() {
 			Expect(config.SkipSSLValidation()).To(BeFalse())
 			Expect(config.ColorEnabled()).To(Equal(ColorEnabled))
 			Expect(config.PluginHome()).To(Equal(filepath.Join(homeDir, ".cf", "plugins")))
+			Expect(config.UploadStateDirectory()).To(Equal(filepath.Join(homeDir, ".cf", "uploads")))
 			Expect(config.StagingTimeout()).To(Equal(DefaultStagingTimeout))
 			Expect(config.StartupTimeout()).To(Equal(DefaultStartupTimeout))
 			Expect(config.Locale()).To(BeEmpty())
@@ -277,6 +280,18 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("UAAGrantType", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{"UAAGrantType": "client_credentials"}`)
+			})
+
+			It("returns the grant type", func() {
+				config, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.UAAGrantType()).To(Equal("client_credentials"))
+			})
+		})
+
 		Describe("UAAOAuthClientSecret", func() {
 			var config *Config
 
@@ -388,6 +403,18 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("AuthorizationEndpoint", func() {
+			It("returns the login server URL", func() {
+				config := Config{
+					ConfigFile: CFConfig{
+						AuthorizationEndpoint: "https://login.foo.com",
+					},
+				}
+
+				Expect(config.AuthorizationEndpoint()).To(Equal("https://login.foo.com"))
+			})
+		})
+
 		Describe("MinCLIVersion", func() {
 			It("returns the minimum CLI version the CC requires", func() {
 				config := Config{
@@ -432,6 +459,14 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("OutputFormat", func() {
+			It("returns the output format provided by the flag override", func() {
+				config, err := LoadConfig(FlagOverride{OutputFormat: "yaml"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.OutputFormat()).To(Equal("yaml"))
+			})
+		})
+
 		DescribeTable("Verbose",
 			func(env string, configTrace string, flag bool, expected bool, location []string) {
 				rawConfig := fmt.Sprintf(`{ "Trace":"%s" }`, configTrace)
@@ -478,6 +513,33 @@ var _ = Describe("Config", func() {
 			Entry("CF_TRACE filepath, config trace filepath, '-v': enables verbose AND logging to file for BOTH paths", "/foo/bar", "/baz", true, true, []string{"/foo/bar", "/baz"}),
 		)
 
+		Describe("ResponseCacheTTL", func() {
+			var originalCacheTTL string
+
+			BeforeEach(func() {
+				originalCacheTTL = os.Getenv("CF_CACHE_TTL")
+			})
+
+			AfterEach(func() {
+				os.Setenv("CF_CACHE_TTL", originalCacheTTL)
+			})
+
+			DescribeTable("parses CF_CACHE_TTL",
+				func(envVal string, expectedTTL time.Duration) {
+					os.Setenv("CF_CACHE_TTL", envVal)
+
+					config, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(config.ResponseCacheTTL()).To(Equal(expectedTTL))
+				},
+
+				Entry("unset", "", time.Duration(0)),
+				Entry("seconds", "30", 30*time.Second),
+				Entry("negative", "-5", time.Duration(0)),
+				Entry("invalid", "banana", time.Duration(0)),
+			)
+		})
+
 		Describe("DialTimeout", func() {
 			var (
 				originalDialTimeout string
@@ -580,6 +642,212 @@ var _ = Describe("Config", func() {
 				Expect(ok).To(BeTrue())
 			})
 		})
+
+		It("does not leave temporary files behind", func() {
+			Expect(WriteConfig(config)).To(Succeed())
+
+			files, err := ioutil.ReadDir(filepath.Join(homeDir, ".cf"))
+			Expect(err).ToNot(HaveOccurred())
+
+			var names []string
+			for _, file := range files {
+				names = append(names, file.Name())
+			}
+			Expect(names).To(ConsistOf("config.json", "config.json.lock"))
+		})
+
+		Context("when several writers write at the same time", func() {
+			It("always leaves a complete config", func() {
+				var wg sync.WaitGroup
+				for i := 0; i < 10; i++ {
+					wg.Add(1)
+					go func(i int) {
+						defer GinkgoRecover()
+						defer wg.Done()
+
+						writer := &Config{ConfigFile: CFConfig{Target: fmt.Sprintf("https://api-%d.com", i)}}
+						Expect(WriteConfig(writer)).To(Succeed())
+					}(i)
+				}
+				wg.Wait()
+
+				file, err := ioutil.ReadFile(filepath.Join(homeDir, ".cf", "config.json"))
+				Expect(err).ToNot(HaveOccurred())
+
+				var writtenCFConfig CFConfig
+				Expect(json.Unmarshal(file, &writtenCFConfig)).To(Succeed())
+				Expect(writtenCFConfig.Target).To(HavePrefix("https://api-"))
+			})
+		})
+
+		Context("when another process refreshed the tokens after the config was loaded", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{"Target": "https://api.foo.com", "AccessToken": "old-access-token", "RefreshToken": "old-refresh-token"}`)
+
+				var err error
+				config, err = LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+
+				setConfig(homeDir, `{"Target": "https://api.foo.com", "AccessToken": "new-access-token", "RefreshToken": "new-refresh-token"}`)
+			})
+
+			Context("when the config's tokens have not changed", func() {
+				It("keeps the newer tokens", func() {
+					Expect(WriteConfig(config)).To(Succeed())
+
+					newConfig, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newConfig.AccessToken()).To(Equal("new-access-token"))
+					Expect(newConfig.RefreshToken()).To(Equal("new-refresh-token"))
+				})
+			})
+
+			Context("when the config's tokens have changed", func() {
+				BeforeEach(func() {
+					config.SetAccessToken("my-access-token")
+					config.SetRefreshToken("my-refresh-token")
+				})
+
+				It("writes the config's tokens", func() {
+					Expect(WriteConfig(config)).To(Succeed())
+
+					newConfig, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newConfig.AccessToken()).To(Equal("my-access-token"))
+					Expect(newConfig.RefreshToken()).To(Equal("my-refresh-token"))
+				})
+			})
+
+			Context("when the config targets a different API", func() {
+				BeforeEach(func() {
+					config.SetTargetInformation("https://api.bar.com", "", "", "", "", "", "", false)
+				})
+
+				It("writes the config's tokens", func() {
+					Expect(WriteConfig(config)).To(Succeed())
+
+					newConfig, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newConfig.AccessToken()).To(Equal("old-access-token"))
+				})
+			})
+		})
+	})
+
+	Describe("LockTokens", func() {
+		var config *Config
+
+		BeforeEach(func() {
+			setConfig(homeDir, `{"Target": "https://api.foo.com", "AccessToken": "old-access-token", "RefreshToken": "old-refresh-token", "SSLDisabled": true}`)
+
+			var err error
+			config, err = LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stores the refreshed tokens in the config.json, leaving the rest of it as it is", func() {
+			err := config.LockTokens(func() error {
+				config.SetAccessToken("my-access-token")
+				config.SetRefreshToken("my-refresh-token")
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			newConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newConfig.AccessToken()).To(Equal("my-access-token"))
+			Expect(newConfig.RefreshToken()).To(Equal("my-refresh-token"))
+			Expect(newConfig.SkipSSLValidation()).To(BeTrue())
+		})
+
+		Context("when another process refreshed the tokens after the config was loaded", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{"Target": "https://api.foo.com", "AccessToken": "new-access-token", "RefreshToken": "new-refresh-token"}`)
+			})
+
+			It("loads the newer tokens before refreshing", func() {
+				var accessToken string
+				err := config.LockTokens(func() error {
+					accessToken = config.AccessToken()
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(accessToken).To(Equal("new-access-token"))
+				Expect(config.RefreshToken()).To(Equal("new-refresh-token"))
+			})
+		})
+
+		Context("when refreshing fails", func() {
+			It("returns the error and does not store the tokens", func() {
+				err := config.LockTokens(func() error {
+					config.SetAccessToken("my-access-token")
+					return errors.New("refresh error")
+				})
+				Expect(err).To(MatchError("refresh error"))
+
+				newConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newConfig.AccessToken()).To(Equal("old-access-token"))
+			})
+		})
+
+		Context("when a context is applied with --context", func() {
+			BeforeEach(func() {
+				setConfig(homeDir, `{
+					"Target": "https://api.foo.com",
+					"AccessToken": "old-access-token",
+					"RefreshToken": "old-refresh-token",
+					"Contexts": {
+						"prod": {"Target": "https://api.prod.com", "AccessToken": "prod-access-token", "RefreshToken": "prod-refresh-token"}
+					}
+				}`)
+
+				var err error
+				config, err = LoadConfig(FlagOverride{Context: "prod"})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(config.UseContextOverride()).To(Succeed())
+			})
+
+			It("stores the refreshed tokens in that context, leaving the current tokens as they are", func() {
+				err := config.LockTokens(func() error {
+					config.SetAccessToken("my-access-token")
+					config.SetRefreshToken("my-refresh-token")
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+
+				newConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newConfig.AccessToken()).To(Equal("old-access-token"))
+				Expect(newConfig.RefreshToken()).To(Equal("old-refresh-token"))
+				Expect(newConfig.ConfigFile.Contexts["prod"].AccessToken).To(Equal("my-access-token"))
+				Expect(newConfig.ConfigFile.Contexts["prod"].RefreshToken).To(Equal("my-refresh-token"))
+			})
+
+			Context("when another process refreshed the context's tokens after the config was loaded", func() {
+				BeforeEach(func() {
+					setConfig(homeDir, `{
+						"Target": "https://api.foo.com",
+						"AccessToken": "old-access-token",
+						"RefreshToken": "old-refresh-token",
+						"Contexts": {
+							"prod": {"Target": "https://api.prod.com", "AccessToken": "new-prod-access-token", "RefreshToken": "new-prod-refresh-token"}
+						}
+					}`)
+				})
+
+				It("loads the newer tokens before refreshing", func() {
+					var accessToken string
+					err := config.LockTokens(func() error {
+						accessToken = config.AccessToken()
+						return nil
+					})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(accessToken).To(Equal("new-prod-access-token"))
+					Expect(config.RefreshToken()).To(Equal("new-prod-refresh-token"))
+				})
+			})
+		})
 	})
 
 	Describe("setter functions", func() {
@@ -637,6 +905,24 @@ var _ = Describe("Config", func() {
 			})
 		})
 
+		Describe("SetUAAClientCredentials", func() {
+			It("sets the UAA client ID and secret", func() {
+				var config Config
+				config.SetUAAClientCredentials("some-client", "some-secret")
+
+				Expect(config.UAAOAuthClient()).To(Equal("some-client"))
+				Expect(config.UAAOAuthClientSecret()).To(Equal("some-secret"))
+			})
+		})
+
+		Describe("SetUAAGrantType", func() {
+			It("sets the UAA grant type", func() {
+				var config Config
+				config.SetUAAGrantType("client_credentials")
+				Expect(config.ConfigFile.UAAGrantType).To(Equal("client_credentials"))
+			})
+		})
+
 		Describe("SetAccessToken", func() {
 			It("sets the authentication token information", func() {
 				var config Config
@@ -0,0 +1,197 @@
+package configv3
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ContextNotFoundError is returned when a named context has not been saved.
+type ContextNotFoundError struct {
+	Name string
+}
+
+func (e ContextNotFoundError) Error() string {
+	return fmt.Sprintf("context '%s' not found", e.Name)
+}
+
+// NamedContext is a saved API endpoint, its authentication and the targeted
+// organization and space, that can be switched to by name.
+type NamedContext struct {
+	Name                  string       `json:"-"`
+	Target                string       `json:"Target"`
+	APIVersion            string       `json:"APIVersion"`
+	AuthorizationEndpoint string       `json:"AuthorizationEndpoint"`
+	DopplerEndpoint       string       `json:"DopplerEndPoint"`
+	UAAEndpoint           string       `json:"UaaEndpoint"`
+	RoutingEndpoint       string       `json:"RoutingAPIEndpoint"`
+	MinCLIVersion         string       `json:"MinCLIVersion"`
+	AccessToken           string       `json:"AccessToken"`
+	RefreshToken          string       `json:"RefreshToken"`
+	SSHOAuthClient        string       `json:"SSHOAuthClient"`
+	UAAOAuthClient        string       `json:"UAAOAuthClient"`
+	UAAOAuthClientSecret  string       `json:"UAAOAuthClientSecret"`
+	UAAGrantType          string       `json:"UAAGrantType"`
+	TargetedOrganization  Organization `json:"OrganizationFields"`
+	TargetedSpace         Space        `json:"SpaceFields"`
+	SkipSSLValidation     bool         `json:"SSLDisabled"`
+}
+
+// Contexts returns the saved contexts sorted by name.
+func (config *Config) Contexts() []NamedContext {
+	contexts := []NamedContext{}
+	for name, context := range config.ConfigFile.Contexts {
+		context.Name = name
+		contexts = append(contexts, context)
+	}
+	sort.Slice(contexts, func(i, j int) bool {
+		return strings.ToLower(contexts[i].Name) < strings.ToLower(contexts[j].Name)
+	})
+	return contexts
+}
+
+// CurrentContext returns the name of the context that was last switched to or
+// saved, as long as the API endpoint has not been changed since.
+func (config *Config) CurrentContext() string {
+	context, ok := config.ConfigFile.Contexts[config.ConfigFile.CurrentContext]
+	if !ok || context.Target != config.ConfigFile.Target {
+		return ""
+	}
+	return config.ConfigFile.CurrentContext
+}
+
+// SaveContext saves the current API endpoint, authentication and target as
+// the named context, replacing any context with the same name.
+func (config *Config) SaveContext(name string) {
+	config.endContextOverride()
+
+	config.ConfigFile.Contexts = copyContexts(config.ConfigFile.Contexts)
+	config.ConfigFile.Contexts[name] = config.ConfigFile.context()
+	config.ConfigFile.CurrentContext = name
+}
+
+// UseContext switches the API endpoint, authentication and target to the ones
+// saved in the named context. Tokens refreshed while using the previous
+// context are saved back to it first.
+func (config *Config) UseContext(name string) error {
+	config.endContextOverride()
+
+	context, ok := config.ConfigFile.Contexts[name]
+	if !ok {
+		return ContextNotFoundError{Name: name}
+	}
+
+	config.ConfigFile.Contexts = copyContexts(config.ConfigFile.Contexts)
+	config.storeCurrentContextTokens()
+	config.ConfigFile.setContext(context)
+	config.ConfigFile.CurrentContext = name
+	return nil
+}
+
+// UseContextOverride applies the context given with the --context global
+// flag for the current command only. When the config is written, any changes
+// made while the override is in effect are saved to that context and the
+// saved API endpoint, authentication and target are left as they were.
+func (config *Config) UseContextOverride() error {
+	name := config.Flags.Context
+	if name == "" {
+		return nil
+	}
+
+	context, ok := config.ConfigFile.Contexts[name]
+	if !ok {
+		return ContextNotFoundError{Name: name}
+	}
+
+	config.overriddenContext = config.ConfigFile.context()
+	config.contextOverride = name
+	config.ConfigFile.setContext(context)
+	config.loadedTokens = tokens{
+		accessToken:  context.AccessToken,
+		refreshToken: context.RefreshToken,
+	}
+	return nil
+}
+
+// endContextOverride saves the changes made under the --context override to
+// that context and restores the settings it replaced.
+func (config *Config) endContextOverride() {
+	if config.contextOverride == "" {
+		return
+	}
+
+	config.ConfigFile.Contexts = copyContexts(config.ConfigFile.Contexts)
+	config.ConfigFile.Contexts[config.contextOverride] = config.ConfigFile.context()
+	config.ConfigFile.setContext(config.overriddenContext)
+	config.contextOverride = ""
+}
+
+// storeCurrentContextTokens saves the current tokens to the current context,
+// so that tokens refreshed since switching to it are not lost.
+func (config *Config) storeCurrentContextTokens() {
+	name := config.CurrentContext()
+	if name == "" {
+		return
+	}
+
+	current := config.ConfigFile.Contexts[name]
+	current.AccessToken = config.ConfigFile.AccessToken
+	current.RefreshToken = config.ConfigFile.RefreshToken
+	config.ConfigFile.Contexts[name] = current
+}
+
+// persistedConfig returns the CFConfig that should be written to the config
+// file.
+func (config *Config) persistedConfig() CFConfig {
+	persisted := *config
+	persisted.endContextOverride()
+	return persisted.ConfigFile
+}
+
+func (cfConfig CFConfig) context() NamedContext {
+	return NamedContext{
+		Target:                cfConfig.Target,
+		APIVersion:            cfConfig.APIVersion,
+		AuthorizationEndpoint: cfConfig.AuthorizationEndpoint,
+		DopplerEndpoint:       cfConfig.DopplerEndpoint,
+		UAAEndpoint:           cfConfig.UAAEndpoint,
+		RoutingEndpoint:       cfConfig.RoutingEndpoint,
+		MinCLIVersion:         cfConfig.MinCLIVersion,
+		AccessToken:           cfConfig.AccessToken,
+		RefreshToken:          cfConfig.RefreshToken,
+		SSHOAuthClient:        cfConfig.SSHOAuthClient,
+		UAAOAuthClient:        cfConfig.UAAOAuthClient,
+		UAAOAuthClientSecret:  cfConfig.UAAOAuthClientSecret,
+		UAAGrantType:          cfConfig.UAAGrantType,
+		TargetedOrganization:  cfConfig.TargetedOrganization,
+		TargetedSpace:         cfConfig.TargetedSpace,
+		SkipSSLValidation:     cfConfig.SkipSSLValidation,
+	}
+}
+
+func (cfConfig *CFConfig) setContext(context NamedContext) {
+	cfConfig.Target = context.Target
+	cfConfig.APIVersion = context.APIVersion
+	cfConfig.AuthorizationEndpoint = context.AuthorizationEndpoint
+	cfConfig.DopplerEndpoint = context.DopplerEndpoint
+	cfConfig.UAAEndpoint = context.UAAEndpoint
+	cfConfig.RoutingEndpoint = context.RoutingEndpoint
+	cfConfig.MinCLIVersion = context.MinCLIVersion
+	cfConfig.AccessToken = context.AccessToken
+	cfConfig.RefreshToken = context.RefreshToken
+	cfConfig.SSHOAuthClient = context.SSHOAuthClient
+	cfConfig.UAAOAuthClient = context.UAAOAuthClient
+	cfConfig.UAAOAuthClientSecret = context.UAAOAuthClientSecret
+	cfConfig.UAAGrantType = context.UAAGrantType
+	cfConfig.TargetedOrganization = context.TargetedOrganization
+	cfConfig.TargetedSpace = context.TargetedSpace
+	cfConfig.SkipSSLValidation = context.SkipSSLValidation
+}
+
+func copyContexts(contexts map[string]NamedContext) map[string]NamedContext {
+	copied := make(map[string]NamedContext, len(contexts)+1)
+	for name, context := range contexts {
+		copied[name] = context
+	}
+	return copied
+}
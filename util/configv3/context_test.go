@@ -0,0 +1,185 @@
+package configv3_test
+
+import (
+	. "code.cloudfoundry.org/cli/util/configv3"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("NamedContext", func() {
+	var (
+		homeDir string
+		config  *Config
+	)
+
+	BeforeEach(func() {
+		homeDir = setup()
+		setConfig(homeDir, `{
+			"Target": "https://api.dev.com",
+			"AccessToken": "dev-access-token",
+			"RefreshToken": "dev-refresh-token",
+			"OrganizationFields": {"GUID": "dev-org-guid", "Name": "dev-org"},
+			"SpaceFields": {"GUID": "dev-space-guid", "Name": "dev-space"},
+			"CurrentContext": "dev",
+			"Contexts": {
+				"dev": {
+					"Target": "https://api.dev.com",
+					"AccessToken": "dev-access-token",
+					"RefreshToken": "dev-refresh-token",
+					"OrganizationFields": {"GUID": "dev-org-guid", "Name": "dev-org"},
+					"SpaceFields": {"GUID": "dev-space-guid", "Name": "dev-space"}
+				},
+				"Prod": {
+					"Target": "https://api.prod.com",
+					"AccessToken": "prod-access-token",
+					"RefreshToken": "prod-refresh-token",
+					"OrganizationFields": {"GUID": "prod-org-guid", "Name": "prod-org"},
+					"SpaceFields": {"GUID": "prod-space-guid", "Name": "prod-space"},
+					"SSLDisabled": true
+				}
+			}
+		}`)
+
+		var err error
+		config, err = LoadConfig()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		teardown(homeDir)
+	})
+
+	Describe("Contexts", func() {
+		It("returns the contexts sorted by name", func() {
+			contexts := config.Contexts()
+			Expect(contexts).To(HaveLen(2))
+			Expect(contexts[0].Name).To(Equal("dev"))
+			Expect(contexts[0].Target).To(Equal("https://api.dev.com"))
+			Expect(contexts[1].Name).To(Equal("Prod"))
+			Expect(contexts[1].TargetedSpace.Name).To(Equal("prod-space"))
+		})
+	})
+
+	Describe("CurrentContext", func() {
+		It("returns the current context", func() {
+			Expect(config.CurrentContext()).To(Equal("dev"))
+		})
+
+		Context("when the API endpoint has changed", func() {
+			BeforeEach(func() {
+				config.SetTargetInformation("https://api.other.com", "", "", "", "", "", "", false)
+			})
+
+			It("returns an empty string", func() {
+				Expect(config.CurrentContext()).To(BeEmpty())
+			})
+		})
+	})
+
+	Describe("SaveContext", func() {
+		It("saves the current settings as the named context", func() {
+			config.SetSpaceInformation("other-space-guid", "other-space", true)
+			config.SaveContext("staging")
+
+			Expect(config.CurrentContext()).To(Equal("staging"))
+			Expect(WriteConfig(config)).To(Succeed())
+
+			newConfig, err := LoadConfig()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newConfig.Contexts()).To(HaveLen(3))
+			Expect(newConfig.Contexts()[2].Name).To(Equal("staging"))
+			Expect(newConfig.Contexts()[2].Target).To(Equal("https://api.dev.com"))
+			Expect(newConfig.Contexts()[2].AccessToken).To(Equal("dev-access-token"))
+			Expect(newConfig.Contexts()[2].TargetedSpace).To(Equal(Space{GUID: "other-space-guid", Name: "other-space", AllowSSH: true}))
+		})
+	})
+
+	Describe("UseContext", func() {
+		It("switches to the named context", func() {
+			Expect(config.UseContext("Prod")).To(Succeed())
+
+			Expect(config.Target()).To(Equal("https://api.prod.com"))
+			Expect(config.AccessToken()).To(Equal("prod-access-token"))
+			Expect(config.RefreshToken()).To(Equal("prod-refresh-token"))
+			Expect(config.TargetedOrganization().Name).To(Equal("prod-org"))
+			Expect(config.TargetedSpace().Name).To(Equal("prod-space"))
+			Expect(config.SkipSSLValidation()).To(BeTrue())
+			Expect(config.CurrentContext()).To(Equal("Prod"))
+		})
+
+		It("saves refreshed tokens to the previous context", func() {
+			config.SetAccessToken("refreshed-access-token")
+			Expect(config.UseContext("Prod")).To(Succeed())
+			Expect(config.UseContext("dev")).To(Succeed())
+
+			Expect(config.AccessToken()).To(Equal("refreshed-access-token"))
+		})
+
+		Context("when the context does not exist", func() {
+			It("returns a ContextNotFoundError", func() {
+				Expect(config.UseContext("staging")).To(MatchError(ContextNotFoundError{Name: "staging"}))
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+			})
+		})
+	})
+
+	Describe("UseContextOverride", func() {
+		Context("when no context is given", func() {
+			It("does nothing", func() {
+				Expect(config.UseContextOverride()).To(Succeed())
+				Expect(config.Target()).To(Equal("https://api.dev.com"))
+			})
+		})
+
+		Context("when a context is given", func() {
+			BeforeEach(func() {
+				var err error
+				config, err = LoadConfig(FlagOverride{Context: "Prod"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.UseContextOverride()).To(Succeed())
+			})
+
+			It("applies the context", func() {
+				Expect(config.Target()).To(Equal("https://api.prod.com"))
+				Expect(config.AccessToken()).To(Equal("prod-access-token"))
+				Expect(config.TargetedSpace().Name).To(Equal("prod-space"))
+			})
+
+			It("saves changes to the context and leaves the current target alone when written", func() {
+				config.SetAccessToken("refreshed-prod-access-token")
+				Expect(WriteConfig(config)).To(Succeed())
+
+				newConfig, err := LoadConfig()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(newConfig.Target()).To(Equal("https://api.dev.com"))
+				Expect(newConfig.AccessToken()).To(Equal("dev-access-token"))
+				Expect(newConfig.TargetedSpace().Name).To(Equal("dev-space"))
+				Expect(newConfig.Contexts()[1].AccessToken).To(Equal("refreshed-prod-access-token"))
+			})
+
+			Context("when switching contexts", func() {
+				It("switches away from the original settings", func() {
+					Expect(config.UseContext("dev")).To(Succeed())
+					Expect(WriteConfig(config)).To(Succeed())
+
+					newConfig, err := LoadConfig()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(newConfig.Target()).To(Equal("https://api.dev.com"))
+					Expect(newConfig.CurrentContext()).To(Equal("dev"))
+				})
+			})
+		})
+
+		Context("when the context does not exist", func() {
+			It("returns a ContextNotFoundError", func() {
+				var err error
+				config, err = LoadConfig(FlagOverride{Context: "staging"})
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(config.UseContextOverride()).To(MatchError(ContextNotFoundError{Name: "staging"}))
+			})
+		})
+	})
+})
@@ -0,0 +1,19 @@
+//go:build !windows
+// +build !windows
+
+package configv3
+
+import (
+	"os"
+	"syscall"
+)
+
+// lockFile blocks until an exclusive lock is acquired on file.
+func lockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_EX)
+}
+
+// unlockFile releases the lock acquired by lockFile.
+func unlockFile(file *os.File) error {
+	return syscall.Flock(int(file.Fd()), syscall.LOCK_UN)
+}
@@ -3,7 +3,6 @@ package configv3
 import (
 	"encoding/json"
 	"fmt"
-	"io/ioutil"
 	"os"
 	"path/filepath"
 	"sort"
@@ -134,5 +133,8 @@ func (config *Config) WritePluginConfig() error {
 	}
 
 	// Write to file
-	return ioutil.WriteFile(filepath.Join(pluginFileDir, "config.json"), rawConfig, 0600)
+	pluginFilePath := filepath.Join(pluginFileDir, "config.json")
+	return withFileLock(pluginFilePath, func() error {
+		return writeFileAtomically(pluginFilePath, rawConfig, 0600)
+	})
 }
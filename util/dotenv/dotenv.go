@@ -0,0 +1,187 @@
+// Package dotenv reads and writes environment variables in the dotenv
+// format, with one NAME=value line per variable.
+package dotenv
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+const maxLineLength = 1024 * 1024
+
+var validName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// ParseError is returned when a line of dotenv input is not a valid variable.
+type ParseError struct {
+	// Path is the file that was read, when the input was read from a file.
+	Path    string
+	Line    int
+	Message string
+}
+
+func (e ParseError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.Line, e.Message)
+}
+
+// ReadFile parses the dotenv file at path.
+func ReadFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	variables, err := Parse(file)
+	if parseErr, ok := err.(ParseError); ok {
+		parseErr.Path = path
+		return nil, parseErr
+	}
+	return variables, err
+}
+
+// Parse returns the variables in the dotenv input. Blank lines and lines
+// starting with # are ignored, and lines may start with "export". Values may
+// be wrapped in single quotes, which are taken literally, or in double
+// quotes, in which \n, \r, \t, \" and \\ are unescaped. The last value of a
+// variable that is set more than once is used.
+func Parse(r io.Reader) (map[string]string, error) {
+	variables := map[string]string{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, bufio.MaxScanTokenSize), maxLineLength)
+	for lineNumber := 1; scanner.Scan(); lineNumber++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		name, value, err := parseLine(line)
+		if err != nil {
+			return nil, ParseError{Line: lineNumber, Message: err.Error()}
+		}
+		variables[name] = value
+	}
+
+	return variables, scanner.Err()
+}
+
+// Write writes the variables to w as dotenv lines, ordered by name. Values
+// that are not strings are written as JSON, and values are quoted when they
+// would not otherwise be read back as they are.
+func Write(w io.Writer, variables map[string]interface{}) error {
+	names := make([]string, 0, len(variables))
+	for name := range variables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value, ok := variables[name].(string)
+		if !ok {
+			raw, err := json.Marshal(variables[name])
+			if err != nil {
+				return err
+			}
+			value = string(raw)
+		}
+
+		_, err := fmt.Fprintf(w, "%s=%s\n", name, quote(value))
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func parseLine(line string) (string, string, error) {
+	if strings.HasPrefix(line, "export ") {
+		line = strings.TrimSpace(strings.TrimPrefix(line, "export "))
+	}
+
+	index := strings.Index(line, "=")
+	if index == -1 {
+		return "", "", fmt.Errorf("expected NAME=value")
+	}
+
+	name := strings.TrimSpace(line[:index])
+	if !validName.MatchString(name) {
+		return "", "", fmt.Errorf("invalid variable name %q", name)
+	}
+
+	value, err := parseValue(strings.TrimSpace(line[index+1:]))
+	return name, value, err
+}
+
+func parseValue(raw string) (string, error) {
+	switch {
+	case strings.HasPrefix(raw, `'`):
+		end := strings.Index(raw[1:], `'`)
+		if end == -1 {
+			return "", fmt.Errorf("unterminated single quoted value")
+		}
+		return raw[1 : end+1], checkTrailing(raw[end+2:])
+	case strings.HasPrefix(raw, `"`):
+		var value bytes.Buffer
+		for i := 1; i < len(raw); i++ {
+			switch raw[i] {
+			case '"':
+				return value.String(), checkTrailing(raw[i+1:])
+			case '\\':
+				if i+1 == len(raw) {
+					return "", fmt.Errorf("unterminated double quoted value")
+				}
+				i++
+				switch raw[i] {
+				case 'n':
+					value.WriteByte('\n')
+				case 'r':
+					value.WriteByte('\r')
+				case 't':
+					value.WriteByte('\t')
+				default:
+					value.WriteByte(raw[i])
+				}
+			default:
+				value.WriteByte(raw[i])
+			}
+		}
+		return "", fmt.Errorf("unterminated double quoted value")
+	}
+
+	if index := strings.Index(raw, " #"); index != -1 {
+		raw = raw[:index]
+	}
+	if index := strings.Index(raw, "\t#"); index != -1 {
+		raw = raw[:index]
+	}
+	return strings.TrimSpace(raw), nil
+}
+
+// checkTrailing returns an error when anything other than a comment follows
+// a quoted value.
+func checkTrailing(rest string) error {
+	rest = strings.TrimSpace(rest)
+	if rest != "" && !strings.HasPrefix(rest, "#") {
+		return fmt.Errorf("unexpected %q after quoted value", rest)
+	}
+	return nil
+}
+
+// quote wraps the value in double quotes when it contains whitespace or a #,
+// or starts with a quote, so that it is parsed back as it is.
+func quote(value string) string {
+	if !strings.ContainsAny(value, " \t\r\n#") && !strings.HasPrefix(value, `"`) && !strings.HasPrefix(value, `'`) {
+		return value
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + replacer.Replace(value) + `"`
+}
@@ -0,0 +1,141 @@
+package dotenv_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	. "code.cloudfoundry.org/cli/util/dotenv"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("dotenv", func() {
+	Describe("Parse", func() {
+		It("returns the variables, ignoring blank lines and comments", func() {
+			variables, err := Parse(strings.NewReader(`
+# a comment
+PLAIN=value
+export EXPORTED=exported
+  SPACED = spaced value   # trailing comment
+EMPTY=
+SINGLE='literal \n # value'
+DOUBLE="line one\nline \"two\"\t\\" # comment
+EQUALS=a=b
+WINDOWS=crlf` + "\r\n" + `
+PLAIN=last wins
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).To(Equal(map[string]string{
+				"PLAIN":    "last wins",
+				"EXPORTED": "exported",
+				"SPACED":   "spaced value",
+				"EMPTY":    "",
+				"SINGLE":   `literal \n # value`,
+				"DOUBLE":   "line one\nline \"two\"\t\\",
+				"EQUALS":   "a=b",
+				"WINDOWS":  "crlf",
+			}))
+		})
+
+		DescribeTable("invalid lines",
+			func(input string, message string) {
+				_, err := Parse(strings.NewReader("VALID=1\n" + input))
+				Expect(err).To(MatchError(ParseError{Line: 2, Message: message}))
+			},
+			Entry("missing =", "NO_EQUALS", "expected NAME=value"),
+			Entry("invalid name", "1NAME=value", `invalid variable name "1NAME"`),
+			Entry("unterminated single quote", "NAME='value", "unterminated single quoted value"),
+			Entry("unterminated double quote", `NAME="value\"`, "unterminated double quoted value"),
+			Entry("text after a quoted value", `NAME="value" more`, `unexpected "more" after quoted value`),
+		)
+	})
+
+	Describe("ReadFile", func() {
+		var path string
+
+		BeforeEach(func() {
+			file, err := ioutil.TempFile("", "dotenv")
+			Expect(err).ToNot(HaveOccurred())
+			_, err = file.WriteString("NAME=value\n")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(file.Close()).To(Succeed())
+			path = file.Name()
+		})
+
+		AfterEach(func() {
+			Expect(os.RemoveAll(path)).To(Succeed())
+		})
+
+		It("parses the file", func() {
+			variables, err := ReadFile(path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).To(Equal(map[string]string{"NAME": "value"}))
+		})
+
+		Context("when the file is invalid", func() {
+			BeforeEach(func() {
+				Expect(ioutil.WriteFile(path, []byte("NAME=value\nINVALID\n"), 0600)).To(Succeed())
+			})
+
+			It("returns a ParseError with the path", func() {
+				_, err := ReadFile(path)
+				Expect(err).To(MatchError(ParseError{Path: path, Line: 2, Message: "expected NAME=value"}))
+			})
+		})
+
+		Context("when the file does not exist", func() {
+			It("returns the error", func() {
+				_, err := ReadFile(filepath.Join(path, "missing"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Write", func() {
+		It("writes the variables ordered by name, quoting values when needed", func() {
+			buffer := new(bytes.Buffer)
+			err := Write(buffer, map[string]interface{}{
+				"PLAIN":  "value",
+				"SPACES": "some value",
+				"QUOTES": "say \"hi\"\n",
+				"DEBUG":  true,
+				"CONFIG": map[string]interface{}{"port": 8080},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buffer.String()).To(Equal(`CONFIG={"port":8080}
+DEBUG=true
+PLAIN=value
+QUOTES="say \"hi\"\n"
+SPACES="some value"
+`))
+		})
+
+		It("writes values that are parsed back as they were", func() {
+			original := map[string]interface{}{
+				"BACKSLASH": `C:\path`,
+				"COMMENT":   "value # not a comment",
+				"JSON":      `{"a":"b"}`,
+				"QUOTED":    `"quoted"`,
+				"SINGLE":    "it's",
+				"TABS":      "\ta\tb\t",
+			}
+			buffer := new(bytes.Buffer)
+			Expect(Write(buffer, original)).To(Succeed())
+
+			variables, err := Parse(buffer)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(variables).To(Equal(map[string]string{
+				"BACKSLASH": `C:\path`,
+				"COMMENT":   "value # not a comment",
+				"JSON":      `{"a":"b"}`,
+				"QUOTED":    `"quoted"`,
+				"SINGLE":    "it's",
+				"TABS":      "\ta\tb\t",
+			}))
+		})
+	})
+})
@@ -0,0 +1,242 @@
+package glob
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// Ignore holds a list of gitignore-style patterns and decides whether paths
+// should be ignored.
+//
+// Pattern notation follows gitignore:
+//   - blank lines and lines starting with `#` are skipped
+//   - a leading `!` re-includes paths ignored by an earlier pattern
+//   - a trailing `/` only matches directories
+//   - a pattern containing a `/` other than a trailing one is anchored to the
+//     root; otherwise it matches at any depth
+//   - a leading `**/` matches in all directories, a trailing `/**` matches
+//     everything inside a directory and `/**/` matches zero or more
+//     directories
+//   - `*`, `?` and `[...]` match within a single path component
+//   - a backslash escapes the character that follows it
+//
+// As with git, a path inside an ignored directory is always ignored, even if
+// a later pattern re-includes it. Unlike git, leading whitespace is ignored.
+type Ignore struct {
+	rules []ignoreRule
+}
+
+// DefaultIgnoreLines are the patterns that are always ignored when gathering
+// an application's files.
+var DefaultIgnoreLines = []string{
+	".cfignore",
+	"/manifest.yml",
+	".gitignore",
+	".git",
+	".hg",
+	".svn",
+	"_darcs",
+	".DS_Store",
+}
+
+type ignoreRule struct {
+	negate  bool
+	dirOnly bool
+	regexp  *regexp.Regexp
+}
+
+// NewIgnore returns an Ignore built from the patterns in each of the texts,
+// in order. Each text contains one pattern per line. Invalid patterns are
+// skipped.
+func NewIgnore(texts ...string) Ignore {
+	var ignore Ignore
+	for _, text := range texts {
+		for _, line := range strings.Split(text, "\n") {
+			rule, ok := parseIgnoreRule(line)
+			if ok {
+				ignore.rules = append(ignore.rules, rule)
+			}
+		}
+	}
+	return ignore
+}
+
+// NewDefaultIgnore returns an Ignore built from DefaultIgnoreLines followed by
+// the patterns in each of the texts.
+func NewDefaultIgnore(texts ...string) Ignore {
+	return NewIgnore(append([]string{strings.Join(DefaultIgnoreLines, "\n")}, texts...)...)
+}
+
+// Match returns true if the path should be ignored. The path is relative to
+// the root the patterns apply to; a path ending in `/` is a directory.
+// Backslashes are treated as path separators.
+func (ignore Ignore) Match(path string) bool {
+	path = strings.TrimPrefix(toSlash(path), "/")
+	isDir := strings.HasSuffix(path, "/")
+	path = strings.TrimSuffix(path, "/")
+	if path == "" {
+		return false
+	}
+
+	components := strings.Split(path, "/")
+	for i := 1; i < len(components); i++ {
+		if ignore.matchRules(strings.Join(components[:i], "/"), true) {
+			return true
+		}
+	}
+	return ignore.matchRules(path, isDir)
+}
+
+// matchRules returns the result of the last rule matching the path, without
+// considering the path's parent directories.
+func (ignore Ignore) matchRules(path string, isDir bool) bool {
+	ignored := false
+	for _, rule := range ignore.rules {
+		if rule.dirOnly && !isDir {
+			continue
+		}
+		if rule.regexp.MatchString(path) {
+			ignored = !rule.negate
+		}
+	}
+	return ignored
+}
+
+func parseIgnoreRule(line string) (ignoreRule, bool) {
+	line = strings.TrimLeft(strings.TrimSuffix(line, "\r"), " \t")
+	line = trimUnescapedTrailingSpace(line)
+	if line == "" || strings.HasPrefix(line, "#") {
+		return ignoreRule{}, false
+	}
+
+	var rule ignoreRule
+	if strings.HasPrefix(line, "!") {
+		rule.negate = true
+		line = line[1:]
+	}
+
+	if strings.HasSuffix(line, "/") {
+		rule.dirOnly = true
+		line = strings.TrimRight(line, "/")
+	}
+
+	anchored := strings.Contains(line, "/")
+	line = strings.TrimPrefix(line, "/")
+	if line == "" {
+		return ignoreRule{}, false
+	}
+
+	expr := translateIgnorePattern(line)
+	if !anchored {
+		expr = `(?:.*/)?` + expr
+	}
+
+	re, err := regexp.Compile("^" + expr + "$")
+	if err != nil {
+		return ignoreRule{}, false
+	}
+	rule.regexp = re
+	return rule, true
+}
+
+// translateIgnorePattern converts a gitignore pattern, without its leading
+// `!` or trailing `/`, into a regular expression.
+func translateIgnorePattern(pattern string) string {
+	var expr bytes.Buffer
+	runes := []rune(pattern)
+
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				expr.WriteString(regexp.QuoteMeta(string(runes[i])))
+			}
+		case '*':
+			if i+1 < len(runes) && runes[i+1] == '*' && (i == 0 || runes[i-1] == '/') {
+				switch {
+				case i+2 == len(runes):
+					// "**" at the end matches everything inside.
+					expr.WriteString(`.*`)
+					i++
+					continue
+				case runes[i+2] == '/':
+					// "**/" matches zero or more directories.
+					expr.WriteString(`(?:.*/)?`)
+					i += 2
+					continue
+				}
+			}
+			for i+1 < len(runes) && runes[i+1] == '*' {
+				i++
+			}
+			expr.WriteString(`[^/]*`)
+		case '?':
+			expr.WriteString(`[^/]`)
+		case '[':
+			class, width := translateCharacterClass(runes[i:])
+			if width == 0 {
+				expr.WriteString(`\[`)
+				continue
+			}
+			expr.WriteString(class)
+			i += width - 1
+		default:
+			expr.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	return expr.String()
+}
+
+// translateCharacterClass converts the bracket expression at the start of
+// runes into a regular expression, returning the number of runes it used. A
+// width of 0 means the bracket is not closed and should match literally.
+func translateCharacterClass(runes []rune) (string, int) {
+	i := 1
+	negate := false
+	if i < len(runes) && (runes[i] == '!' || runes[i] == '^') {
+		negate = true
+		i++
+	}
+
+	var class bytes.Buffer
+	start := i
+	for ; i < len(runes); i++ {
+		c := runes[i]
+		if c == ']' && i > start {
+			break
+		}
+		switch c {
+		case '\\':
+			if i+1 < len(runes) {
+				i++
+				class.WriteString(regexp.QuoteMeta(string(runes[i])))
+			}
+		case '-':
+			class.WriteRune(c)
+		default:
+			class.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	if i >= len(runes) {
+		return "", 0
+	}
+
+	if negate {
+		return `[^/` + class.String() + `]`, i + 1
+	}
+	return `[` + class.String() + `]`, i + 1
+}
+
+func trimUnescapedTrailingSpace(line string) string {
+	for strings.HasSuffix(line, " ") || strings.HasSuffix(line, "\t") {
+		trimmed := line[:len(line)-1]
+		if strings.HasSuffix(trimmed, `\`) {
+			break
+		}
+		line = trimmed
+	}
+	return line
+}
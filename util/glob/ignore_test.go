@@ -0,0 +1,64 @@
+package glob
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Ignore", func() {
+	DescribeTable("Match",
+		func(patterns string, path string, expected bool) {
+			Expect(NewIgnore(patterns).Match(path)).To(Equal(expected))
+		},
+
+		Entry("matches a name at any depth", "foo", "a/b/foo", true),
+		Entry("matches a directory name at any depth", "foo", "a/foo/", true),
+		Entry("ignores the contents of a matched directory", "foo", "a/foo/bar", true),
+		Entry("does not match a partial name", "foo", "foobar", false),
+		Entry("skips comments", "#foo", "#foo", false),
+		Entry("matches an escaped hash", `\#foo`, "#foo", true),
+		Entry("matches an escaped exclamation mark", `\!foo`, "!foo", true),
+		Entry("trims unescaped trailing spaces", "foo  ", "foo", true),
+		Entry("keeps escaped trailing spaces", `foo\ `, "foo ", true),
+
+		Entry("anchors a pattern with a leading slash", "/foo", "foo", true),
+		Entry("does not match an anchored pattern below the root", "/foo", "a/foo", false),
+		Entry("anchors a pattern with a slash in the middle", "a/foo", "b/a/foo", false),
+		Entry("matches an anchored pattern with a slash in the middle", "a/foo", "a/foo", true),
+
+		Entry("matches a directory-only pattern against a directory", "foo/", "a/foo/", true),
+		Entry("does not match a directory-only pattern against a file", "foo/", "a/foo", false),
+		Entry("ignores files inside a directory-only match", "foo/", "foo/bar", true),
+
+		Entry("matches a star within a component", "*.so", "a/b.so", true),
+		Entry("does not match a star across components", "a/*.so", "a/b/c.so", false),
+		Entry("matches a question mark", "fo?", "fox", true),
+		Entry("matches a character class", "fo[a-z]", "fox", true),
+		Entry("does not match outside a character class", "fo[a-c]", "fox", false),
+		Entry("matches a negated character class", "fo[!a-c]", "fox", true),
+		Entry("matches an unclosed bracket literally", "fo[", "fo[", true),
+
+		Entry("matches a leading double star in all directories", "**/foo", "a/b/foo", true),
+		Entry("matches a leading double star at the root", "**/foo", "foo", true),
+		Entry("matches a trailing double star inside a directory", "a/**", "a/b/c", true),
+		Entry("does not match a trailing double star against the directory", "a/**", "a", false),
+		Entry("matches a middle double star against zero directories", "a/**/b", "a/b", true),
+		Entry("matches a middle double star against many directories", "a/**/b", "a/x/y/b", true),
+
+		Entry("re-includes a negated path", "*.log\n!keep.log", "keep.log", false),
+		Entry("applies the last matching pattern", "*.log\n!*.log\nbad.log", "bad.log", true),
+		Entry("re-includes files in a directory whose contents are ignored", "a/*\n!a/b", "a/b", false),
+		Entry("cannot re-include a file inside an ignored directory", "a/\n!a/b", "a/b", true),
+		Entry("re-includes a directory that was ignored", "foo\n!foo", "foo/bar", false),
+
+		Entry("treats backslashes as separators", "a/b", `a\b`, true),
+		Entry("never matches the root", "*", "/", false),
+	)
+
+	It("applies the patterns of each text in order", func() {
+		ignore := NewIgnore("*.log", "!keep.log")
+		Expect(ignore.Match("keep.log")).To(BeFalse())
+		Expect(ignore.Match("other.log")).To(BeTrue())
+	})
+})
@@ -19,7 +19,7 @@ const (
 func RunCLICommand(cmdName string, args []string, requirementsFactory requirements.Factory, updateFunc func(bool), pluginCall bool, ui *testterm.FakeUI) bool {
 	updateFunc(pluginCall)
 	cmd := commandregistry.Commands.FindCommand(cmdName)
-	context := flags.NewFlagContext(cmd.MetaData().Flags)
+	context := flags.NewFlagContext(cmd.MetaData().CommandLineFlags())
 	context.SkipFlagParsing(cmd.MetaData().SkipFlagParsing)
 	err := context.Parse(args...)
 	if err != nil {
@@ -27,6 +27,10 @@ func RunCLICommand(cmdName string, args []string, requirementsFactory requiremen
 		os.Exit(1)
 	}
 
+	if cmd.MetaData().StructuredOutput {
+		ui.SetOutputFormat(context.String("output"))
+	}
+
 	var requirements []requirements.Requirement
 	requirements, err = cmd.Requirements(requirementsFactory, context)
 	if err != nil {
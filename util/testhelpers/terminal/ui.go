@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/cli/cf/configuration/coreconfig"
 	term "code.cloudfoundry.org/cli/cf/terminal"
+	utilui "code.cloudfoundry.org/cli/util/ui"
 )
 
 type FakeUI struct {
@@ -24,6 +25,8 @@ type FakeUI struct {
 	FailedWithUsageCommandName    string
 	ShowConfigurationCalled       bool
 	NotifyUpdateIfNeededCallCount int
+	OutputFormat                  string
+	StructuredOutput              string
 
 	sayMutex sync.Mutex
 }
@@ -177,3 +180,21 @@ func (ui *FakeUI) Table(headers []string) *term.UITable {
 func (ui *FakeUI) NotifyUpdateIfNeeded(config coreconfig.Reader) {
 	ui.NotifyUpdateIfNeededCallCount += 1
 }
+
+func (ui *FakeUI) SetOutputFormat(format string) {
+	ui.OutputFormat = format
+}
+
+func (ui *FakeUI) StructuredOutputEnabled() bool {
+	return ui.OutputFormat != ""
+}
+
+func (ui *FakeUI) DisplayStructuredData(kind string, data interface{}) error {
+	output, err := utilui.MarshalStructuredData(utilui.OutputFormat(ui.OutputFormat), kind, data)
+	if err != nil {
+		return err
+	}
+
+	ui.StructuredOutput = string(output)
+	return nil
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// OutputFormat is the format that command data is displayed in.
+type OutputFormat string
+
+const (
+	// OutputTable displays command data as human readable tables.
+	OutputTable OutputFormat = ""
+	// OutputJSON displays command data as JSON.
+	OutputJSON OutputFormat = "json"
+	// OutputYAML displays command data as YAML.
+	OutputYAML OutputFormat = "yaml"
+)
+
+// StructuredOutputSchemaVersion is the version of the document written by
+// DisplayStructuredData. It is incremented whenever a field is removed or
+// changes meaning.
+const StructuredOutputSchemaVersion = 1
+
+// StructuredDocument is the envelope that wraps all structured command data.
+type StructuredDocument struct {
+	SchemaVersion int         `json:"schema_version"`
+	Kind          string      `json:"kind"`
+	Data          interface{} `json:"data"`
+}
+
+// StructuredOutputEnabled returns true when command data should be displayed
+// with DisplayStructuredData instead of tables.
+func (ui *UI) StructuredOutputEnabled() bool {
+	return ui.OutputFormat != OutputTable
+}
+
+// DisplayStructuredData outputs data, wrapped in a StructuredDocument of the
+// given kind, to ui.Out in the configured output format. The field names are
+// taken from the JSON tags of data for both formats.
+func (ui *UI) DisplayStructuredData(kind string, data interface{}) error {
+	output, err := MarshalStructuredData(ui.OutputFormat, kind, data)
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = fmt.Fprintf(ui.Out, "%s\n", output)
+	return err
+}
+
+// MarshalStructuredData returns data, wrapped in a StructuredDocument of the
+// given kind, in the given output format without a trailing newline.
+func MarshalStructuredData(format OutputFormat, kind string, data interface{}) ([]byte, error) {
+	document := StructuredDocument{
+		SchemaVersion: StructuredOutputSchemaVersion,
+		Kind:          kind,
+		Data:          data,
+	}
+
+	output, err := json.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	if format == OutputYAML {
+		// JSON is valid YAML, so the JSON document is converted to preserve
+		// the JSON field names and order.
+		var content yaml.MapSlice
+		err = yaml.Unmarshal(output, &content)
+		if err != nil {
+			return nil, err
+		}
+
+		output, err = yaml.Marshal(content)
+		if err != nil {
+			return nil, err
+		}
+		output = output[:len(output)-1]
+	}
+
+	return output, nil
+}
+
+// StructuredLogMessage is the JSON object written for each log message by
+// DisplayLogMessageAsJSON.
+type StructuredLogMessage struct {
+	AppName        string    `json:"app_name"`
+	Timestamp      time.Time `json:"timestamp"`
+	SourceType     string    `json:"source_type"`
+	SourceInstance string    `json:"source_instance"`
+	Type           string    `json:"type"`
+	Message        string    `json:"message"`
+}
+
+// DisplayLogMessageAsJSON outputs the log message of the application to ui.Out
+// as a single line JSON object, so that a stream of messages can be processed
+// line by line.
+func (ui *UI) DisplayLogMessageAsJSON(appName string, message LogMessage) error {
+	output, err := json.Marshal(StructuredLogMessage{
+		AppName:        appName,
+		Timestamp:      message.Timestamp().UTC(),
+		SourceType:     message.SourceType(),
+		SourceInstance: message.SourceInstance(),
+		Type:           message.Type(),
+		Message:        strings.TrimRight(message.Message(), "\r\n"),
+	})
+	if err != nil {
+		return err
+	}
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	_, err = fmt.Fprintf(ui.Out, "%s\n", output)
+	return err
+}
@@ -0,0 +1,133 @@
+package ui_test
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/cli/util/configv3"
+	. "code.cloudfoundry.org/cli/util/ui"
+	"code.cloudfoundry.org/cli/util/ui/uifakes"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gbytes"
+)
+
+var _ = Describe("Structured Output", func() {
+	type someData struct {
+		Name  string   `json:"name"`
+		Count int      `json:"count"`
+		Tags  []string `json:"tags"`
+	}
+
+	var (
+		ui         *UI
+		fakeConfig *uifakes.FakeConfig
+		data       someData
+	)
+
+	BeforeEach(func() {
+		fakeConfig = new(uifakes.FakeConfig)
+		fakeConfig.ColorEnabledReturns(configv3.ColorDisabled)
+
+		data = someData{Name: "some-name", Count: 2, Tags: []string{"a", "b"}}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		ui, err = NewUI(fakeConfig)
+		Expect(err).NotTo(HaveOccurred())
+
+		ui.Out = NewBuffer()
+		ui.Err = NewBuffer()
+	})
+
+	Context("when the output format is not set", func() {
+		It("disables structured output and displays text to Out", func() {
+			Expect(ui.StructuredOutputEnabled()).To(BeFalse())
+
+			ui.DisplayText("some text")
+			ui.DisplayOK()
+			Expect(ui.Out).To(Say("some text\nOK\n"))
+		})
+	})
+
+	Context("when the output format is json", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns("json")
+		})
+
+		It("enables structured output", func() {
+			Expect(ui.StructuredOutputEnabled()).To(BeTrue())
+		})
+
+		It("displays text to Err", func() {
+			ui.DisplayText("some text")
+			ui.DisplayKeyValueTable("", [][]string{{"key:", "value"}}, 3)
+			Expect(ui.Err).To(Say("some text\nkey:   value\n"))
+			Expect(ui.Out).NotTo(Say("some text"))
+		})
+
+		It("displays the data as a versioned JSON document to Out", func() {
+			Expect(ui.DisplayStructuredData("some-kind", data)).To(Succeed())
+			Expect(ui.Out).To(Say(`{
+  "schema_version": 1,
+  "kind": "some-kind",
+  "data": {
+    "name": "some-name",
+    "count": 2,
+    "tags": \[
+      "a",
+      "b"
+    \]
+  }
+}
+`))
+		})
+	})
+
+	Context("when the output format is yaml", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns("yaml")
+		})
+
+		It("displays the data as a versioned YAML document to Out, using the JSON field names", func() {
+			Expect(ui.DisplayStructuredData("some-kind", data)).To(Succeed())
+			Expect(ui.Out).To(Say(`schema_version: 1
+kind: some-kind
+data:
+  name: some-name
+  count: 2
+  tags:
+  - a
+  - b
+`))
+		})
+	})
+
+	Context("when the data cannot be marshalled", func() {
+		BeforeEach(func() {
+			fakeConfig.OutputFormatReturns("json")
+		})
+
+		It("returns the error", func() {
+			Expect(ui.DisplayStructuredData("some-kind", make(chan int))).NotTo(Succeed())
+		})
+	})
+
+	Describe("DisplayLogMessageAsJSON", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nwith two lines\r\n")
+			message.TypeReturns("ERR")
+			message.TimestampReturns(time.Unix(1468969692, 0))
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("displays the message as a single line JSON object to Out", func() {
+			Expect(ui.DisplayLogMessageAsJSON("some-app", message)).To(Succeed())
+			Expect(ui.Out).To(Say(`{"app_name":"some-app","timestamp":"2016-07-19T23:08:12Z","source_type":"APP/PROC/WEB","source_instance":"12","type":"ERR","message":"This is a log message\\nwith two lines"}\n`))
+		})
+	})
+})
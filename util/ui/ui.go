@@ -7,6 +7,7 @@ package ui
 
 import (
 	"fmt"
+	"hash/fnv"
 	"io"
 	"os"
 	"strings"
@@ -33,6 +34,9 @@ type Config interface {
 	IsTTY() bool
 	// TerminalWidth returns the width of the terminal
 	TerminalWidth() int
+	// OutputFormat is the format command data is displayed in; empty for
+	// tables
+	OutputFormat() string
 }
 
 //go:generate counterfeiter . TranslatableError
@@ -75,6 +79,11 @@ type UI struct {
 	IsTTY         bool
 	TerminalWidth int
 
+	// OutputFormat is the format used by DisplayStructuredData. When it is
+	// set, all other output is written to Err so that Out only contains the
+	// structured data.
+	OutputFormat OutputFormat
+
 	TimezoneLocation *time.Location
 }
 
@@ -98,6 +107,7 @@ func NewUI(config Config) (*UI, error) {
 		fileLock:         &sync.Mutex{},
 		IsTTY:            config.IsTTY(),
 		TerminalWidth:    config.TerminalWidth(),
+		OutputFormat:     OutputFormat(config.OutputFormat()),
 		TimezoneLocation: location,
 	}, nil
 }
@@ -146,12 +156,21 @@ func (ui *UI) Writer() io.Writer {
 	return ui.Out
 }
 
+// textOutput returns the writer for human readable output, which is Err when
+// structured output is enabled.
+func (ui *UI) textOutput() io.Writer {
+	if ui.StructuredOutputEnabled() {
+		return ui.Err
+	}
+	return ui.Out
+}
+
 // DisplayOK outputs a bold green translated "OK" to UI.Out.
 func (ui *UI) DisplayOK() {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
+	fmt.Fprintf(ui.textOutput(), "%s\n", ui.modifyColor(ui.TranslateText("OK"), color.New(color.FgGreen, color.Bold)))
 }
 
 // DisplayNewline outputs a newline to UI.Out.
@@ -159,7 +178,7 @@ func (ui *UI) DisplayNewline() {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "\n")
+	fmt.Fprintf(ui.textOutput(), "\n")
 }
 
 // DisplayBoolPrompt outputs the prompt and waits for user input. It only
@@ -172,11 +191,25 @@ func (ui *UI) DisplayBoolPrompt(defaultResponse bool, template string, templateV
 	response := defaultResponse
 	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
 	interactivePrompt.Input = ui.In
-	interactivePrompt.Output = ui.Out
+	interactivePrompt.Output = ui.textOutput()
 	err := interactivePrompt.Resolve(&response)
 	return response, err
 }
 
+// DisplayPasswordPrompt outputs the prompt and waits for user input without
+// echoing it.
+func (ui *UI) DisplayPasswordPrompt(template string, templateValues ...map[string]interface{}) (string, error) {
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	var password interact.Password
+	interactivePrompt := interact.NewInteraction(ui.TranslateText(template, templateValues...))
+	interactivePrompt.Input = ui.In
+	interactivePrompt.Output = ui.textOutput()
+	err := interactivePrompt.Resolve(interact.Required(&password))
+	return string(password), err
+}
+
 // DisplayNonWrappingTable outputs a matrix of strings as a table to UI.Out. Prefix will
 // be prepended to each row and padding adds the specified number of spaces
 // between columns.
@@ -203,16 +236,16 @@ func (ui *UI) DisplayNonWrappingTable(prefix string, table [][]string, padding i
 	}
 
 	for row := 0; row < rows; row++ {
-		fmt.Fprintf(ui.Out, prefix)
+		fmt.Fprintf(ui.textOutput(), prefix)
 		for col := 0; col < columns; col++ {
 			data := table[row][col]
 			var addedPadding int
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - wordSize(data)
 			}
-			fmt.Fprintf(ui.Out, "%s%s", data, strings.Repeat(" ", addedPadding))
+			fmt.Fprintf(ui.textOutput(), "%s%s", data, strings.Repeat(" ", addedPadding))
 		}
-		fmt.Fprintf(ui.Out, "\n")
+		fmt.Fprintf(ui.textOutput(), "\n")
 	}
 }
 
@@ -271,7 +304,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 	lastColumnWidth := ui.TerminalWidth - spilloverPadding
 
 	for row := 0; row < rows; row++ {
-		fmt.Fprintf(ui.Out, prefix)
+		fmt.Fprintf(ui.textOutput(), prefix)
 
 		// for all columns except last, add cell value and padding
 		for col := 0; col < columns-1; col++ {
@@ -279,7 +312,7 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 			if col+1 != columns {
 				addedPadding = columnPadding[col] - runewidth.StringWidth(table[row][col])
 			}
-			fmt.Fprintf(ui.Out, "%s%s", table[row][col], strings.Repeat(" ", addedPadding))
+			fmt.Fprintf(ui.textOutput(), "%s%s", table[row][col], strings.Repeat(" ", addedPadding))
 		}
 
 		// for last column, add each word individually. If the added word would make the column exceed terminal width, create a new line and add padding
@@ -290,17 +323,17 @@ func (ui *UI) displayWrappingTableWithWidth(prefix string, table [][]string, pad
 			wordWidth := runewidth.StringWidth(word)
 			if currentWidth == 0 {
 				currentWidth = wordWidth
-				fmt.Fprintf(ui.Out, "%s", word)
+				fmt.Fprintf(ui.textOutput(), "%s", word)
 			} else if wordWidth+1+currentWidth > lastColumnWidth {
-				fmt.Fprintf(ui.Out, "\n%s%s", strings.Repeat(" ", spilloverPadding), word)
+				fmt.Fprintf(ui.textOutput(), "\n%s%s", strings.Repeat(" ", spilloverPadding), word)
 				currentWidth = wordWidth
 			} else {
-				fmt.Fprintf(ui.Out, " %s", word)
+				fmt.Fprintf(ui.textOutput(), " %s", word)
 				currentWidth += wordWidth + 1
 			}
 		}
 
-		fmt.Fprintf(ui.Out, "\n")
+		fmt.Fprintf(ui.textOutput(), "\n")
 	}
 }
 
@@ -320,7 +353,7 @@ func (ui *UI) DisplayText(template string, templateValues ...map[string]interfac
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, templateValues...))
+	fmt.Fprintf(ui.textOutput(), "%s\n", ui.TranslateText(template, templateValues...))
 }
 
 // DisplayHeader translates the header, bolds and adds the default color to the
@@ -329,7 +362,7 @@ func (ui *UI) DisplayHeader(text string) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText(text), color.New(color.Bold)))
+	fmt.Fprintf(ui.textOutput(), "%s\n", ui.modifyColor(ui.TranslateText(text), color.New(color.Bold)))
 }
 
 // DisplayTextWithFlavor translates the template, bolds and adds cyan color to
@@ -343,7 +376,7 @@ func (ui *UI) DisplayTextWithFlavor(template string, templateValues ...map[strin
 	for key, value := range firstTemplateValues {
 		firstTemplateValues[key] = ui.modifyColor(fmt.Sprint(value), color.New(color.FgCyan, color.Bold))
 	}
-	fmt.Fprintf(ui.Out, "%s\n", ui.TranslateText(template, firstTemplateValues))
+	fmt.Fprintf(ui.textOutput(), "%s\n", ui.TranslateText(template, firstTemplateValues))
 }
 
 // DisplayWarning translates the warning, substitutes in templateValues, and
@@ -374,7 +407,7 @@ func (ui *UI) DisplayError(err error) {
 	ui.terminalLock.Lock()
 	defer ui.terminalLock.Unlock()
 
-	fmt.Fprintf(ui.Out, "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
+	fmt.Fprintf(ui.textOutput(), "%s\n", ui.modifyColor(ui.TranslateText("FAILED"), color.New(color.FgRed, color.Bold)))
 }
 
 const LogTimestampFormat = "2006-01-02T15:04:05.00-0700"
@@ -401,7 +434,46 @@ func (ui *UI) DisplayLogMessage(message LogMessage, displayHeader bool) {
 		if message.Type() == "ERR" {
 			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
 		}
-		fmt.Fprintf(ui.Out, "%s\n", logLine)
+		fmt.Fprintf(ui.textOutput(), "%s\n", logLine)
+	}
+}
+
+// appNameColors are the colors used to tell the log messages of several
+// applications apart.
+var appNameColors = []color.Attribute{
+	color.FgCyan,
+	color.FgMagenta,
+	color.FgYellow,
+	color.FgBlue,
+	color.FgGreen,
+}
+
+// DisplayAppLogMessage formats and outputs a given log message, prefixed by
+// the name of the application it is from. Each application name is always
+// displayed in the same color.
+func (ui *UI) DisplayAppLogMessage(appName string, message LogMessage) {
+	hash := fnv.New32a()
+	hash.Write([]byte(appName))
+	appColor := appNameColors[hash.Sum32()%uint32(len(appNameColors))]
+
+	ui.terminalLock.Lock()
+	defer ui.terminalLock.Unlock()
+
+	time := message.Timestamp().In(ui.TimezoneLocation).Format(LogTimestampFormat)
+	prefix := ui.modifyColor(appName, color.New(appColor, color.Bold))
+	header := fmt.Sprintf("%s [%s/%s] %s ",
+		time,
+		message.SourceType(),
+		message.SourceInstance(),
+		message.Type(),
+	)
+
+	for _, line := range strings.Split(message.Message(), "\n") {
+		logLine := fmt.Sprintf("%s%s", header, strings.TrimRight(line, "\r\n"))
+		if message.Type() == "ERR" {
+			logLine = ui.modifyColor(logLine, color.New(color.FgRed))
+		}
+		fmt.Fprintf(ui.textOutput(), "%s | %s\n", prefix, logLine)
 	}
 }
 
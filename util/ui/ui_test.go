@@ -140,6 +140,24 @@ var _ = Describe("UI", func() {
 		})
 	})
 
+	Describe("DisplayPasswordPrompt", func() {
+		var inBuffer *Buffer
+
+		BeforeEach(func() {
+			inBuffer = NewBuffer()
+			ui.In = inBuffer
+			inBuffer.Write([]byte("some-passcode\n"))
+		})
+
+		It("displays the prompt and returns the user's input", func() {
+			response, err := ui.DisplayPasswordPrompt("some-prompt")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(response).To(Equal("some-passcode"))
+			Expect(ui.Out).To(Say("some-prompt"))
+			Expect(ui.Out).ToNot(Say("some-passcode"))
+		})
+	})
+
 	Describe("DisplayBoolPrompt", func() {
 		var inBuffer *Buffer
 
@@ -534,4 +552,27 @@ var _ = Describe("UI", func() {
 			})
 		})
 	})
+
+	Describe("DisplayAppLogMessage", func() {
+		var message *uifakes.FakeLogMessage
+
+		BeforeEach(func() {
+			var err error
+			ui.TimezoneLocation, err = time.LoadLocation("America/Los_Angeles")
+			Expect(err).NotTo(HaveOccurred())
+
+			message = new(uifakes.FakeLogMessage)
+			message.MessageReturns("This is a log message\nThis is also a log message")
+			message.TypeReturns("OUT")
+			message.TimestampReturns(time.Unix(1468969692, 0)) // "2016-07-19T16:08:12-07:00"
+			message.SourceTypeReturns("APP/PROC/WEB")
+			message.SourceInstanceReturns("12")
+		})
+
+		It("prefixes each line with the colored app name", func() {
+			ui.DisplayAppLogMessage("some-app", message)
+			Expect(ui.Out).To(Say("\x1b\\[3\\d;1msome-app\x1b\\[0m \\| 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is a log message\n"))
+			Expect(ui.Out).To(Say("some-app\x1b\\[0m \\| 2016-07-19T16:08:12.00-0700 \\[APP/PROC/WEB/12\\] OUT This is also a log message\n"))
+		})
+	})
 })